@@ -178,6 +178,20 @@ blob_cb(void *arg, int rc)
 	blob_common_cb(ba, rc);
 }
 
+static void
+blob_resize_cb(void *arg, int rc)
+{
+	struct blob_msg_arg	*bma = arg;
+
+	/* Persist the new cluster count before reporting completion */
+	if (rc == 0) {
+		spdk_blob_sync_md(bma->bma_ioc->bic_blob, blob_cb, bma);
+		return;
+	}
+
+	blob_cb(arg, rc);
+}
+
 static void
 blob_wait_completion(struct bio_xs_context *xs_ctxt, struct blob_cp_arg *ba)
 {
@@ -229,6 +243,15 @@ blob_msg_close(void *msg_arg)
 	spdk_blob_close(blob, blob_close_cb, msg_arg);
 }
 
+static void
+blob_msg_resize(void *msg_arg)
+{
+	struct blob_msg_arg	*arg = msg_arg;
+	struct spdk_blob	*blob = arg->bma_ioc->bic_blob;
+
+	spdk_blob_resize(blob, arg->bma_opts.num_clusters, blob_resize_cb, msg_arg);
+}
+
 static void
 bio_bs_unhold(struct bio_blobstore *bbs)
 {
@@ -1186,6 +1209,81 @@ skip_media_error:
 	return rc;
 }
 
+int
+bio_blob_resize(struct bio_io_context *ioctxt, uint64_t blob_sz)
+{
+	struct blob_msg_arg	 bma = { 0 };
+	struct blob_cp_arg	*ba = &bma.bma_cp_arg;
+	struct bio_blobstore	*bbs;
+	uint64_t		 cluster_sz;
+	uint64_t		 num_clusters;
+	uint64_t		 cur_clusters;
+	int			 rc;
+
+	if (ioctxt->bic_dummy) {
+		D_ERROR(DF_UUID": No data blob to be resized\n", DP_UUID(ioctxt->bic_pool_id));
+		return -DER_NOTSUPPORTED;
+	}
+
+	D_ASSERT(ioctxt->bic_xs_ctxt != NULL);
+	if (!is_blob_valid(ioctxt)) {
+		D_ERROR("Blobstore is invalid. blob:%p, closing:%d\n",
+			ioctxt->bic_blob, ioctxt->bic_closing);
+		return -DER_NO_HDL;
+	}
+
+	bbs = ioctxt->bic_xs_blobstore->bxb_blobstore;
+	cluster_sz = spdk_bs_get_cluster_size(bbs->bb_bs);
+	num_clusters = (blob_sz + cluster_sz - 1) / cluster_sz;
+	cur_clusters = spdk_blob_get_num_clusters(ioctxt->bic_blob);
+
+	if (num_clusters == cur_clusters)
+		return 0;
+	if (num_clusters < cur_clusters) {
+		D_ERROR(DF_UUID": Shrinking blob from "DF_U64" to "DF_U64" clusters "
+			"isn't supported\n", DP_UUID(ioctxt->bic_pool_id), cur_clusters,
+			num_clusters);
+		return -DER_NOTSUPPORTED;
+	}
+
+	rc = blob_cp_arg_init(ba);
+	if (rc)
+		return rc;
+
+	rc = bio_bs_hold(bbs);
+	if (rc) {
+		blob_cp_arg_fini(ba);
+		return rc;
+	}
+
+	D_DEBUG(DB_MGMT, "Resizing blob %p from "DF_U64" to "DF_U64" clusters for xs:%p\n",
+		ioctxt->bic_blob, cur_clusters, num_clusters, ioctxt->bic_xs_ctxt);
+
+	ba->bca_inflights = 1;
+	bma.bma_ioc = ioctxt;
+	bma.bma_opts.num_clusters = num_clusters;
+	spdk_thread_send_msg(owner_thread(bbs), blob_msg_resize, &bma);
+
+	/* Wait for blob resize done */
+	blob_wait_completion(ioctxt->bic_xs_ctxt, ba);
+	rc = ba->bca_rc;
+
+	bio_bs_unhold(bbs);
+	blob_cp_arg_fini(ba);
+
+	if (rc != 0) {
+		D_ERROR("Resize blob %p failed for xs:%p rc:%d\n",
+			ioctxt->bic_blob, ioctxt->bic_xs_ctxt, rc);
+		return rc;
+	}
+
+	D_DEBUG(DB_MGMT, "Successfully resized blob %p for xs:%p\n",
+		ioctxt->bic_blob, ioctxt->bic_xs_ctxt);
+
+	/* Keep the blob size recorded in smd in sync with the blob */
+	return smd_pool_set_blob_sz(ioctxt->bic_pool_id, SMD_DEV_TYPE_DATA, blob_sz);
+}
+
 static int
 blob_unmap_sgl(struct bio_io_context *ioctxt, d_sg_list_t *unmap_sgl, uint32_t blk_sz,
 	       unsigned int start_idx, unsigned int unmap_cnt)
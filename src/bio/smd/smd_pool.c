@@ -539,3 +539,36 @@ out:
 	smd_db_unlock();
 	return rc;
 }
+
+int
+smd_pool_set_blob_sz(uuid_t pool_id, enum smd_dev_type st, uint64_t blob_sz)
+{
+	struct smd_pool	pool;
+	struct d_uuid	id;
+	int		rc;
+
+	uuid_copy(id.uuid, pool_id);
+
+	smd_db_lock();
+	rc = smd_db_fetch(TABLE_POOLS[st], &id, sizeof(id), &pool, sizeof(pool));
+	if (rc) {
+		D_ERROR("Failed to fetch smd entry of pool "DF_UUID". "DF_RC"\n",
+			DP_UUID(&id.uuid), DP_RC(rc));
+		goto out;
+	}
+
+	if (pool.sp_blob_sz == blob_sz)
+		goto out;
+
+	pool.sp_blob_sz = blob_sz;
+
+	rc = smd_db_upsert(TABLE_POOLS[st], &id, sizeof(id), &pool, sizeof(pool));
+	if (rc) {
+		D_ERROR("Failed to update blob size of pool "DF_UUID" in smd. "DF_RC"\n",
+			DP_UUID(&id.uuid), DP_RC(rc));
+		goto out;
+	}
+out:
+	smd_db_unlock();
+	return rc;
+}
@@ -404,6 +404,19 @@ ut_pool(void **state)
 	rc = smd_pool_get_info(id3, &pool_info);
 	assert_rc_equal(rc, -DER_NONEXIST);
 
+	rc = smd_pool_set_blob_sz(id1, SMD_DEV_TYPE_DATA, 300);
+	assert_rc_equal(rc, 0);
+
+	rc = smd_pool_set_blob_sz(id3, SMD_DEV_TYPE_DATA, 300);
+	assert_rc_equal(rc, -DER_NONEXIST);
+
+	rc = smd_pool_get_info(id1, &pool_info);
+	assert_rc_equal(rc, 0);
+	verify_pool(pool_info, id1, 10, 100);
+	assert_int_equal(pool_info->spi_blob_sz[SMD_DEV_TYPE_DATA], 300);
+	assert_int_equal(pool_info->spi_blob_sz[SMD_DEV_TYPE_WAL], 100);
+	smd_pool_free_info(pool_info);
+
 	for (i = 0; i < 6; i++) {
 		st = (i < 4) ? SMD_DEV_TYPE_DATA : SMD_DEV_TYPE_DATA + i - 3;
 		rc = smd_pool_get_blob(id1, i, st, &blob_id);
@@ -75,7 +75,9 @@
 	  ds_cont_tgt_epoch_aggregate_handler, &ds_cont_tgt_epoch_aggregate_co_ops)                \
 	X(CONT_TGT_SNAPSHOT_NOTIFY, 0, &CQF_cont_tgt_snapshot_notify,                              \
 	  ds_cont_tgt_snapshot_notify_handler, &ds_cont_tgt_snapshot_notify_co_ops)                \
-	X(CONT_PROP_SET_BYLABEL, 0, &CQF_cont_prop_set_bylabel, ds_cont_set_prop_srv_handler, NULL)
+	X(CONT_PROP_SET_BYLABEL, 0, &CQF_cont_prop_set_bylabel, ds_cont_set_prop_srv_handler,     \
+	  NULL)                                                                                    \
+	X(CONT_PROP_GET, 0, &CQF_cont_prop_get, ds_cont_get_prop_srv_handler, NULL)
 
 /* Define for RPC enum population below */
 #define X(a, ...) a,
@@ -926,6 +928,17 @@ CRT_RPC_DECLARE(cont_prop_set_v8, DAOS_ISEQ_CONT_PROP_SET_V8, DAOS_OSEQ_CONT_PRO
 
 CRT_RPC_DECLARE(cont_prop_set_bylabel, DAOS_ISEQ_CONT_PROP_SET_BYLABEL, DAOS_OSEQ_CONT_PROP_SET)
 
+/* NB: prop get is on the server side only - no version variants */
+#define DAOS_ISEQ_CONT_PROP_GET		/* input fields */		 \
+	((struct cont_op_in)		(cpgi_op)		CRT_VAR) \
+	((uuid_t)			(cpgi_pool_uuid)	CRT_VAR)
+
+#define DAOS_OSEQ_CONT_PROP_GET		/* output fields */		 \
+	((struct cont_op_out)		(cpgo_op)		CRT_VAR) \
+	((daos_prop_t)			(cpgo_prop)		CRT_PTR)
+
+CRT_RPC_DECLARE(cont_prop_get, DAOS_ISEQ_CONT_PROP_GET, DAOS_OSEQ_CONT_PROP_GET)
+
 /* clang-format on */
 
 static inline void
@@ -5584,6 +5584,7 @@ cont_cli_opc_name(crt_opcode_t opc)
 	case CONT_PROP_SET:		return "PROP_SET";
 	case CONT_PROP_SET_BYLABEL:
 		return "PROP_SET_BYLABEL";
+	case CONT_PROP_GET:		return "PROP_GET";
 	case CONT_ACL_UPDATE:		return "ACL_UPDATE";
 	case CONT_ACL_DELETE:		return "ACL_DELETE";
 	case CONT_OPEN_BYLABEL:		return "OPEN_BYLABEL";
@@ -5923,6 +5924,140 @@ out:
 	crt_reply_send(rpc);
 }
 
+/* Send the RPC from a DAOS server instance to the container service */
+int
+ds_cont_svc_get_prop(uuid_t pool_uuid, uuid_t cont_uuid, d_rank_list_t *ranks,
+		     daos_prop_t **prop_out)
+{
+	int                       rc;
+	struct rsvc_client        client;
+	crt_endpoint_t            ep;
+	uuid_t                    null_uuid;
+	struct dss_module_info   *info = dss_get_module_info();
+	crt_rpc_t                *rpc;
+	struct cont_prop_get_in  *in;
+	struct cont_prop_get_out *out;
+
+	D_DEBUG(DB_MGMT, DF_CONT ": Getting container prop\n", DP_CONT(pool_uuid, cont_uuid));
+
+	uuid_clear(null_uuid);
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL; /* primary group */
+	rc        = rsvc_client_choose(&client, &ep);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": cannot find pool service", DP_UUID(pool_uuid));
+		D_GOTO(out_client, rc);
+	}
+
+	rc = cont_req_create(info->dmi_ctx, &ep, CONT_PROP_GET, null_uuid, cont_uuid, null_uuid,
+			     NULL /* req_timep */, &rpc);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": failed to create cont get prop rpc",
+			 DP_CONT(pool_uuid, cont_uuid));
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_copy(in->cpgi_pool_uuid, pool_uuid);
+
+	rc  = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+
+	rc = rsvc_client_complete_rpc(&client, &ep, rc, out->cpgo_op.co_rc, &out->cpgo_op.co_hint);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->cpgo_op.co_rc;
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": failed to get prop", DP_CONT(pool_uuid, cont_uuid));
+		D_GOTO(out_rpc, rc);
+	}
+
+	if (out->cpgo_prop == NULL) {
+		D_ERROR(DF_CONT ": no properties in reply\n", DP_CONT(pool_uuid, cont_uuid));
+		D_GOTO(out_rpc, rc = -DER_PROTO);
+	}
+
+	*prop_out = daos_prop_dup(out->cpgo_prop, false /* pool */, false /* input */);
+	if (*prop_out == NULL)
+		rc = -DER_NOMEM;
+
+out_rpc:
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+void
+ds_cont_get_prop_srv_handler(crt_rpc_t *rpc)
+{
+	int                       rc;
+	struct cont_prop_get_in  *in  = crt_req_get(rpc);
+	struct cont_prop_get_out *out = crt_reply_get(rpc);
+	struct cont_svc          *svc;
+	struct rdb_tx             tx;
+	struct cont              *cont;
+	daos_prop_t              *prop = NULL;
+
+	D_DEBUG(DB_MD, DF_CONT ": processing cont get prop rpc %p\n",
+		DP_CONT(in->cpgi_pool_uuid, in->cpgi_op.ci_uuid), rpc);
+
+	rc = cont_svc_lookup_leader(in->cpgi_pool_uuid, 0, &svc, &out->cpgo_op.co_hint);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to look up cont svc", DP_UUID(in->cpgi_pool_uuid));
+		D_GOTO(out, rc);
+	}
+
+	rc = rdb_tx_begin(svc->cs_rsvc->s_db, svc->cs_rsvc->s_term, &tx);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to start RDB transaction",
+			 DP_UUID(in->cpgi_pool_uuid));
+		D_GOTO(out_svc, rc);
+	}
+
+	ABT_rwlock_rdlock(svc->cs_lock);
+
+	rc = cont_lookup(&tx, svc, in->cpgi_op.ci_uuid, &cont);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": failed to look up container",
+			 DP_CONT(in->cpgi_pool_uuid, in->cpgi_op.ci_uuid));
+		D_GOTO(out_lock, rc);
+	}
+
+	rc = cont_prop_read(&tx, cont, DAOS_CO_QUERY_PROP_ALL, &prop, true);
+	if (rc != 0)
+		DL_ERROR(rc, DF_CONT ": failed to read properties",
+			 DP_CONT(svc->cs_pool_uuid, cont->c_uuid));
+	/* the allocated prop will be freed after the rpc is replied */
+	out->cpgo_prop = prop;
+
+	/* read-only, so no rdb_tx_commit */
+	cont_put(cont);
+out_lock:
+	ABT_rwlock_unlock(svc->cs_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(svc->cs_rsvc, &out->cpgo_op.co_hint);
+	cont_svc_put_leader(svc);
+out:
+	D_DEBUG(DB_MD, DF_CONT ": replying rpc: %p rc=%d\n",
+		DP_CONT(in->cpgi_pool_uuid, in->cpgi_op.ci_uuid), rpc, rc);
+
+	out->cpgo_op.co_rc = rc;
+	crt_reply_send(rpc);
+	daos_prop_free(prop);
+}
+
 int
 ds_cont_get_prop(uuid_t pool_uuid, uuid_t cont_uuid, daos_prop_t **prop_out)
 {
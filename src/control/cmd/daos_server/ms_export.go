@@ -0,0 +1,223 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	sdb "github.com/daos-stack/daos/src/control/system/raft"
+)
+
+const (
+	dumpFormatJSON = "json"
+	dumpFormatYAML = "yaml"
+)
+
+// encodeDatabaseExport serializes the exported database in the requested
+// format. YAML output is derived from the JSON representation so that both
+// formats share the same field names and value encodings.
+func encodeDatabaseExport(de *sdb.DatabaseExport, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(de, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(format) {
+	case dumpFormatJSON:
+		return data, nil
+	case dumpFormatYAML:
+		// JSON is a subset of YAML, so the YAML decoder can read it.
+		var doc yaml.MapSlice
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return yaml.Marshal(doc)
+	default:
+		return nil, errors.Errorf("unsupported format %q", format)
+	}
+}
+
+// decodeDatabaseExport parses an exported database document in either JSON
+// or YAML format.
+func decodeDatabaseExport(data []byte) (*sdb.DatabaseExport, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	doc, err := yamlToJSONValue(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonData, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	de := new(sdb.DatabaseExport)
+	if err := json.Unmarshal(jsonData, de); err != nil {
+		return nil, err
+	}
+
+	return de, nil
+}
+
+// yamlToJSONValue converts the generic maps produced by the YAML decoder into
+// maps that can be encoded as JSON.
+func yamlToJSONValue(in interface{}) (interface{}, error) {
+	switch v := in.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			keyStr, ok := key.(string)
+			if !ok {
+				return nil, errors.Errorf("unexpected non-string key %v", key)
+			}
+			conv, err := yamlToJSONValue(val)
+			if err != nil {
+				return nil, err
+			}
+			out[keyStr] = conv
+		}
+		return out, nil
+	case []interface{}:
+		for i, val := range v {
+			conv, err := yamlToJSONValue(val)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = conv
+		}
+		return v, nil
+	default:
+		return in, nil
+	}
+}
+
+type msDumpCmd struct {
+	dbCfgCmd
+
+	Format string `long:"format" choice:"json" choice:"yaml" default:"json" description:"Output format"`
+	Output string `long:"output" description:"Write output to this file instead of stdout"`
+}
+
+func (cmd *msDumpCmd) Execute([]string) error {
+	if err := common.CheckDupeProcess(); err != nil {
+		return err
+	}
+
+	dbCfg, err := cmd.getDatabaseConfig()
+	if err != nil {
+		return err
+	}
+
+	de, err := sdb.ExportLocalReplica(cmd.Logger, dbCfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to export management service database")
+	}
+
+	data, err := encodeDatabaseExport(de, cmd.Format)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode management service database")
+	}
+
+	if cmd.Output == "" {
+		cmd.Info(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(cmd.Output, data, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write %q", cmd.Output)
+	}
+	cmd.Infof("Wrote %s DB (%d members, %d pools) to %s", dbCfg.SystemName,
+		len(de.Members), len(de.PoolServices), cmd.Output)
+
+	return nil
+}
+
+type msImportCmd struct {
+	dbCfgCmd
+
+	Force bool   `short:"f" long:"force" description:"Don't prompt for confirmation"`
+	Path  string `short:"p" long:"path" description:"Path to JSON or YAML database export file" required:"1"`
+}
+
+func (cmd *msImportCmd) Execute([]string) error {
+	if err := common.CheckDupeProcess(); err != nil {
+		return err
+	}
+
+	dbCfg, err := cmd.getDatabaseConfig()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(cmd.Path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %q", cmd.Path)
+	}
+	de, err := decodeDatabaseExport(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %q", cmd.Path)
+	}
+	if err := de.Validate(); err != nil {
+		return errors.Wrapf(err, "invalid database export in %q", cmd.Path)
+	}
+
+	msg := `
+Running this command will replace the management service database on this
+replica with the contents of the supplied export file. The raft configuration
+will be updated to force this node to be re-bootstrapped into single-node mode.
+Peer replicas will re-join the quorum as they are restarted.
+
+WARNING: This is a potentially-destructive operation. Any local data on this
+replica will be replaced by the imported data, and any uncommitted logs
+on peer replicas will be discarded in favor of the data on this replica.
+
+Requirements:
+  - All other control plane servers must be stopped
+
+After successful completion of this command, the control plane service
+may be started normally across the system.
+
+`
+	if !cmd.Force {
+		cmd.Info(msg)
+		cmd.Infof("Export file %s contains %d members, %d pools and %d checker findings (map version %d)\n",
+			cmd.Path, len(de.Members), len(de.PoolServices), len(de.CheckerFindings), de.MapVersion)
+
+		if !common.GetConsent(cmd.Logger) {
+			return nil
+		}
+	}
+
+	if err := sdb.ImportLocalReplica(cmd.Logger, dbCfg, de); err != nil {
+		return err
+	}
+
+	sInfo, err := sdb.GetLatestSnapshot(cmd.Logger, dbCfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest snapshot after import")
+	}
+
+	cmd.Info("Successfully imported management service database")
+
+	var buf strings.Builder
+	fmt.Fprintln(&buf, "Latest snapshot info:")
+	printSnapshotDetails(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), sInfo)
+	cmd.Info(buf.String())
+
+	return nil
+}
@@ -0,0 +1,108 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+	sdb "github.com/daos-stack/daos/src/control/system/raft"
+)
+
+func TestDaosServer_DatabaseExport_EncodeDecode(t *testing.T) {
+	de := &sdb.DatabaseExport{
+		Version:    sdb.DatabaseExportVersion,
+		MapVersion: 5,
+		NextRank:   3,
+		Members: []*system.Member{
+			system.MockMember(t, 1, system.MemberStateJoined),
+			system.MockMember(t, 2, system.MemberStateExcluded),
+		},
+		PoolServices: []*system.PoolService{
+			{
+				PoolUUID:  uuid.MustParse("4d6c4c7e-3d7a-4a6a-9a2f-6b7c1e6b4b2f"),
+				PoolLabel: "pool1",
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{1, 2},
+			},
+		},
+		SystemAttrs: map[string]string{"foo": "bar"},
+	}
+
+	for name, tc := range map[string]struct {
+		format    string
+		expPrefix string
+		expErr    error
+	}{
+		"json": {
+			format:    dumpFormatJSON,
+			expPrefix: "{",
+		},
+		"yaml": {
+			format:    dumpFormatYAML,
+			expPrefix: fmt.Sprintf("version: %d", sdb.DatabaseExportVersion),
+		},
+		"unknown format": {
+			format: "xml",
+			expErr: errors.New("unsupported format"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := encodeDatabaseExport(de, tc.format)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if !strings.HasPrefix(string(data), tc.expPrefix) {
+				t.Fatalf("expected output to start with %q, got:\n%s", tc.expPrefix, data)
+			}
+
+			got, err := decodeDatabaseExport(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := got.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			// Compare the re-encoded documents rather than the structs
+			// in order to avoid comparing internal state.
+			expJSON, err := encodeDatabaseExport(de, dumpFormatJSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotJSON, err := encodeDatabaseExport(got, dumpFormatJSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(expJSON), string(gotJSON)); diff != "" {
+				t.Fatalf("unexpected round-trip result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDaosServer_decodeDatabaseExport_BadInput(t *testing.T) {
+	_, err := decodeDatabaseExport([]byte("version: [1"))
+	if err == nil {
+		t.Fatal("expected error for malformed input")
+	}
+
+	_, err = decodeDatabaseExport([]byte("version: one"))
+	if err == nil {
+		t.Fatal("expected error for invalid version")
+	}
+}
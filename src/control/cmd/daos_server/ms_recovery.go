@@ -26,6 +26,8 @@ type msCmdRoot struct {
 	Status  msStatusCmd   `command:"status" description:"Show status of the local management service replica"`
 	Recover msRecoveryCmd `command:"recover" description:"Recover the management service using this replica"`
 	Restore msRestoreCmd  `command:"restore" description:"Restore the management service from a snapshot"`
+	Dump    msDumpCmd     `command:"dump" description:"Export the local management service database as JSON or YAML"`
+	Import  msImportCmd   `command:"import" description:"Import a JSON or YAML management service database export into this replica"`
 }
 
 type dbCfgCmd struct {
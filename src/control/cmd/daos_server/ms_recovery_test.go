@@ -39,5 +39,19 @@ func TestDaosServer_MS_Commands_JSON(t *testing.T) {
 			nil,
 			errJSONOutputNotSupported,
 		},
+		{
+			"MS dump",
+			"ms dump -j",
+			nil,
+			nil,
+			errJSONOutputNotSupported,
+		},
+		{
+			"MS import",
+			"ms import -p foo -j",
+			nil,
+			nil,
+			errJSONOutputNotSupported,
+		},
 	})
 }
@@ -76,9 +76,9 @@ func runCmd(t *testing.T, cmd string, log *logging.LeveledLogger, ctlClient cont
 }
 
 // printRequest generates a stable string representation of the
-// supplied UnaryRequest. It only includes exported fields in
-// the output.
-func printRequest(t *testing.T, req control.UnaryRequest) string {
+// supplied UnaryRequest or StreamRequest. It only includes exported
+// fields in the output.
+func printRequest(t *testing.T, req interface{}) string {
 	buf, err := json.Marshal(req)
 	if err != nil {
 		t.Fatalf("unable to print %+v: %s", req, err)
@@ -112,8 +112,12 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		})
 	case *control.PoolDestroyReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolDestroyResp{})
+	case *control.PoolUndeleteReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.PoolEvictReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolEvictResp{})
+	case *control.PoolResizeReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolResizeResp{})
 	case *control.PoolSetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolSetPropResp{})
 	case *control.PoolGetPropReq:
@@ -137,6 +141,12 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemExcludeResp{})
 	case *control.SystemDrainReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDrainResp{})
+	case *control.SystemMaintenanceReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemMaintenanceResp{})
+	case *control.SystemSetQuotaReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.SystemGetQuotaReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetQuotaResp{})
 	case *control.SystemQueryReq:
 		if req.FailOnUnavailable {
 			resp = control.MockMSResponse("", system.ErrRaftUnavail, nil)
@@ -149,12 +159,18 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{})
 	case *control.ListPoolsReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ListPoolsResp{})
+	case *control.ListContainersReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ListContResp{})
 	case *control.ContSetOwnerReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.PoolQueryReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryResp{})
 	case *control.PoolQueryTargetReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryTargetResp{})
+	case *control.PoolForecastReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolForecastResp{})
+	case *control.PoolRebuildHistoryReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolRebuildHistoryResp{})
 	case *control.PoolUpgradeReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolUpgradeResp{})
 	case *control.PoolGetACLReq, *control.PoolOverwriteACLReq,
@@ -192,6 +208,18 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.SystemGetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetPropResp{})
+	case *control.SystemEventsListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemEventsListResp{})
+	case *control.SystemBackupCreateReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemBackupCreateResp{
+			Backup: &mgmtpb.SystemBackup{},
+		})
+	case *control.SystemBackupListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemBackupListResp{})
+	case *control.SystemReplicasListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemReplicasListResp{})
+	case *control.SystemReplicasUpdateReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemReplicasUpdateResp{})
 	case *control.GetAttachInfoReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.GetAttachInfoResp{})
 	case *control.NetworkScanReq:
@@ -241,6 +269,12 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 	return resp, nil
 }
 
+func (bci *bridgeConnInvoker) InvokeStreamRPC(ctx context.Context, sReq control.StreamRequest) error {
+	bci.conn.appendInvocation(printRequest(bci.t, sReq))
+
+	return nil
+}
+
 func runCmdTest(t *testing.T, cmd, expectedCalls string, expectedErr error) {
 	t.Helper()
 	log, buf := logging.NewTestLogger(t.Name())
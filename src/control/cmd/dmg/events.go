@@ -0,0 +1,202 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// rasEventIDsFlag parses a comma-separated list of RAS event names or IDs.
+type rasEventIDsFlag struct {
+	IDs []events.RASID
+}
+
+func (f *rasEventIDsFlag) UnmarshalFlag(fv string) error {
+	for _, str := range strings.Split(fv, ",") {
+		id, err := events.RASIDFromString(str)
+		if err != nil {
+			return err
+		}
+		f.IDs = append(f.IDs, id)
+	}
+
+	return nil
+}
+
+// rasEventTypesFlag parses a comma-separated list of RAS event types.
+type rasEventTypesFlag struct {
+	Types []events.RASTypeID
+}
+
+func (f *rasEventTypesFlag) UnmarshalFlag(fv string) error {
+	for _, str := range strings.Split(fv, ",") {
+		typ, err := events.RASTypeFromString(str)
+		if err != nil {
+			return err
+		}
+		f.Types = append(f.Types, typ)
+	}
+
+	return nil
+}
+
+// rasEventSeveritiesFlag parses a comma-separated list of RAS event
+// severities.
+type rasEventSeveritiesFlag struct {
+	Severities []events.RASSeverityID
+}
+
+func (f *rasEventSeveritiesFlag) UnmarshalFlag(fv string) error {
+	for _, str := range strings.Split(fv, ",") {
+		sev, err := events.RASSeverityFromString(str)
+		if err != nil {
+			return err
+		}
+		f.Severities = append(f.Severities, sev)
+	}
+
+	return nil
+}
+
+// eventTimeFlag parses either an absolute RFC3339 timestamp or a duration
+// relative to the current time (e.g. "2h" for two hours ago).
+type eventTimeFlag struct {
+	time.Time
+}
+
+func (f *eventTimeFlag) UnmarshalFlag(fv string) error {
+	if d, err := time.ParseDuration(fv); err == nil {
+		if d < 0 {
+			return errors.Errorf("invalid negative duration %q", fv)
+		}
+		f.Time = time.Now().Add(-d)
+		return nil
+	}
+
+	t, err := common.ParseTime(fv)
+	if err != nil {
+		return errors.Errorf("invalid time %q (expected RFC3339 timestamp or duration e.g. 2h)", fv)
+	}
+	f.Time = t
+
+	return nil
+}
+
+// eventFilterCmd provides flags to filter RAS events by ID, type, severity,
+// rank or host.
+type eventFilterCmd struct {
+	rankListCmd
+	IDs        rasEventIDsFlag        `long:"id" description:"Comma separated RAS event names or IDs to match (e.g. engine_died,swim_rank_dead)"`
+	Types      rasEventTypesFlag      `long:"type" description:"Comma separated RAS event types to match (STATE_CHANGE,INFO)"`
+	Severities rasEventSeveritiesFlag `long:"severity" description:"Comma separated RAS event severities to match (ERROR,WARNING,NOTICE)"`
+}
+
+// systemEventsCmd is the struct representing the command to display RAS
+// events published on the MS leader.
+type systemEventsCmd struct {
+	baseCtlCmd
+	eventFilterCmd
+	Follow bool                `long:"follow" short:"f" description:"Stream events as they are published until interrupted"`
+	List   systemEventsListCmd `command:"list" description:"List RAS events retained in the MS event history"`
+}
+
+func (cmd *systemEventsCmd) printEvent(evt *events.RASEvent) {
+	if cmd.JSONOutputEnabled() {
+		if err := cmd.OutputJSON(evt, nil); err != nil {
+			cmd.Errorf("failed to output %s event as JSON: %s", evt.ID, err)
+		}
+		return
+	}
+
+	cmd.Info(evt.PrintRAS())
+}
+
+// Execute is run when systemEventsCmd activates.
+func (cmd *systemEventsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system events failed")
+	}()
+
+	if !cmd.Follow {
+		return errors.New("--follow must be specified (or use the list subcommand)")
+	}
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+
+	req := &control.SystemEventsFollowReq{
+		Handler: events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			cmd.printEvent(evt)
+		}),
+	}
+	req.IDs = cmd.IDs.IDs
+	req.Types = cmd.Types.Types
+	req.Severities = cmd.Severities.Severities
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+
+	return control.SystemEventsFollow(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+}
+
+// systemEventsListCmd is the struct representing the command to list RAS
+// events retained in the MS event history.
+type systemEventsListCmd struct {
+	baseCtlCmd
+	eventFilterCmd
+	Since eventTimeFlag `long:"since" description:"Only list events published at or after this time (RFC3339 timestamp or duration ago, e.g. 2h)"`
+	Until eventTimeFlag `long:"until" description:"Only list events published at or before this time (RFC3339 timestamp or duration ago, e.g. 30m)"`
+}
+
+// Execute is run when systemEventsListCmd activates.
+func (cmd *systemEventsListCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system events list failed")
+	}()
+
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+	if !cmd.Since.IsZero() && !cmd.Until.IsZero() && cmd.Until.Before(cmd.Since.Time) {
+		return errors.New("--until time must not be before --since time")
+	}
+
+	req := &control.SystemEventsListReq{
+		Since: cmd.Since.Time,
+		Until: cmd.Until.Time,
+	}
+	req.IDs = cmd.IDs.IDs
+	req.Types = cmd.Types.Types
+	req.Severities = cmd.Severities.Severities
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+
+	resp, err := control.SystemEventsList(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Events) == 0 {
+		cmd.Info("No matching events found in the event history")
+		return nil
+	}
+	for _, evt := range resp.Events {
+		cmd.Info(evt.PrintRAS())
+	}
+
+	return nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,9 @@ package main
 import (
 	"io"
 	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
@@ -122,10 +126,20 @@ type firmwareUpdateCmd struct {
 	ModelID     string `short:"m" long:"model" description:"Limit update to a model ID"`
 	FirmwareRev string `short:"f" long:"fwrev" description:"Limit update to a current firmware revision"`
 	Verbose     bool   `short:"v" long:"verbose" description:"Display verbose output"`
+	Rolling     bool   `long:"rolling" description:"Update one fault domain at a time, stopping its ranks and waiting for pool rebuild before moving on to the next (NVMe only)"`
+	NewFwRev    string `long:"new-fwrev" description:"Firmware revision that updated devices are expected to report (rolling update only; default: any revision other than the original)"`
+	DomainLevel int    `long:"domain-level" description:"Fault domain level to update at a time, counting from the top of the fault domain tree (rolling update only; default: host level)"`
+
+	RejoinTimeout  time.Duration `long:"rejoin-timeout" default:"10m" description:"Maximum time to wait for restarted ranks to rejoin (rolling update only)"`
+	RebuildTimeout time.Duration `long:"rebuild-timeout" description:"Maximum time to wait for pool rebuild after each fault domain (rolling update only; default: no limit)"`
 }
 
 // Execute runs the firmware update command.
 func (cmd *firmwareUpdateCmd) Execute(args []string) error {
+	if cmd.Rolling {
+		return cmd.rollingUpdate()
+	}
+
 	ctx := cmd.MustLogCtx()
 
 	req := &control.FirmwareUpdateReq{
@@ -169,6 +183,56 @@ func (cmd *firmwareUpdateCmd) Execute(args []string) error {
 	return resp.Errors()
 }
 
+// rollingUpdate updates the device firmware one fault domain at a time,
+// verifying the new firmware revision and waiting for the system to recover
+// before moving on to the next fault domain.
+func (cmd *firmwareUpdateCmd) rollingUpdate() (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "rolling firmware update failed")
+	}()
+
+	req := &control.FirmwareRollingUpdateReq{
+		FirmwarePath:   cmd.FilePath,
+		Type:           control.DeviceTypeNVMe,
+		ModelID:        cmd.ModelID,
+		FirmwareRev:    cmd.FirmwareRev,
+		NewFirmwareRev: cmd.NewFwRev,
+		DomainLevel:    cmd.DomainLevel,
+		RejoinTimeout:  cmd.RejoinTimeout,
+		RebuildTimeout: cmd.RebuildTimeout,
+	}
+	if cmd.isSCMUpdate() {
+		req.Type = control.DeviceTypeSCM
+	}
+	if cmd.Devices != "" {
+		req.Devices = strings.Split(cmd.Devices, ",")
+	}
+	req.Hosts.Replace(&cmd.HostList.HostSet)
+	if !cmd.JSONOutputEnabled() {
+		req.ReportFn = func(fd *control.FirmwareRollingDomain) {
+			hosts := strings.Join(fd.Hosts, ",")
+			if fd.Error != "" {
+				cmd.Errorf("fault domain %s (hosts %s): %s failed: %s", fd.Domain, hosts,
+					fd.Stage, fd.Error)
+				return
+			}
+			cmd.Infof("fault domain %s (hosts %s): %s", fd.Domain, hosts, fd.Stage)
+		}
+	}
+
+	resp, err := control.FirmwareRollingUpdate(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if resp != nil {
+		var out strings.Builder
+		pretty.PrintFirmwareRollingUpdate(&out, resp.Domains)
+		cmd.Info(out.String())
+	}
+
+	return err
+}
+
 func (cmd *firmwareUpdateCmd) isSCMUpdate() bool {
 	return cmd.DeviceType == "scm"
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -199,5 +200,23 @@ func TestFirmwareCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Rolling update with no ranks",
+			"firmware update --type=nvme --path=/dont/care --rolling --new-fwrev=FW200",
+			"",
+			errors.New("no ranks to update"),
+		},
+		{
+			"Rolling update with SCM",
+			"firmware update --type=scm --path=/dont/care --rolling",
+			"",
+			errors.New("not supported for SCM"),
+		},
+		{
+			"Rolling update with invalid timeout",
+			"firmware update --type=nvme --path=/dont/care --rolling --rejoin-timeout=10",
+			"",
+			errors.New("missing unit"),
+		},
 	})
 }
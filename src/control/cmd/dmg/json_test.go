@@ -68,7 +68,8 @@ func TestDmg_JsonOutput(t *testing.T) {
 			testArgs := append([]string{"-i", "--json"}, args...)
 			switch strings.Join(args, " ") {
 			case "version", "telemetry config", "telemetry run", "config generate",
-				"manpage", "system set-prop", "support collect-log", "check repair":
+				"manpage", "system set-prop", "support collect-log", "check repair",
+				"storage replace-wizard":
 				return
 			case "storage nvme-rebind":
 				testArgs = append(testArgs, "-l", "foo.com", "-a",
@@ -86,7 +87,7 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, test.MockUUID())
 			case "pool create":
 				testArgs = append(testArgs, "-s", "1TB", "label")
-			case "pool destroy", "pool evict", "pool query", "pool get-acl", "pool upgrade":
+			case "pool destroy", "pool undelete", "pool rebuild-status", "pool doctor", "pool evict", "pool query", "pool get-acl", "pool upgrade":
 				testArgs = append(testArgs, test.MockUUID())
 			case "pool overwrite-acl", "pool update-acl":
 				testArgs = append(testArgs, test.MockUUID(), "-a", aclPath)
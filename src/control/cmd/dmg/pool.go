@@ -15,6 +15,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -32,23 +33,29 @@ import (
 
 // PoolCmd is the struct representing the top-level pool subcommand.
 type PoolCmd struct {
-	Create       poolCreateCmd       `command:"create" description:"Create a DAOS pool"`
-	Destroy      poolDestroyCmd      `command:"destroy" description:"Destroy a DAOS pool"`
-	Evict        poolEvictCmd        `command:"evict" description:"Evict all pool connections to a DAOS pool"`
-	List         poolListCmd         `command:"list" alias:"ls" description:"List DAOS pools"`
-	Extend       poolExtendCmd       `command:"extend" description:"Extend a DAOS pool to include new ranks."`
-	Exclude      poolExcludeCmd      `command:"exclude" description:"Exclude targets from a rank"`
-	Drain        poolDrainCmd        `command:"drain" description:"Drain targets from a rank"`
-	Reintegrate  poolReintegrateCmd  `command:"reintegrate" alias:"reint" description:"Reintegrate targets for a rank"`
-	Query        poolQueryCmd        `command:"query" description:"Query a DAOS pool"`
-	QueryTargets poolQueryTargetsCmd `command:"query-targets" description:"Query pool target info"`
-	GetACL       poolGetACLCmd       `command:"get-acl" description:"Get a DAOS pool's Access Control List"`
-	OverwriteACL poolOverwriteACLCmd `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
-	UpdateACL    poolUpdateACLCmd    `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
-	DeleteACL    poolDeleteACLCmd    `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
-	SetProp      poolSetPropCmd      `command:"set-prop" description:"Set pool property"`
-	GetProp      poolGetPropCmd      `command:"get-prop" description:"Get pool properties"`
-	Upgrade      poolUpgradeCmd      `command:"upgrade" description:"Upgrade pool to latest format"`
+	Create        poolCreateCmd        `command:"create" description:"Create a DAOS pool"`
+	Destroy       poolDestroyCmd       `command:"destroy" description:"Destroy a DAOS pool"`
+	Undelete      poolUndeleteCmd      `command:"undelete" description:"Restore a DAOS pool that is pending destroy"`
+	Evict         poolEvictCmd         `command:"evict" description:"Evict all pool connections to a DAOS pool"`
+	List          poolListCmd          `command:"list" alias:"ls" description:"List DAOS pools"`
+	Extend        poolExtendCmd        `command:"extend" description:"Extend a DAOS pool to include new ranks."`
+	Resize        poolResizeCmd        `command:"resize" description:"Grow the per-engine storage allocation of a DAOS pool"`
+	Exclude       poolExcludeCmd       `command:"exclude" description:"Exclude targets from a rank"`
+	Drain         poolDrainCmd         `command:"drain" description:"Drain targets from a rank"`
+	Reintegrate   poolReintegrateCmd   `command:"reintegrate" alias:"reint" description:"Reintegrate targets for a rank"`
+	Query         poolQueryCmd         `command:"query" description:"Query a DAOS pool"`
+	QueryTargets  poolQueryTargetsCmd  `command:"query-targets" description:"Query pool target info"`
+	RebuildStatus poolRebuildStatusCmd `command:"rebuild-status" description:"Display the rebuild progress or rebuild history of a DAOS pool"`
+	Doctor        poolDoctorCmd        `command:"doctor" description:"Diagnose the health of a DAOS pool and suggest remedies"`
+	GetACL        poolGetACLCmd        `command:"get-acl" description:"Get a DAOS pool's Access Control List"`
+	OverwriteACL  poolOverwriteACLCmd  `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
+	UpdateACL     poolUpdateACLCmd     `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
+	DeleteACL     poolDeleteACLCmd     `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
+	SetProp       poolSetPropCmd       `command:"set-prop" description:"Set pool property"`
+	GetProp       poolGetPropCmd       `command:"get-prop" description:"Get pool properties"`
+	Upgrade       poolUpgradeCmd       `command:"upgrade" description:"Upgrade pool to latest format"`
+	Apply         poolApplyCmd         `command:"apply" description:"Create or update pools to match a manifest"`
+	Export        poolExportCmd        `command:"export" description:"Generate a manifest describing existing pools"`
 }
 
 var (
@@ -184,6 +191,9 @@ type poolCreateCmd struct {
 	DataSize   ui.ByteSizeFlag     `long:"data-size" description:"Per-engine Data-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
 	MemRatio   tierRatioFlag       `long:"mem-ratio" description:"Percentage of the pool metadata storage size (on SSD) that should be used as the memory file size (on ram-disk). Default value is 100% and only valid in MD-on-SSD mode"`
 	RankList   ui.RankSetFlag      `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	Domains    string              `long:"domains" description:"Comma-separated list of fault domains to restrict pool ranks to (e.g. /rack0,/rack1)"`
+	Spread     string              `long:"spread-across" description:"Fault domain level, by label or number, to spread pool ranks across (e.g. rack)"`
+	MinDomains uint32              `long:"min-domains" description:"Minimum number of distinct --spread-across domains the pool must span"`
 
 	Args struct {
 		PoolLabel string `positional-arg-name:"<pool label>" required:"1"`
@@ -224,10 +234,40 @@ func (cmd *poolCreateCmd) setMemRatio(req *control.PoolCreateReq, defVal float32
 	return nil
 }
 
+func (cmd *poolCreateCmd) hasPlacementFlags() bool {
+	return cmd.Domains != "" || cmd.Spread != "" || cmd.MinDomains > 0
+}
+
+// setPlacement sets the fault domain placement constraints in the request.
+func (cmd *poolCreateCmd) setPlacement(req *control.PoolCreateReq) error {
+	if !cmd.hasPlacementFlags() {
+		return nil
+	}
+	if !cmd.RankList.Empty() {
+		return errIncompatFlags("ranks", "domains", "spread-across", "min-domains")
+	}
+	if cmd.MinDomains > 0 && cmd.Spread == "" {
+		return errors.New("--min-domains cannot be set without --spread-across")
+	}
+
+	for _, d := range strings.Split(cmd.Domains, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			req.Domains = append(req.Domains, d)
+		}
+	}
+	req.SpreadAcross = cmd.Spread
+	req.MinDomains = cmd.MinDomains
+
+	return nil
+}
+
 func (cmd *poolCreateCmd) storageAutoPercentage(ctx context.Context, req *control.PoolCreateReq) error {
 	if cmd.NumRanks > 0 {
 		return errIncompatFlags("size", "nranks")
 	}
+	if cmd.hasPlacementFlags() {
+		return errIncompatFlags("size=%", "domains", "spread-across", "min-domains")
+	}
 	if cmd.TierRatio.IsSet() {
 		return errIncompatFlags("size=%", "tier-ratio")
 	}
@@ -348,6 +388,10 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		}
 	}
 
+	if err := cmd.setPlacement(req); err != nil {
+		return err
+	}
+
 	// Refuse unsupported input value combinations.
 
 	pmemParams := cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet()
@@ -412,6 +456,7 @@ type poolListCmd struct {
 	Verbose     bool `short:"v" long:"verbose" description:"Add pool UUIDs and service replica lists to display"`
 	NoQuery     bool `short:"n" long:"no-query" description:"Disable query of listed pools"`
 	RebuildOnly bool `short:"r" long:"rebuild-only" description:"List only pools which rebuild stats is not idle"`
+	Forecast    bool `long:"forecast" description:"Display usage growth rate and projected days until each storage tier is full"`
 }
 
 // Execute is run when PoolListCmd activates
@@ -424,6 +469,13 @@ func (cmd *poolListCmd) Execute(_ []string) (errOut error) {
 		return errors.New("no configuration loaded")
 	}
 
+	if cmd.Forecast {
+		if cmd.NoQuery || cmd.RebuildOnly {
+			return errIncompatFlags("forecast", "no-query", "rebuild-only")
+		}
+		return outputPoolForecasts(cmd.MustLogCtx(), cmd.Logger, &cmd.JSONOutputCmd, cmd.ctlInvoker)
+	}
+
 	req := &control.ListPoolsReq{
 		NoQuery: cmd.NoQuery,
 	}
@@ -488,6 +540,7 @@ type poolDestroyCmd struct {
 	poolCmd
 	Recursive bool `short:"r" long:"recursive" description:"Remove pool with existing containers"`
 	Force     bool `short:"f" long:"force" description:"Forcibly remove pool with active client connections"`
+	Immediate bool `long:"immediate" description:"Destroy pool now, ignoring any configured destroy grace period"`
 }
 
 // Execute is run when PoolDestroyCmd subcommand is activated
@@ -498,11 +551,15 @@ func (cmd *poolDestroyCmd) Execute(args []string) error {
 		ID:        cmd.PoolID().String(),
 		Force:     cmd.Force,
 		Recursive: cmd.Recursive,
+		Immediate: cmd.Immediate,
 	}
 
-	err := control.PoolDestroy(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	resp, err := control.PoolDestroyWithResp(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
 		msg = errors.WithMessage(err, "failed").Error()
+	} else if !resp.DestroyAfter.IsZero() {
+		msg = fmt.Sprintf("succeeded: pool will be destroyed after %s "+
+			"(use dmg pool undelete to restore it)", resp.DestroyAfter.Format(time.RFC3339))
 	}
 
 	cmd.ctlInvoker.Debugf("Pool-destroy command %s", msg)
@@ -511,13 +568,44 @@ func (cmd *poolDestroyCmd) Execute(args []string) error {
 	return err
 }
 
+// poolUndeleteCmd is the struct representing the command to restore a DAOS
+// pool that is pending destroy.
+type poolUndeleteCmd struct {
+	poolCmd
+}
+
+// Execute is run when poolUndeleteCmd subcommand is activated
+func (cmd *poolUndeleteCmd) Execute(args []string) error {
+	msg := "succeeded"
+
+	req := &control.PoolUndeleteReq{ID: cmd.PoolID().String()}
+
+	err := control.PoolUndelete(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		msg = errors.WithMessage(err, "failed").Error()
+	}
+
+	cmd.Infof("Pool-undelete command %s\n", msg)
+
+	return err
+}
+
 // poolEvictCmd is the struct representing the command to evict a DAOS pool.
 type poolEvictCmd struct {
-	poolCmd
+	poolSelectCmd
 }
 
 // Execute is run when PoolEvictCmd subcommand is activated
 func (cmd *poolEvictCmd) Execute(args []string) error {
+	if err := cmd.resolvePoolArgs(); err != nil {
+		return err
+	}
+	if cmd.hasSelector() {
+		return cmd.runSelected(func(ctx context.Context, id string) error {
+			return control.PoolEvict(ctx, cmd.ctlInvoker, &control.PoolEvictReq{ID: id})
+		})
+	}
+
 	msg := "succeeded"
 
 	req := &control.PoolEvictReq{ID: cmd.PoolID().String()}
@@ -534,7 +622,7 @@ func (cmd *poolEvictCmd) Execute(args []string) error {
 
 // poolExcludeCmd is the struct representing the command to exclude a DAOS target.
 type poolExcludeCmd struct {
-	poolCmd
+	poolSelectCmd
 	Force     bool   `short:"f" long:"force" description:"Force the operation to continue, potentially leading to data loss"`
 	Rank      uint32 `long:"rank" required:"1" description:"Engine rank of the targets to be excluded"`
 	TargetIdx string `long:"target-idx" description:"Comma-separated list of target idx(s) to be excluded from the rank"`
@@ -544,11 +632,26 @@ type poolExcludeCmd struct {
 func (cmd *poolExcludeCmd) Execute(args []string) error {
 	msg := "succeeded"
 
+	if err := cmd.resolvePoolArgs(); err != nil {
+		return err
+	}
+
 	var idxList []uint32
 	if err := common.ParseNumberList(cmd.TargetIdx, &idxList); err != nil {
 		return errors.WithMessage(err, "parsing target list")
 	}
 
+	if cmd.hasSelector() {
+		return cmd.runSelected(func(ctx context.Context, id string) error {
+			return control.PoolExclude(ctx, cmd.ctlInvoker, &control.PoolExcludeReq{
+				ID:        id,
+				Rank:      ranklist.Rank(cmd.Rank),
+				TargetIdx: idxList,
+				Force:     cmd.Force,
+			})
+		})
+	}
+
 	req := &control.PoolExcludeReq{ID: cmd.PoolID().String(), Rank: ranklist.Rank(cmd.Rank), TargetIdx: idxList, Force: cmd.Force}
 
 	err := control.PoolExclude(cmd.MustLogCtx(), cmd.ctlInvoker, req)
@@ -563,7 +666,7 @@ func (cmd *poolExcludeCmd) Execute(args []string) error {
 
 // poolDrainCmd is the struct representing the command to Drain a DAOS target.
 type poolDrainCmd struct {
-	poolCmd
+	poolSelectCmd
 	Rank      uint32 `long:"rank" required:"1" description:"Engine rank of the targets to be drained"`
 	TargetIdx string `long:"target-idx" description:"Comma-separated list of target idx(s) to be drained on the rank"`
 }
@@ -572,12 +675,26 @@ type poolDrainCmd struct {
 func (cmd *poolDrainCmd) Execute(args []string) error {
 	msg := "succeeded"
 
+	if err := cmd.resolvePoolArgs(); err != nil {
+		return err
+	}
+
 	var idxList []uint32
 	if err := common.ParseNumberList(cmd.TargetIdx, &idxList); err != nil {
 		err = errors.WithMessage(err, "parsing target list")
 		return err
 	}
 
+	if cmd.hasSelector() {
+		return cmd.runSelected(func(ctx context.Context, id string) error {
+			return control.PoolDrain(ctx, cmd.ctlInvoker, &control.PoolDrainReq{
+				ID:        id,
+				Rank:      ranklist.Rank(cmd.Rank),
+				TargetIdx: idxList,
+			})
+		})
+	}
+
 	req := &control.PoolDrainReq{
 		ID:        cmd.PoolID().String(),
 		Rank:      ranklist.Rank(cmd.Rank),
@@ -619,9 +736,55 @@ func (cmd *poolExtendCmd) Execute(args []string) error {
 	return err
 }
 
+// poolResizeCmd is the struct representing the command to resize a DAOS pool.
+type poolResizeCmd struct {
+	poolCmd
+	Size     ui.ByteSizeFlag `short:"z" long:"size" description:"New total size of DAOS pool, SCM allocation is retained"`
+	ScmSize  ui.ByteSizeFlag `short:"s" long:"scm-size" description:"New per-engine SCM allocation for DAOS pool"`
+	NVMeSize ui.ByteSizeFlag `short:"n" long:"nvme-size" description:"New per-engine NVMe allocation for DAOS pool"`
+}
+
+// Execute is run when poolResizeCmd subcommand is activated
+func (cmd *poolResizeCmd) Execute(args []string) error {
+	req := &control.PoolResizeReq{
+		ID: cmd.PoolID().String(),
+	}
+
+	switch {
+	case cmd.Size.IsSet() && (cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet()):
+		return errIncompatFlags("size", "scm-size", "nvme-size")
+	case cmd.Size.IsSet():
+		req.TotalBytes = cmd.Size.Bytes
+	case cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet():
+		// Zero retains the current allocation of a tier.
+		req.TierBytes = []uint64{cmd.ScmSize.Bytes, cmd.NVMeSize.Bytes}
+	default:
+		return errors.New("either --size or --scm-size/--nvme-size must be supplied")
+	}
+
+	resp, err := control.PoolResize(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if resp != nil {
+		var bld strings.Builder
+		pretty.PrintPoolResizeResponse(&bld, resp)
+		cmd.Info(bld.String())
+	}
+
+	msg := "succeeded"
+	if err != nil {
+		msg = errors.WithMessage(err, "failed").Error()
+	}
+	cmd.Infof("Resize command %s\n", msg)
+
+	return err
+}
+
 // poolReintegrateCmd is the struct representing the command to Add a DAOS target.
 type poolReintegrateCmd struct {
-	poolCmd
+	poolSelectCmd
 	Rank      uint32 `long:"rank" required:"1" description:"Engine rank of the targets to be reintegrated"`
 	TargetIdx string `long:"target-idx" description:"Comma-separated list of target idx(s) to be reintegrated into the rank"`
 }
@@ -630,12 +793,26 @@ type poolReintegrateCmd struct {
 func (cmd *poolReintegrateCmd) Execute(args []string) error {
 	msg := "succeeded"
 
+	if err := cmd.resolvePoolArgs(); err != nil {
+		return err
+	}
+
 	var idxList []uint32
 	if err := common.ParseNumberList(cmd.TargetIdx, &idxList); err != nil {
 		err = errors.WithMessage(err, "parsing target list")
 		return err
 	}
 
+	if cmd.hasSelector() {
+		return cmd.runSelected(func(ctx context.Context, id string) error {
+			return control.PoolReintegrate(ctx, cmd.ctlInvoker, &control.PoolReintegrateReq{
+				ID:        id,
+				Rank:      ranklist.Rank(cmd.Rank),
+				TargetIdx: idxList,
+			})
+		})
+	}
+
 	req := &control.PoolReintegrateReq{
 		ID:        cmd.PoolID().String(),
 		Rank:      ranklist.Rank(cmd.Rank),
@@ -657,10 +834,41 @@ type poolQueryCmd struct {
 	poolCmd
 	ShowEnabledRanks bool `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
 	HealthOnly       bool `short:"t" long:"health-only" description:"Only perform pool health related queries"`
+	Forecast         bool `long:"forecast" description:"Display usage growth rate and projected days until each storage tier is full"`
+}
+
+// outputPoolForecasts displays the capacity forecasts for the given pools, or
+// for all pools if none are specified.
+func outputPoolForecasts(ctx context.Context, log logging.Logger, jsonCmd *cmdutil.JSONOutputCmd, invoker control.Invoker, ids ...string) error {
+	req := &control.PoolForecastReq{IDs: ids}
+
+	resp, err := control.PoolForecast(ctx, invoker, req)
+	if jsonCmd.JSONOutputEnabled() {
+		return jsonCmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "pool forecast failed")
+	}
+
+	var out strings.Builder
+	pretty.PrintPoolForecasts(&out, resp.Forecasts)
+	// Infof prints raw string and doesn't try to expand "%"
+	// preserving column formatting in txtfmt table
+	log.Infof("%s", out.String())
+
+	return nil
 }
 
 // Execute is run when PoolQueryCmd subcommand is activated
 func (cmd *poolQueryCmd) Execute(args []string) error {
+	if cmd.Forecast {
+		if cmd.ShowEnabledRanks || cmd.HealthOnly {
+			return errIncompatFlags("forecast", "show-enabled", "health-only")
+		}
+		return outputPoolForecasts(cmd.MustLogCtx(), cmd.Logger, &cmd.JSONOutputCmd, cmd.ctlInvoker,
+			cmd.PoolID().String())
+	}
+
 	req := &control.PoolQueryReq{
 		ID:        cmd.PoolID().String(),
 		QueryMask: daos.DefaultPoolQueryMask,
@@ -758,11 +966,20 @@ func (cmd *poolQueryTargetsCmd) Execute(args []string) error {
 
 // poolUpgradeCmd is the struct representing the command to update a DAOS pool.
 type poolUpgradeCmd struct {
-	poolCmd
+	poolSelectCmd
 }
 
 // Execute is run when PoolUpgradeCmd subcommand is activated
 func (cmd *poolUpgradeCmd) Execute(args []string) error {
+	if err := cmd.resolvePoolArgs(); err != nil {
+		return err
+	}
+	if cmd.hasSelector() {
+		return cmd.runSelected(func(ctx context.Context, id string) error {
+			return control.PoolUpgrade(ctx, cmd.ctlInvoker, &control.PoolUpgradeReq{ID: id})
+		})
+	}
+
 	req := &control.PoolUpgradeReq{
 		ID: cmd.PoolID().String(),
 	}
@@ -778,15 +995,39 @@ func (cmd *poolUpgradeCmd) Execute(args []string) error {
 
 // poolSetPropCmd represents the command to set a property on a pool.
 type poolSetPropCmd struct {
-	poolCmd
+	poolSelectCmd
 
 	Args struct {
-		Props PoolSetPropsFlag `positional-arg-name:"<key:val[,key:val...]>" required:"1"`
+		Props PoolSetPropsFlag `positional-arg-name:"<key:val[,key:val...]>"`
 	} `positional-args:"yes"`
 }
 
+// resolvePropArgs parses the properties to be set. When selector flags are
+// used the pool argument is omitted, so the properties are supplied in the
+// pool argument's position.
+func (cmd *poolSetPropCmd) resolvePropArgs() error {
+	if cmd.hasSelector() && len(cmd.Args.Props.ToSet) == 0 && cmd.poolSelectCmd.Args.Pool != "" {
+		if err := cmd.Args.Props.UnmarshalFlag(cmd.poolSelectCmd.Args.Pool); err != nil {
+			return err
+		}
+		cmd.poolSelectCmd.Args.Pool = ""
+	}
+	if err := cmd.resolvePoolArgs(); err != nil {
+		return err
+	}
+
+	if len(cmd.Args.Props.ToSet) == 0 {
+		return errors.New("no properties specified")
+	}
+	return nil
+}
+
 // Execute is run when PoolSetPropCmd subcommand is activatecmd.
 func (cmd *poolSetPropCmd) Execute(_ []string) error {
+	if err := cmd.resolvePropArgs(); err != nil {
+		return err
+	}
+
 	for _, prop := range cmd.Args.Props.ToSet {
 		if prop.Name == "perf_domain" {
 			return errors.New("can't set perf_domain on existing pool.")
@@ -800,6 +1041,18 @@ func (cmd *poolSetPropCmd) Execute(_ []string) error {
 		if prop.Name == "rp_pda" {
 			return errors.New("can't set RP performance domain affinity on existing pool.")
 		}
+		if prop.Name == "label" && cmd.hasSelector() {
+			return errors.New("can't set the same label on multiple pools.")
+		}
+	}
+
+	if cmd.hasSelector() {
+		return cmd.runSelected(func(ctx context.Context, id string) error {
+			return control.PoolSetProp(ctx, cmd.ctlInvoker, &control.PoolSetPropReq{
+				ID:         id,
+				Properties: cmd.Args.Props.ToSet,
+			})
+		})
 	}
 
 	req := &control.PoolSetPropReq{
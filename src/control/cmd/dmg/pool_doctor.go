@@ -0,0 +1,41 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// poolDoctorCmd is the struct representing the command to diagnose the health
+// of a DAOS pool.
+type poolDoctorCmd struct {
+	poolCmd
+}
+
+// Execute is run when poolDoctorCmd subcommand is activated
+func (cmd *poolDoctorCmd) Execute(_ []string) error {
+	req := &control.PoolDoctorReq{ID: cmd.PoolID().String()}
+
+	resp, err := control.PoolDoctor(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "pool doctor failed")
+	}
+
+	var out strings.Builder
+	pretty.PrintPoolDoctorResponse(&out, resp)
+	cmd.Infof("%s", out.String())
+
+	return nil
+}
@@ -0,0 +1,173 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// TestPoolDoctor_Remediation verifies that the remediation commands suggested
+// by the pool doctor are accepted by the dmg command-line parser.
+func TestPoolDoctor_Remediation(t *testing.T) {
+	member := func(rank uint32, state system.MemberState) *mgmtpb.SystemMember {
+		return &mgmtpb.SystemMember{
+			Rank:  rank,
+			Uuid:  test.MockUUID(int32(rank)),
+			State: state.String(),
+			Addr:  "10.0.0.1:10001",
+		}
+	}
+	smdResp := func(rankResps ...*ctlpb.SmdQueryResp_RankResp) *control.UnaryResponse {
+		return &control.UnaryResponse{
+			Responses: []*control.HostResponse{
+				{
+					Addr:    "host-0",
+					Message: &ctlpb.SmdQueryResp{Ranks: rankResps},
+				},
+			},
+		}
+	}
+	dev := func(rank uint32, idx int32, state ctlpb.NvmeDevState) *ctlpb.SmdQueryResp_RankResp {
+		return &ctlpb.SmdQueryResp_RankResp{
+			Rank: rank,
+			Devices: []*ctlpb.SmdDevice{
+				{
+					Uuid:   test.MockUUID(idx),
+					TgtIds: []int32{1, 2},
+					Ctrlr: &ctlpb.NvmeController{
+						PciAddr:  test.MockPCIAddr(idx),
+						DevState: state,
+					},
+				},
+			},
+		}
+	}
+	// Placeholders in the suggested commands that the operator is expected
+	// to fill in.
+	placeholders := strings.NewReplacer(
+		"<new device UUID>", test.MockUUID(9),
+		"<ranks>", "0-1",
+		"<rank>", "0",
+	)
+
+	for name, tc := range map[string]struct {
+		uResps     []*control.UnaryResponse
+		expMinCmds int
+	}{
+		"degraded": {
+			uResps: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Uuid:             test.MockUUID(1),
+					Label:            "tank",
+					TotalTargets:     16,
+					ActiveTargets:    10,
+					DisabledTargets:  6,
+					TotalEngines:     4,
+					EnabledRanks:     "[0-2,4]",
+					DisabledRanks:    "[1,3]",
+					DeadRanks:        "[3,5]",
+					PoolLayoutVer:    1,
+					UpgradeLayoutVer: 2,
+					Rebuild: &mgmtpb.PoolRebuildStatus{
+						State: mgmtpb.PoolRebuildStatus_BUSY,
+					},
+					TierStats: []*mgmtpb.StorageUsageStats{
+						{Total: 100, Free: 8, MediaType: mgmtpb.StorageMediaType_SCM},
+					},
+				}),
+				control.MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+					Members: []*mgmtpb.SystemMember{
+						member(0, system.MemberStateJoined),
+						member(1, system.MemberStateJoined),
+						member(2, system.MemberStateJoined),
+						member(3, system.MemberStateExcluded),
+						member(4, system.MemberStateAdminExcluded),
+					},
+				}),
+				control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryTargetResp{
+					Infos: []*mgmtpb.PoolQueryTargetInfo{
+						{State: mgmtpb.PoolQueryTargetInfo_UP_IN},
+						{State: mgmtpb.PoolQueryTargetInfo_DOWN_OUT},
+						{State: mgmtpb.PoolQueryTargetInfo_DOWN_OUT},
+						{State: mgmtpb.PoolQueryTargetInfo_UP_IN},
+					},
+				}),
+				smdResp(dev(1, 5, ctlpb.NvmeDevState_EVICTED), dev(2, 6, ctlpb.NvmeDevState_UNPLUGGED)),
+			},
+			expMinCmds: 12,
+		},
+		"targets disabled; rebuild failed": {
+			uResps: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Uuid:            test.MockUUID(1),
+					Label:           "tank",
+					TotalTargets:    8,
+					ActiveTargets:   6,
+					DisabledTargets: 2,
+					TotalEngines:    2,
+					EnabledRanks:    "[0-1]",
+					Rebuild: &mgmtpb.PoolRebuildStatus{
+						Status: -1,
+					},
+				}),
+				control.MockMSResponse("host1", errors.New("remote failed"), nil),
+				smdResp(),
+			},
+			expMinCmds: 3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			resp, err := control.PoolDoctor(test.Context(t), mi, &control.PoolDoctorReq{ID: "tank"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var cmds []string
+			for _, f := range resp.Findings {
+				cmds = append(cmds, f.Remediation...)
+			}
+			if len(cmds) < tc.expMinCmds {
+				t.Fatalf("expected at least %d remediation commands, got %d: %v",
+					tc.expMinCmds, len(cmds), cmds)
+			}
+
+			for _, cmd := range cmds {
+				args := strings.Fields(placeholders.Replace(cmd))
+				if args[0] != "dmg" {
+					t.Fatalf("unexpected remediation %q", cmd)
+				}
+
+				var opts cliOptions
+				p := flags.NewParser(&opts, flags.HelpFlag|flags.PassDoubleDash)
+				p.CommandHandler = func(flags.Commander, []string) error {
+					return nil
+				}
+				if _, err := p.ParseArgs(args[1:]); err != nil {
+					t.Errorf("remediation %q not accepted by dmg: %s", cmd, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// poolApplyCmd is the struct representing the command to converge the pools
+// in the system with a manifest.
+type poolApplyCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	File   string `short:"f" long:"file" required:"1" description:"Path of the YAML pool manifest"`
+	DryRun bool   `long:"dry-run" description:"Display the planned changes without making them"`
+}
+
+// Execute is run when poolApplyCmd subcommand is activated.
+func (cmd *poolApplyCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "pool apply failed")
+	}()
+
+	manifest, err := control.ReadPoolManifest(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.MustLogCtx()
+	plan, err := control.PlanPoolApply(ctx, cmd.ctlInvoker, manifest)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.JSONOutputEnabled() {
+		var out strings.Builder
+		pretty.PrintPoolApplyPlan(&out, plan)
+		cmd.Infof("%s", out.String())
+	}
+
+	if !cmd.DryRun && len(plan.Changes) > 0 {
+		err = control.PoolApply(ctx, cmd.ctlInvoker, plan)
+		if err == nil && !cmd.JSONOutputEnabled() {
+			cmd.Infof("Applied %d pool changes", len(plan.Changes))
+		}
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(plan, err)
+	}
+
+	return err
+}
+
+// poolExportCmd is the struct representing the command to generate a pool
+// manifest describing existing pools.
+type poolExportCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Output string `short:"o" long:"output" description:"Write the manifest to a file instead of the console"`
+
+	Args struct {
+		Pools []string `positional-arg-name:"<pool label or UUID>"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolExportCmd subcommand is activated.
+func (cmd *poolExportCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "pool export failed")
+	}()
+
+	req := &control.PoolManifestExportReq{Pools: cmd.Args.Pools}
+	manifest, err := control.PoolManifestExport(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() && cmd.Output == "" {
+		return cmd.OutputJSON(manifest, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == "" {
+		cmd.Infof("%s", string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(cmd.Output, data, 0644); err != nil {
+		return err
+	}
+	cmd.Infof("Wrote manifest for %d pools to %s", len(manifest.Pools), cmd.Output)
+
+	return nil
+}
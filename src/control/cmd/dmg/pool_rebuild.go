@@ -0,0 +1,97 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// poolRebuildStatusCmd is the struct representing the command to display the
+// rebuild progress or rebuild history of a DAOS pool.
+type poolRebuildStatusCmd struct {
+	poolCmd
+	Watch    bool          `short:"w" long:"watch" description:"Query the pool repeatedly until the rebuild is no longer in progress, displaying rates and an estimated time to completion"`
+	Interval time.Duration `long:"interval" default:"5s" description:"Interval between pool queries with --watch"`
+	History  bool          `long:"history" description:"Display the rebuild history of the pool recorded by the management service"`
+}
+
+func (cmd *poolRebuildStatusCmd) printProgress(prog *control.PoolRebuildProgress) {
+	if cmd.JSONOutputEnabled() {
+		if err := cmd.OutputJSON(prog, nil); err != nil {
+			cmd.Errorf("failed to output rebuild progress as JSON: %s", err)
+		}
+		return
+	}
+
+	var out strings.Builder
+	pretty.PrintPoolRebuildProgress(&out, prog)
+	cmd.Infof("%s", out.String())
+}
+
+func (cmd *poolRebuildStatusCmd) showHistory() error {
+	req := &control.PoolRebuildHistoryReq{ID: cmd.PoolID().String()}
+
+	resp, err := control.PoolRebuildHistory(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintPoolRebuildHistory(&out, resp.Records)
+	cmd.Infof("%s", out.String())
+
+	return nil
+}
+
+// Execute is run when poolRebuildStatusCmd subcommand is activated
+func (cmd *poolRebuildStatusCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "pool rebuild-status failed")
+	}()
+
+	if cmd.History {
+		if cmd.Watch {
+			return errIncompatFlags("history", "watch")
+		}
+		return cmd.showHistory()
+	}
+
+	if cmd.Watch {
+		if cmd.Interval <= 0 {
+			return errors.New("--interval must be greater than zero")
+		}
+		return control.PoolRebuildWatch(cmd.MustLogCtx(), cmd.ctlInvoker, &control.PoolRebuildWatchReq{
+			ID:           cmd.PoolID().String(),
+			PollInterval: cmd.Interval,
+			Handler:      cmd.printProgress,
+		})
+	}
+
+	resp, err := control.PoolQuery(cmd.MustLogCtx(), cmd.ctlInvoker, &control.PoolQueryReq{
+		ID:        cmd.PoolID().String(),
+		QueryMask: daos.HealthOnlyPoolQueryMask,
+	})
+	if err != nil {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(nil, err)
+		}
+		return err
+	}
+
+	cmd.printProgress(new(control.PoolRebuildTracker).Update(time.Now(), resp.Rebuild))
+	return nil
+}
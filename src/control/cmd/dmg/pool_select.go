@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+// poolSelectCmd is the base struct for pool commands that can be applied
+// either to a single pool or to the set of pools matching the selector flags.
+type poolSelectCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+
+	All       bool           `long:"all" description:"Apply the operation to all pools in the system"`
+	LabelGlob string         `long:"label-glob" description:"Apply the operation to pools with labels matching the glob pattern"`
+	Owner     string         `long:"owner" description:"Apply the operation to pools owned by the given user, format name@domain"`
+	OnRanks   ui.RankSetFlag `long:"on-rank" description:"Apply the operation to pools with storage on any of the given ranks"`
+
+	// The pool argument is validated after parsing, as it is omitted when
+	// selector flags are used.
+	Args struct {
+		Pool string `positional-arg-name:"<pool label or UUID>"`
+	} `positional-args:"yes"`
+
+	poolID PoolID
+}
+
+func (cmd *poolSelectCmd) PoolID() *PoolID {
+	return &cmd.poolID
+}
+
+func (cmd *poolSelectCmd) selectReq() *control.PoolSelectReq {
+	req := &control.PoolSelectReq{
+		All:       cmd.All,
+		LabelGlob: cmd.LabelGlob,
+		Owner:     cmd.Owner,
+	}
+	if !cmd.OnRanks.Empty() {
+		req.Ranks = &cmd.OnRanks.RankSet
+	}
+	return req
+}
+
+func (cmd *poolSelectCmd) hasSelector() bool {
+	return cmd.selectReq().IsSet()
+}
+
+// resolvePoolArgs checks that either a pool or selector flags were supplied,
+// and parses the pool label or UUID if a single pool is to be operated on.
+func (cmd *poolSelectCmd) resolvePoolArgs() error {
+	if cmd.hasSelector() {
+		if cmd.Args.Pool != "" {
+			return errors.New("a pool label or UUID may not be combined with pool selector flags")
+		}
+		return nil
+	}
+
+	if cmd.Args.Pool == "" {
+		return errors.New("a pool label or UUID or pool selector flags must be specified")
+	}
+	return cmd.poolID.UnmarshalFlag(cmd.Args.Pool)
+}
+
+// runSelected applies the operation to each of the selected pools
+// concurrently and displays the result for each pool. An error is returned
+// if the operation failed on any pool.
+func (cmd *poolSelectCmd) runSelected(op func(context.Context, string) error) error {
+	ctx := cmd.MustLogCtx()
+
+	pools, err := control.SelectPools(ctx, cmd.ctlInvoker, cmd.selectReq())
+	if err != nil {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(nil, err)
+		}
+		return err
+	}
+	cmd.Debugf("selected %d pools", len(pools))
+
+	resp := control.PoolOpFanOut(ctx, pools, op)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out strings.Builder
+	pretty.PrintPoolOpResults(&out, resp)
+	cmd.Infof("%s", out.String())
+
+	return resp.Errors()
+}
@@ -177,6 +177,13 @@ func TestPoolCommands(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	testManifestFile := test.CreateTestFile(t, tmpDir, fmt.Sprintf(`
+pools:
+- label: tank
+  size: %s
+`, testSizeStr))
+	testBadManifestFile := test.CreateTestFile(t, tmpDir, "pools: []\n")
+
 	propWithVal := func(key, val string) *daos.PoolProperty {
 		hdlr := daos.PoolProperties()[key]
 		prop := hdlr.GetProperty(key)
@@ -193,6 +200,8 @@ func TestPoolCommands(t *testing.T) {
 		xfrm(&qm)
 		return qm
 	}
+	resizeQueryMask := daos.MustNewPoolQueryMask(daos.PoolQueryOptionSpace,
+		daos.PoolQueryOptionEnabledEngines)
 
 	runCmdTests(t, []cmdTest{
 		{
@@ -533,6 +542,45 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool with fault domain constraints",
+			fmt.Sprintf("pool create label --size %s --nranks 4 --domains /rack0,/rack1 --spread-across rack --min-domains 2", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes:   uint64(testSize),
+					TierRatio:    []float64{0.06, 0.94},
+					NumRanks:     4,
+					User:         eUsr.Username + "@",
+					UserGroup:    eGrp.Name + "@",
+					Ranks:        []ranklist.Rank{},
+					Domains:      []string{"/rack0", "/rack1"},
+					SpreadAcross: "rack",
+					MinDomains:   2,
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "label"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with incompatible arguments (ranks domains)",
+			fmt.Sprintf("pool create label --size %s --ranks 1,2 --domains /rack0", testSizeStr),
+			"",
+			errors.New("--ranks may not be mixed with --domains"),
+		},
+		{
+			"Create pool with incompatible arguments (% size spread-across)",
+			"pool create label --size 100% --spread-across rack",
+			"",
+			errors.New("--size=% may not be mixed with --domains"),
+		},
+		{
+			"Create pool with min-domains without spread-across",
+			fmt.Sprintf("pool create label --size %s --min-domains 2", testSizeStr),
+			"",
+			errors.New("--min-domains cannot be set without --spread-across"),
+		},
 		{
 			"Create pool with user and group domains",
 			fmt.Sprintf("pool create label --scm-size %s --nsvc 3 --user foo@home --group bar@home", testSizeStr),
@@ -653,6 +701,14 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Exclude a rank from pools on the rank",
+			"pool exclude --on-rank 1 --rank 1",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			}, " "),
+			nil,
+		},
 		{
 			"Drain a target with single target idx",
 			"pool drain 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1",
@@ -718,6 +774,48 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Resize pool with missing sizes",
+			"pool resize 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errors.New("must be supplied"),
+		},
+		{
+			"Resize pool with total and tier sizes",
+			"pool resize 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --size 10T --nvme-size 1T",
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Resize pool NVMe allocation",
+			"pool resize 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --nvme-size 1T",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					QueryMask: resizeQueryMask,
+				}),
+				printRequest(t, &control.PoolResizeReq{
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					TierBytes: []uint64{0, 1 * humanize.TByte},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Resize pool total size",
+			"pool resize 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --size 10T",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					QueryMask: resizeQueryMask,
+				}),
+				printRequest(t, &control.PoolResizeReq{
+					ID:         "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					TotalBytes: 10 * humanize.TByte,
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Reintegrate a target with single target idx",
 			"pool reintegrate 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0 --target-idx 1",
@@ -754,6 +852,14 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Reintegrate a rank into pools matching label glob",
+			"pool reintegrate --label-glob tank-* --rank 1",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			}, " "),
+			nil,
+		},
 		{
 			"Destroy pool with force",
 			"pool destroy 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --force",
@@ -776,6 +882,75 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Destroy pool immediately",
+			"pool destroy 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --immediate",
+			strings.Join([]string{
+				printRequest(t, &control.PoolDestroyReq{
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Immediate: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Undelete pool",
+			"pool undelete 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolUndeleteReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Pool rebuild status",
+			"pool rebuild-status 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					QueryMask: daos.HealthOnlyPoolQueryMask,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Pool rebuild status history",
+			"pool rebuild-status --history 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolRebuildHistoryReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Pool rebuild status history with watch",
+			"pool rebuild-status --history --watch 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errIncompatFlags("history", "watch"),
+		},
+		{
+			"Pool rebuild status watch with zero interval",
+			"pool rebuild-status --watch --interval 0s 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errors.New("--interval must be greater than zero"),
+		},
+		{
+			"Pool doctor",
+			"pool doctor 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					QueryMask: daos.MustNewPoolQueryMask(daos.PoolQueryOptionSpace,
+						daos.PoolQueryOptionRebuild, daos.PoolQueryOptionEnabledEngines,
+						daos.PoolQueryOptionDisabledEngines, daos.PoolQueryOptionDeadEngines),
+				}),
+				printRequest(t, &control.SystemQueryReq{}),
+				printRequest(t, &control.SmdQueryReq{Rank: ranklist.NilRank}),
+			}, " "),
+			nil,
+		},
 		{
 			"Evict pool",
 			"pool evict 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
@@ -786,6 +961,44 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Evict all pools",
+			"pool evict --all",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			}, " "),
+			nil,
+		},
+		{
+			"Evict pool with no pool or selector",
+			"pool evict",
+			"",
+			errors.New("pool selector flags must be specified"),
+		},
+		{
+			"Evict pool with pool and selector",
+			"pool evict --all 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errors.New("may not be combined with pool selector flags"),
+		},
+		{
+			"Evict pools with all and other selectors",
+			"pool evict --all --owner bob",
+			"",
+			errors.New("may not be combined with other selection criteria"),
+		},
+		{
+			"Evict pools with bad label glob",
+			"pool evict --label-glob tank-[",
+			"",
+			errors.New("invalid label glob"),
+		},
+		{
+			"Evict pool with invalid label",
+			"pool evict tank:1",
+			"",
+			errors.New("invalid label"),
+		},
 		{
 			"List pools",
 			"pool list",
@@ -802,6 +1015,20 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"List pools with forecast",
+			"pool list --forecast",
+			strings.Join([]string{
+				printRequest(t, &control.PoolForecastReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"List pools with forecast and no query",
+			"pool list --forecast --no-query",
+			"",
+			errors.New("--forecast may not be mixed"),
+		},
 		{
 			"Set pool properties",
 			"pool set-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb label:foo,space_rb:42",
@@ -878,6 +1105,32 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			errors.New("can't set RP performance domain affinity on existing pool"),
 		},
+		{
+			"Set pool property on selected pools",
+			"pool set-prop --label-glob tank-* reclaim:lazy",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool property with no properties",
+			"pool set-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errors.New("no properties specified"),
+		},
+		{
+			"Set pool property on selected pools with no properties",
+			"pool set-prop --all",
+			"",
+			errors.New("no properties specified"),
+		},
+		{
+			"Set pool label on selected pools is not allowed",
+			"pool set-prop --all label:foo",
+			"",
+			errors.New("can't set the same label on multiple pools"),
+		},
 		{
 			"Get pool property",
 			"pool get-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb label",
@@ -1137,6 +1390,22 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Query pool with forecast",
+			"pool query --forecast test_label",
+			strings.Join([]string{
+				printRequest(t, &control.PoolForecastReq{
+					IDs: []string{"test_label"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool with forecast and health only",
+			"pool query --forecast --health-only test_label",
+			"",
+			errors.New("--forecast may not be mixed"),
+		},
 		{
 			"Query pool with empty ID",
 			"pool query \"\"",
@@ -1153,6 +1422,65 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Upgrade pools owned by user",
+			"pool upgrade --owner bob",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			}, " "),
+			nil,
+		},
+		{
+			"Apply pool manifest without file",
+			"pool apply",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"Apply nonexistent pool manifest",
+			"pool apply -f /nonexistent/pools.yaml",
+			"",
+			errors.New("reading pool manifest"),
+		},
+		{
+			"Apply invalid pool manifest",
+			fmt.Sprintf("pool apply -f %s", testBadManifestFile),
+			"",
+			errors.New("no pools in manifest"),
+		},
+		{
+			"Apply pool manifest dry-run",
+			fmt.Sprintf("pool apply -f %s --dry-run", testManifestFile),
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			}, " "),
+			nil,
+		},
+		{
+			"Apply pool manifest",
+			fmt.Sprintf("pool apply -f %s", testManifestFile),
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.06, 0.94},
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "tank"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Export pools",
+			"pool export",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"pool quack",
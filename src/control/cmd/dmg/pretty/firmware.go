@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -569,3 +570,30 @@ func PrintNVMeFirmwareUpdateMapVerbose(fwMap control.HostNVMeUpdateMap, out io.W
 	}
 	return w.Err
 }
+
+// PrintFirmwareRollingUpdate generates a table listing the supplied rolling
+// firmware update fault domains and the stage reached in each.
+func PrintFirmwareRollingUpdate(out io.Writer, domains []*control.FirmwareRollingDomain) {
+	if len(domains) == 0 {
+		fmt.Fprintln(out, "No fault domains updated")
+		return
+	}
+
+	titles := []string{"Fault Domain", "Hosts", "Ranks", "Devices", "Stage", "Error"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	for _, d := range domains {
+		row := txtfmt.TableRow{
+			"Fault Domain": d.Domain,
+			"Hosts":        strings.Join(d.Hosts, ","),
+			"Ranks":        d.Ranks.String(),
+			"Devices":      fmt.Sprintf("%d", d.Devices),
+			"Stage":        string(d.Stage),
+			"Error":        d.Error,
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
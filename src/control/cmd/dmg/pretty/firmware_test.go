@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -15,6 +16,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
@@ -1449,3 +1451,58 @@ host2
 		})
 	}
 }
+
+func TestPretty_PrintFirmwareRollingUpdate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		domains []*control.FirmwareRollingDomain
+		expOut  string
+	}{
+		"no domains": {
+			expOut: `
+No fault domains updated
+`,
+		},
+		"domains": {
+			domains: []*control.FirmwareRollingDomain{
+				{
+					Domain:  "/host1",
+					Hosts:   []string{"10.0.0.1:10001"},
+					Ranks:   ranklist.MustCreateRankSet("0-1"),
+					Devices: 2,
+					Stage:   control.FirmwareRollingStageDone,
+				},
+				{
+					Domain:  "/host2",
+					Hosts:   []string{"10.0.0.2:10001"},
+					Ranks:   ranklist.MustCreateRankSet("2"),
+					Devices: 2,
+					Stage:   control.FirmwareRollingStageUpdate,
+					Error:   "bad image",
+				},
+				{
+					Domain: "/host3",
+					Hosts:  []string{"10.0.0.3:10001"},
+					Ranks:  ranklist.MustCreateRankSet("3"),
+					Stage:  control.FirmwareRollingStagePending,
+				},
+			},
+			expOut: `
+Fault Domain Hosts          Ranks Devices Stage   Error     
+------------ -----          ----- ------- -----   -----     
+/host1       10.0.0.1:10001 0-1   2       done              
+/host2       10.0.0.2:10001 2     2       update  bad image 
+/host3       10.0.0.3:10001 3     0       pending           
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintFirmwareRollingUpdate(&out, tc.domains)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
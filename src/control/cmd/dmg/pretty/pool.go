@@ -10,6 +10,8 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -195,3 +197,273 @@ func PrintPoolProperties(poolID string, out io.Writer, properties ...*daos.PoolP
 	tf.InitWriter(out)
 	tf.Format(table)
 }
+
+// PrintPoolApplyPlan displays the changes needed to converge the pools in the
+// system with a manifest, followed by any differences that cannot be converged.
+func PrintPoolApplyPlan(out io.Writer, plan *control.PoolApplyPlan) {
+	if len(plan.Changes) == 0 {
+		fmt.Fprintln(out, "No changes required")
+	} else {
+		poolTitle := "Pool"
+		actionTitle := "Action"
+		changesTitle := "Changes"
+
+		table := []txtfmt.TableRow{}
+		for _, change := range plan.Changes {
+			table = append(table, txtfmt.TableRow{
+				poolTitle:    change.Pool,
+				actionTitle:  string(change.Action),
+				changesTitle: strings.Join(change.Details, ", "),
+			})
+		}
+
+		tf := txtfmt.NewTableFormatter(poolTitle, actionTitle, changesTitle)
+		fmt.Fprintln(out, tf.Format(table))
+	}
+
+	for _, warning := range plan.Warnings {
+		fmt.Fprintf(out, "Warning: %s\n", warning)
+	}
+}
+
+func forecastGrowth(ptf *control.PoolTierForecast) string {
+	if ptf.GrowthRate < 0 {
+		return "-" + humanize.IBytes(uint64(-ptf.GrowthRate))
+	}
+	return humanize.IBytes(uint64(ptf.GrowthRate))
+}
+
+func forecastDaysUntilFull(ptf *control.PoolTierForecast) string {
+	switch {
+	case ptf.Free == 0:
+		return "full"
+	case ptf.DaysUntilFull < 0:
+		return "never"
+	default:
+		return fmt.Sprintf("%.1f", ptf.DaysUntilFull)
+	}
+}
+
+// PrintPoolForecasts generates a table listing the usage growth rate and the
+// projected number of days until full of each storage tier in the supplied
+// pool forecasts.
+func PrintPoolForecasts(out io.Writer, forecasts []*control.PoolUsageForecast) {
+	if len(forecasts) == 0 {
+		fmt.Fprintln(out, msgNoPools)
+		return
+	}
+
+	poolTitle := "Pool"
+	tierTitle := "Tier"
+	usedTitle := "Used"
+	sizeTitle := "Size"
+	growthTitle := "Growth/Day"
+	fullTitle := "Days Until Full"
+
+	table := []txtfmt.TableRow{}
+	for _, pf := range forecasts {
+		name := pf.Label
+		if name == "" {
+			name = pf.UUID
+		}
+
+		if len(pf.Tiers) == 0 {
+			table = append(table, txtfmt.TableRow{
+				poolTitle:   name,
+				tierTitle:   "-",
+				usedTitle:   "-",
+				sizeTitle:   "-",
+				growthTitle: "-",
+				fullTitle:   "-",
+			})
+			continue
+		}
+
+		for _, ptf := range pf.Tiers {
+			row := txtfmt.TableRow{
+				poolTitle:   name,
+				tierTitle:   strings.ToUpper(ptf.MediaType.String()),
+				usedTitle:   humanize.IBytes(ptf.Total - ptf.Free),
+				sizeTitle:   humanize.IBytes(ptf.Total),
+				growthTitle: "-",
+				fullTitle:   "-",
+			}
+			if pf.NumSamples > 1 {
+				row[growthTitle] = forecastGrowth(ptf)
+				row[fullTitle] = forecastDaysUntilFull(ptf)
+			}
+			table = append(table, row)
+		}
+	}
+
+	tf := txtfmt.NewTableFormatter(poolTitle, tierTitle, usedTitle, sizeTitle, growthTitle, fullTitle)
+	fmt.Fprintln(out, tf.Format(table))
+}
+
+// PrintPoolRebuildProgress writes a single line describing the supplied pool
+// rebuild progress.
+func PrintPoolRebuildProgress(out io.Writer, prog *control.PoolRebuildProgress) {
+	ts := prog.Time.Format(time.TimeOnly)
+	rs := prog.Rebuild
+	if rs == nil {
+		fmt.Fprintf(out, "%s No rebuild status available\n", ts)
+		return
+	}
+	if rs.Status != 0 {
+		fmt.Fprintf(out, "%s Rebuild failed, status=%d\n", ts, rs.Status)
+		return
+	}
+
+	objs := fmt.Sprintf("%d", rs.Objects)
+	if rs.TotalObjects > 0 {
+		objs += fmt.Sprintf("/%d", rs.TotalObjects)
+	}
+	line := fmt.Sprintf("%s Rebuild %s, %s objs, %d recs", ts, rs.State, objs, rs.Records)
+	if prog.PercentDone >= 0 {
+		line += fmt.Sprintf(" (%.01f%% complete)", prog.PercentDone)
+	}
+
+	if rs.State == daos.PoolRebuildStateBusy {
+		line += fmt.Sprintf(", %.01f objs/s, %.01f recs/s", prog.ObjectRate, prog.RecordRate)
+		eta := "unknown"
+		if prog.ETA >= 0 {
+			eta = prog.ETA.String()
+		}
+		line += ", ETA " + eta
+	}
+
+	fmt.Fprintln(out, line)
+}
+
+// PrintPoolRebuildHistory generates a table listing the supplied pool rebuild
+// records.
+func PrintPoolRebuildHistory(out io.Writer, records []*control.PoolRebuildRecord) {
+	if len(records) == 0 {
+		fmt.Fprintln(out, "No rebuilds recorded for pool")
+		return
+	}
+
+	opTitle := "Operation"
+	verTitle := "Map Version"
+	startTitle := "Started"
+	finishTitle := "Finished"
+	durTitle := "Duration"
+	resultTitle := "Result"
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	table := []txtfmt.TableRow{}
+	for _, rec := range records {
+		row := txtfmt.TableRow{
+			opTitle:     rec.Operation,
+			verTitle:    fmt.Sprintf("%d", rec.MapVersion),
+			startTitle:  formatTime(rec.Started),
+			finishTitle: formatTime(rec.Finished),
+			durTitle:    "-",
+			resultTitle: "in progress",
+		}
+		if dur := rec.Duration(); dur > 0 {
+			row[durTitle] = dur.String()
+		}
+		switch {
+		case rec.Failed:
+			row[resultTitle] = "failed"
+			if rec.Message != "" {
+				row[resultTitle] = rec.Message
+			}
+		case rec.Finished != nil:
+			row[resultTitle] = "done"
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(opTitle, verTitle, startTitle, finishTitle, durTitle, resultTitle)
+	fmt.Fprintln(out, tf.Format(table))
+}
+
+// PrintPoolOpResults generates a table listing the result of an operation on
+// each of a set of selected pools.
+func PrintPoolOpResults(out io.Writer, resp *control.PoolOpResp) {
+	if len(resp.Results) == 0 {
+		fmt.Fprintln(out, "No pools matched the selection")
+		return
+	}
+
+	labelTitle := "Pool"
+	uuidTitle := "UUID"
+	resultTitle := "Result"
+
+	table := []txtfmt.TableRow{}
+	for _, res := range resp.Results {
+		row := txtfmt.TableRow{
+			labelTitle:  res.PoolLabel,
+			uuidTitle:   res.PoolUUID.String(),
+			resultTitle: "succeeded",
+		}
+		if res.Error != "" {
+			row[resultTitle] = "failed: " + res.Error
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(labelTitle, uuidTitle, resultTitle)
+	fmt.Fprintln(out, tf.Format(table))
+}
+
+// PrintPoolResizeResponse generates a table listing the result of a pool resize on each of the
+// pool's ranks, preceded by the new per-rank allocation if the pool was resized.
+func PrintPoolResizeResponse(out io.Writer, resp *control.PoolResizeResp) {
+	if len(resp.TierBytes) > 1 {
+		fmt.Fprintf(out, "Per-rank allocation: SCM %s, NVMe %s\n",
+			humanize.IBytes(resp.TierBytes[0]), humanize.IBytes(resp.TierBytes[1]))
+	}
+
+	rankTitle := "Rank"
+	resultTitle := "Result"
+
+	table := []txtfmt.TableRow{}
+	for _, rr := range resp.RankResults {
+		row := txtfmt.TableRow{
+			rankTitle:   rr.Rank.String(),
+			resultTitle: "succeeded",
+		}
+		if rr.Status != 0 {
+			row[resultTitle] = "failed: " + daos.Status(rr.Status).Error()
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(rankTitle, resultTitle)
+	fmt.Fprintln(out, tf.Format(table))
+}
+
+// PrintPoolDoctorResponse generates a human-readable representation of the
+// findings of a pool doctor request, most severe first.
+func PrintPoolDoctorResponse(out io.Writer, resp *control.PoolDoctorResp) {
+	poolID := resp.PoolLabel
+	if poolID == "" {
+		poolID = resp.PoolUUID.String()
+	}
+	fmt.Fprintf(out, "Pool %s health score: %d/100\n", poolID, resp.HealthScore)
+
+	if len(resp.Findings) == 0 {
+		fmt.Fprintln(out, "No problems found")
+		return
+	}
+
+	fmt.Fprintln(out, "Findings:")
+	for i, f := range resp.Findings {
+		fmt.Fprintf(out, "%d. [%s] %s\n", i+1, strings.ToUpper(f.Severity.String()), f.Summary)
+		if f.Details != "" {
+			fmt.Fprintf(out, "   %s\n", f.Details)
+		}
+		for _, cmd := range f.Remediation {
+			fmt.Fprintf(out, "   Suggested: %s\n", cmd)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -634,3 +635,446 @@ Label UUID                                 State SvcReps SCM Size SCM Used SCM I
 		})
 	}
 }
+
+func TestPretty_PrintPoolApplyPlan(t *testing.T) {
+	for name, tc := range map[string]struct {
+		plan        *control.PoolApplyPlan
+		expPrintStr string
+	}{
+		"no changes": {
+			plan: &control.PoolApplyPlan{},
+			expPrintStr: `
+No changes required
+`,
+		},
+		"changes and warnings": {
+			plan: &control.PoolApplyPlan{
+				Changes: []*control.PoolApplyChange{
+					{
+						Pool:    "tank",
+						Action:  control.PoolApplyActionSetProp,
+						Details: []string{"reclaim: lazy -> time", "space_rb: 0 -> 5"},
+					},
+					{
+						Pool:    "scratch",
+						Action:  control.PoolApplyActionCreate,
+						Details: []string{"size 10%"},
+					},
+				},
+				Warnings: []string{"pool tank: has 3 service replicas, manifest specifies 5"},
+			},
+			expPrintStr: `
+Pool    Action   Changes                                 
+----    ------   -------                                 
+tank    set-prop reclaim: lazy -> time, space_rb: 0 -> 5 
+scratch create   size 10%                                
+
+Warning: pool tank: has 3 service replicas, manifest specifies 5
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolApplyPlan(&bld, tc.plan)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolForecasts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		forecasts   []*control.PoolUsageForecast
+		expPrintStr string
+	}{
+		"no pools": {
+			expPrintStr: `
+No pools in system
+`,
+		},
+		"forecasts": {
+			forecasts: []*control.PoolUsageForecast{
+				{
+					UUID:       test.MockUUID(1),
+					Label:      "tank",
+					NumSamples: 24,
+					Tiers: []*control.PoolTierForecast{
+						{
+							MediaType:     daos.StorageMediaTypeScm,
+							Total:         100 * humanize.GiByte,
+							Free:          70 * humanize.GiByte,
+							GrowthRate:    10 * humanize.GiByte,
+							DaysUntilFull: 7,
+						},
+						{
+							MediaType:     daos.StorageMediaTypeNvme,
+							Total:         humanize.TiByte,
+							Free:          humanize.TiByte,
+							DaysUntilFull: -1,
+						},
+					},
+				},
+				{
+					UUID:       test.MockUUID(2),
+					NumSamples: 1,
+					Tiers: []*control.PoolTierForecast{
+						{
+							MediaType:     daos.StorageMediaTypeScm,
+							Total:         humanize.GiByte,
+							Free:          humanize.GiByte,
+							DaysUntilFull: -1,
+						},
+					},
+				},
+				{
+					UUID:       test.MockUUID(3),
+					Label:      "scratch",
+					NumSamples: 10,
+					Tiers: []*control.PoolTierForecast{
+						{
+							MediaType:     daos.StorageMediaTypeScm,
+							Total:         humanize.GiByte,
+							GrowthRate:    -humanize.MiByte,
+							DaysUntilFull: -1,
+						},
+					},
+				},
+				{
+					UUID:  test.MockUUID(4),
+					Label: "new",
+				},
+			},
+			expPrintStr: `
+Pool                                 Tier Used    Size    Growth/Day Days Until Full 
+----                                 ---- ----    ----    ---------- --------------- 
+tank                                 SCM  30 GiB  100 GiB 10 GiB     7.0             
+tank                                 NVME 0 B     1.0 TiB 0 B        never           
+00000002-0002-0002-0002-000000000002 SCM  0 B     1.0 GiB -          -               
+scratch                              SCM  1.0 GiB 1.0 GiB -1.0 MiB   full            
+new                                  -    -       -       -          -               
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolForecasts(&bld, tc.forecasts)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolRebuildProgress(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 30, 10, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		prog        *control.PoolRebuildProgress
+		expPrintStr string
+	}{
+		"no rebuild status": {
+			prog: &control.PoolRebuildProgress{Time: ts},
+			expPrintStr: `
+12:30:10 No rebuild status available
+`,
+		},
+		"rebuild failed": {
+			prog: &control.PoolRebuildProgress{
+				Time:    ts,
+				Rebuild: &daos.PoolRebuildStatus{Status: -1007},
+			},
+			expPrintStr: `
+12:30:10 Rebuild failed, status=-1007
+`,
+		},
+		"idle": {
+			prog: &control.PoolRebuildProgress{
+				Time:        ts,
+				Rebuild:     &daos.PoolRebuildStatus{State: daos.PoolRebuildStateIdle},
+				PercentDone: -1,
+				ETA:         -1,
+			},
+			expPrintStr: `
+12:30:10 Rebuild idle, 0 objs, 0 recs
+`,
+		},
+		"busy with eta": {
+			prog: &control.PoolRebuildProgress{
+				Time: ts,
+				Rebuild: &daos.PoolRebuildStatus{
+					State:        daos.PoolRebuildStateBusy,
+					Objects:      30,
+					Records:      1100,
+					TotalObjects: 100,
+				},
+				ObjectRate:  2,
+				RecordRate:  100,
+				PercentDone: 30,
+				ETA:         35 * time.Second,
+			},
+			expPrintStr: `
+12:30:10 Rebuild busy, 30/100 objs, 1100 recs (30.0% complete), 2.0 objs/s, 100.0 recs/s, ETA 35s
+`,
+		},
+		"busy without eta": {
+			prog: &control.PoolRebuildProgress{
+				Time: ts,
+				Rebuild: &daos.PoolRebuildStatus{
+					State:   daos.PoolRebuildStateBusy,
+					Objects: 30,
+					Records: 1100,
+				},
+				PercentDone: -1,
+				ETA:         -1,
+			},
+			expPrintStr: `
+12:30:10 Rebuild busy, 30 objs, 1100 recs, 0.0 objs/s, 0.0 recs/s, ETA unknown
+`,
+		},
+		"done": {
+			prog: &control.PoolRebuildProgress{
+				Time: ts,
+				Rebuild: &daos.PoolRebuildStatus{
+					State:        daos.PoolRebuildStateDone,
+					Objects:      100,
+					Records:      4000,
+					TotalObjects: 100,
+				},
+				PercentDone: 100,
+			},
+			expPrintStr: `
+12:30:10 Rebuild done, 100/100 objs, 4000 recs (100.0% complete)
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolRebuildProgress(&bld, tc.prog)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolRebuildHistory(t *testing.T) {
+	started := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	finished := started.Add(90 * time.Minute)
+
+	for name, tc := range map[string]struct {
+		records     []*control.PoolRebuildRecord
+		expPrintStr string
+	}{
+		"no records": {
+			expPrintStr: `
+No rebuilds recorded for pool
+`,
+		},
+		"records": {
+			records: []*control.PoolRebuildRecord{
+				{
+					Operation:  "Rebuild",
+					MapVersion: 4,
+					Started:    &started,
+					Finished:   &finished,
+				},
+				{
+					Operation:  "Rebuild",
+					MapVersion: 6,
+					Finished:   &finished,
+					Failed:     true,
+					Message:    "Pool rebuild failed: DER_NOSPACE(-1007)",
+				},
+				{
+					Operation:  "Reclaim",
+					MapVersion: 7,
+					Started:    &started,
+				},
+			},
+			expPrintStr: `
+Operation Map Version Started              Finished             Duration Result                                  
+--------- ----------- -------              --------             -------- ------                                  
+Rebuild   4           2025-01-01T00:00:00Z 2025-01-01T01:30:00Z 1h30m0s  done                                    
+Rebuild   6           -                    2025-01-01T01:30:00Z -        Pool rebuild failed: DER_NOSPACE(-1007) 
+Reclaim   7           2025-01-01T00:00:00Z -                    -        in progress                             
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolRebuildHistory(&bld, tc.records)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolOpResults(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.PoolOpResp
+		expPrintStr string
+	}{
+		"no results": {
+			resp: new(control.PoolOpResp),
+			expPrintStr: `
+No pools matched the selection
+`,
+		},
+		"results": {
+			resp: &control.PoolOpResp{
+				Results: []*control.PoolOpResult{
+					{
+						PoolUUID:  test.MockPoolUUID(1),
+						PoolLabel: "tank-1",
+					},
+					{
+						PoolUUID:  test.MockPoolUUID(2),
+						PoolLabel: "tank-2",
+						Error:     "DER_BUSY(-1012): Device or resource busy",
+					},
+				},
+			},
+			expPrintStr: `
+Pool   UUID                                 Result                                           
+----   ----                                 ------                                           
+tank-1 00000001-0001-0001-0001-000000000001 succeeded                                        
+tank-2 00000002-0002-0002-0002-000000000002 failed: DER_BUSY(-1012): Device or resource busy 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolOpResults(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolResizeResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.PoolResizeResp
+		expPrintStr string
+	}{
+		"resized": {
+			resp: &control.PoolResizeResp{
+				TierBytes: []uint64{humanize.GiByte, 20 * humanize.GiByte},
+				RankResults: []*control.PoolResizeRankResult{
+					{Rank: 0},
+					{Rank: 1},
+				},
+			},
+			expPrintStr: `
+Per-rank allocation: SCM 1.0 GiB, NVMe 20 GiB
+Rank Result    
+---- ------    
+0    succeeded 
+1    succeeded 
+
+`,
+		},
+		"failed on some ranks": {
+			resp: &control.PoolResizeResp{
+				Status: int32(daos.NoSpace),
+				RankResults: []*control.PoolResizeRankResult{
+					{Rank: 0},
+					{Rank: 1, Status: int32(daos.NoSpace)},
+				},
+			},
+			expPrintStr: `
+Rank Result                                                 
+---- ------                                                 
+0    succeeded                                              
+1    failed: DER_NOSPACE(-1007): No space on storage target 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolResizeResponse(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolDoctorResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.PoolDoctorResp
+		expPrintStr string
+	}{
+		"healthy": {
+			resp: &control.PoolDoctorResp{
+				PoolUUID:    test.MockPoolUUID(1),
+				PoolLabel:   "tank",
+				HealthScore: 100,
+			},
+			expPrintStr: `
+Pool tank health score: 100/100
+No problems found
+`,
+		},
+		"findings": {
+			resp: &control.PoolDoctorResp{
+				PoolUUID:    test.MockPoolUUID(1),
+				HealthScore: 60,
+				Findings: []*control.PoolFinding{
+					{
+						Severity:    control.PoolFindingCritical,
+						Summary:     "Rank 3 is dead",
+						Details:     "engine state Excluded on 10.0.0.1",
+						Remediation: []string{"dmg system start --ranks 3"},
+					},
+					{
+						Severity: control.PoolFindingWarning,
+						Summary:  "SCM tier is low on free space",
+						Details:  "8.0% free",
+						Remediation: []string{
+							"dmg pool query --forecast tank",
+							"dmg pool extend tank --ranks <ranks>",
+						},
+					},
+					{
+						Severity: control.PoolFindingInfo,
+						Summary:  "Pool layout can be upgraded",
+					},
+				},
+			},
+			expPrintStr: `
+Pool 00000001-0001-0001-0001-000000000001 health score: 60/100
+Findings:
+1. [CRITICAL] Rank 3 is dead
+   engine state Excluded on 10.0.0.1
+   Suggested: dmg system start --ranks 3
+2. [WARNING] SCM tier is low on free space
+   8.0% free
+   Suggested: dmg pool query --forecast tank
+   Suggested: dmg pool extend tank --ranks <ranks>
+3. [INFO] Pool layout can be upgraded
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolDoctorResponse(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
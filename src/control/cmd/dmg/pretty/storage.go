@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,7 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
@@ -134,3 +136,127 @@ func PrintStorageFormatMap(hsm control.HostStorageMap, out io.Writer, opts ...Pr
 	tablePrint.Format(table)
 	return nil
 }
+
+func printMetadataFormatPlan(plan *control.MetadataFormatPlan, out io.Writer) {
+	switch {
+	case plan == nil:
+		fmt.Fprintln(out, "Control metadata: stored on SCM")
+	case !plan.Format:
+		fmt.Fprintf(out, "Control metadata: %s is already formatted\n", plan.RootPath)
+	default:
+		if plan.Device != "" {
+			fmt.Fprintf(out, "Control metadata: device %s would be formatted and mounted at %s\n",
+				plan.Device, plan.RootPath)
+		} else {
+			fmt.Fprintf(out, "Control metadata: %s would be formatted\n", plan.RootPath)
+		}
+		iw := txtfmt.NewIndentWriter(out)
+		fmt.Fprintln(iw, "Directories created:")
+		for _, path := range plan.Paths {
+			fmt.Fprintf(txtfmt.NewIndentWriter(iw), "%s\n", path)
+		}
+	}
+}
+
+func scmFormatPlanAction(plan *control.ScmFormatPlan) string {
+	switch {
+	case !plan.Format:
+		return plan.Info
+	case plan.RamdiskSize != 0:
+		return fmt.Sprintf("mount %d GiB tmpfs at %s", plan.RamdiskSize, plan.MountPoint)
+	default:
+		return fmt.Sprintf("mkfs and mount at %s", plan.MountPoint)
+	}
+}
+
+func bdevTierFormatPlanAction(engine *control.EngineFormatPlan, tier *control.BdevTierFormatPlan) string {
+	switch {
+	case !engine.FormatBdevs:
+		return engine.BdevInfo
+	case len(tier.Devices) == 0:
+		return "no devices"
+	default:
+		return "format"
+	}
+}
+
+func formatPlanDevices(devices []string) string {
+	if len(devices) == 0 {
+		return "-"
+	}
+	return strings.Join(devices, ",")
+}
+
+// PrintStorageFormatPlans generates a human-readable representation of the supplied per-host
+// storage format plans and writes it to the supplied io.Writer.
+func PrintStorageFormatPlans(plans map[string]*control.StorageFormatPlan, out io.Writer, opts ...PrintConfigOption) error {
+	if len(plans) == 0 {
+		return nil
+	}
+
+	addrs := make([]string, 0, len(plans))
+	for addr := range plans {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	engineTitle := "Engine"
+	tierTitle := "Tier"
+	classTitle := "Class"
+	devicesTitle := "Devices"
+	rolesTitle := "Roles"
+	actionTitle := "Action"
+
+	for _, addr := range addrs {
+		plan := plans[addr]
+		hosts := getPrintHosts(addr, opts...)
+		lineBreak := strings.Repeat("-", len(hosts))
+		fmt.Fprintf(out, "%s\n%s\n%s\n", lineBreak, hosts, lineBreak)
+
+		printMetadataFormatPlan(plan.Metadata, out)
+		if len(plan.Engines) == 0 {
+			fmt.Fprintln(out, "No engines configured")
+			fmt.Fprintln(out)
+			continue
+		}
+		fmt.Fprintln(out)
+
+		tablePrint := txtfmt.NewTableFormatter(engineTitle, tierTitle, classTitle,
+			devicesTitle, rolesTitle, actionTitle)
+		tablePrint.InitWriter(out)
+		table := []txtfmt.TableRow{}
+		for _, engine := range plan.Engines {
+			if engine.Scm != nil {
+				table = append(table, txtfmt.TableRow{
+					engineTitle:  fmt.Sprintf("%d", engine.Index),
+					tierTitle:    "0",
+					classTitle:   engine.Scm.Class,
+					devicesTitle: formatPlanDevices(engine.Scm.Devices),
+					rolesTitle:   "-",
+					actionTitle:  scmFormatPlanAction(engine.Scm),
+				})
+			}
+			for _, tier := range engine.BdevTiers {
+				table = append(table, txtfmt.TableRow{
+					engineTitle:  fmt.Sprintf("%d", engine.Index),
+					tierTitle:    fmt.Sprintf("%d", tier.Tier),
+					classTitle:   tier.Class,
+					devicesTitle: formatPlanDevices(tier.Devices),
+					rolesTitle:   tier.Roles,
+					actionTitle:  bdevTierFormatPlanAction(engine, tier),
+				})
+			}
+		}
+		tablePrint.Format(table)
+
+		for _, engine := range plan.Engines {
+			if engine.FormatBdevs && engine.NvmeConfig != "" {
+				fmt.Fprintf(out, "Engine %d NVMe config would be written to %s\n",
+					engine.Index, engine.NvmeConfig)
+			}
+		}
+		fmt.Fprintln(out)
+	}
+
+	return nil
+}
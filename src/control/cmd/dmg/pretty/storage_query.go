@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -438,3 +440,135 @@ func PrintSmdManageResp(op control.SmdManageOpcode, resp *control.SmdResp, out,
 		return errors.Errorf("unsupported opcode %d", op)
 	}
 }
+
+// PrintNvmeHealthHistory generates a table summarizing the health trends of
+// the NVMe devices in the supplied response. If samples is set, the health
+// samples of each device are also listed.
+func PrintNvmeHealthHistory(out io.Writer, resp *control.NvmeHealthHistoryResp, samples bool) {
+	if len(resp.Devices) == 0 {
+		fmt.Fprintln(out, "No NVMe health history found")
+		return
+	}
+
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	uuidTitle := "UUID"
+	periodTitle := "Period"
+	errsTitle := "Media/Read/Write/Csum Errs"
+	tempTitle := "Max Temp"
+	usedTitle := "Life Used"
+	rateTitle := "Wear/Day"
+	eolTitle := "End of Life"
+
+	var table []txtfmt.TableRow
+	for _, dev := range resp.Devices {
+		row := txtfmt.TableRow{
+			hostTitle:   dev.Host,
+			rankTitle:   dev.Rank.String(),
+			uuidTitle:   dev.UUID,
+			periodTitle: "-",
+			errsTitle:   "-",
+			tempTitle:   "-",
+			usedTitle:   "-",
+			rateTitle:   "-",
+			eolTitle:    "-",
+		}
+		if tr := dev.Trend; tr != nil {
+			row[periodTitle] = fmt.Sprintf("%.01f days", float64(tr.Period)/(24*60*60))
+			row[errsTitle] = fmt.Sprintf("+%d/+%d/+%d/+%d", tr.MediaErrors, tr.ReadErrors,
+				tr.WriteErrors, tr.ChecksumErrors)
+			row[tempTitle] = fmt.Sprintf("%dK", tr.MaxTemperature)
+			row[usedTitle] = fmt.Sprintf("%.01f%%", tr.LifeUsed)
+			if tr.AvailSpareWarn {
+				row[usedTitle] += " (spare low)"
+			}
+			if tr.Period > 0 {
+				row[rateTitle] = fmt.Sprintf("%.03f%%", tr.WearRate)
+			}
+			if tr.EndOfLife != nil {
+				row[eolTitle] = tr.EndOfLife.Format(time.DateOnly)
+			}
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(hostTitle, rankTitle, uuidTitle, periodTitle, errsTitle,
+		tempTitle, usedTitle, rateTitle, eolTitle)
+	fmt.Fprintln(out, tf.Format(table))
+
+	if !samples {
+		return
+	}
+
+	timeTitle := "Time"
+	mediaTitle := "Media Errs"
+	readTitle := "Read Errs"
+	writeTitle := "Write Errs"
+	csumTitle := "Csum Errs"
+	sampleTempTitle := "Temp"
+	wearTitle := "Wear Leveling"
+
+	for _, dev := range resp.Devices {
+		fmt.Fprintf(out, "Device %s on %s rank %d:\n", dev.UUID, dev.Host, dev.Rank)
+		if len(dev.Samples) == 0 {
+			fmt.Fprintln(txtfmt.NewIndentWriter(out), "No samples")
+			continue
+		}
+
+		var sTable []txtfmt.TableRow
+		for _, s := range dev.Samples {
+			sTable = append(sTable, txtfmt.TableRow{
+				timeTitle:       s.Time().Format(time.DateTime),
+				mediaTitle:      fmt.Sprintf("%d", s.MediaErrors),
+				readTitle:       fmt.Sprintf("%d", s.ReadErrors),
+				writeTitle:      fmt.Sprintf("%d", s.WriteErrors),
+				csumTitle:       fmt.Sprintf("%d", s.ChecksumErrors),
+				sampleTempTitle: fmt.Sprintf("%dK", s.Temperature),
+				wearTitle: fmt.Sprintf("norm:%d avg:%d raw:%d", s.WearLevelingCntNorm,
+					s.WearLevelingCntAvg, s.MediaWearRaw),
+			})
+		}
+		stf := txtfmt.NewTableFormatter(timeTitle, mediaTitle, readTitle, writeTitle, csumTitle,
+			sampleTempTitle, wearTitle)
+		fmt.Fprintln(out, stf.Format(sTable))
+	}
+}
+
+// PrintNvmeReplaceState generates a human-readable representation of the
+// progress of a guided NVMe SSD replacement.
+func PrintNvmeReplaceState(out io.Writer, state *control.NvmeReplaceState) {
+	fmt.Fprintf(out, "Host: %s Rank: %d Stage: %s\n", state.Host, state.Rank, state.Stage)
+	if state.Error != "" {
+		fmt.Fprintf(out, "Error: %s\n", state.Error)
+	}
+
+	devTitle := "Device"
+	uuidTitle := "UUID"
+	pciTitle := "PCI Address"
+	serialTitle := "Serial"
+
+	orDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+
+	table := []txtfmt.TableRow{
+		{
+			devTitle:    "Replaced",
+			uuidTitle:   state.OldUUID,
+			pciTitle:    orDash(state.OldPciAddr),
+			serialTitle: orDash(state.OldSerial),
+		},
+		{
+			devTitle:    "Replacement",
+			uuidTitle:   orDash(state.NewUUID),
+			pciTitle:    orDash(state.NewPciAddr),
+			serialTitle: orDash(state.NewSerial),
+		},
+	}
+
+	tf := txtfmt.NewTableFormatter(devTitle, uuidTitle, pciTitle, serialTitle)
+	fmt.Fprintln(out, tf.Format(table))
+}
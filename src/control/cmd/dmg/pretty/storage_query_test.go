@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -17,6 +18,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
@@ -939,3 +941,182 @@ host[1-2]
 		})
 	}
 }
+
+func TestPretty_PrintNvmeHealthHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	eol := time.Date(2026, 3, 27, 0, 0, 0, 0, time.UTC)
+	samples := []*storage.NvmeHealthSample{
+		{
+			Timestamp:    uint64(start.Unix()),
+			Temperature:  300,
+			MediaWearRaw: 10240,
+		},
+		{
+			Timestamp:      uint64(start.Add(10 * 24 * time.Hour).Unix()),
+			MediaErrors:    1,
+			ReadErrors:     2,
+			ChecksumErrors: 3,
+			Temperature:    310,
+			MediaWearRaw:   12288,
+		},
+	}
+	devices := func(withSamples bool) []*control.HostNvmeHealthHistory {
+		dev1 := &control.HostNvmeHealthHistory{
+			Host: "host1",
+			NvmeHealthHistory: storage.NvmeHealthHistory{
+				UUID: test.MockUUID(1),
+				Rank: 0,
+			},
+			Trend: &storage.NvmeHealthTrend{
+				NumSamples:       2,
+				Period:           10 * 24 * 60 * 60,
+				MediaErrors:      1,
+				ReadErrors:       2,
+				ChecksumErrors:   3,
+				MaxTemperature:   310,
+				LifeUsed:         12,
+				WearRate:         0.2,
+				DaysUntilWornOut: 440,
+				EndOfLife:        &eol,
+			},
+		}
+		if withSamples {
+			dev1.Samples = samples
+		}
+		return []*control.HostNvmeHealthHistory{
+			dev1,
+			{
+				Host: "host2",
+				NvmeHealthHistory: storage.NvmeHealthHistory{
+					UUID: test.MockUUID(2),
+					Rank: 1,
+				},
+				Trend: &storage.NvmeHealthTrend{
+					NumSamples:       1,
+					MaxTemperature:   300,
+					AvailSpareWarn:   true,
+					LifeUsed:         50,
+					DaysUntilWornOut: -1,
+				},
+			},
+			{
+				Host: "host3",
+				NvmeHealthHistory: storage.NvmeHealthHistory{
+					UUID: test.MockUUID(3),
+					Rank: ranklist.Rank(2),
+				},
+			},
+		}
+	}
+	trendTable := `
+Host  Rank UUID                                 Period    Media/Read/Write/Csum Errs Max Temp Life Used         Wear/Day End of Life 
+----  ---- ----                                 ------    -------------------------- -------- ---------         -------- ----------- 
+host1 0    00000001-0001-0001-0001-000000000001 10.0 days +1/+2/+0/+3                310K     12.0%             0.200%   2026-03-27  
+host2 1    00000002-0002-0002-0002-000000000002 0.0 days  +0/+0/+0/+0                300K     50.0% (spare low) -        -           
+host3 2    00000003-0003-0003-0003-000000000003 -         -                          -        -                 -        -           
+
+`
+
+	for name, tc := range map[string]struct {
+		resp        *control.NvmeHealthHistoryResp
+		samples     bool
+		expPrintStr string
+	}{
+		"no devices": {
+			resp: &control.NvmeHealthHistoryResp{},
+			expPrintStr: `
+No NVMe health history found
+`,
+		},
+		"trends only": {
+			resp: &control.NvmeHealthHistoryResp{
+				Devices: devices(false),
+			},
+			expPrintStr: trendTable,
+		},
+		"with samples": {
+			resp: &control.NvmeHealthHistoryResp{
+				Devices: devices(true),
+			},
+			samples: true,
+			expPrintStr: trendTable + fmt.Sprintf(`Device 00000001-0001-0001-0001-000000000001 on host1 rank 0:
+Time                Media Errs Read Errs Write Errs Csum Errs Temp Wear Leveling          
+----                ---------- --------- ---------- --------- ---- -------------          
+%s 0          0         0          0         300K norm:0 avg:0 raw:10240 
+%s 1          2         0          3         310K norm:0 avg:0 raw:12288 
+
+Device 00000002-0002-0002-0002-000000000002 on host2 rank 1:
+  No samples
+Device 00000003-0003-0003-0003-000000000003 on host3 rank 2:
+  No samples
+`, samples[0].Time().Format(time.DateTime), samples[1].Time().Format(time.DateTime)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintNvmeHealthHistory(&bld, tc.resp, tc.samples)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintNvmeReplaceState(t *testing.T) {
+	for name, tc := range map[string]struct {
+		state       *control.NvmeReplaceState
+		expPrintStr string
+	}{
+		"waiting for new device": {
+			state: &control.NvmeReplaceState{
+				Host:       "host1",
+				Rank:       1,
+				OldUUID:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				OldSerial:  "old",
+				Stage:      control.NvmeReplaceStageWaitDevice,
+				Error:      "timed out after 30m0s waiting for a new SSD on host host1",
+			},
+			expPrintStr: `
+Host: host1 Rank: 1 Stage: wait-new-device
+Error: timed out after 30m0s waiting for a new SSD on host host1
+Device      UUID                                 PCI Address  Serial 
+------      ----                                 -----------  ------ 
+Replaced    00000001-0001-0001-0001-000000000001 0000:01:00.0 old    
+Replacement -                                    -            -      
+
+`,
+		},
+		"done": {
+			state: &control.NvmeReplaceState{
+				Host:       "host1",
+				Rank:       1,
+				OldUUID:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				OldSerial:  "old",
+				NewUUID:    test.MockUUID(2),
+				NewPciAddr: test.MockPCIAddr(1),
+				NewSerial:  "new",
+				Stage:      control.NvmeReplaceStageDone,
+			},
+			expPrintStr: `
+Host: host1 Rank: 1 Stage: done
+Device      UUID                                 PCI Address  Serial 
+------      ----                                 -----------  ------ 
+Replaced    00000001-0001-0001-0001-000000000001 0000:01:00.0 old    
+Replacement 00000002-0002-0002-0002-000000000002 0000:01:00.0 new    
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintNvmeReplaceState(&bld, tc.state)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
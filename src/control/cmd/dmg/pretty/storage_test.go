@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -1259,3 +1260,143 @@ SCM Mount Format Result
 		})
 	}
 }
+
+func TestPretty_PrintStorageFormatPlans(t *testing.T) {
+	for name, tc := range map[string]struct {
+		plans       map[string]*control.StorageFormatPlan
+		expPrintStr string
+	}{
+		"no plans": {},
+		"no engines": {
+			plans: map[string]*control.StorageFormatPlan{
+				"host1:10001": {
+					Metadata: &control.MetadataFormatPlan{RootPath: "/md"},
+				},
+			},
+			expPrintStr: `
+-----
+host1
+-----
+Control metadata: /md is already formatted
+No engines configured
+
+`,
+		},
+		"multiple hosts": {
+			plans: map[string]*control.StorageFormatPlan{
+				"host2:10001": {
+					Engines: []*control.EngineFormatPlan{
+						{
+							Scm: &control.ScmFormatPlan{
+								Class:       "ram",
+								MountPoint:  "/mnt/daos0",
+								RamdiskSize: 16,
+								Info:        "SCM is already formatted",
+							},
+							BdevTiers: []*control.BdevTierFormatPlan{
+								{
+									Tier:    1,
+									Class:   "nvme",
+									Devices: []string{"0000:80:00.0"},
+									Roles:   "NA",
+								},
+							},
+							BdevInfo: "NVMe format skipped on instance 0, SCM was not formatted",
+						},
+					},
+				},
+				"host1:10001": {
+					Metadata: &control.MetadataFormatPlan{
+						Format:   true,
+						RootPath: "/var/daos/config",
+						Device:   "/dev/sdb1",
+						Paths: []string{
+							"/var/daos/config",
+							"/var/daos/config/daos_control",
+							"/var/daos/config/daos_control/engine0",
+							"/var/daos/config/daos_control/engine1",
+						},
+					},
+					Engines: []*control.EngineFormatPlan{
+						{
+							Scm: &control.ScmFormatPlan{
+								Format:     true,
+								Class:      "dcpm",
+								MountPoint: "/mnt/daos0",
+								Devices:    []string{"/dev/pmem0"},
+							},
+							FormatBdevs: true,
+							BdevTiers: []*control.BdevTierFormatPlan{
+								{
+									Tier:    1,
+									Class:   "nvme",
+									Devices: []string{"0000:80:00.0", "0000:81:00.0"},
+									Roles:   "wal,meta",
+								},
+								{
+									Tier:  2,
+									Class: "nvme",
+									Roles: "data",
+								},
+							},
+							NvmeConfig: "/mnt/daos0/daos_nvme.conf",
+						},
+						{
+							Index: 1,
+							Scm: &control.ScmFormatPlan{
+								Format:      true,
+								Class:       "ram",
+								MountPoint:  "/mnt/daos1",
+								RamdiskSize: 16,
+							},
+							FormatBdevs: true,
+							NvmeConfig:  "/mnt/daos1/daos_nvme.conf",
+						},
+					},
+				},
+			},
+			expPrintStr: `
+-----
+host1
+-----
+Control metadata: device /dev/sdb1 would be formatted and mounted at /var/daos/config
+  Directories created:
+    /var/daos/config
+    /var/daos/config/daos_control
+    /var/daos/config/daos_control/engine0
+    /var/daos/config/daos_control/engine1
+
+Engine Tier Class Devices                   Roles    Action                           
+------ ---- ----- -------                   -----    ------                           
+0      0    dcpm  /dev/pmem0                -        mkfs and mount at /mnt/daos0     
+0      1    nvme  0000:80:00.0,0000:81:00.0 wal,meta format                           
+0      2    nvme  -                         data     no devices                       
+1      0    ram   -                         -        mount 16 GiB tmpfs at /mnt/daos1 
+Engine 0 NVMe config would be written to /mnt/daos0/daos_nvme.conf
+Engine 1 NVMe config would be written to /mnt/daos1/daos_nvme.conf
+
+-----
+host2
+-----
+Control metadata: stored on SCM
+
+Engine Tier Class Devices      Roles Action                                                   
+------ ---- ----- -------      ----- ------                                                   
+0      0    ram   -            -     SCM is already formatted                                 
+0      1    nvme  0000:80:00.0 NA    NVMe format skipped on instance 0, SCM was not formatted 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintStorageFormatPlans(tc.plans, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
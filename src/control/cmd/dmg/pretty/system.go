@@ -8,10 +8,14 @@
 package pretty
 
 import (
+	"encoding/csv"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
@@ -71,6 +75,21 @@ func printAbsentRanks(out io.Writer, absentRanks *ranklist.RankSet) {
 	}
 }
 
+func printMaintenanceRanks(out io.Writer, members system.Members) {
+	maintRanks := ranklist.MustCreateRankSet("")
+	for _, m := range members {
+		if m.Maintenance {
+			maintRanks.Add(m.Rank)
+		}
+	}
+
+	if maintRanks.Count() > 0 {
+		fmt.Fprintf(out, "%s in maintenance: %s\n",
+			english.Plural(maintRanks.Count(), "rank", "ranks"),
+			maintRanks.String())
+	}
+}
+
 func printSystemQuery(out io.Writer, members system.Members, absentRanks *ranklist.RankSet) error {
 	groups := make(system.RankGroups)
 	if err := groups.FromMembers(members); err != nil {
@@ -84,6 +103,7 @@ func printSystemQuery(out io.Writer, members system.Members, absentRanks *rankli
 	if err := tabulateRankGroups(out, groups, "Rank", "State"); err != nil {
 		return errors.Wrap(err, "printing state table")
 	}
+	printMaintenanceRanks(out, members)
 
 	return nil
 }
@@ -105,6 +125,9 @@ func printSystemQueryVerbose(out io.Writer, members system.Members) {
 		row[addrTitle] = m.Addr.String()
 		row[faultDomainTitle] = m.FaultDomain.String()
 		row[stateTitle] = m.State.String()
+		if m.Maintenance {
+			row[stateTitle] += " (maintenance)"
+		}
 		row[reasonTitle] = m.Info
 
 		table = append(table, row)
@@ -253,3 +276,287 @@ func PrintPoolRankResults(out io.Writer, results []*control.PoolRankResult) {
 
 	fmt.Fprintln(out, formatter.Format(table))
 }
+
+func poolImpactString(pi *control.PoolImpact) string {
+	var impacts []string
+	switch {
+	case pi.RfBroken:
+		impacts = append(impacts, "exceeds RF")
+	case pi.Degraded:
+		impacts = append(impacts, "degraded")
+	}
+	if pi.SvcMajorityLost {
+		impacts = append(impacts, "pool service unavailable")
+	}
+	if pi.Msg != "" {
+		impacts = append(impacts, "RF unknown")
+	}
+	if len(impacts) == 0 {
+		return "none"
+	}
+	return strings.Join(impacts, ", ")
+}
+
+// PrintPoolImpacts generates a table showing the predicted impact on each pool
+// of making a set of ranks unavailable.
+func PrintPoolImpacts(out io.Writer, impacts []*control.PoolImpact) {
+	if len(impacts) == 0 {
+		fmt.Fprintln(out, "No pools would be affected")
+		return
+	}
+
+	titles := []string{"Pool", "Ranks", "Down Ranks", "RF", "Svc Ranks", "Impact"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	for _, pi := range impacts {
+		rf := "-"
+		if pi.Msg == "" {
+			rf = fmt.Sprintf("%d", pi.RedundancyFactor)
+		}
+		downRanks := "-"
+		if pi.DownRanks != "" {
+			downRanks = pi.DownRanks
+		}
+		svcRanks := "-"
+		if pi.SvcRanks != "" {
+			svcRanks = fmt.Sprintf("%s of %d", pi.SvcRanks, pi.SvcReplicas)
+		}
+		row := txtfmt.TableRow{
+			"Pool":       pi.PoolID,
+			"Ranks":      pi.Ranks,
+			"Down Ranks": downRanks,
+			"RF":         rf,
+			"Svc Ranks":  svcRanks,
+			"Impact":     poolImpactString(pi),
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemBackups generates a table listing the supplied MS database backups.
+func PrintSystemBackups(out io.Writer, backups []*control.SystemBackup) {
+	if len(backups) == 0 {
+		fmt.Fprintln(out, "No backups found")
+		return
+	}
+
+	titles := []string{"Name", "Index", "Term", "Map Version", "Members", "Pools", "Size", "Compressed"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	for _, b := range backups {
+		compressed := "no"
+		if b.Compressed {
+			compressed = "yes"
+		}
+		row := txtfmt.TableRow{
+			"Name":        b.Name,
+			"Index":       fmt.Sprintf("%d", b.Index),
+			"Term":        fmt.Sprintf("%d", b.Term),
+			"Map Version": fmt.Sprintf("%d", b.MapVersion),
+			"Members":     fmt.Sprintf("%d", b.NumMembers),
+			"Pools":       fmt.Sprintf("%d", b.NumPools),
+			"Size":        humanize.Bytes(uint64(b.Size)),
+			"Compressed":  compressed,
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemReplicas generates a table listing the supplied MS replicas.
+func PrintSystemReplicas(out io.Writer, replicas []*control.SystemReplica) {
+	if len(replicas) == 0 {
+		fmt.Fprintln(out, "No MS replicas found")
+		return
+	}
+
+	titles := []string{"Address", "State", "Leader"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	for _, r := range replicas {
+		leader := "no"
+		if r.Leader {
+			leader = "yes"
+		}
+		row := txtfmt.TableRow{
+			"Address": r.Addr,
+			"State":   r.State,
+			"Leader":  leader,
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemRollingRestart generates a table listing the supplied rolling
+// restart fault domains and the stage reached in each.
+func PrintSystemRollingRestart(out io.Writer, domains []*control.RollingRestartDomain) {
+	if len(domains) == 0 {
+		fmt.Fprintln(out, "No fault domains restarted")
+		return
+	}
+
+	titles := []string{"Fault Domain", "Ranks", "Stage", "Error"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	for _, d := range domains {
+		row := txtfmt.TableRow{
+			"Fault Domain": d.Domain,
+			"Ranks":        d.Ranks.String(),
+			"Stage":        string(d.Stage),
+			"Error":        d.Error,
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+func quotaUsage(used, limit string, unlimited bool) string {
+	if unlimited {
+		limit = "unlimited"
+	}
+	return fmt.Sprintf("%s / %s", used, limit)
+}
+
+// PrintTenantQuotas generates a table listing the supplied tenant quotas and
+// the current usage of each tenant.
+func PrintTenantQuotas(out io.Writer, quotas []*control.TenantQuota) {
+	if len(quotas) == 0 {
+		fmt.Fprintln(out, "No quotas found")
+		return
+	}
+
+	titles := []string{"Principal", "SCM Used/Limit", "NVMe Used/Limit", "Pools Used/Limit"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	for _, q := range quotas {
+		row := txtfmt.TableRow{
+			"Principal": q.Principal,
+			"SCM Used/Limit": quotaUsage(humanize.IBytes(q.UsedScmBytes),
+				humanize.IBytes(q.MaxScmBytes), q.MaxScmBytes == 0),
+			"NVMe Used/Limit": quotaUsage(humanize.IBytes(q.UsedNvmeBytes),
+				humanize.IBytes(q.MaxNvmeBytes), q.MaxNvmeBytes == 0),
+			"Pools Used/Limit": quotaUsage(fmt.Sprint(q.UsedPools),
+				fmt.Sprint(q.MaxPools), q.MaxPools == 0),
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+var inventoryCSVHeader = []string{
+	"type", "pool_uuid", "pool_label", "cont_uuid", "cont_label", "state", "owner_user",
+	"owner_group", "total_bytes", "free_bytes", "rd_fac", "svc_rf", "creation_time", "error",
+}
+
+// PrintSystemInventoryCSV writes the supplied inventory rows in CSV format
+// with a header row. Sizes are in bytes and times are in RFC3339 format.
+// Fields that are not known for a row, such as the size of a container, are
+// left empty.
+func PrintSystemInventoryCSV(out io.Writer, rows []*control.SystemInventoryRow) error {
+	w := csv.NewWriter(out)
+	if err := w.Write(inventoryCSVHeader); err != nil {
+		return err
+	}
+
+	for _, r := range rows {
+		var total, free, created string
+		if r.Type == control.InventoryRowPool {
+			total = strconv.FormatUint(r.TotalBytes, 10)
+			free = strconv.FormatUint(r.FreeBytes, 10)
+		}
+		if r.CreationTime != nil {
+			created = r.CreationTime.UTC().Format(time.RFC3339)
+		}
+		if err := w.Write([]string{
+			string(r.Type), r.PoolUUID.String(), r.PoolLabel, r.ContUUID, r.ContLabel,
+			r.State, r.OwnerUser, r.OwnerGroup, total, free, r.RedundancyFac,
+			r.SvcRedundancy, created, r.Error,
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func inventoryPoolName(r *control.SystemInventoryRow) string {
+	if r.PoolLabel == "" {
+		return r.PoolUUID.String()
+	}
+	return r.PoolLabel
+}
+
+func inventoryContName(r *control.SystemInventoryRow) string {
+	if r.ContLabel == "" {
+		return r.ContUUID
+	}
+	return r.ContLabel
+}
+
+// PrintSystemInventory generates a table listing the supplied inventory rows,
+// followed by any errors encountered while gathering the inventory.
+func PrintSystemInventory(out io.Writer, rows []*control.SystemInventoryRow) {
+	if len(rows) == 0 {
+		fmt.Fprintln(out, "No pools in system")
+		return
+	}
+
+	titles := []string{"Type", "Pool", "Container", "State", "Owner", "Group", "Size", "Free",
+		"RF", "Svc RF", "Created"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	var errRows []*control.SystemInventoryRow
+	for _, r := range rows {
+		row := txtfmt.TableRow{
+			"Type":      string(r.Type),
+			"Pool":      inventoryPoolName(r),
+			"Container": inventoryContName(r),
+			"State":     r.State,
+			"Owner":     r.OwnerUser,
+			"Group":     r.OwnerGroup,
+			"Size":      "",
+			"Free":      "",
+			"RF":        r.RedundancyFac,
+			"Svc RF":    r.SvcRedundancy,
+			"Created":   "",
+		}
+		if r.Type == control.InventoryRowPool {
+			row["Size"] = humanize.IBytes(r.TotalBytes)
+			row["Free"] = humanize.IBytes(r.FreeBytes)
+			row["Created"] = "unknown"
+		}
+		if r.CreationTime != nil {
+			row["Created"] = r.CreationTime.UTC().Format(time.RFC3339)
+		}
+		table = append(table, row)
+
+		if r.Error != "" {
+			errRows = append(errRows, r)
+		}
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	for _, r := range errRows {
+		if r.Type == control.InventoryRowContainer {
+			fmt.Fprintf(out, "Container %s in pool %s: %s\n", inventoryContName(r),
+				inventoryPoolName(r), r.Error)
+			continue
+		}
+		fmt.Fprintf(out, "Pool %s: %s\n", inventoryPoolName(r), r.Error)
+	}
+}
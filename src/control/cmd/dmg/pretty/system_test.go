@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -111,6 +112,12 @@ Ranks Action Result
 }
 
 func TestPretty_PrintSystemQueryResp(t *testing.T) {
+	maintMember := func(rank uint32, state MemberState) *Member {
+		m := MockMember(t, rank, state)
+		m.Maintenance = true
+		return m
+	}
+
 	for name, tc := range map[string]struct {
 		resp        *control.SystemQueryResp
 		absentHosts string
@@ -312,6 +319,39 @@ Rank UUID                                 Control Address Fault Domain State
 5    00000005-0005-0005-0005-000000000005 127.0.0.5:10001 /            Joined          
 6    00000006-0006-0006-0006-000000000006 127.0.0.6:10001 /            Joined          
 
+`,
+		},
+		"response with ranks in maintenance": {
+			resp: &control.SystemQueryResp{
+				Members: Members{
+					maintMember(0, MemberStateJoined),
+					maintMember(1, MemberStateStopped),
+					MockMember(t, 2, MemberStateJoined),
+				},
+			},
+			expPrintStr: `
+Rank  State   
+----  -----   
+[0,2] Joined  
+1     Stopped 
+
+2 ranks in maintenance: 0-1
+`,
+		},
+		"response verbose with ranks in maintenance": {
+			resp: &control.SystemQueryResp{
+				Members: Members{
+					maintMember(0, MemberStateJoined),
+					MockMember(t, 1, MemberStateJoined),
+				},
+			},
+			verbose: true,
+			expPrintStr: `
+Rank UUID                                 Control Address Fault Domain State                Reason 
+---- ----                                 --------------- ------------ -----                ------ 
+0    00000000-0000-0000-0000-000000000000 127.0.0.0:10001 /            Joined (maintenance)        
+1    00000001-0001-0001-0001-000000000001 127.0.0.1:10001 /            Joined                      
+
 `,
 		},
 		"response verbose with missing hosts and ranks": {
@@ -673,3 +713,366 @@ Pool                                 Ranks Result Reason
 		})
 	}
 }
+
+func TestPretty_PrintPoolImpacts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		impacts []*control.PoolImpact
+		expOut  string
+	}{
+		"no impacts": {
+			expOut: `
+No pools would be affected
+`,
+		},
+		"impacts": {
+			impacts: []*control.PoolImpact{
+				{
+					PoolID:           "pool1",
+					Ranks:            "1",
+					RedundancyFactor: 1,
+					SvcRanks:         "1",
+					SvcReplicas:      3,
+					Degraded:         true,
+				},
+				{
+					PoolID:           "pool2",
+					Ranks:            "1-2",
+					DownRanks:        "4",
+					RedundancyFactor: 1,
+					SvcRanks:         "1-2",
+					SvcReplicas:      3,
+					Degraded:         true,
+					RfBroken:         true,
+					SvcMajorityLost:  true,
+				},
+				{
+					PoolID:   "pool3",
+					Ranks:    "1",
+					Degraded: true,
+					Msg:      "failed to get redundancy factor",
+				},
+			},
+			expOut: `
+Pool  Ranks Down Ranks RF Svc Ranks Impact                               
+----  ----- ---------- -- --------- ------                               
+pool1 1     -          1  1 of 3    degraded                             
+pool2 1-2   4          1  1-2 of 3  exceeds RF, pool service unavailable 
+pool3 1     -          -  -         degraded, RF unknown                 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintPoolImpacts(&out, tc.impacts)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemBackups(t *testing.T) {
+	for name, tc := range map[string]struct {
+		backups []*control.SystemBackup
+		expOut  string
+	}{
+		"no backups": {
+			expOut: `
+No backups found
+`,
+		},
+		"backups": {
+			backups: []*control.SystemBackup{
+				{
+					Name:       "backup-20250102T030405.000Z",
+					Index:      42,
+					Term:       3,
+					MapVersion: 7,
+					NumMembers: 4,
+					NumPools:   2,
+					Size:       1024,
+				},
+				{
+					Name:       "backup-20250102T040405.000Z",
+					Index:      43,
+					Term:       3,
+					MapVersion: 7,
+					NumMembers: 4,
+					NumPools:   2,
+					Size:       2048,
+					Compressed: true,
+				},
+			},
+			expOut: `
+Name                        Index Term Map Version Members Pools Size   Compressed 
+----                        ----- ---- ----------- ------- ----- ----   ---------- 
+backup-20250102T030405.000Z 42    3    7           4       2     1.0 kB no         
+backup-20250102T040405.000Z 43    3    7           4       2     2.0 kB yes        
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintSystemBackups(&out, tc.backups)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemReplicas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		replicas []*control.SystemReplica
+		expOut   string
+	}{
+		"no replicas": {
+			expOut: `
+No MS replicas found
+`,
+		},
+		"replicas": {
+			replicas: []*control.SystemReplica{
+				{
+					Addr:   "10.0.0.1:10001",
+					State:  "voter",
+					Leader: true,
+				},
+				{
+					Addr:  "10.0.0.2:10001",
+					State: "voter",
+				},
+				{
+					Addr:  "10.0.0.3:10001",
+					State: "pending",
+				},
+			},
+			expOut: `
+Address        State   Leader 
+-------        -----   ------ 
+10.0.0.1:10001 voter   yes    
+10.0.0.2:10001 voter   no     
+10.0.0.3:10001 pending no     
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintSystemReplicas(&out, tc.replicas)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemRollingRestart(t *testing.T) {
+	for name, tc := range map[string]struct {
+		domains []*control.RollingRestartDomain
+		expOut  string
+	}{
+		"no domains": {
+			expOut: `
+No fault domains restarted
+`,
+		},
+		"domains": {
+			domains: []*control.RollingRestartDomain{
+				{
+					Domain: "/host1",
+					Ranks:  ranklist.MustCreateRankSet("0-1"),
+					Stage:  control.RollingRestartStageDone,
+				},
+				{
+					Domain: "/host2",
+					Ranks:  ranklist.MustCreateRankSet("2"),
+					Stage:  control.RollingRestartStageRejoin,
+					Error:  "timed out",
+				},
+			},
+			expOut: `
+Fault Domain Ranks Stage  Error     
+------------ ----- -----  -----     
+/host1       0-1   done             
+/host2       2     rejoin timed out 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintSystemRollingRestart(&out, tc.domains)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintTenantQuotas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quotas []*control.TenantQuota
+		expOut string
+	}{
+		"no quotas": {
+			expOut: `
+No quotas found
+`,
+		},
+		"quotas": {
+			quotas: []*control.TenantQuota{
+				{
+					Principal:     "g:builders@",
+					MaxNvmeBytes:  1 << 40,
+					UsedScmBytes:  4 << 30,
+					UsedNvmeBytes: 40 << 30,
+					UsedPools:     2,
+				},
+				{
+					Principal:     "u:bob@",
+					MaxScmBytes:   8 << 30,
+					MaxPools:      4,
+					UsedScmBytes:  4 << 30,
+					UsedNvmeBytes: 40 << 30,
+					UsedPools:     2,
+				},
+			},
+			expOut: `
+Principal   SCM Used/Limit      NVMe Used/Limit    Pools Used/Limit 
+---------   --------------      ---------------    ---------------- 
+g:builders@ 4.0 GiB / unlimited 40 GiB / 1.0 TiB   2 / unlimited    
+u:bob@      4.0 GiB / 8.0 GiB   40 GiB / unlimited 2 / 4            
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintTenantQuotas(&out, tc.quotas)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func mockInventoryRows() []*control.SystemInventoryRow {
+	created := time.Unix(1735689600, 0)
+	return []*control.SystemInventoryRow{
+		{
+			Type:          control.InventoryRowPool,
+			PoolUUID:      test.MockPoolUUID(1),
+			PoolLabel:     "tank",
+			State:         "Ready",
+			OwnerUser:     "alice@",
+			OwnerGroup:    "research@",
+			TotalBytes:    1 << 40,
+			FreeBytes:     1 << 39,
+			RedundancyFac: "1",
+			SvcRedundancy: "2",
+			CreationTime:  &created,
+		},
+		{
+			Type:          control.InventoryRowContainer,
+			PoolUUID:      test.MockPoolUUID(1),
+			PoolLabel:     "tank",
+			ContUUID:      test.MockUUID(2),
+			ContLabel:     "results",
+			OwnerUser:     "bob@",
+			OwnerGroup:    "research@",
+			RedundancyFac: "2",
+		},
+		{
+			Type:      control.InventoryRowContainer,
+			PoolUUID:  test.MockPoolUUID(1),
+			PoolLabel: "tank",
+			ContUUID:  test.MockUUID(4),
+			Error:     "container details failed: DER_NO_PERM(-1001): Operation not permitted",
+		},
+		{
+			Type:      control.InventoryRowPool,
+			PoolUUID:  test.MockPoolUUID(3),
+			PoolLabel: "scratch",
+			State:     "Ready",
+			Error:     "query failed: DER_NO_PERM(-1001): Operation not permitted",
+		},
+	}
+}
+
+func TestPretty_PrintSystemInventory(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rows   []*control.SystemInventoryRow
+		expOut string
+	}{
+		"no rows": {
+			expOut: `
+No pools in system
+`,
+		},
+		"pools and containers": {
+			rows: mockInventoryRows(),
+			expOut: `
+Type      Pool    Container                            State Owner  Group     Size    Free    RF Svc RF Created              
+----      ----    ---------                            ----- -----  -----     ----    ----    -- ------ -------              
+pool      tank                                         Ready alice@ research@ 1.0 TiB 512 GiB 1  2      2025-01-01T00:00:00Z 
+container tank    results                                    bob@   research@                 2                              
+container tank    00000004-0004-0004-0004-000000000004                                                                       
+pool      scratch                                      Ready                  0 B     0 B               unknown              
+
+Container 00000004-0004-0004-0004-000000000004 in pool tank: container details failed: DER_NO_PERM(-1001): Operation not permitted
+Pool scratch: query failed: DER_NO_PERM(-1001): Operation not permitted
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintSystemInventory(&out, tc.rows)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemInventoryCSV(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rows   []*control.SystemInventoryRow
+		expOut string
+	}{
+		"no rows": {
+			expOut: `
+type,pool_uuid,pool_label,cont_uuid,cont_label,state,owner_user,owner_group,total_bytes,free_bytes,rd_fac,svc_rf,creation_time,error
+`,
+		},
+		"pools and containers": {
+			rows: mockInventoryRows(),
+			expOut: `
+type,pool_uuid,pool_label,cont_uuid,cont_label,state,owner_user,owner_group,total_bytes,free_bytes,rd_fac,svc_rf,creation_time,error
+pool,00000001-0001-0001-0001-000000000001,tank,,,Ready,alice@,research@,1099511627776,549755813888,1,2,2025-01-01T00:00:00Z,
+container,00000001-0001-0001-0001-000000000001,tank,00000002-0002-0002-0002-000000000002,results,,bob@,research@,,,2,,,
+container,00000001-0001-0001-0001-000000000001,tank,00000004-0004-0004-0004-000000000004,,,,,,,,,,container details failed: DER_NO_PERM(-1001): Operation not permitted
+pool,00000003-0003-0003-0003-000000000003,scratch,,,Ready,,,0,0,,,,query failed: DER_NO_PERM(-1001): Operation not permitted
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			if err := PrintSystemInventoryCSV(&out, tc.rows); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
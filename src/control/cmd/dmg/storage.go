@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -18,14 +19,15 @@ import (
 
 // storageCmd is the struct representing the top-level storage subcommand.
 type storageCmd struct {
-	Scan          storageScanCmd    `command:"scan" description:"Scan SCM and NVMe storage attached to remote servers."`
-	Format        storageFormatCmd  `command:"format" description:"Format SCM and NVMe storage attached to remote servers."`
-	Query         storageQueryCmd   `command:"query" description:"Query storage commands, including raw NVMe SSD device health stats and internal blobstore health info."`
-	NvmeRebind    nvmeRebindCmd     `command:"nvme-rebind" description:"Detach NVMe SSD from kernel driver and rebind to userspace driver for use with DAOS."`
-	NvmeAddDevice nvmeAddDeviceCmd  `command:"nvme-add-device" description:"Add a hot-inserted NVMe SSD to a specific engine configuration to enable the new device to be used."`
-	Set           setFaultyCmd      `command:"set" description:"Manually set the device state."`
-	Replace       storageReplaceCmd `command:"replace" description:"Replace a storage device that has been hot-removed with a new device."`
-	LedManage     ledManageCmd      `command:"led" description:"Manage LED status for supported drives."`
+	Scan          storageScanCmd          `command:"scan" description:"Scan SCM and NVMe storage attached to remote servers."`
+	Format        storageFormatCmd        `command:"format" description:"Format SCM and NVMe storage attached to remote servers."`
+	Query         storageQueryCmd         `command:"query" description:"Query storage commands, including raw NVMe SSD device health stats and internal blobstore health info."`
+	NvmeRebind    nvmeRebindCmd           `command:"nvme-rebind" description:"Detach NVMe SSD from kernel driver and rebind to userspace driver for use with DAOS."`
+	NvmeAddDevice nvmeAddDeviceCmd        `command:"nvme-add-device" description:"Add a hot-inserted NVMe SSD to a specific engine configuration to enable the new device to be used."`
+	Set           setFaultyCmd            `command:"set" description:"Manually set the device state."`
+	Replace       storageReplaceCmd       `command:"replace" description:"Replace a storage device that has been hot-removed with a new device."`
+	LedManage     ledManageCmd            `command:"led" description:"Manage LED status for supported drives."`
+	ReplaceWizard storageReplaceWizardCmd `command:"replace-wizard" description:"Guide the replacement of an evicted NVMe SSD, resuming any previously interrupted replacement of the device."`
 }
 
 // storageScanCmd is the struct representing the scan storage subcommand.
@@ -98,12 +100,17 @@ type storageFormatCmd struct {
 	cmdutil.JSONOutputCmd
 	Verbose bool `short:"v" long:"verbose" description:"Show results of each SCM & NVMe device format operation"`
 	Force   bool `long:"force" description:"Force storage format on a host, stopping any running engines (CAUTION: destructive operation)"`
+	DryRun  bool `long:"dry-run" description:"Report what would be formatted on each host without modifying any storage"`
 }
 
 // Execute is run when storageFormatCmd activates.
 //
 // Run NVMe and SCM storage format on all connected servers.
 func (cmd *storageFormatCmd) Execute(args []string) (err error) {
+	if cmd.DryRun {
+		return cmd.formatPlan()
+	}
+
 	ctx := cmd.MustLogCtx()
 
 	req := &control.StorageFormatReq{Reformat: cmd.Force}
@@ -121,6 +128,41 @@ func (cmd *storageFormatCmd) Execute(args []string) (err error) {
 	return cmd.printFormatResp(resp)
 }
 
+// formatPlan requests a format plan from each host instead of formatting.
+func (cmd *storageFormatCmd) formatPlan() error {
+	req := &control.StorageFormatPlanReq{Reformat: cmd.Force}
+	req.SetHostList(cmd.getHostList())
+
+	resp, err := control.GetStorageFormatPlan(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	return cmd.printFormatPlan(resp)
+}
+
+func (cmd *storageFormatCmd) printFormatPlan(resp *control.StorageFormatPlanResp) error {
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintStorageFormatPlans(resp.HostPlans, &out); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	return resp.Errors()
+}
+
 func (cmd *storageFormatCmd) printFormatResp(resp *control.StorageFormatResp) error {
 	var outErr strings.Builder
 	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
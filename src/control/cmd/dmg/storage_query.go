@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -74,9 +75,10 @@ func (cmd *smdQueryCmd) makeRequest(ctx context.Context, req *control.SmdQueryRe
 
 // storageQueryCmd is the struct representing the storage query subcommand
 type storageQueryCmd struct {
-	ListPools   listPoolsQueryCmd   `command:"list-pools" description:"List pools with NVMe on the server"`
-	ListDevices listDevicesQueryCmd `command:"list-devices" description:"List storage devices on the server"`
-	Usage       usageQueryCmd       `command:"usage" description:"Show SCM & NVMe storage space utilization per storage server"`
+	ListPools     listPoolsQueryCmd     `command:"list-pools" description:"List pools with NVMe on the server"`
+	ListDevices   listDevicesQueryCmd   `command:"list-devices" description:"List storage devices on the server"`
+	Usage         usageQueryCmd         `command:"usage" description:"Show SCM & NVMe storage space utilization per storage server"`
+	HealthHistory healthHistoryQueryCmd `command:"health-history" description:"Show NVMe device health trends and projected end-of-life"`
 }
 
 type listDevicesQueryCmd struct {
@@ -187,6 +189,55 @@ func (cmd *usageQueryCmd) Execute(_ []string) error {
 	return resp.Errors()
 }
 
+// healthHistoryQueryCmd is the struct representing the NVMe health history
+// query subcommand.
+type healthHistoryQueryCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	rankCmd
+	UUID    string `short:"u" long:"uuid" description:"Device UUID (all devices if blank)"`
+	Samples bool   `short:"s" long:"samples" description:"Include the retained health samples in results"`
+}
+
+// Execute is run when healthHistoryQueryCmd activates.
+//
+// Queries the NVMe device health samples retained by hosts and reports the
+// trends in device health.
+func (cmd *healthHistoryQueryCmd) Execute(_ []string) error {
+	ctx := cmd.MustLogCtx()
+	req := &control.NvmeHealthHistoryReq{
+		UUID:           cmd.UUID,
+		Rank:           cmd.GetRank(),
+		IncludeSamples: cmd.Samples,
+	}
+	req.SetHostList(cmd.getHostList())
+
+	resp, err := control.NvmeHealthHistory(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+
+	var out strings.Builder
+	pretty.PrintNvmeHealthHistory(&out, resp, cmd.Samples)
+	cmd.Infof("%s", out.String())
+
+	return resp.Errors()
+}
+
 type smdManageCmd struct {
 	baseCmd
 	ctlInvokerCmd
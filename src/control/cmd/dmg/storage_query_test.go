@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -157,6 +158,38 @@ func TestStorageQueryCommands(t *testing.T) {
 			printRequest(t, &control.StorageScanReq{Usage: true}),
 			nil,
 		},
+		{
+			"nvme health history query",
+			"storage query health-history",
+			printRequest(t, &control.NvmeHealthHistoryReq{
+				Rank: ranklist.NilRank,
+			}),
+			nil,
+		},
+		{
+			"nvme health history query (by rank with samples)",
+			"storage query health-history --rank 2 --samples",
+			printRequest(t, &control.NvmeHealthHistoryReq{
+				Rank:           ranklist.Rank(2),
+				IncludeSamples: true,
+			}),
+			nil,
+		},
+		{
+			"nvme health history query (by uuid)",
+			"storage query health-history --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			printRequest(t, &control.NvmeHealthHistoryReq{
+				UUID: "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+				Rank: ranklist.NilRank,
+			}),
+			nil,
+		},
+		{
+			"nvme health history query (invalid uuid)",
+			"storage query health-history --uuid bad",
+			"",
+			errors.New("invalid UUID"),
+		},
 		{
 			"Set FAULTY device status (missing host)",
 			"storage set nvme-faulty --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d -f",
@@ -234,6 +267,24 @@ func TestStorageQueryCommands(t *testing.T) {
 			"",
 			errors.New("the required flag `--new-uuid' was not specified"),
 		},
+		{
+			"Replace wizard without host specified",
+			"storage replace-wizard --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			"",
+			errors.New("not specified"),
+		},
+		{
+			"Replace wizard without old device UUID specified",
+			"storage replace-wizard -l foo",
+			"",
+			errors.New("the required flag `--old-uuid' was not specified"),
+		},
+		{
+			"Replace wizard with invalid old device UUID",
+			"storage replace-wizard -l foo --old-uuid bad --state-dir /nonexistent",
+			"",
+			errors.New("invalid old device UUID"),
+		},
 		{
 			"Identify device without device UUID or PCI address specified",
 			"storage led identify",
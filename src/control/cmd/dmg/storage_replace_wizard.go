@@ -0,0 +1,116 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+const replaceWizardStateDir = ".dmg_replace_wizard"
+
+// storageReplaceWizardCmd is the struct representing the command to guide the
+// replacement of an evicted NVMe SSD.
+type storageReplaceWizardCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	singleHostCmd
+	cmdutil.JSONOutputCmd
+	OldDevUUID      string        `long:"old-uuid" description:"Device UUID of the evicted SSD to be replaced" required:"1"`
+	NewDevUUID      string        `long:"new-uuid" description:"Device UUID of the new device (default: detected once the new SSD has been inserted)"`
+	EngineIndex     int32         `short:"e" long:"engine-index" default:"-1" description:"Index of the engine to add the new SSD to if it is not inserted at the PCI address of the replaced SSD"`
+	IdentifyTimeout uint32        `long:"identify-timeout" default:"60" description:"Number of minutes to blink the status LED of the SSD to be replaced"`
+	DeviceTimeout   time.Duration `long:"device-timeout" default:"30m" description:"Maximum time to wait for the new SSD to be inserted, detected and reintegrated"`
+	RebuildTimeout  time.Duration `long:"rebuild-timeout" description:"Maximum time to wait for pool rebuild after the replacement (default: no limit)"`
+	StateDir        string        `long:"state-dir" description:"Directory in which replacement progress is saved (default: ~/.dmg_replace_wizard)"`
+	Reset           bool          `long:"reset" description:"Discard any saved progress and restart the replacement from the beginning"`
+}
+
+func (cmd *storageReplaceWizardCmd) statePath() (string, error) {
+	dir := cmd.StateDir
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", errors.Wrap(err, "unable to determine default state directory")
+		}
+		dir = filepath.Join(home, replaceWizardStateDir)
+	}
+
+	return filepath.Join(dir, cmd.OldDevUUID+".json"), nil
+}
+
+// Execute is run when storageReplaceWizardCmd activates.
+//
+// Drive the replacement of an evicted NVMe SSD through each stage, saving
+// progress so that an interrupted replacement can be resumed.
+func (cmd *storageReplaceWizardCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "storage replace-wizard failed")
+	}()
+
+	statePath, err := cmd.statePath()
+	if err != nil {
+		return err
+	}
+
+	var state *control.NvmeReplaceState
+	if !cmd.Reset {
+		state, err = control.LoadNvmeReplaceState(statePath)
+		if err != nil {
+			return err
+		}
+		if state != nil && !cmd.JSONOutputEnabled() {
+			cmd.Infof("Resuming replacement of device %s from %s stage", state.OldUUID,
+				state.Stage)
+		}
+	}
+
+	req := &control.NvmeReplaceWizardReq{
+		Host:            cmd.Host.Slice()[0],
+		OldUUID:         cmd.OldDevUUID,
+		NewUUID:         cmd.NewDevUUID,
+		EngineIndex:     cmd.EngineIndex,
+		IdentifyTimeout: cmd.IdentifyTimeout,
+		DeviceTimeout:   cmd.DeviceTimeout,
+		RebuildTimeout:  cmd.RebuildTimeout,
+		State:           state,
+		SaveFn: func(s *control.NvmeReplaceState) error {
+			return control.SaveNvmeReplaceState(statePath, s)
+		},
+	}
+	if !cmd.JSONOutputEnabled() {
+		req.ReportFn = func(_ *control.NvmeReplaceState, msg string) {
+			cmd.Info(msg)
+		}
+	}
+
+	cmd.Debugf("storage replace-wizard request: %+v", req)
+
+	state, err = control.NvmeReplaceWizard(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(state, err)
+	}
+	if state != nil {
+		var out strings.Builder
+		pretty.PrintNvmeReplaceState(&out, state)
+		cmd.Info(out.String())
+		if err != nil {
+			cmd.Noticef("Progress saved in %s; re-run the command to resume the replacement",
+				statePath)
+		}
+	}
+
+	return err
+}
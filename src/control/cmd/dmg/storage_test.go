@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -54,6 +55,14 @@ func TestStorageCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Format dry-run",
+			"storage format --dry-run",
+			strings.Join([]string{
+				printRequest(t, &control.StorageFormatPlanReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"Scan summary",
 			"storage scan",
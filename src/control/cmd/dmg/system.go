@@ -28,22 +28,29 @@ var errNoRanks = errors.New("no ranks or hosts specified")
 
 // SystemCmd is the struct representing the top-level system subcommand.
 type SystemCmd struct {
-	LeaderQuery  leaderQueryCmd        `command:"leader-query" description:"Query for current Management Service leader"`
-	Query        systemQueryCmd        `command:"query" description:"Query DAOS system status"`
-	Stop         systemStopCmd         `command:"stop" description:"Perform controlled shutdown of DAOS system"`
-	Start        systemStartCmd        `command:"start" description:"Perform start of stopped DAOS system"`
-	Exclude      systemExcludeCmd      `command:"exclude" description:"Exclude ranks from DAOS system"`
-	ClearExclude systemClearExcludeCmd `command:"clear-exclude" description:"Clear excluded state for ranks"`
-	Drain        systemDrainCmd        `command:"drain" description:"Drain ranks or hosts from all relevant pools in DAOS system"`
-	Reintegrate  systemReintegrateCmd  `command:"reintegrate" alias:"reint" description:"Reintegrate ranks or hosts into all relevant pools in DAOS system"`
-	Erase        systemEraseCmd        `command:"erase" description:"Erase system metadata prior to reformat"`
-	ListPools    poolListCmd           `command:"list-pools" description:"List all pools in the DAOS system"`
-	Cleanup      systemCleanupCmd      `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
-	SetAttr      systemSetAttrCmd      `command:"set-attr" description:"Set system attributes"`
-	GetAttr      systemGetAttrCmd      `command:"get-attr" description:"Get system attributes"`
-	DelAttr      systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
-	SetProp      systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
-	GetProp      systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
+	LeaderQuery    leaderQueryCmd          `command:"leader-query" description:"Query for current Management Service leader"`
+	Query          systemQueryCmd          `command:"query" description:"Query DAOS system status"`
+	Stop           systemStopCmd           `command:"stop" description:"Perform controlled shutdown of DAOS system"`
+	Start          systemStartCmd          `command:"start" description:"Perform start of stopped DAOS system"`
+	Exclude        systemExcludeCmd        `command:"exclude" description:"Exclude ranks from DAOS system"`
+	ClearExclude   systemClearExcludeCmd   `command:"clear-exclude" description:"Clear excluded state for ranks"`
+	Drain          systemDrainCmd          `command:"drain" description:"Drain ranks or hosts from all relevant pools in DAOS system"`
+	Reintegrate    systemReintegrateCmd    `command:"reintegrate" alias:"reint" description:"Reintegrate ranks or hosts into all relevant pools in DAOS system"`
+	Erase          systemEraseCmd          `command:"erase" description:"Erase system metadata prior to reformat"`
+	ListPools      poolListCmd             `command:"list-pools" description:"List all pools in the DAOS system"`
+	Cleanup        systemCleanupCmd        `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
+	SetAttr        systemSetAttrCmd        `command:"set-attr" description:"Set system attributes"`
+	GetAttr        systemGetAttrCmd        `command:"get-attr" description:"Get system attributes"`
+	DelAttr        systemDelAttrCmd        `command:"del-attr" description:"Delete system attributes"`
+	SetProp        systemSetPropCmd        `command:"set-prop" description:"Set system properties"`
+	GetProp        systemGetPropCmd        `command:"get-prop" description:"Get system properties"`
+	Events         systemEventsCmd         `command:"events" subcommands-optional:"true" description:"Display RAS events published on the MS leader"`
+	Backup         systemBackupCmd         `command:"backup" description:"Manage MS database backups"`
+	Replicas       systemReplicasCmd       `command:"replicas" description:"Manage the MS replica set"`
+	RollingRestart systemRollingRestartCmd `command:"rolling-restart" description:"Restart system ranks one fault domain at a time"`
+	Maintenance    systemMaintenanceCmd    `command:"maintenance" description:"Manage the maintenance state of system hosts"`
+	Quota          systemQuotaCmd          `command:"quota" description:"Manage tenant storage quotas"`
+	Inventory      systemInventoryCmd      `command:"inventory" description:"List all pools and containers for usage accounting"`
 }
 
 type baseCtlCmd struct {
@@ -112,6 +119,21 @@ type baseRankListCmd struct {
 	rankListCmd
 }
 
+// printPoolImpacts displays the results of a dry run. An error is returned if
+// any pool would lose data beyond its redundancy factor or lose its pool
+// service replica majority.
+func (cmd *baseRankListCmd) printPoolImpacts(impacts control.PoolImpacts, resp interface{}) error {
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, impacts.Errors())
+	}
+
+	var out strings.Builder
+	pretty.PrintPoolImpacts(&out, impacts)
+	cmd.Info(out.String())
+
+	return impacts.Errors()
+}
+
 // systemQueryCmd is the struct representing the command to query system status.
 type systemQueryCmd struct {
 	baseRankListCmd
@@ -177,7 +199,8 @@ func (cmd *systemEraseCmd) Execute(_ []string) error {
 // systemStopCmd is the struct representing the command to shutdown DAOS system.
 type systemStopCmd struct {
 	baseRankListCmd
-	Force bool `long:"force" description:"Force stop DAOS system members"`
+	Force  bool `long:"force" description:"Force stop DAOS system members"`
+	DryRun bool `long:"dry-run" description:"Report the impact on pools without stopping ranks"`
 }
 
 // Execute is run when systemStopCmd activates.
@@ -191,7 +214,7 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
 	}
-	req := &control.SystemStopReq{Force: cmd.Force}
+	req := &control.SystemStopReq{Force: cmd.Force, DryRun: cmd.DryRun}
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
 
@@ -200,6 +223,10 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 		return err // control api returned an error, disregard response
 	}
 
+	if cmd.DryRun {
+		return cmd.printPoolImpacts(resp.PoolImpacts, resp)
+	}
+
 	if cmd.JSONOutputEnabled() {
 		return cmd.OutputJSON(resp, resp.Errors())
 	}
@@ -258,6 +285,7 @@ func (cmd *systemStartCmd) Execute(_ []string) (errOut error) {
 
 type systemExcludeCmd struct {
 	baseRankListCmd
+	DryRun bool `long:"dry-run" description:"Report the impact on pools without excluding ranks"`
 }
 
 func (cmd *systemExcludeCmd) execute(clear bool) error {
@@ -267,8 +295,11 @@ func (cmd *systemExcludeCmd) execute(clear bool) error {
 	if cmd.Ranks.Count() == 0 && cmd.Hosts.Count() == 0 {
 		return errNoRanks
 	}
+	if clear && cmd.DryRun {
+		return errors.New("--dry-run is not supported when clearing excluded state")
+	}
 
-	req := &control.SystemExcludeReq{Clear: clear}
+	req := &control.SystemExcludeReq{Clear: clear, DryRun: cmd.DryRun}
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
 
@@ -277,6 +308,10 @@ func (cmd *systemExcludeCmd) execute(clear bool) error {
 		return err // control api returned an error, disregard response
 	}
 
+	if cmd.DryRun {
+		return cmd.printPoolImpacts(resp.PoolImpacts, resp)
+	}
+
 	if cmd.JSONOutputEnabled() {
 		return cmd.OutputJSON(resp, resp.Errors())
 	}
@@ -308,6 +343,7 @@ func (cmd *systemClearExcludeCmd) Execute(_ []string) error {
 
 type systemDrainCmd struct {
 	baseRankListCmd
+	DryRun bool `long:"dry-run" description:"Report the impact on pools without draining ranks"`
 }
 
 func (cmd *systemDrainCmd) execute(reint bool) (errOut error) {
@@ -325,18 +361,26 @@ func (cmd *systemDrainCmd) execute(reint bool) (errOut error) {
 	if cmd.Ranks.Count() == 0 && cmd.Hosts.Count() == 0 {
 		return errNoRanks
 	}
+	if reint && cmd.DryRun {
+		return errors.New("--dry-run is not supported for reintegration")
+	}
 
 	req := new(control.SystemDrainReq)
 	req.SetSystem(cmd.config.SystemName)
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
 	req.Reint = reint
+	req.DryRun = cmd.DryRun
 
 	resp, err := control.SystemDrain(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
 		return err // control api returned an error, disregard response
 	}
 
+	if cmd.DryRun {
+		return cmd.printPoolImpacts(resp.PoolImpacts, resp)
+	}
+
 	if cmd.JSONOutputEnabled() {
 		return cmd.OutputJSON(resp, resp.Errors())
 	}
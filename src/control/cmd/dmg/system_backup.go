@@ -0,0 +1,75 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemBackupCmd is the struct representing the command to manage MS
+// database backups.
+type systemBackupCmd struct {
+	Create systemBackupCreateCmd `command:"create" description:"Create a backup of the MS database on the MS leader"`
+	List   systemBackupListCmd   `command:"list" description:"List MS database backups available on the MS leader"`
+}
+
+// systemBackupCreateCmd is the struct representing the command to create a
+// MS database backup.
+type systemBackupCreateCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when systemBackupCreateCmd activates.
+func (cmd *systemBackupCreateCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system backup create failed")
+	}()
+
+	resp, err := control.SystemBackupCreate(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemBackupCreateReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Created MS database backup %s", resp.Backup.Path)
+
+	return nil
+}
+
+// systemBackupListCmd is the struct representing the command to list MS
+// database backups.
+type systemBackupListCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when systemBackupListCmd activates.
+func (cmd *systemBackupListCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system backup list failed")
+	}()
+
+	resp, err := control.SystemBackupList(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemBackupListReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemBackups(&out, resp.Backups)
+	cmd.Infof("MS database backups in %s:\n%s", resp.Dir, out.String())
+
+	return nil
+}
@@ -0,0 +1,57 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemInventoryCmd is the struct representing the command to list all
+// pools and containers in the system for usage accounting.
+type systemInventoryCmd struct {
+	baseCtlCmd
+	CSV          bool `long:"csv" description:"Output the inventory in CSV format"`
+	NoContainers bool `long:"no-containers" description:"Exclude containers from the inventory"`
+}
+
+// Execute is run when systemInventoryCmd activates.
+func (cmd *systemInventoryCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system inventory failed")
+	}()
+
+	if cmd.CSV && cmd.JSONOutputEnabled() {
+		return errIncompatFlags("csv", "json")
+	}
+
+	req := &control.SystemInventoryReq{NoContainers: cmd.NoContainers}
+	resp, err := control.SystemInventory(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if cmd.CSV {
+		if err := pretty.PrintSystemInventoryCSV(&out, resp.Rows); err != nil {
+			return err
+		}
+		cmd.Infof("%s", out.String())
+	} else {
+		pretty.PrintSystemInventory(&out, resp.Rows)
+		cmd.Info(out.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+// systemMaintenanceCmd is the struct representing the command to manage the
+// maintenance state of system hosts.
+type systemMaintenanceCmd struct {
+	Enter systemMaintenanceEnterCmd `command:"enter" description:"Place hosts or a fault domain in maintenance"`
+	Exit  systemMaintenanceExitCmd  `command:"exit" description:"Take hosts or a fault domain out of maintenance"`
+}
+
+type baseSystemMaintenanceCmd struct {
+	baseCtlCmd
+	Hosts       ui.HostSetFlag `long:"host-list" description:"Hostlist representing hosts whose managed ranks are to be operated on"`
+	FaultDomain string         `long:"fault-domain" description:"Fault domain whose ranks are to be operated on"`
+}
+
+func (cmd *baseSystemMaintenanceCmd) execute(exit bool) error {
+	if cmd.Hosts.Count() == 0 && cmd.FaultDomain == "" {
+		return errors.New("one of --host-list or --fault-domain must be supplied")
+	}
+	if cmd.Hosts.Count() > 0 && cmd.FaultDomain != "" {
+		return errors.New("--host-list and --fault-domain options cannot be set together")
+	}
+
+	req := &control.SystemMaintenanceReq{
+		FaultDomain: cmd.FaultDomain,
+		Exit:        exit,
+	}
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+
+	resp, err := control.SystemMaintenance(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	updated := ranklist.NewRankSet()
+	for _, result := range resp.Results {
+		updated.Add(result.Rank)
+	}
+
+	if resp.Errors() != nil {
+		cmd.Errorf("Errors: %s", resp.Errors())
+	}
+	cmd.Infof("updated ranks: %s", updated)
+
+	return resp.Errors()
+}
+
+// systemMaintenanceEnterCmd is the struct representing the command to place
+// hosts or a fault domain in maintenance.
+type systemMaintenanceEnterCmd struct {
+	baseSystemMaintenanceCmd
+}
+
+// Execute is run when systemMaintenanceEnterCmd activates.
+func (cmd *systemMaintenanceEnterCmd) Execute(_ []string) error {
+	return errors.Wrap(cmd.execute(false), "system maintenance enter failed")
+}
+
+// systemMaintenanceExitCmd is the struct representing the command to take
+// hosts or a fault domain out of maintenance.
+type systemMaintenanceExitCmd struct {
+	baseSystemMaintenanceCmd
+}
+
+// Execute is run when systemMaintenanceExitCmd activates.
+func (cmd *systemMaintenanceExitCmd) Execute(_ []string) error {
+	return errors.Wrap(cmd.execute(true), "system maintenance exit failed")
+}
@@ -0,0 +1,145 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// systemQuotaCmd is the struct representing the command to manage tenant
+// storage quotas.
+type systemQuotaCmd struct {
+	Set  systemQuotaSetCmd  `command:"set" description:"Set or remove the storage quota for a user or group"`
+	Get  systemQuotaGetCmd  `command:"get" description:"Display the storage quota and usage for a user or group"`
+	List systemQuotaListCmd `command:"list" description:"List storage quotas and usage for all users and groups"`
+}
+
+type quotaPrincipalCmd struct {
+	User  ui.ACLPrincipalFlag `short:"u" long:"user" description:"User owning the pools, format name@domain"`
+	Group ui.ACLPrincipalFlag `short:"g" long:"group" description:"Group owning the pools, format name@domain"`
+}
+
+func (cmd *quotaPrincipalCmd) principal() (string, error) {
+	switch {
+	case cmd.User == "" && cmd.Group == "":
+		return "", errors.New("one of --user or --group must be supplied")
+	case cmd.User != "" && cmd.Group != "":
+		return "", errors.New("--user and --group options cannot be set together")
+	case cmd.User != "":
+		return system.UserQuotaPrincipal(cmd.User.String()), nil
+	default:
+		return system.GroupQuotaPrincipal(cmd.Group.String()), nil
+	}
+}
+
+// systemQuotaSetCmd is the struct representing the command to set or remove
+// the storage quota for a user or group.
+type systemQuotaSetCmd struct {
+	baseCtlCmd
+	quotaPrincipalCmd
+	ScmSize  ui.ByteSizeFlag `short:"s" long:"scm-size" description:"Maximum total SCM allocation across owned pools"`
+	NVMeSize ui.ByteSizeFlag `short:"n" long:"nvme-size" description:"Maximum total NVMe allocation across owned pools"`
+	MaxPools uint32          `short:"p" long:"max-pools" description:"Maximum number of owned pools"`
+	Remove   bool            `long:"remove" description:"Remove the quota"`
+}
+
+// Execute is run when systemQuotaSetCmd activates.
+func (cmd *systemQuotaSetCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system quota set failed")
+	}()
+
+	principal, err := cmd.principal()
+	if err != nil {
+		return err
+	}
+
+	hasLimits := cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet() || cmd.MaxPools > 0
+	switch {
+	case cmd.Remove && hasLimits:
+		return errIncompatFlags("remove", "scm-size", "nvme-size", "max-pools")
+	case !cmd.Remove && !hasLimits:
+		return errors.New("at least one of --scm-size, --nvme-size or --max-pools must be supplied")
+	}
+
+	req := &control.SystemSetQuotaReq{
+		Principal:    principal,
+		MaxScmBytes:  cmd.ScmSize.Bytes,
+		MaxNvmeBytes: cmd.NVMeSize.Bytes,
+		MaxPools:     cmd.MaxPools,
+		Remove:       cmd.Remove,
+	}
+
+	err = control.SystemSetQuota(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cmd.Remove {
+		cmd.Infof("Quota removed for %s", principal)
+	} else {
+		cmd.Infof("Quota set for %s", principal)
+	}
+
+	return nil
+}
+
+func (cmd *baseCtlCmd) getQuotas(principals ...string) error {
+	req := &control.SystemGetQuotaReq{Principals: principals}
+
+	resp, err := control.SystemGetQuota(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintTenantQuotas(&out, resp.Quotas)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+// systemQuotaGetCmd is the struct representing the command to display the
+// storage quota and usage for a user or group.
+type systemQuotaGetCmd struct {
+	baseCtlCmd
+	quotaPrincipalCmd
+}
+
+// Execute is run when systemQuotaGetCmd activates.
+func (cmd *systemQuotaGetCmd) Execute(_ []string) error {
+	principal, err := cmd.principal()
+	if err != nil {
+		return errors.Wrap(err, "system quota get failed")
+	}
+
+	return errors.Wrap(cmd.getQuotas(principal), "system quota get failed")
+}
+
+// systemQuotaListCmd is the struct representing the command to list the
+// storage quotas and usage for all users and groups.
+type systemQuotaListCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when systemQuotaListCmd activates.
+func (cmd *systemQuotaListCmd) Execute(_ []string) error {
+	return errors.Wrap(cmd.getQuotas(), "system quota list failed")
+}
@@ -0,0 +1,121 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemReplicasCmd is the struct representing the command to manage the MS
+// replica set.
+type systemReplicasCmd struct {
+	List   systemReplicasListCmd   `command:"list" description:"List MS replicas and their raft membership state"`
+	Add    systemReplicasAddCmd    `command:"add" description:"Add MS replicas to the system"`
+	Remove systemReplicasRemoveCmd `command:"remove" description:"Remove MS replicas from the system"`
+}
+
+// systemReplicasListCmd is the struct representing the command to list the
+// MS replicas.
+type systemReplicasListCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when systemReplicasListCmd activates.
+func (cmd *systemReplicasListCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system replicas list failed")
+	}()
+
+	resp, err := control.SystemReplicasList(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemReplicasListReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemReplicas(&out, resp.Replicas)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+type systemReplicasUpdateCmd struct {
+	baseCtlCmd
+	Force bool `long:"force" description:"Allow the change to result in an even number of MS replicas"`
+	Args  struct {
+		Addrs []string `positional-arg-name:"replica control addresses (host:port)" required:"1"`
+	} `positional-args:"yes"`
+}
+
+func (cmd *systemReplicasUpdateCmd) update(req *control.SystemReplicasUpdateReq) error {
+	req.Force = cmd.Force
+
+	resp, err := control.SystemReplicasUpdate(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	out.WriteString("MS replicas: " + strings.Join(resp.Replicas, ",") + "\n")
+	if len(resp.RestartHosts) > 0 {
+		out.WriteString("Restart daos_server on the following hosts to start their MS replica: " +
+			strings.Join(resp.RestartHosts, ",") + "\n")
+	}
+	if len(resp.HostErrors) > 0 {
+		hosts := make([]string, 0, len(resp.HostErrors))
+		for host := range resp.HostErrors {
+			hosts = append(hosts, host)
+		}
+		sort.Strings(hosts)
+
+		out.WriteString("Failed to update the MS replica set on the following hosts:\n")
+		for _, host := range hosts {
+			out.WriteString("  " + host + ": " + resp.HostErrors[host] + "\n")
+		}
+	}
+	cmd.Info(out.String())
+
+	if len(resp.HostErrors) > 0 {
+		return errors.Errorf("%d hosts failed to update the MS replica set", len(resp.HostErrors))
+	}
+	return nil
+}
+
+// systemReplicasAddCmd is the struct representing the command to add MS
+// replicas.
+type systemReplicasAddCmd struct {
+	systemReplicasUpdateCmd
+}
+
+// Execute is run when systemReplicasAddCmd activates.
+func (cmd *systemReplicasAddCmd) Execute(_ []string) error {
+	return errors.Wrap(cmd.update(&control.SystemReplicasUpdateReq{Add: cmd.Args.Addrs}),
+		"system replicas add failed")
+}
+
+// systemReplicasRemoveCmd is the struct representing the command to remove
+// MS replicas.
+type systemReplicasRemoveCmd struct {
+	systemReplicasUpdateCmd
+}
+
+// Execute is run when systemReplicasRemoveCmd activates.
+func (cmd *systemReplicasRemoveCmd) Execute(_ []string) error {
+	return errors.Wrap(cmd.update(&control.SystemReplicasUpdateReq{Remove: cmd.Args.Addrs}),
+		"system replicas remove failed")
+}
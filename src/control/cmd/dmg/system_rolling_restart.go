@@ -0,0 +1,66 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemRollingRestartCmd is the struct representing the command to restart
+// the system ranks one fault domain at a time.
+type systemRollingRestartCmd struct {
+	baseRankListCmd
+	DomainLevel    int           `long:"domain-level" description:"Fault domain level to restart at a time, counting from the top of the fault domain tree (default: host level)"`
+	RejoinTimeout  time.Duration `long:"rejoin-timeout" default:"10m" description:"Maximum time to wait for restarted ranks to rejoin"`
+	RebuildTimeout time.Duration `long:"rebuild-timeout" description:"Maximum time to wait for pool rebuild after each fault domain (default: no limit)"`
+}
+
+// Execute is run when systemRollingRestartCmd activates.
+func (cmd *systemRollingRestartCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system rolling-restart failed")
+	}()
+
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+	req := &control.SystemRollingRestartReq{
+		DomainLevel:    cmd.DomainLevel,
+		RejoinTimeout:  cmd.RejoinTimeout,
+		RebuildTimeout: cmd.RebuildTimeout,
+	}
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+	if !cmd.JSONOutputEnabled() {
+		req.ReportFn = func(rd *control.RollingRestartDomain) {
+			if rd.Error != "" {
+				cmd.Errorf("fault domain %s (ranks %s): %s failed: %s", rd.Domain, rd.Ranks,
+					rd.Stage, rd.Error)
+				return
+			}
+			cmd.Infof("fault domain %s (ranks %s): %s", rd.Domain, rd.Ranks, rd.Stage)
+		}
+	}
+
+	resp, err := control.SystemRollingRestart(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if resp != nil {
+		var out strings.Builder
+		pretty.PrintSystemRollingRestart(&out, resp.Domains)
+		cmd.Info(out.String())
+	}
+
+	return err
+}
@@ -11,12 +11,14 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
@@ -165,6 +167,14 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system stop with dry-run",
+			"system stop --ranks 0-1 --dry-run",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemStopReq{DryRun: true}, 0, 1)),
+			}, " "),
+			nil,
+		},
 		{
 			"system stop with single rank",
 			"system stop --ranks 0",
@@ -259,6 +269,20 @@ func TestDmg_SystemCommands(t *testing.T) {
 			"",
 			errNoRanks,
 		},
+		{
+			"system exclude with dry-run",
+			"system exclude --ranks 0,1,4 --dry-run",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemExcludeReq{DryRun: true}, 0, 1, 4)),
+			}, " "),
+			nil,
+		},
+		{
+			"system clear-exclude with dry-run",
+			"system clear-exclude --ranks 0 --dry-run",
+			"",
+			errors.New("not supported when clearing"),
+		},
 		{
 			"system drain with multiple hosts",
 			"system drain --rank-hosts foo-[0,1,4]",
@@ -285,6 +309,22 @@ func TestDmg_SystemCommands(t *testing.T) {
 			"",
 			errNoRanks,
 		},
+		{
+			"system drain with dry-run",
+			"system drain --ranks 0,1,4 --dry-run",
+			strings.Join([]string{
+				printRequest(t, withSystem(
+					withRanks(&control.SystemDrainReq{DryRun: true}, 0, 1, 4),
+					"daos_server")),
+			}, " "),
+			nil,
+		},
+		{
+			"system reintegrate with dry-run",
+			"system reintegrate --ranks 0 --dry-run",
+			"",
+			errors.New("not supported for reintegration"),
+		},
 		{
 			"system reintegrate with multiple hosts",
 			"system reintegrate --rank-hosts foo-[0,1,4]",
@@ -393,6 +433,282 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system events without follow",
+			"system events",
+			"",
+			errors.New("--follow must be specified"),
+		},
+		{
+			"system events follow",
+			"system events --follow",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsFollowReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events follow with filters",
+			"system events -f --id engine_died,swim_rank_dead --type state_change --severity error,warning --ranks 0-3",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemEventsFollowReq {
+					req := &control.SystemEventsFollowReq{}
+					req.IDs = []events.RASID{events.RASEngineDied, events.RASSwimRankDead}
+					req.Types = []events.RASTypeID{events.RASTypeStateChange}
+					req.Severities = []events.RASSeverityID{
+						events.RASSeverityError, events.RASSeverityWarning,
+					}
+					req.SetRanks(ranklist.MustCreateRankSet("0-3"))
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system events follow with bad event ID",
+			"system events --follow --id foo",
+			"",
+			errors.New("unknown RAS event"),
+		},
+		{
+			"system events follow with ranks and hosts",
+			"system events --follow --ranks 0 --rank-hosts foo",
+			"",
+			errors.New("--ranks and --rank-hosts options cannot be set together"),
+		},
+		{
+			"system events list",
+			"system events list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsListReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events list with filters and time range",
+			"system events list --id engine_died --ranks 1-2 --since 2025-01-02T03:04:05Z --until 2025-01-02T04:04:05Z",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemEventsListReq {
+					req := &control.SystemEventsListReq{
+						Since: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+						Until: time.Date(2025, 1, 2, 4, 4, 5, 0, time.UTC),
+					}
+					req.IDs = []events.RASID{events.RASEngineDied}
+					req.SetRanks(ranklist.MustCreateRankSet("1-2"))
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system events list with bad time",
+			"system events list --since yesterday",
+			"",
+			errors.New("invalid time"),
+		},
+		{
+			"system events list with inverted time range",
+			"system events list --since 2025-01-02T04:04:05Z --until 2025-01-02T03:04:05Z",
+			"",
+			errors.New("must not be before"),
+		},
+		{
+			"system backup create",
+			"system backup create",
+			strings.Join([]string{
+				printRequest(t, &control.SystemBackupCreateReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system backup list",
+			"system backup list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemBackupListReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system backup without subcommand",
+			"system backup",
+			"",
+			errors.New("Please specify one command"),
+		},
+		{
+			"system replicas list",
+			"system replicas list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicasListReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system replicas add",
+			"system replicas add 10.0.0.2:10001 10.0.0.3:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicasUpdateReq{
+					Add: []string{"10.0.0.2:10001", "10.0.0.3:10001"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system replicas remove with force",
+			"system replicas remove --force 10.0.0.2:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicasUpdateReq{
+					Remove: []string{"10.0.0.2:10001"},
+					Force:  true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system replicas add without addresses",
+			"system replicas add",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"system rolling-restart with no ranks",
+			"system rolling-restart --ranks 0-3 --rejoin-timeout 1m",
+			"",
+			errors.New("no ranks to restart"),
+		},
+		{
+			"system rolling-restart with hosts and ranks",
+			"system rolling-restart --ranks 0-3 --rank-hosts foo-[0-3]",
+			"",
+			errors.New("--ranks and --rank-hosts options cannot be set together"),
+		},
+		{
+			"system rolling-restart with invalid timeout",
+			"system rolling-restart --rejoin-timeout 10",
+			"",
+			errors.New("missing unit"),
+		},
+		{
+			"system maintenance enter with hosts",
+			"system maintenance enter --host-list foo-[0,1]",
+			strings.Join([]string{
+				printRequest(t, func() *control.SystemMaintenanceReq {
+					req := &control.SystemMaintenanceReq{}
+					req.Hosts.Replace(hostlist.MustCreateSet("foo-[0-1]"))
+					return req
+				}()),
+			}, " "),
+			nil,
+		},
+		{
+			"system maintenance exit with fault domain",
+			"system maintenance exit --fault-domain /rack0",
+			strings.Join([]string{
+				printRequest(t, &control.SystemMaintenanceReq{
+					FaultDomain: "/rack0",
+					Exit:        true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system maintenance enter with no hosts or fault domain",
+			"system maintenance enter",
+			"",
+			errors.New("one of --host-list or --fault-domain must be supplied"),
+		},
+		{
+			"system maintenance enter with hosts and fault domain",
+			"system maintenance enter --host-list foo-0 --fault-domain /rack0",
+			"",
+			errors.New("cannot be set together"),
+		},
+		{
+			"system quota set for user",
+			"system quota set --user bob --scm-size 8GiB --max-pools 4",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetQuotaReq{
+					Principal:   "u:bob@",
+					MaxScmBytes: 8 << 30,
+					MaxPools:    4,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system quota set remove for group",
+			"system quota set --group builders@ --remove",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetQuotaReq{
+					Principal: "g:builders@",
+					Remove:    true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system quota set with no limits",
+			"system quota set --user bob",
+			"",
+			errors.New("at least one of --scm-size, --nvme-size or --max-pools"),
+		},
+		{
+			"system quota set remove with limits",
+			"system quota set --user bob --remove --max-pools 1",
+			"",
+			errors.New("--remove may not be mixed"),
+		},
+		{
+			"system quota set with user and group",
+			"system quota set --user bob --group builders --max-pools 1",
+			"",
+			errors.New("cannot be set together"),
+		},
+		{
+			"system quota get for group",
+			"system quota get --group builders",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetQuotaReq{
+					Principals: []string{"g:builders@"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system quota get with no principal",
+			"system quota get",
+			"",
+			errors.New("one of --user or --group must be supplied"),
+		},
+		{
+			"system quota list",
+			"system quota list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetQuotaReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system inventory",
+			"system inventory",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system inventory csv without containers",
+			"system inventory --csv --no-containers",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system inventory csv with json",
+			"-j system inventory --csv",
+			"",
+			errIncompatFlags("csv", "json"),
+		},
 		{
 			"Non-existent subcommand",
 			"system quack",
@@ -26,10 +26,14 @@ type CtlSvcClient interface {
 	StorageScan(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (*StorageScanResp, error)
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error)
+	// Report what a storage format would do without modifying any storage
+	StorageFormatPlan(ctx context.Context, in *StorageFormatPlanReq, opts ...grpc.CallOption) (*StorageFormatPlanResp, error)
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
 	StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDeviceReq, opts ...grpc.CallOption) (*NvmeAddDeviceResp, error)
+	// Retrieve the retained health samples of NVMe devices on server
+	StorageNvmeHealthHistory(ctx context.Context, in *NvmeHealthHistoryReq, opts ...grpc.CallOption) (*NvmeHealthHistoryResp, error)
 	// Perform a fabric scan to determine the available provider, device, NUMA node combinations
 	NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error)
 	// Retrieve firmware details from storage devices on server
@@ -80,6 +84,15 @@ func (c *ctlSvcClient) StorageFormat(ctx context.Context, in *StorageFormatReq,
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageFormatPlan(ctx context.Context, in *StorageFormatPlanReq, opts ...grpc.CallOption) (*StorageFormatPlanResp, error) {
+	out := new(StorageFormatPlanResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageFormatPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error) {
 	out := new(NvmeRebindResp)
 	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageNvmeRebind", in, out, opts...)
@@ -98,6 +111,15 @@ func (c *ctlSvcClient) StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDevi
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageNvmeHealthHistory(ctx context.Context, in *NvmeHealthHistoryReq, opts ...grpc.CallOption) (*NvmeHealthHistoryResp, error) {
+	out := new(NvmeHealthHistoryResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageNvmeHealthHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error) {
 	out := new(NetworkScanResp)
 	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/NetworkScan", in, out, opts...)
@@ -205,10 +227,14 @@ type CtlSvcServer interface {
 	StorageScan(context.Context, *StorageScanReq) (*StorageScanResp, error)
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error)
+	// Report what a storage format would do without modifying any storage
+	StorageFormatPlan(context.Context, *StorageFormatPlanReq) (*StorageFormatPlanResp, error)
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
 	StorageNvmeAddDevice(context.Context, *NvmeAddDeviceReq) (*NvmeAddDeviceResp, error)
+	// Retrieve the retained health samples of NVMe devices on server
+	StorageNvmeHealthHistory(context.Context, *NvmeHealthHistoryReq) (*NvmeHealthHistoryResp, error)
 	// Perform a fabric scan to determine the available provider, device, NUMA node combinations
 	NetworkScan(context.Context, *NetworkScanReq) (*NetworkScanResp, error)
 	// Retrieve firmware details from storage devices on server
@@ -244,12 +270,18 @@ func (UnimplementedCtlSvcServer) StorageScan(context.Context, *StorageScanReq) (
 func (UnimplementedCtlSvcServer) StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageFormat not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageFormatPlan(context.Context, *StorageFormatPlanReq) (*StorageFormatPlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageFormatPlan not implemented")
+}
 func (UnimplementedCtlSvcServer) StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeRebind not implemented")
 }
 func (UnimplementedCtlSvcServer) StorageNvmeAddDevice(context.Context, *NvmeAddDeviceReq) (*NvmeAddDeviceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeAddDevice not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageNvmeHealthHistory(context.Context, *NvmeHealthHistoryReq) (*NvmeHealthHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeHealthHistory not implemented")
+}
 func (UnimplementedCtlSvcServer) NetworkScan(context.Context, *NetworkScanReq) (*NetworkScanResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkScan not implemented")
 }
@@ -332,6 +364,24 @@ func _CtlSvc_StorageFormat_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageFormatPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageFormatPlanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageFormatPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/StorageFormatPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageFormatPlan(ctx, req.(*StorageFormatPlanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_StorageNvmeRebind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NvmeRebindReq)
 	if err := dec(in); err != nil {
@@ -368,6 +418,24 @@ func _CtlSvc_StorageNvmeAddDevice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageNvmeHealthHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NvmeHealthHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageNvmeHealthHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/StorageNvmeHealthHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageNvmeHealthHistory(ctx, req.(*NvmeHealthHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_NetworkScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NetworkScanReq)
 	if err := dec(in); err != nil {
@@ -581,6 +649,10 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StorageFormat",
 			Handler:    _CtlSvc_StorageFormat_Handler,
 		},
+		{
+			MethodName: "StorageFormatPlan",
+			Handler:    _CtlSvc_StorageFormatPlan_Handler,
+		},
 		{
 			MethodName: "StorageNvmeRebind",
 			Handler:    _CtlSvc_StorageNvmeRebind_Handler,
@@ -589,6 +661,10 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StorageNvmeAddDevice",
 			Handler:    _CtlSvc_StorageNvmeAddDevice_Handler,
 		},
+		{
+			MethodName: "StorageNvmeHealthHistory",
+			Handler:    _CtlSvc_StorageNvmeHealthHistory_Handler,
+		},
 		{
 			MethodName: "NetworkScan",
 			Handler:    _CtlSvc_NetworkScan_Handler,
@@ -365,6 +365,476 @@ func (x *StorageFormatResp) GetMrets() []*ScmMountResult {
 	return nil
 }
 
+// MetadataFormatPlan describes what would be done to control plane metadata storage.
+type MetadataFormatPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format   bool     `protobuf:"varint,1,opt,name=format,proto3" json:"format,omitempty"`                    // Control metadata storage would be formatted
+	RootPath string   `protobuf:"bytes,2,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"` // Metadata root directory or mount point
+	Device   string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`                     // Device that would be mkfs'd and mounted at root_path
+	Paths    []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`                       // Directories that would be created
+}
+
+func (x *MetadataFormatPlan) Reset() {
+	*x = MetadataFormatPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataFormatPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataFormatPlan) ProtoMessage() {}
+
+func (x *MetadataFormatPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataFormatPlan.ProtoReflect.Descriptor instead.
+func (*MetadataFormatPlan) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MetadataFormatPlan) GetFormat() bool {
+	if x != nil {
+		return x.Format
+	}
+	return false
+}
+
+func (x *MetadataFormatPlan) GetRootPath() string {
+	if x != nil {
+		return x.RootPath
+	}
+	return ""
+}
+
+func (x *MetadataFormatPlan) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *MetadataFormatPlan) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// ScmFormatPlan describes what would be done to an engine's SCM tier.
+type ScmFormatPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format      bool     `protobuf:"varint,1,opt,name=format,proto3" json:"format,omitempty"` // SCM would be formatted and mounted
+	Class       string   `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`    // SCM class, dcpm or ram
+	MountPoint  string   `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	Devices     []string `protobuf:"bytes,4,rep,name=devices,proto3" json:"devices,omitempty"`                             // PMem namespace block devices that would be mkfs'd
+	RamdiskSize uint32   `protobuf:"varint,5,opt,name=ramdisk_size,json=ramdiskSize,proto3" json:"ramdisk_size,omitempty"` // Size of tmpfs in GiB
+	Info        string   `protobuf:"bytes,6,opt,name=info,proto3" json:"info,omitempty"`                                   // Reason SCM would not be formatted
+}
+
+func (x *ScmFormatPlan) Reset() {
+	*x = ScmFormatPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScmFormatPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScmFormatPlan) ProtoMessage() {}
+
+func (x *ScmFormatPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScmFormatPlan.ProtoReflect.Descriptor instead.
+func (*ScmFormatPlan) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScmFormatPlan) GetFormat() bool {
+	if x != nil {
+		return x.Format
+	}
+	return false
+}
+
+func (x *ScmFormatPlan) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *ScmFormatPlan) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *ScmFormatPlan) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *ScmFormatPlan) GetRamdiskSize() uint32 {
+	if x != nil {
+		return x.RamdiskSize
+	}
+	return 0
+}
+
+func (x *ScmFormatPlan) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+// BdevTierFormatPlan describes the bdevs in an engine's bdev tier that would be written.
+type BdevTierFormatPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tier    uint32   `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	Class   string   `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"` // Bdev class, nvme, kdev, file or ram
+	Devices []string `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
+	Roles   string   `protobuf:"bytes,4,opt,name=roles,proto3" json:"roles,omitempty"` // Bdev roles assigned to the tier
+}
+
+func (x *BdevTierFormatPlan) Reset() {
+	*x = BdevTierFormatPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BdevTierFormatPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BdevTierFormatPlan) ProtoMessage() {}
+
+func (x *BdevTierFormatPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BdevTierFormatPlan.ProtoReflect.Descriptor instead.
+func (*BdevTierFormatPlan) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BdevTierFormatPlan) GetTier() uint32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *BdevTierFormatPlan) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *BdevTierFormatPlan) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *BdevTierFormatPlan) GetRoles() string {
+	if x != nil {
+		return x.Roles
+	}
+	return ""
+}
+
+// EngineFormatPlan describes what would be done to an engine's storage.
+type EngineFormatPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index       uint32                `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Scm         *ScmFormatPlan        `protobuf:"bytes,2,opt,name=scm,proto3" json:"scm,omitempty"`
+	FormatBdevs bool                  `protobuf:"varint,3,opt,name=format_bdevs,json=formatBdevs,proto3" json:"format_bdevs,omitempty"` // Bdev tiers would be formatted
+	BdevTiers   []*BdevTierFormatPlan `protobuf:"bytes,4,rep,name=bdev_tiers,json=bdevTiers,proto3" json:"bdev_tiers,omitempty"`
+	BdevInfo    string                `protobuf:"bytes,5,opt,name=bdev_info,json=bdevInfo,proto3" json:"bdev_info,omitempty"`       // Reason bdevs would not be formatted
+	NvmeConfig  string                `protobuf:"bytes,6,opt,name=nvme_config,json=nvmeConfig,proto3" json:"nvme_config,omitempty"` // Path of NVMe config file that would be written
+}
+
+func (x *EngineFormatPlan) Reset() {
+	*x = EngineFormatPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineFormatPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineFormatPlan) ProtoMessage() {}
+
+func (x *EngineFormatPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineFormatPlan.ProtoReflect.Descriptor instead.
+func (*EngineFormatPlan) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EngineFormatPlan) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EngineFormatPlan) GetScm() *ScmFormatPlan {
+	if x != nil {
+		return x.Scm
+	}
+	return nil
+}
+
+func (x *EngineFormatPlan) GetFormatBdevs() bool {
+	if x != nil {
+		return x.FormatBdevs
+	}
+	return false
+}
+
+func (x *EngineFormatPlan) GetBdevTiers() []*BdevTierFormatPlan {
+	if x != nil {
+		return x.BdevTiers
+	}
+	return nil
+}
+
+func (x *EngineFormatPlan) GetBdevInfo() string {
+	if x != nil {
+		return x.BdevInfo
+	}
+	return ""
+}
+
+func (x *EngineFormatPlan) GetNvmeConfig() string {
+	if x != nil {
+		return x.NvmeConfig
+	}
+	return ""
+}
+
+// StorageFormatPlan describes what a storage format would do on a host.
+type StorageFormatPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *MetadataFormatPlan `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Engines  []*EngineFormatPlan `protobuf:"bytes,2,rep,name=engines,proto3" json:"engines,omitempty"`
+}
+
+func (x *StorageFormatPlan) Reset() {
+	*x = StorageFormatPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageFormatPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageFormatPlan) ProtoMessage() {}
+
+func (x *StorageFormatPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageFormatPlan.ProtoReflect.Descriptor instead.
+func (*StorageFormatPlan) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StorageFormatPlan) GetMetadata() *MetadataFormatPlan {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StorageFormatPlan) GetEngines() []*EngineFormatPlan {
+	if x != nil {
+		return x.Engines
+	}
+	return nil
+}
+
+type StorageFormatPlanReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reformat bool `protobuf:"varint,1,opt,name=reformat,proto3" json:"reformat,omitempty"` // Plan as if the format was forced
+}
+
+func (x *StorageFormatPlanReq) Reset() {
+	*x = StorageFormatPlanReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageFormatPlanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageFormatPlanReq) ProtoMessage() {}
+
+func (x *StorageFormatPlanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageFormatPlanReq.ProtoReflect.Descriptor instead.
+func (*StorageFormatPlanReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StorageFormatPlanReq) GetReformat() bool {
+	if x != nil {
+		return x.Reformat
+	}
+	return false
+}
+
+type StorageFormatPlanResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan *StorageFormatPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+}
+
+func (x *StorageFormatPlanResp) Reset() {
+	*x = StorageFormatPlanResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageFormatPlanResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageFormatPlanResp) ProtoMessage() {}
+
+func (x *StorageFormatPlanResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageFormatPlanResp.ProtoReflect.Descriptor instead.
+func (*StorageFormatPlanResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StorageFormatPlanResp) GetPlan() *StorageFormatPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
 type NvmeRebindReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -376,7 +846,7 @@ type NvmeRebindReq struct {
 func (x *NvmeRebindReq) Reset() {
 	*x = NvmeRebindReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[5]
+		mi := &file_ctl_storage_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +859,7 @@ func (x *NvmeRebindReq) String() string {
 func (*NvmeRebindReq) ProtoMessage() {}
 
 func (x *NvmeRebindReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[5]
+	mi := &file_ctl_storage_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +872,7 @@ func (x *NvmeRebindReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeRebindReq.ProtoReflect.Descriptor instead.
 func (*NvmeRebindReq) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{5}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *NvmeRebindReq) GetPciAddr() string {
@@ -423,7 +893,7 @@ type NvmeRebindResp struct {
 func (x *NvmeRebindResp) Reset() {
 	*x = NvmeRebindResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[6]
+		mi := &file_ctl_storage_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -436,7 +906,7 @@ func (x *NvmeRebindResp) String() string {
 func (*NvmeRebindResp) ProtoMessage() {}
 
 func (x *NvmeRebindResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[6]
+	mi := &file_ctl_storage_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +919,7 @@ func (x *NvmeRebindResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeRebindResp.ProtoReflect.Descriptor instead.
 func (*NvmeRebindResp) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{6}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *NvmeRebindResp) GetState() *ResponseState {
@@ -472,7 +942,7 @@ type NvmeAddDeviceReq struct {
 func (x *NvmeAddDeviceReq) Reset() {
 	*x = NvmeAddDeviceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[7]
+		mi := &file_ctl_storage_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -485,7 +955,7 @@ func (x *NvmeAddDeviceReq) String() string {
 func (*NvmeAddDeviceReq) ProtoMessage() {}
 
 func (x *NvmeAddDeviceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[7]
+	mi := &file_ctl_storage_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +968,7 @@ func (x *NvmeAddDeviceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeAddDeviceReq.ProtoReflect.Descriptor instead.
 func (*NvmeAddDeviceReq) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{7}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *NvmeAddDeviceReq) GetPciAddr() string {
@@ -533,7 +1003,7 @@ type NvmeAddDeviceResp struct {
 func (x *NvmeAddDeviceResp) Reset() {
 	*x = NvmeAddDeviceResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[8]
+		mi := &file_ctl_storage_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -546,7 +1016,7 @@ func (x *NvmeAddDeviceResp) String() string {
 func (*NvmeAddDeviceResp) ProtoMessage() {}
 
 func (x *NvmeAddDeviceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[8]
+	mi := &file_ctl_storage_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +1029,7 @@ func (x *NvmeAddDeviceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeAddDeviceResp.ProtoReflect.Descriptor instead.
 func (*NvmeAddDeviceResp) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{8}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *NvmeAddDeviceResp) GetState() *ResponseState {
@@ -627,30 +1097,85 @@ var file_ctl_storage_proto_rawDesc = []byte{
 	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a,
 	0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63,
 	0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x0d, 0x4e, 0x76, 0x6d, 0x65,
-	0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0x3a, 0x0a, 0x0e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69,
-	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0x7e, 0x0a, 0x10, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69,
-	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x22, 0x3d, 0x0a, 0x11, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42,
-	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
-	0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72,
-	0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x74, 0x52, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x53, 0x63, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x72, 0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x22, 0x6e, 0x0a, 0x12, 0x42, 0x64, 0x65, 0x76, 0x54, 0x69, 0x65, 0x72, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24,
+	0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x03, 0x73, 0x63, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x62,
+	0x64, 0x65, 0x76, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x42, 0x64, 0x65, 0x76, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x62, 0x64, 0x65, 0x76, 0x5f,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x42, 0x64, 0x65, 0x76, 0x54, 0x69, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x09, 0x62, 0x64, 0x65, 0x76, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x64, 0x65, 0x76, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x62, 0x64, 0x65, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x76, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x79, 0x0a,
+	0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x07, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x43, 0x0a, 0x15,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61,
+	0x6e, 0x22, 0x2a, 0x0a, 0x0d, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22, 0x3a, 0x0a,
+	0x0e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7e, 0x0a, 0x10, 0x4e, 0x76, 0x6d,
+	0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x54, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x11, 0x4e, 0x76, 0x6d,
+	0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -665,44 +1190,56 @@ func file_ctl_storage_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_proto_rawDescData
 }
 
-var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_ctl_storage_proto_goTypes = []interface{}{
-	(*StorageScanReq)(nil),       // 0: ctl.StorageScanReq
-	(*MemInfo)(nil),              // 1: ctl.MemInfo
-	(*StorageScanResp)(nil),      // 2: ctl.StorageScanResp
-	(*StorageFormatReq)(nil),     // 3: ctl.StorageFormatReq
-	(*StorageFormatResp)(nil),    // 4: ctl.StorageFormatResp
-	(*NvmeRebindReq)(nil),        // 5: ctl.NvmeRebindReq
-	(*NvmeRebindResp)(nil),       // 6: ctl.NvmeRebindResp
-	(*NvmeAddDeviceReq)(nil),     // 7: ctl.NvmeAddDeviceReq
-	(*NvmeAddDeviceResp)(nil),    // 8: ctl.NvmeAddDeviceResp
-	(*ScanNvmeReq)(nil),          // 9: ctl.ScanNvmeReq
-	(*ScanScmReq)(nil),           // 10: ctl.ScanScmReq
-	(*ScanNvmeResp)(nil),         // 11: ctl.ScanNvmeResp
-	(*ScanScmResp)(nil),          // 12: ctl.ScanScmResp
-	(*FormatNvmeReq)(nil),        // 13: ctl.FormatNvmeReq
-	(*FormatScmReq)(nil),         // 14: ctl.FormatScmReq
-	(*NvmeControllerResult)(nil), // 15: ctl.NvmeControllerResult
-	(*ScmMountResult)(nil),       // 16: ctl.ScmMountResult
-	(*ResponseState)(nil),        // 17: ctl.ResponseState
+	(*StorageScanReq)(nil),        // 0: ctl.StorageScanReq
+	(*MemInfo)(nil),               // 1: ctl.MemInfo
+	(*StorageScanResp)(nil),       // 2: ctl.StorageScanResp
+	(*StorageFormatReq)(nil),      // 3: ctl.StorageFormatReq
+	(*StorageFormatResp)(nil),     // 4: ctl.StorageFormatResp
+	(*MetadataFormatPlan)(nil),    // 5: ctl.MetadataFormatPlan
+	(*ScmFormatPlan)(nil),         // 6: ctl.ScmFormatPlan
+	(*BdevTierFormatPlan)(nil),    // 7: ctl.BdevTierFormatPlan
+	(*EngineFormatPlan)(nil),      // 8: ctl.EngineFormatPlan
+	(*StorageFormatPlan)(nil),     // 9: ctl.StorageFormatPlan
+	(*StorageFormatPlanReq)(nil),  // 10: ctl.StorageFormatPlanReq
+	(*StorageFormatPlanResp)(nil), // 11: ctl.StorageFormatPlanResp
+	(*NvmeRebindReq)(nil),         // 12: ctl.NvmeRebindReq
+	(*NvmeRebindResp)(nil),        // 13: ctl.NvmeRebindResp
+	(*NvmeAddDeviceReq)(nil),      // 14: ctl.NvmeAddDeviceReq
+	(*NvmeAddDeviceResp)(nil),     // 15: ctl.NvmeAddDeviceResp
+	(*ScanNvmeReq)(nil),           // 16: ctl.ScanNvmeReq
+	(*ScanScmReq)(nil),            // 17: ctl.ScanScmReq
+	(*ScanNvmeResp)(nil),          // 18: ctl.ScanNvmeResp
+	(*ScanScmResp)(nil),           // 19: ctl.ScanScmResp
+	(*FormatNvmeReq)(nil),         // 20: ctl.FormatNvmeReq
+	(*FormatScmReq)(nil),          // 21: ctl.FormatScmReq
+	(*NvmeControllerResult)(nil),  // 22: ctl.NvmeControllerResult
+	(*ScmMountResult)(nil),        // 23: ctl.ScmMountResult
+	(*ResponseState)(nil),         // 24: ctl.ResponseState
 }
 var file_ctl_storage_proto_depIdxs = []int32{
-	9,  // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
-	10, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
-	11, // 2: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
-	12, // 3: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
+	16, // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
+	17, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
+	18, // 2: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
+	19, // 3: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
 	1,  // 4: ctl.StorageScanResp.mem_info:type_name -> ctl.MemInfo
-	13, // 5: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
-	14, // 6: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
-	15, // 7: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
-	16, // 8: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
-	17, // 9: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
-	17, // 10: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	20, // 5: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
+	21, // 6: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
+	22, // 7: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
+	23, // 8: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
+	6,  // 9: ctl.EngineFormatPlan.scm:type_name -> ctl.ScmFormatPlan
+	7,  // 10: ctl.EngineFormatPlan.bdev_tiers:type_name -> ctl.BdevTierFormatPlan
+	5,  // 11: ctl.StorageFormatPlan.metadata:type_name -> ctl.MetadataFormatPlan
+	8,  // 12: ctl.StorageFormatPlan.engines:type_name -> ctl.EngineFormatPlan
+	9,  // 13: ctl.StorageFormatPlanResp.plan:type_name -> ctl.StorageFormatPlan
+	24, // 14: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
+	24, // 15: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_ctl_storage_proto_init() }
@@ -775,7 +1312,7 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NvmeRebindReq); i {
+			switch v := v.(*MetadataFormatPlan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -787,7 +1324,7 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NvmeRebindResp); i {
+			switch v := v.(*ScmFormatPlan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -799,7 +1336,7 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NvmeAddDeviceReq); i {
+			switch v := v.(*BdevTierFormatPlan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -811,6 +1348,90 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineFormatPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageFormatPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageFormatPlanReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageFormatPlanResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeRebindReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeRebindResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeAddDeviceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NvmeAddDeviceResp); i {
 			case 0:
 				return &v.state
@@ -829,7 +1450,7 @@ func file_ctl_storage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -6,7 +6,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.31.0
+// 	protoc-gen-go v1.30.0
 // 	protoc        v3.5.0
 // source: ctl/storage_nvme.proto
 
@@ -278,6 +278,317 @@ func (*FormatNvmeReq) Descriptor() ([]byte, []int) {
 	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{3}
 }
 
+// NvmeHealthSample is a point-in-time record of the NVMe device health
+// statistics that are tracked over time.
+type NvmeHealthSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp           uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Time of sample in seconds since epoch
+	MediaErrs           uint64 `protobuf:"varint,2,opt,name=media_errs,json=mediaErrs,proto3" json:"media_errs,omitempty"`
+	BioReadErrs         uint32 `protobuf:"varint,3,opt,name=bio_read_errs,json=bioReadErrs,proto3" json:"bio_read_errs,omitempty"`
+	BioWriteErrs        uint32 `protobuf:"varint,4,opt,name=bio_write_errs,json=bioWriteErrs,proto3" json:"bio_write_errs,omitempty"`
+	ChecksumErrs        uint32 `protobuf:"varint,5,opt,name=checksum_errs,json=checksumErrs,proto3" json:"checksum_errs,omitempty"`
+	Temperature         uint32 `protobuf:"varint,6,opt,name=temperature,proto3" json:"temperature,omitempty"` // in Kelvin
+	AvailSpareWarn      bool   `protobuf:"varint,7,opt,name=avail_spare_warn,json=availSpareWarn,proto3" json:"avail_spare_warn,omitempty"`
+	WearLevelingCntNorm uint32 `protobuf:"varint,8,opt,name=wear_leveling_cnt_norm,json=wearLevelingCntNorm,proto3" json:"wear_leveling_cnt_norm,omitempty"` // percent of rated life remaining
+	WearLevelingCntAvg  uint32 `protobuf:"varint,9,opt,name=wear_leveling_cnt_avg,json=wearLevelingCntAvg,proto3" json:"wear_leveling_cnt_avg,omitempty"`    // average erase cycles
+	MediaWearRaw        uint64 `protobuf:"varint,10,opt,name=media_wear_raw,json=mediaWearRaw,proto3" json:"media_wear_raw,omitempty"`                       // media wear in 1/1024ths of a percent
+}
+
+func (x *NvmeHealthSample) Reset() {
+	*x = NvmeHealthSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthSample) ProtoMessage() {}
+
+func (x *NvmeHealthSample) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthSample.ProtoReflect.Descriptor instead.
+func (*NvmeHealthSample) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NvmeHealthSample) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetMediaErrs() uint64 {
+	if x != nil {
+		return x.MediaErrs
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetBioReadErrs() uint32 {
+	if x != nil {
+		return x.BioReadErrs
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetBioWriteErrs() uint32 {
+	if x != nil {
+		return x.BioWriteErrs
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetChecksumErrs() uint32 {
+	if x != nil {
+		return x.ChecksumErrs
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetTemperature() uint32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetAvailSpareWarn() bool {
+	if x != nil {
+		return x.AvailSpareWarn
+	}
+	return false
+}
+
+func (x *NvmeHealthSample) GetWearLevelingCntNorm() uint32 {
+	if x != nil {
+		return x.WearLevelingCntNorm
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetWearLevelingCntAvg() uint32 {
+	if x != nil {
+		return x.WearLevelingCntAvg
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetMediaWearRaw() uint64 {
+	if x != nil {
+		return x.MediaWearRaw
+	}
+	return 0
+}
+
+type NvmeHealthHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`  // Constrain query to this device UUID
+	Rank uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"` // Restrict response to only include devices on this rank
+}
+
+func (x *NvmeHealthHistoryReq) Reset() {
+	*x = NvmeHealthHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthHistoryReq) ProtoMessage() {}
+
+func (x *NvmeHealthHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthHistoryReq.ProtoReflect.Descriptor instead.
+func (*NvmeHealthHistoryReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NvmeHealthHistoryReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *NvmeHealthHistoryReq) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// NvmeHealthHistory contains the health samples retained for an NVMe device.
+type NvmeHealthHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid    string              `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                      // UUID of device
+	PciAddr string              `protobuf:"bytes,2,opt,name=pci_addr,json=pciAddr,proto3" json:"pci_addr,omitempty"` // PCI address of device controller
+	Model   string              `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`                    // Controller model name
+	Serial  string              `protobuf:"bytes,4,opt,name=serial,proto3" json:"serial,omitempty"`                  // Controller serial number
+	Rank    uint32              `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`                     // Rank to which the device belongs
+	Samples []*NvmeHealthSample `protobuf:"bytes,6,rep,name=samples,proto3" json:"samples,omitempty"`                // Health samples, oldest first
+}
+
+func (x *NvmeHealthHistory) Reset() {
+	*x = NvmeHealthHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthHistory) ProtoMessage() {}
+
+func (x *NvmeHealthHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthHistory.ProtoReflect.Descriptor instead.
+func (*NvmeHealthHistory) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NvmeHealthHistory) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *NvmeHealthHistory) GetPciAddr() string {
+	if x != nil {
+		return x.PciAddr
+	}
+	return ""
+}
+
+func (x *NvmeHealthHistory) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *NvmeHealthHistory) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *NvmeHealthHistory) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *NvmeHealthHistory) GetSamples() []*NvmeHealthSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type NvmeHealthHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*NvmeHealthHistory `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *NvmeHealthHistoryResp) Reset() {
+	*x = NvmeHealthHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthHistoryResp) ProtoMessage() {}
+
+func (x *NvmeHealthHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthHistoryResp.ProtoReflect.Descriptor instead.
+func (*NvmeHealthHistoryResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NvmeHealthHistoryResp) GetDevices() []*NvmeHealthHistory {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
 var File_ctl_storage_nvme_proto protoreflect.FileDescriptor
 
 var file_ctl_storage_nvme_proto_rawDesc = []byte{
@@ -312,11 +623,57 @@ var file_ctl_storage_nvme_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
 	0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4e, 0x76, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73,
-	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x71, 0x22, 0x98, 0x03, 0x0a, 0x10, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x65,
+	0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x45, 0x72, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x69, 0x6f, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x69, 0x6f,
+	0x52, 0x65, 0x61, 0x64, 0x45, 0x72, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x69, 0x6f, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x62, 0x69, 0x6f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x45,
+	0x72, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x73,
+	0x70, 0x61, 0x72, 0x65, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x53, 0x70, 0x61, 0x72, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x12,
+	0x33, 0x0a, 0x16, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6e, 0x74,
+	0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x12, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e,
+	0x67, 0x43, 0x6e, 0x74, 0x41, 0x76, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x57, 0x65, 0x61, 0x72, 0x52, 0x61, 0x77, 0x22, 0x3e, 0x0a,
+	0x14, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xb5, 0x01,
+	0x0a, 0x11, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30,
+	0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
+	0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73,
+	0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -331,24 +688,30 @@ func file_ctl_storage_nvme_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_nvme_proto_rawDescData
 }
 
-var file_ctl_storage_nvme_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ctl_storage_nvme_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_ctl_storage_nvme_proto_goTypes = []interface{}{
-	(*NvmeControllerResult)(nil), // 0: ctl.NvmeControllerResult
-	(*ScanNvmeReq)(nil),          // 1: ctl.ScanNvmeReq
-	(*ScanNvmeResp)(nil),         // 2: ctl.ScanNvmeResp
-	(*FormatNvmeReq)(nil),        // 3: ctl.FormatNvmeReq
-	(*ResponseState)(nil),        // 4: ctl.ResponseState
-	(*NvmeController)(nil),       // 5: ctl.NvmeController
+	(*NvmeControllerResult)(nil),  // 0: ctl.NvmeControllerResult
+	(*ScanNvmeReq)(nil),           // 1: ctl.ScanNvmeReq
+	(*ScanNvmeResp)(nil),          // 2: ctl.ScanNvmeResp
+	(*FormatNvmeReq)(nil),         // 3: ctl.FormatNvmeReq
+	(*NvmeHealthSample)(nil),      // 4: ctl.NvmeHealthSample
+	(*NvmeHealthHistoryReq)(nil),  // 5: ctl.NvmeHealthHistoryReq
+	(*NvmeHealthHistory)(nil),     // 6: ctl.NvmeHealthHistory
+	(*NvmeHealthHistoryResp)(nil), // 7: ctl.NvmeHealthHistoryResp
+	(*ResponseState)(nil),         // 8: ctl.ResponseState
+	(*NvmeController)(nil),        // 9: ctl.NvmeController
 }
 var file_ctl_storage_nvme_proto_depIdxs = []int32{
-	4, // 0: ctl.NvmeControllerResult.state:type_name -> ctl.ResponseState
-	5, // 1: ctl.ScanNvmeResp.ctrlrs:type_name -> ctl.NvmeController
-	4, // 2: ctl.ScanNvmeResp.state:type_name -> ctl.ResponseState
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 0: ctl.NvmeControllerResult.state:type_name -> ctl.ResponseState
+	9, // 1: ctl.ScanNvmeResp.ctrlrs:type_name -> ctl.NvmeController
+	8, // 2: ctl.ScanNvmeResp.state:type_name -> ctl.ResponseState
+	4, // 3: ctl.NvmeHealthHistory.samples:type_name -> ctl.NvmeHealthSample
+	6, // 4: ctl.NvmeHealthHistoryResp.devices:type_name -> ctl.NvmeHealthHistory
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_ctl_storage_nvme_proto_init() }
@@ -407,6 +770,54 @@ func file_ctl_storage_nvme_proto_init() {
 				return nil
 			}
 		}
+		file_ctl_storage_nvme_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_nvme_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_nvme_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_nvme_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -414,7 +825,7 @@ func file_ctl_storage_nvme_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_nvme_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -32,7 +32,8 @@ func ShouldDebug(msg proto.Message, ldrChk func() bool) bool {
 		*grpcpb.InstallSnapshotRequest, *grpcpb.InstallSnapshotResponse,
 		*mgmtpb.LeaderQueryReq, *mgmtpb.SystemQueryReq,
 		*ctlpb.StorageScanResp, *ctlpb.NetworkScanResp,
-		*ctlpb.StorageFormatResp, *ctlpb.PrepareScmResp,
+		*ctlpb.StorageFormatResp, *ctlpb.StorageFormatPlanResp, *ctlpb.PrepareScmResp,
+		*ctlpb.NvmeHealthHistoryResp,
 		*mgmtpb.GetAttachInfoReq:
 		return false
 	default:
@@ -44,7 +45,10 @@ func ShouldDebug(msg proto.Message, ldrChk func() bool) bool {
 				*mgmtpb.ListPoolsReq, *mgmtpb.GetACLReq,
 				*mgmtpb.PoolQueryTargetReq, *mgmtpb.ListContReq,
 				*mgmtpb.SystemEraseReq, *mgmtpb.SystemGetPropReq,
-				*mgmtpb.SystemGetAttrReq:
+				*mgmtpb.SystemGetAttrReq, *mgmtpb.SystemEventsListReq,
+				*mgmtpb.SystemBackupListReq, *mgmtpb.SystemReplicasListReq,
+				*mgmtpb.SystemGetQuotaReq, *mgmtpb.PoolForecastReq,
+				*mgmtpb.PoolRebuildHistoryReq:
 				return true
 			default:
 				return false
@@ -141,6 +141,16 @@ func (r *PoolExtendReq) SetUUID(id uuid.UUID) {
 	r.Id = id.String()
 }
 
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *PoolResizeReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *PoolResizeReq) SetUUID(id uuid.UUID) {
+	r.Id = id.String()
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolQueryReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
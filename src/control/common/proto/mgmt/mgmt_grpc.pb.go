@@ -37,6 +37,7 @@ const (
 	MgmtSvc_PoolExclude_FullMethodName              = "/mgmt.MgmtSvc/PoolExclude"
 	MgmtSvc_PoolDrain_FullMethodName                = "/mgmt.MgmtSvc/PoolDrain"
 	MgmtSvc_PoolExtend_FullMethodName               = "/mgmt.MgmtSvc/PoolExtend"
+	MgmtSvc_PoolResize_FullMethodName               = "/mgmt.MgmtSvc/PoolResize"
 	MgmtSvc_PoolReintegrate_FullMethodName          = "/mgmt.MgmtSvc/PoolReintegrate"
 	MgmtSvc_PoolQuery_FullMethodName                = "/mgmt.MgmtSvc/PoolQuery"
 	MgmtSvc_PoolQueryTarget_FullMethodName          = "/mgmt.MgmtSvc/PoolQueryTarget"
@@ -70,6 +71,19 @@ const (
 	MgmtSvc_SystemGetAttr_FullMethodName            = "/mgmt.MgmtSvc/SystemGetAttr"
 	MgmtSvc_SystemSetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemSetProp"
 	MgmtSvc_SystemGetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemGetProp"
+	MgmtSvc_SystemEventsFollow_FullMethodName       = "/mgmt.MgmtSvc/SystemEventsFollow"
+	MgmtSvc_SystemEventsList_FullMethodName         = "/mgmt.MgmtSvc/SystemEventsList"
+	MgmtSvc_SystemBackupCreate_FullMethodName       = "/mgmt.MgmtSvc/SystemBackupCreate"
+	MgmtSvc_SystemBackupList_FullMethodName         = "/mgmt.MgmtSvc/SystemBackupList"
+	MgmtSvc_SystemReplicasList_FullMethodName       = "/mgmt.MgmtSvc/SystemReplicasList"
+	MgmtSvc_SystemReplicasUpdate_FullMethodName     = "/mgmt.MgmtSvc/SystemReplicasUpdate"
+	MgmtSvc_SetMgmtSvcReplicas_FullMethodName       = "/mgmt.MgmtSvc/SetMgmtSvcReplicas"
+	MgmtSvc_SystemMaintenance_FullMethodName        = "/mgmt.MgmtSvc/SystemMaintenance"
+	MgmtSvc_SystemSetQuota_FullMethodName           = "/mgmt.MgmtSvc/SystemSetQuota"
+	MgmtSvc_SystemGetQuota_FullMethodName           = "/mgmt.MgmtSvc/SystemGetQuota"
+	MgmtSvc_PoolForecast_FullMethodName             = "/mgmt.MgmtSvc/PoolForecast"
+	MgmtSvc_PoolUndelete_FullMethodName             = "/mgmt.MgmtSvc/PoolUndelete"
+	MgmtSvc_PoolRebuildHistory_FullMethodName       = "/mgmt.MgmtSvc/PoolRebuildHistory"
 	MgmtSvc_FaultInjectReport_FullMethodName        = "/mgmt.MgmtSvc/FaultInjectReport"
 	MgmtSvc_FaultInjectPoolFault_FullMethodName     = "/mgmt.MgmtSvc/FaultInjectPoolFault"
 	MgmtSvc_FaultInjectMgmtPoolFault_FullMethodName = "/mgmt.MgmtSvc/FaultInjectMgmtPoolFault"
@@ -98,6 +112,8 @@ type MgmtSvcClient interface {
 	PoolDrain(ctx context.Context, in *PoolDrainReq, opts ...grpc.CallOption) (*PoolDrainResp, error)
 	// Extend a pool.
 	PoolExtend(ctx context.Context, in *PoolExtendReq, opts ...grpc.CallOption) (*PoolExtendResp, error)
+	// Resize a pool.
+	PoolResize(ctx context.Context, in *PoolResizeReq, opts ...grpc.CallOption) (*PoolResizeResp, error)
 	// Reintegrate a pool target.
 	PoolReintegrate(ctx context.Context, in *PoolReintReq, opts ...grpc.CallOption) (*PoolReintResp, error)
 	// PoolQuery queries a DAOS pool.
@@ -164,6 +180,32 @@ type MgmtSvcClient interface {
 	SystemSetProp(ctx context.Context, in *SystemSetPropReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(ctx context.Context, in *SystemGetPropReq, opts ...grpc.CallOption) (*SystemGetPropResp, error)
+	// Stream RAS events published on the MS leader.
+	SystemEventsFollow(ctx context.Context, in *SystemEventsFollowReq, opts ...grpc.CallOption) (MgmtSvc_SystemEventsFollowClient, error)
+	// List RAS events retained in the MS event history.
+	SystemEventsList(ctx context.Context, in *SystemEventsListReq, opts ...grpc.CallOption) (*SystemEventsListResp, error)
+	// Create a MS database backup.
+	SystemBackupCreate(ctx context.Context, in *SystemBackupCreateReq, opts ...grpc.CallOption) (*SystemBackupCreateResp, error)
+	// List MS database backups.
+	SystemBackupList(ctx context.Context, in *SystemBackupListReq, opts ...grpc.CallOption) (*SystemBackupListResp, error)
+	// List MS replicas.
+	SystemReplicasList(ctx context.Context, in *SystemReplicasListReq, opts ...grpc.CallOption) (*SystemReplicasListResp, error)
+	// Add or remove MS replicas.
+	SystemReplicasUpdate(ctx context.Context, in *SystemReplicasUpdateReq, opts ...grpc.CallOption) (*SystemReplicasUpdateResp, error)
+	// Update the MS replica set on a control plane server.
+	SetMgmtSvcReplicas(ctx context.Context, in *SetMgmtSvcReplicasReq, opts ...grpc.CallOption) (*SetMgmtSvcReplicasResp, error)
+	// Place hosts in or take them out of maintenance.
+	SystemMaintenance(ctx context.Context, in *SystemMaintenanceReq, opts ...grpc.CallOption) (*SystemMaintenanceResp, error)
+	// Set or remove a tenant storage quota.
+	SystemSetQuota(ctx context.Context, in *SystemSetQuotaReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Get tenant storage quotas and usage.
+	SystemGetQuota(ctx context.Context, in *SystemGetQuotaReq, opts ...grpc.CallOption) (*SystemGetQuotaResp, error)
+	// PoolForecast reports the capacity growth rate and projected time until full for pools.
+	PoolForecast(ctx context.Context, in *PoolForecastReq, opts ...grpc.CallOption) (*PoolForecastResp, error)
+	// Restore a DAOS pool that is pending destroy.
+	PoolUndelete(ctx context.Context, in *PoolUndeleteReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// PoolRebuildHistory returns the recorded rebuild history of a pool.
+	PoolRebuildHistory(ctx context.Context, in *PoolRebuildHistoryReq, opts ...grpc.CallOption) (*PoolRebuildHistoryResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error)
@@ -261,6 +303,15 @@ func (c *mgmtSvcClient) PoolExtend(ctx context.Context, in *PoolExtendReq, opts
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolResize(ctx context.Context, in *PoolResizeReq, opts ...grpc.CallOption) (*PoolResizeResp, error) {
+	out := new(PoolResizeResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolResize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolReintegrate(ctx context.Context, in *PoolReintReq, opts ...grpc.CallOption) (*PoolReintResp, error) {
 	out := new(PoolReintResp)
 	err := c.cc.Invoke(ctx, MgmtSvc_PoolReintegrate_FullMethodName, in, out, opts...)
@@ -558,6 +609,146 @@ func (c *mgmtSvcClient) SystemGetProp(ctx context.Context, in *SystemGetPropReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemEventsFollow(ctx context.Context, in *SystemEventsFollowReq, opts ...grpc.CallOption) (MgmtSvc_SystemEventsFollowClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[0], MgmtSvc_SystemEventsFollow_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemEventsFollowClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemEventsFollowClient interface {
+	Recv() (*shared.RASEvent, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemEventsFollowClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemEventsFollowClient) Recv() (*shared.RASEvent, error) {
+	m := new(shared.RASEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemEventsList(ctx context.Context, in *SystemEventsListReq, opts ...grpc.CallOption) (*SystemEventsListResp, error) {
+	out := new(SystemEventsListResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemEventsList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemBackupCreate(ctx context.Context, in *SystemBackupCreateReq, opts ...grpc.CallOption) (*SystemBackupCreateResp, error) {
+	out := new(SystemBackupCreateResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemBackupCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemBackupList(ctx context.Context, in *SystemBackupListReq, opts ...grpc.CallOption) (*SystemBackupListResp, error) {
+	out := new(SystemBackupListResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemBackupList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemReplicasList(ctx context.Context, in *SystemReplicasListReq, opts ...grpc.CallOption) (*SystemReplicasListResp, error) {
+	out := new(SystemReplicasListResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemReplicasList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemReplicasUpdate(ctx context.Context, in *SystemReplicasUpdateReq, opts ...grpc.CallOption) (*SystemReplicasUpdateResp, error) {
+	out := new(SystemReplicasUpdateResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemReplicasUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SetMgmtSvcReplicas(ctx context.Context, in *SetMgmtSvcReplicasReq, opts ...grpc.CallOption) (*SetMgmtSvcReplicasResp, error) {
+	out := new(SetMgmtSvcReplicasResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SetMgmtSvcReplicas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemMaintenance(ctx context.Context, in *SystemMaintenanceReq, opts ...grpc.CallOption) (*SystemMaintenanceResp, error) {
+	out := new(SystemMaintenanceResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemSetQuota(ctx context.Context, in *SystemSetQuotaReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemSetQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemGetQuota(ctx context.Context, in *SystemGetQuotaReq, opts ...grpc.CallOption) (*SystemGetQuotaResp, error) {
+	out := new(SystemGetQuotaResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemGetQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolForecast(ctx context.Context, in *PoolForecastReq, opts ...grpc.CallOption) (*PoolForecastResp, error) {
+	out := new(PoolForecastResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolForecast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolUndelete(ctx context.Context, in *PoolUndeleteReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolUndelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolRebuildHistory(ctx context.Context, in *PoolRebuildHistoryReq, opts ...grpc.CallOption) (*PoolRebuildHistoryResp, error) {
+	out := new(PoolRebuildHistoryResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolRebuildHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error) {
 	out := new(DaosResp)
 	err := c.cc.Invoke(ctx, MgmtSvc_FaultInjectReport_FullMethodName, in, out, opts...)
@@ -608,6 +799,8 @@ type MgmtSvcServer interface {
 	PoolDrain(context.Context, *PoolDrainReq) (*PoolDrainResp, error)
 	// Extend a pool.
 	PoolExtend(context.Context, *PoolExtendReq) (*PoolExtendResp, error)
+	// Resize a pool.
+	PoolResize(context.Context, *PoolResizeReq) (*PoolResizeResp, error)
 	// Reintegrate a pool target.
 	PoolReintegrate(context.Context, *PoolReintReq) (*PoolReintResp, error)
 	// PoolQuery queries a DAOS pool.
@@ -674,6 +867,32 @@ type MgmtSvcServer interface {
 	SystemSetProp(context.Context, *SystemSetPropReq) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error)
+	// Stream RAS events published on the MS leader.
+	SystemEventsFollow(*SystemEventsFollowReq, MgmtSvc_SystemEventsFollowServer) error
+	// List RAS events retained in the MS event history.
+	SystemEventsList(context.Context, *SystemEventsListReq) (*SystemEventsListResp, error)
+	// Create a MS database backup.
+	SystemBackupCreate(context.Context, *SystemBackupCreateReq) (*SystemBackupCreateResp, error)
+	// List MS database backups.
+	SystemBackupList(context.Context, *SystemBackupListReq) (*SystemBackupListResp, error)
+	// List MS replicas.
+	SystemReplicasList(context.Context, *SystemReplicasListReq) (*SystemReplicasListResp, error)
+	// Add or remove MS replicas.
+	SystemReplicasUpdate(context.Context, *SystemReplicasUpdateReq) (*SystemReplicasUpdateResp, error)
+	// Update the MS replica set on a control plane server.
+	SetMgmtSvcReplicas(context.Context, *SetMgmtSvcReplicasReq) (*SetMgmtSvcReplicasResp, error)
+	// Place hosts in or take them out of maintenance.
+	SystemMaintenance(context.Context, *SystemMaintenanceReq) (*SystemMaintenanceResp, error)
+	// Set or remove a tenant storage quota.
+	SystemSetQuota(context.Context, *SystemSetQuotaReq) (*DaosResp, error)
+	// Get tenant storage quotas and usage.
+	SystemGetQuota(context.Context, *SystemGetQuotaReq) (*SystemGetQuotaResp, error)
+	// PoolForecast reports the capacity growth rate and projected time until full for pools.
+	PoolForecast(context.Context, *PoolForecastReq) (*PoolForecastResp, error)
+	// Restore a DAOS pool that is pending destroy.
+	PoolUndelete(context.Context, *PoolUndeleteReq) (*DaosResp, error)
+	// PoolRebuildHistory returns the recorded rebuild history of a pool.
+	PoolRebuildHistory(context.Context, *PoolRebuildHistoryReq) (*PoolRebuildHistoryResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error)
@@ -714,6 +933,9 @@ func (UnimplementedMgmtSvcServer) PoolDrain(context.Context, *PoolDrainReq) (*Po
 func (UnimplementedMgmtSvcServer) PoolExtend(context.Context, *PoolExtendReq) (*PoolExtendResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolExtend not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolResize(context.Context, *PoolResizeReq) (*PoolResizeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolResize not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolReintegrate(context.Context, *PoolReintReq) (*PoolReintResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolReintegrate not implemented")
 }
@@ -813,6 +1035,45 @@ func (UnimplementedMgmtSvcServer) SystemSetProp(context.Context, *SystemSetPropR
 func (UnimplementedMgmtSvcServer) SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemEventsFollow(*SystemEventsFollowReq, MgmtSvc_SystemEventsFollowServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemEventsFollow not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemEventsList(context.Context, *SystemEventsListReq) (*SystemEventsListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemEventsList not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemBackupCreate(context.Context, *SystemBackupCreateReq) (*SystemBackupCreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemBackupCreate not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemBackupList(context.Context, *SystemBackupListReq) (*SystemBackupListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemBackupList not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemReplicasList(context.Context, *SystemReplicasListReq) (*SystemReplicasListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplicasList not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemReplicasUpdate(context.Context, *SystemReplicasUpdateReq) (*SystemReplicasUpdateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplicasUpdate not implemented")
+}
+func (UnimplementedMgmtSvcServer) SetMgmtSvcReplicas(context.Context, *SetMgmtSvcReplicasReq) (*SetMgmtSvcReplicasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMgmtSvcReplicas not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemMaintenance(context.Context, *SystemMaintenanceReq) (*SystemMaintenanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemMaintenance not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemSetQuota(context.Context, *SystemSetQuotaReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSetQuota not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemGetQuota(context.Context, *SystemGetQuotaReq) (*SystemGetQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGetQuota not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolForecast(context.Context, *PoolForecastReq) (*PoolForecastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolForecast not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolUndelete(context.Context, *PoolUndeleteReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolUndelete not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolRebuildHistory(context.Context, *PoolRebuildHistoryReq) (*PoolRebuildHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolRebuildHistory not implemented")
+}
 func (UnimplementedMgmtSvcServer) FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FaultInjectReport not implemented")
 }
@@ -997,6 +1258,24 @@ func _MgmtSvc_PoolExtend_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolResizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolResize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolResize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolResize(ctx, req.(*PoolResizeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolReintegrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolReintReq)
 	if err := dec(in); err != nil {
@@ -1591,6 +1870,243 @@ func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_SystemEventsFollow_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemEventsFollowReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemEventsFollow(m, &mgmtSvcSystemEventsFollowServer{stream})
+}
+
+type MgmtSvc_SystemEventsFollowServer interface {
+	Send(*shared.RASEvent) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemEventsFollowServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemEventsFollowServer) Send(m *shared.RASEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemEventsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemEventsListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemEventsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemEventsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemEventsList(ctx, req.(*SystemEventsListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemBackupCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemBackupCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemBackupCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemBackupCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemBackupCreate(ctx, req.(*SystemBackupCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemBackupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemBackupListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemBackupList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemBackupList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemBackupList(ctx, req.(*SystemBackupListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemReplicasList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicasListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplicasList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemReplicasList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplicasList(ctx, req.(*SystemReplicasListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemReplicasUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicasUpdateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplicasUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemReplicasUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplicasUpdate(ctx, req.(*SystemReplicasUpdateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SetMgmtSvcReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMgmtSvcReplicasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SetMgmtSvcReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SetMgmtSvcReplicas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SetMgmtSvcReplicas(ctx, req.(*SetMgmtSvcReplicasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemMaintenanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemMaintenance(ctx, req.(*SystemMaintenanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemSetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemSetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSetQuota(ctx, req.(*SystemSetQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemGetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemGetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemGetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemGetQuota(ctx, req.(*SystemGetQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolForecastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolForecast(ctx, req.(*PoolForecastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolUndelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolUndeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolUndelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolUndelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolUndelete(ctx, req.(*PoolUndeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolRebuildHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolRebuildHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolRebuildHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolRebuildHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolRebuildHistory(ctx, req.(*PoolRebuildHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_FaultInjectReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(chk.CheckReport)
 	if err := dec(in); err != nil {
@@ -1688,6 +2204,10 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolExtend",
 			Handler:    _MgmtSvc_PoolExtend_Handler,
 		},
+		{
+			MethodName: "PoolResize",
+			Handler:    _MgmtSvc_PoolResize_Handler,
+		},
 		{
 			MethodName: "PoolReintegrate",
 			Handler:    _MgmtSvc_PoolReintegrate_Handler,
@@ -1820,6 +2340,54 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemGetProp",
 			Handler:    _MgmtSvc_SystemGetProp_Handler,
 		},
+		{
+			MethodName: "SystemEventsList",
+			Handler:    _MgmtSvc_SystemEventsList_Handler,
+		},
+		{
+			MethodName: "SystemBackupCreate",
+			Handler:    _MgmtSvc_SystemBackupCreate_Handler,
+		},
+		{
+			MethodName: "SystemBackupList",
+			Handler:    _MgmtSvc_SystemBackupList_Handler,
+		},
+		{
+			MethodName: "SystemReplicasList",
+			Handler:    _MgmtSvc_SystemReplicasList_Handler,
+		},
+		{
+			MethodName: "SystemReplicasUpdate",
+			Handler:    _MgmtSvc_SystemReplicasUpdate_Handler,
+		},
+		{
+			MethodName: "SetMgmtSvcReplicas",
+			Handler:    _MgmtSvc_SetMgmtSvcReplicas_Handler,
+		},
+		{
+			MethodName: "SystemMaintenance",
+			Handler:    _MgmtSvc_SystemMaintenance_Handler,
+		},
+		{
+			MethodName: "SystemSetQuota",
+			Handler:    _MgmtSvc_SystemSetQuota_Handler,
+		},
+		{
+			MethodName: "SystemGetQuota",
+			Handler:    _MgmtSvc_SystemGetQuota_Handler,
+		},
+		{
+			MethodName: "PoolForecast",
+			Handler:    _MgmtSvc_PoolForecast_Handler,
+		},
+		{
+			MethodName: "PoolUndelete",
+			Handler:    _MgmtSvc_PoolUndelete_Handler,
+		},
+		{
+			MethodName: "PoolRebuildHistory",
+			Handler:    _MgmtSvc_PoolRebuildHistory_Handler,
+		},
 		{
 			MethodName: "FaultInjectReport",
 			Handler:    _MgmtSvc_FaultInjectReport_Handler,
@@ -1833,6 +2401,12 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _MgmtSvc_FaultInjectMgmtPoolFault_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SystemEventsFollow",
+			Handler:       _MgmtSvc_SystemEventsFollow_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "mgmt/mgmt.proto",
 }
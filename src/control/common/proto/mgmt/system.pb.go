@@ -47,6 +47,7 @@ type SystemMember struct {
 	FaultDomain         string   `protobuf:"bytes,9,opt,name=fault_domain,json=faultDomain,proto3" json:"fault_domain,omitempty"`
 	LastUpdate          string   `protobuf:"bytes,10,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
 	SecondaryFabricUris []string `protobuf:"bytes,11,rep,name=secondary_fabric_uris,json=secondaryFabricUris,proto3" json:"secondary_fabric_uris,omitempty"`
+	Maintenance         bool     `protobuf:"varint,12,opt,name=maintenance,proto3" json:"maintenance,omitempty"` // member's host is in maintenance
 }
 
 func (x *SystemMember) Reset() {
@@ -158,18 +159,26 @@ func (x *SystemMember) GetSecondaryFabricUris() []string {
 	return nil
 }
 
+func (x *SystemMember) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
 // SystemStopReq supplies system shutdown parameters.
 type SystemStopReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`    // DAOS system name
-	Prep  bool   `protobuf:"varint,2,opt,name=prep,proto3" json:"prep,omitempty"` // indicates that the prep stage should be performed
-	Kill  bool   `protobuf:"varint,3,opt,name=kill,proto3" json:"kill,omitempty"` // indicates that the kill stage should be performed
-	Force bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
-	Ranks string `protobuf:"bytes,5,opt,name=ranks,proto3" json:"ranks,omitempty"` // rankset to query
-	Hosts string `protobuf:"bytes,6,opt,name=hosts,proto3" json:"hosts,omitempty"` // hostset to query
+	Sys    string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`    // DAOS system name
+	Prep   bool   `protobuf:"varint,2,opt,name=prep,proto3" json:"prep,omitempty"` // indicates that the prep stage should be performed
+	Kill   bool   `protobuf:"varint,3,opt,name=kill,proto3" json:"kill,omitempty"` // indicates that the kill stage should be performed
+	Force  bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	Ranks  string `protobuf:"bytes,5,opt,name=ranks,proto3" json:"ranks,omitempty"`                  // rankset to query
+	Hosts  string `protobuf:"bytes,6,opt,name=hosts,proto3" json:"hosts,omitempty"`                  // hostset to query
+	DryRun bool   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // report impact on pools without stopping ranks
 }
 
 func (x *SystemStopReq) Reset() {
@@ -246,6 +255,13 @@ func (x *SystemStopReq) GetHosts() string {
 	return ""
 }
 
+func (x *SystemStopReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // SystemStopResp returns status of shutdown attempt and results
 // of attempts to stop system members.
 type SystemStopResp struct {
@@ -254,8 +270,9 @@ type SystemStopResp struct {
 	unknownFields protoimpl.UnknownFields
 
 	Results     []*shared.RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Absentranks string               `protobuf:"bytes,2,opt,name=absentranks,proto3" json:"absentranks,omitempty"` // rankset missing from membership
-	Absenthosts string               `protobuf:"bytes,3,opt,name=absenthosts,proto3" json:"absenthosts,omitempty"` // hostset missing from membership
+	Absentranks string               `protobuf:"bytes,2,opt,name=absentranks,proto3" json:"absentranks,omitempty"`                    // rankset missing from membership
+	Absenthosts string               `protobuf:"bytes,3,opt,name=absenthosts,proto3" json:"absenthosts,omitempty"`                    // hostset missing from membership
+	PoolImpacts []*PoolImpact        `protobuf:"bytes,4,rep,name=pool_impacts,json=poolImpacts,proto3" json:"pool_impacts,omitempty"` // Impact on pools if dry_run was requested
 }
 
 func (x *SystemStopResp) Reset() {
@@ -311,6 +328,13 @@ func (x *SystemStopResp) GetAbsenthosts() string {
 	return ""
 }
 
+func (x *SystemStopResp) GetPoolImpacts() []*PoolImpact {
+	if x != nil {
+		return x.PoolImpacts
+	}
+	return nil
+}
+
 // SystemStartReq supplies system restart parameters.
 type SystemStartReq struct {
 	state         protoimpl.MessageState
@@ -454,10 +478,11 @@ type SystemExcludeReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`      // DAOS system name
-	Ranks string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`  // rankset to exclude
-	Hosts string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`  // hostset to exclude
-	Clear bool   `protobuf:"varint,4,opt,name=clear,proto3" json:"clear,omitempty"` // Clear excluded state
+	Sys    string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                      // DAOS system name
+	Ranks  string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`                  // rankset to exclude
+	Hosts  string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`                  // hostset to exclude
+	Clear  bool   `protobuf:"varint,4,opt,name=clear,proto3" json:"clear,omitempty"`                 // Clear excluded state
+	DryRun bool   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // report impact on pools without excluding ranks
 }
 
 func (x *SystemExcludeReq) Reset() {
@@ -520,13 +545,21 @@ func (x *SystemExcludeReq) GetClear() bool {
 	return false
 }
 
+func (x *SystemExcludeReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // SystemExcludeResp returns status of exclude request.
 type SystemExcludeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*shared.RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Results     []*shared.RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	PoolImpacts []*PoolImpact        `protobuf:"bytes,2,rep,name=pool_impacts,json=poolImpacts,proto3" json:"pool_impacts,omitempty"` // Impact on pools if dry_run was requested
 }
 
 func (x *SystemExcludeResp) Reset() {
@@ -568,6 +601,254 @@ func (x *SystemExcludeResp) GetResults() []*shared.RankResult {
 	return nil
 }
 
+func (x *SystemExcludeResp) GetPoolImpacts() []*PoolImpact {
+	if x != nil {
+		return x.PoolImpacts
+	}
+	return nil
+}
+
+// SystemMaintenanceReq supplies the members to place in or take out of
+// maintenance.
+type SystemMaintenanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys         string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                    // DAOS system name
+	Hosts       string `protobuf:"bytes,2,opt,name=hosts,proto3" json:"hosts,omitempty"`                                // hostset to place in maintenance
+	FaultDomain string `protobuf:"bytes,3,opt,name=fault_domain,json=faultDomain,proto3" json:"fault_domain,omitempty"` // fault domain to place in maintenance
+	Exit        bool   `protobuf:"varint,4,opt,name=exit,proto3" json:"exit,omitempty"`                                 // Take members out of maintenance
+}
+
+func (x *SystemMaintenanceReq) Reset() {
+	*x = SystemMaintenanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemMaintenanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemMaintenanceReq) ProtoMessage() {}
+
+func (x *SystemMaintenanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemMaintenanceReq.ProtoReflect.Descriptor instead.
+func (*SystemMaintenanceReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SystemMaintenanceReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemMaintenanceReq) GetHosts() string {
+	if x != nil {
+		return x.Hosts
+	}
+	return ""
+}
+
+func (x *SystemMaintenanceReq) GetFaultDomain() string {
+	if x != nil {
+		return x.FaultDomain
+	}
+	return ""
+}
+
+func (x *SystemMaintenanceReq) GetExit() bool {
+	if x != nil {
+		return x.Exit
+	}
+	return false
+}
+
+// SystemMaintenanceResp returns results of a system maintenance request.
+type SystemMaintenanceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*shared.RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SystemMaintenanceResp) Reset() {
+	*x = SystemMaintenanceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemMaintenanceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemMaintenanceResp) ProtoMessage() {}
+
+func (x *SystemMaintenanceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemMaintenanceResp.ProtoReflect.Descriptor instead.
+func (*SystemMaintenanceResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SystemMaintenanceResp) GetResults() []*shared.RankResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Predicted impact on a pool of making a set of ranks unavailable.
+type PoolImpact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolId           string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                                // Label or uuid of pool
+	Ranks            string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`                                                // Pool ranks that would become unavailable
+	DownRanks        string `protobuf:"bytes,3,opt,name=down_ranks,json=downRanks,proto3" json:"down_ranks,omitempty"`                       // Pool ranks that are already unavailable
+	RedundancyFactor uint32 `protobuf:"varint,4,opt,name=redundancy_factor,json=redundancyFactor,proto3" json:"redundancy_factor,omitempty"` // Pool redundancy factor (rd_fac)
+	SvcRanks         string `protobuf:"bytes,5,opt,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`                          // Pool service replicas that would become unavailable
+	SvcReplicas      uint32 `protobuf:"varint,6,opt,name=svc_replicas,json=svcReplicas,proto3" json:"svc_replicas,omitempty"`                // Total number of pool service replicas
+	Degraded         bool   `protobuf:"varint,7,opt,name=degraded,proto3" json:"degraded,omitempty"`                                         // Pool would lose redundancy within its redundancy factor
+	RfBroken         bool   `protobuf:"varint,8,opt,name=rf_broken,json=rfBroken,proto3" json:"rf_broken,omitempty"`                         // Unavailable fault domains would exceed the redundancy factor
+	SvcMajorityLost  bool   `protobuf:"varint,9,opt,name=svc_majority_lost,json=svcMajorityLost,proto3" json:"svc_majority_lost,omitempty"`  // Pool service would lose its replica majority
+	Msg              string `protobuf:"bytes,10,opt,name=msg,proto3" json:"msg,omitempty"`                                                   // Error message if the impact could not be fully determined
+}
+
+func (x *PoolImpact) Reset() {
+	*x = PoolImpact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolImpact) ProtoMessage() {}
+
+func (x *PoolImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolImpact.ProtoReflect.Descriptor instead.
+func (*PoolImpact) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PoolImpact) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *PoolImpact) GetRanks() string {
+	if x != nil {
+		return x.Ranks
+	}
+	return ""
+}
+
+func (x *PoolImpact) GetDownRanks() string {
+	if x != nil {
+		return x.DownRanks
+	}
+	return ""
+}
+
+func (x *PoolImpact) GetRedundancyFactor() uint32 {
+	if x != nil {
+		return x.RedundancyFactor
+	}
+	return 0
+}
+
+func (x *PoolImpact) GetSvcRanks() string {
+	if x != nil {
+		return x.SvcRanks
+	}
+	return ""
+}
+
+func (x *PoolImpact) GetSvcReplicas() uint32 {
+	if x != nil {
+		return x.SvcReplicas
+	}
+	return 0
+}
+
+func (x *PoolImpact) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *PoolImpact) GetRfBroken() bool {
+	if x != nil {
+		return x.RfBroken
+	}
+	return false
+}
+
+func (x *PoolImpact) GetSvcMajorityLost() bool {
+	if x != nil {
+		return x.SvcMajorityLost
+	}
+	return false
+}
+
+func (x *PoolImpact) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
 // Results for system OSA calls on multiple pool-ranks.
 type PoolRankResult struct {
 	state         protoimpl.MessageState
@@ -583,7 +864,7 @@ type PoolRankResult struct {
 func (x *PoolRankResult) Reset() {
 	*x = PoolRankResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[7]
+		mi := &file_mgmt_system_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -596,7 +877,7 @@ func (x *PoolRankResult) String() string {
 func (*PoolRankResult) ProtoMessage() {}
 
 func (x *PoolRankResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[7]
+	mi := &file_mgmt_system_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +890,7 @@ func (x *PoolRankResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolRankResult.ProtoReflect.Descriptor instead.
 func (*PoolRankResult) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{7}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PoolRankResult) GetStatus() int32 {
@@ -646,16 +927,17 @@ type SystemDrainReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`      // DAOS system name
-	Ranks string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`  // rankset to drain on all pools
-	Hosts string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`  // hostset to drain on all pools
-	Reint bool   `protobuf:"varint,4,opt,name=reint,proto3" json:"reint,omitempty"` // Flag to indicate if request is for drain or reint.
+	Sys    string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                      // DAOS system name
+	Ranks  string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`                  // rankset to drain on all pools
+	Hosts  string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`                  // hostset to drain on all pools
+	Reint  bool   `protobuf:"varint,4,opt,name=reint,proto3" json:"reint,omitempty"`                 // Flag to indicate if request is for drain or reint.
+	DryRun bool   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Report impact on pools without draining ranks.
 }
 
 func (x *SystemDrainReq) Reset() {
 	*x = SystemDrainReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[8]
+		mi := &file_mgmt_system_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +950,7 @@ func (x *SystemDrainReq) String() string {
 func (*SystemDrainReq) ProtoMessage() {}
 
 func (x *SystemDrainReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[8]
+	mi := &file_mgmt_system_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +963,7 @@ func (x *SystemDrainReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemDrainReq.ProtoReflect.Descriptor instead.
 func (*SystemDrainReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{8}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SystemDrainReq) GetSys() string {
@@ -712,20 +994,28 @@ func (x *SystemDrainReq) GetReint() bool {
 	return false
 }
 
+func (x *SystemDrainReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // SystemDrainResp returns status of system-drain request.
 type SystemDrainResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reint   bool              `protobuf:"varint,1,opt,name=reint,proto3" json:"reint,omitempty"`    // Flag to indicate if results are for drain or reint.
-	Results []*PoolRankResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // Results for drain or reint calls on pool-ranks.
+	Reint       bool              `protobuf:"varint,1,opt,name=reint,proto3" json:"reint,omitempty"`                               // Flag to indicate if results are for drain or reint.
+	Results     []*PoolRankResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`                            // Results for drain or reint calls on pool-ranks.
+	PoolImpacts []*PoolImpact     `protobuf:"bytes,3,rep,name=pool_impacts,json=poolImpacts,proto3" json:"pool_impacts,omitempty"` // Impact on pools if dry_run was requested.
 }
 
 func (x *SystemDrainResp) Reset() {
 	*x = SystemDrainResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[9]
+		mi := &file_mgmt_system_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -738,7 +1028,7 @@ func (x *SystemDrainResp) String() string {
 func (*SystemDrainResp) ProtoMessage() {}
 
 func (x *SystemDrainResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[9]
+	mi := &file_mgmt_system_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +1041,7 @@ func (x *SystemDrainResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemDrainResp.ProtoReflect.Descriptor instead.
 func (*SystemDrainResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{9}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SystemDrainResp) GetReint() bool {
@@ -768,6 +1058,13 @@ func (x *SystemDrainResp) GetResults() []*PoolRankResult {
 	return nil
 }
 
+func (x *SystemDrainResp) GetPoolImpacts() []*PoolImpact {
+	if x != nil {
+		return x.PoolImpacts
+	}
+	return nil
+}
+
 // SystemQueryReq supplies system query parameters.
 type SystemQueryReq struct {
 	state         protoimpl.MessageState
@@ -783,7 +1080,7 @@ type SystemQueryReq struct {
 func (x *SystemQueryReq) Reset() {
 	*x = SystemQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[10]
+		mi := &file_mgmt_system_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -796,7 +1093,7 @@ func (x *SystemQueryReq) String() string {
 func (*SystemQueryReq) ProtoMessage() {}
 
 func (x *SystemQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[10]
+	mi := &file_mgmt_system_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +1106,7 @@ func (x *SystemQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemQueryReq.ProtoReflect.Descriptor instead.
 func (*SystemQueryReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{10}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SystemQueryReq) GetSys() string {
@@ -856,7 +1153,7 @@ type SystemQueryResp struct {
 func (x *SystemQueryResp) Reset() {
 	*x = SystemQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[11]
+		mi := &file_mgmt_system_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -869,7 +1166,7 @@ func (x *SystemQueryResp) String() string {
 func (*SystemQueryResp) ProtoMessage() {}
 
 func (x *SystemQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[11]
+	mi := &file_mgmt_system_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1179,7 @@ func (x *SystemQueryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemQueryResp.ProtoReflect.Descriptor instead.
 func (*SystemQueryResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{11}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SystemQueryResp) GetMembers() []*SystemMember {
@@ -932,7 +1229,7 @@ type SystemEraseReq struct {
 func (x *SystemEraseReq) Reset() {
 	*x = SystemEraseReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[12]
+		mi := &file_mgmt_system_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -945,7 +1242,7 @@ func (x *SystemEraseReq) String() string {
 func (*SystemEraseReq) ProtoMessage() {}
 
 func (x *SystemEraseReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[12]
+	mi := &file_mgmt_system_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1255,7 @@ func (x *SystemEraseReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemEraseReq.ProtoReflect.Descriptor instead.
 func (*SystemEraseReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{12}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SystemEraseReq) GetSys() string {
@@ -979,7 +1276,7 @@ type SystemEraseResp struct {
 func (x *SystemEraseResp) Reset() {
 	*x = SystemEraseResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[13]
+		mi := &file_mgmt_system_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -992,7 +1289,7 @@ func (x *SystemEraseResp) String() string {
 func (*SystemEraseResp) ProtoMessage() {}
 
 func (x *SystemEraseResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[13]
+	mi := &file_mgmt_system_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,7 +1302,7 @@ func (x *SystemEraseResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemEraseResp.ProtoReflect.Descriptor instead.
 func (*SystemEraseResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{13}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SystemEraseResp) GetResults() []*shared.RankResult {
@@ -1028,7 +1325,7 @@ type SystemCleanupReq struct {
 func (x *SystemCleanupReq) Reset() {
 	*x = SystemCleanupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[14]
+		mi := &file_mgmt_system_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1041,7 +1338,7 @@ func (x *SystemCleanupReq) String() string {
 func (*SystemCleanupReq) ProtoMessage() {}
 
 func (x *SystemCleanupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[14]
+	mi := &file_mgmt_system_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1351,7 @@ func (x *SystemCleanupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemCleanupReq.ProtoReflect.Descriptor instead.
 func (*SystemCleanupReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{14}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SystemCleanupReq) GetSys() string {
@@ -1083,7 +1380,7 @@ type SystemCleanupResp struct {
 func (x *SystemCleanupResp) Reset() {
 	*x = SystemCleanupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[15]
+		mi := &file_mgmt_system_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1096,7 +1393,7 @@ func (x *SystemCleanupResp) String() string {
 func (*SystemCleanupResp) ProtoMessage() {}
 
 func (x *SystemCleanupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[15]
+	mi := &file_mgmt_system_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +1406,7 @@ func (x *SystemCleanupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemCleanupResp.ProtoReflect.Descriptor instead.
 func (*SystemCleanupResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{15}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SystemCleanupResp) GetResults() []*SystemCleanupResp_CleanupResult {
@@ -1132,7 +1429,7 @@ type SystemSetAttrReq struct {
 func (x *SystemSetAttrReq) Reset() {
 	*x = SystemSetAttrReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[16]
+		mi := &file_mgmt_system_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1145,7 +1442,7 @@ func (x *SystemSetAttrReq) String() string {
 func (*SystemSetAttrReq) ProtoMessage() {}
 
 func (x *SystemSetAttrReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[16]
+	mi := &file_mgmt_system_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1455,7 @@ func (x *SystemSetAttrReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemSetAttrReq.ProtoReflect.Descriptor instead.
 func (*SystemSetAttrReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{16}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SystemSetAttrReq) GetSys() string {
@@ -1189,7 +1486,7 @@ type SystemGetAttrReq struct {
 func (x *SystemGetAttrReq) Reset() {
 	*x = SystemGetAttrReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[17]
+		mi := &file_mgmt_system_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1202,7 +1499,7 @@ func (x *SystemGetAttrReq) String() string {
 func (*SystemGetAttrReq) ProtoMessage() {}
 
 func (x *SystemGetAttrReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[17]
+	mi := &file_mgmt_system_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1512,7 @@ func (x *SystemGetAttrReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetAttrReq.ProtoReflect.Descriptor instead.
 func (*SystemGetAttrReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{17}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SystemGetAttrReq) GetSys() string {
@@ -1244,7 +1541,7 @@ type SystemGetAttrResp struct {
 func (x *SystemGetAttrResp) Reset() {
 	*x = SystemGetAttrResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[18]
+		mi := &file_mgmt_system_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1257,7 +1554,7 @@ func (x *SystemGetAttrResp) String() string {
 func (*SystemGetAttrResp) ProtoMessage() {}
 
 func (x *SystemGetAttrResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[18]
+	mi := &file_mgmt_system_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,7 +1567,7 @@ func (x *SystemGetAttrResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetAttrResp.ProtoReflect.Descriptor instead.
 func (*SystemGetAttrResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{18}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SystemGetAttrResp) GetAttributes() map[string]string {
@@ -1293,7 +1590,7 @@ type SystemSetPropReq struct {
 func (x *SystemSetPropReq) Reset() {
 	*x = SystemSetPropReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[19]
+		mi := &file_mgmt_system_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1306,7 +1603,7 @@ func (x *SystemSetPropReq) String() string {
 func (*SystemSetPropReq) ProtoMessage() {}
 
 func (x *SystemSetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[19]
+	mi := &file_mgmt_system_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1616,7 @@ func (x *SystemSetPropReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemSetPropReq.ProtoReflect.Descriptor instead.
 func (*SystemSetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{19}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SystemSetPropReq) GetSys() string {
@@ -1350,7 +1647,7 @@ type SystemGetPropReq struct {
 func (x *SystemGetPropReq) Reset() {
 	*x = SystemGetPropReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[20]
+		mi := &file_mgmt_system_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1363,7 +1660,7 @@ func (x *SystemGetPropReq) String() string {
 func (*SystemGetPropReq) ProtoMessage() {}
 
 func (x *SystemGetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[20]
+	mi := &file_mgmt_system_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1376,7 +1673,7 @@ func (x *SystemGetPropReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetPropReq.ProtoReflect.Descriptor instead.
 func (*SystemGetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{20}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SystemGetPropReq) GetSys() string {
@@ -1405,7 +1702,7 @@ type SystemGetPropResp struct {
 func (x *SystemGetPropResp) Reset() {
 	*x = SystemGetPropResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[21]
+		mi := &file_mgmt_system_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1418,7 +1715,7 @@ func (x *SystemGetPropResp) String() string {
 func (*SystemGetPropResp) ProtoMessage() {}
 
 func (x *SystemGetPropResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[21]
+	mi := &file_mgmt_system_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1728,7 @@ func (x *SystemGetPropResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetPropResp.ProtoReflect.Descriptor instead.
 func (*SystemGetPropResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{21}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SystemGetPropResp) GetProperties() map[string]string {
@@ -1441,34 +1738,37 @@ func (x *SystemGetPropResp) GetProperties() map[string]string {
 	return nil
 }
 
-type SystemCleanupResp_CleanupResult struct {
+// RASEventFilter describes the criteria used to select RAS events. Empty
+// fields match any value.
+type RASEventFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`              // Status of the evict on the specific pool
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                     // Error message if status indicates an error
-	PoolId string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // uuid of pool
-	Count  uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                // number of pool handles cleaned up
+	Ids        []uint32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`               // RAS event IDs to match
+	Types      []uint32 `protobuf:"varint,2,rep,packed,name=types,proto3" json:"types,omitempty"`           // RAS event types to match
+	Severities []uint32 `protobuf:"varint,3,rep,packed,name=severities,proto3" json:"severities,omitempty"` // RAS event severities to match
+	Ranks      string   `protobuf:"bytes,4,opt,name=ranks,proto3" json:"ranks,omitempty"`                   // Ranks (ranged string) to match
+	Hosts      string   `protobuf:"bytes,5,opt,name=hosts,proto3" json:"hosts,omitempty"`                   // Hosts (ranged string) to match
 }
 
-func (x *SystemCleanupResp_CleanupResult) Reset() {
-	*x = SystemCleanupResp_CleanupResult{}
+func (x *RASEventFilter) Reset() {
+	*x = RASEventFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[22]
+		mi := &file_mgmt_system_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemCleanupResp_CleanupResult) String() string {
+func (x *RASEventFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemCleanupResp_CleanupResult) ProtoMessage() {}
+func (*RASEventFilter) ProtoMessage() {}
 
-func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[22]
+func (x *RASEventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,291 +1779,1785 @@ func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemCleanupResp_CleanupResult.ProtoReflect.Descriptor instead.
-func (*SystemCleanupResp_CleanupResult) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use RASEventFilter.ProtoReflect.Descriptor instead.
+func (*RASEventFilter) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetStatus() int32 {
+func (x *RASEventFilter) GetIds() []uint32 {
 	if x != nil {
-		return x.Status
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetMsg() string {
+func (x *RASEventFilter) GetTypes() []uint32 {
 	if x != nil {
-		return x.Msg
+		return x.Types
+	}
+	return nil
+}
+
+func (x *RASEventFilter) GetSeverities() []uint32 {
+	if x != nil {
+		return x.Severities
+	}
+	return nil
+}
+
+func (x *RASEventFilter) GetRanks() string {
+	if x != nil {
+		return x.Ranks
 	}
 	return ""
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetPoolId() string {
+func (x *RASEventFilter) GetHosts() string {
 	if x != nil {
-		return x.PoolId
+		return x.Hosts
 	}
 	return ""
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetCount() uint32 {
+// SystemEventsFollowReq contains a request to stream RAS events as they are
+// published on the MS leader.
+type SystemEventsFollowReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys    string          `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Filter *RASEventFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *SystemEventsFollowReq) Reset() {
+	*x = SystemEventsFollowReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemEventsFollowReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEventsFollowReq) ProtoMessage() {}
+
+func (x *SystemEventsFollowReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEventsFollowReq.ProtoReflect.Descriptor instead.
+func (*SystemEventsFollowReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SystemEventsFollowReq) GetSys() string {
 	if x != nil {
-		return x.Count
+		return x.Sys
 	}
-	return 0
+	return ""
 }
 
-var File_mgmt_system_proto protoreflect.FileDescriptor
+func (x *SystemEventsFollowReq) GetFilter() *RASEventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
 
-var file_mgmt_system_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x1a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x02,
-	0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
-	0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x55, 0x72,
-	0x69, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x62, 0x72,
-	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f,
-	0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x46, 0x61, 0x62, 0x72,
-	0x69, 0x63, 0x55, 0x72, 0x69, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72,
-	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x72, 0x65, 0x70, 0x12, 0x12,
-	0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6b, 0x69,
-	0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
-	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72,
-	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65,
-	0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e,
-	0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62,
-	0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62,
-	0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x66,
-	0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f,
-	0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x41, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x0e, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x61, 0x6e, 0x6b, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x72,
-	0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x0f, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65,
-	0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
-	0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f,
-	0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
-	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x73, 0x6b, 0x22, 0xc4, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e,
-	0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74,
-	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73,
-	0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x0e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x3f, 0x0a,
-	0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3e,
-	0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xbe,
-	0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x68, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74,
-	0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52,
-	0x65, 0x71, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d,
-	0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a,
-	0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47,
-	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01,
-	0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73,
-	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// SystemEventsListReq contains a request to list RAS events retained in the
+// MS event history.
+type SystemEventsListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys    string          `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Filter *RASEventFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Since  int64           `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"` // Exclude events published before this Unix time (ns)
+	Until  int64           `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"` // Exclude events published after this Unix time (ns)
 }
 
-var (
-	file_mgmt_system_proto_rawDescOnce sync.Once
-	file_mgmt_system_proto_rawDescData = file_mgmt_system_proto_rawDesc
-)
+func (x *SystemEventsListReq) Reset() {
+	*x = SystemEventsListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_mgmt_system_proto_rawDescGZIP() []byte {
-	file_mgmt_system_proto_rawDescOnce.Do(func() {
-		file_mgmt_system_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_system_proto_rawDescData)
-	})
-	return file_mgmt_system_proto_rawDescData
+func (x *SystemEventsListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_mgmt_system_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_mgmt_system_proto_goTypes = []interface{}{
-	(*SystemMember)(nil),                    // 0: mgmt.SystemMember
-	(*SystemStopReq)(nil),                   // 1: mgmt.SystemStopReq
-	(*SystemStopResp)(nil),                  // 2: mgmt.SystemStopResp
-	(*SystemStartReq)(nil),                  // 3: mgmt.SystemStartReq
-	(*SystemStartResp)(nil),                 // 4: mgmt.SystemStartResp
-	(*SystemExcludeReq)(nil),                // 5: mgmt.SystemExcludeReq
-	(*SystemExcludeResp)(nil),               // 6: mgmt.SystemExcludeResp
-	(*PoolRankResult)(nil),                  // 7: mgmt.PoolRankResult
-	(*SystemDrainReq)(nil),                  // 8: mgmt.SystemDrainReq
-	(*SystemDrainResp)(nil),                 // 9: mgmt.SystemDrainResp
-	(*SystemQueryReq)(nil),                  // 10: mgmt.SystemQueryReq
-	(*SystemQueryResp)(nil),                 // 11: mgmt.SystemQueryResp
-	(*SystemEraseReq)(nil),                  // 12: mgmt.SystemEraseReq
-	(*SystemEraseResp)(nil),                 // 13: mgmt.SystemEraseResp
-	(*SystemCleanupReq)(nil),                // 14: mgmt.SystemCleanupReq
-	(*SystemCleanupResp)(nil),               // 15: mgmt.SystemCleanupResp
-	(*SystemSetAttrReq)(nil),                // 16: mgmt.SystemSetAttrReq
-	(*SystemGetAttrReq)(nil),                // 17: mgmt.SystemGetAttrReq
-	(*SystemGetAttrResp)(nil),               // 18: mgmt.SystemGetAttrResp
-	(*SystemSetPropReq)(nil),                // 19: mgmt.SystemSetPropReq
-	(*SystemGetPropReq)(nil),                // 20: mgmt.SystemGetPropReq
-	(*SystemGetPropResp)(nil),               // 21: mgmt.SystemGetPropResp
-	(*SystemCleanupResp_CleanupResult)(nil), // 22: mgmt.SystemCleanupResp.CleanupResult
-	nil,                                     // 23: mgmt.SystemSetAttrReq.AttributesEntry
-	nil,                                     // 24: mgmt.SystemGetAttrResp.AttributesEntry
-	nil,                                     // 25: mgmt.SystemSetPropReq.PropertiesEntry
-	nil,                                     // 26: mgmt.SystemGetPropResp.PropertiesEntry
-	(*shared.RankResult)(nil),               // 27: shared.RankResult
+func (*SystemEventsListReq) ProtoMessage() {}
+
+func (x *SystemEventsListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_mgmt_system_proto_depIdxs = []int32{
-	27, // 0: mgmt.SystemStopResp.results:type_name -> shared.RankResult
-	27, // 1: mgmt.SystemStartResp.results:type_name -> shared.RankResult
-	27, // 2: mgmt.SystemExcludeResp.results:type_name -> shared.RankResult
-	7,  // 3: mgmt.SystemDrainResp.results:type_name -> mgmt.PoolRankResult
-	0,  // 4: mgmt.SystemQueryResp.members:type_name -> mgmt.SystemMember
-	27, // 5: mgmt.SystemEraseResp.results:type_name -> shared.RankResult
-	22, // 6: mgmt.SystemCleanupResp.results:type_name -> mgmt.SystemCleanupResp.CleanupResult
-	23, // 7: mgmt.SystemSetAttrReq.attributes:type_name -> mgmt.SystemSetAttrReq.AttributesEntry
-	24, // 8: mgmt.SystemGetAttrResp.attributes:type_name -> mgmt.SystemGetAttrResp.AttributesEntry
-	25, // 9: mgmt.SystemSetPropReq.properties:type_name -> mgmt.SystemSetPropReq.PropertiesEntry
-	26, // 10: mgmt.SystemGetPropResp.properties:type_name -> mgmt.SystemGetPropResp.PropertiesEntry
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+
+// Deprecated: Use SystemEventsListReq.ProtoReflect.Descriptor instead.
+func (*SystemEventsListReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{27}
 }
 
-func init() { file_mgmt_system_proto_init() }
-func file_mgmt_system_proto_init() {
-	if File_mgmt_system_proto != nil {
-		return
+func (x *SystemEventsListReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemEventsListReq) GetFilter() *RASEventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SystemEventsListReq) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *SystemEventsListReq) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+// SystemEventsListResp contains the RAS events matching the request.
+type SystemEventsListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*shared.RASEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Events ordered oldest to newest
+}
+
+func (x *SystemEventsListResp) Reset() {
+	*x = SystemEventsListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemEventsListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEventsListResp) ProtoMessage() {}
+
+func (x *SystemEventsListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEventsListResp.ProtoReflect.Descriptor instead.
+func (*SystemEventsListResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SystemEventsListResp) GetEvents() []*shared.RASEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// SystemBackup contains details of a MS database backup.
+type SystemBackup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                // Path of the backup on the MS leader host
+	Created    int64  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`                         // Unix time (ns) the backup was created
+	Index      uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`                             // Raft log index of the backup
+	Term       uint64 `protobuf:"varint,5,opt,name=term,proto3" json:"term,omitempty"`                               // Raft term of the backup
+	MapVersion uint32 `protobuf:"varint,6,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"` // System map version of the backup
+	Size       int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`                               // Size of the backup data in bytes
+	Compressed bool   `protobuf:"varint,8,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	NumMembers uint32 `protobuf:"varint,9,opt,name=num_members,json=numMembers,proto3" json:"num_members,omitempty"`
+	NumPools   uint32 `protobuf:"varint,10,opt,name=num_pools,json=numPools,proto3" json:"num_pools,omitempty"`
+}
+
+func (x *SystemBackup) Reset() {
+	*x = SystemBackup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBackup) ProtoMessage() {}
+
+func (x *SystemBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBackup.ProtoReflect.Descriptor instead.
+func (*SystemBackup) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SystemBackup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemBackup) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SystemBackup) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *SystemBackup) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SystemBackup) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *SystemBackup) GetMapVersion() uint32 {
+	if x != nil {
+		return x.MapVersion
+	}
+	return 0
+}
+
+func (x *SystemBackup) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SystemBackup) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+func (x *SystemBackup) GetNumMembers() uint32 {
+	if x != nil {
+		return x.NumMembers
+	}
+	return 0
+}
+
+func (x *SystemBackup) GetNumPools() uint32 {
+	if x != nil {
+		return x.NumPools
+	}
+	return 0
+}
+
+// SystemBackupCreateReq contains a request to create a MS database backup.
+type SystemBackupCreateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+}
+
+func (x *SystemBackupCreateReq) Reset() {
+	*x = SystemBackupCreateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemBackupCreateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBackupCreateReq) ProtoMessage() {}
+
+func (x *SystemBackupCreateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBackupCreateReq.ProtoReflect.Descriptor instead.
+func (*SystemBackupCreateReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SystemBackupCreateReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemBackupCreateResp contains details of the created backup.
+type SystemBackupCreateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backup *SystemBackup `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (x *SystemBackupCreateResp) Reset() {
+	*x = SystemBackupCreateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemBackupCreateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBackupCreateResp) ProtoMessage() {}
+
+func (x *SystemBackupCreateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBackupCreateResp.ProtoReflect.Descriptor instead.
+func (*SystemBackupCreateResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SystemBackupCreateResp) GetBackup() *SystemBackup {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+// SystemBackupListReq contains a request to list MS database backups.
+type SystemBackupListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+}
+
+func (x *SystemBackupListReq) Reset() {
+	*x = SystemBackupListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemBackupListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBackupListReq) ProtoMessage() {}
+
+func (x *SystemBackupListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBackupListReq.ProtoReflect.Descriptor instead.
+func (*SystemBackupListReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SystemBackupListReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemBackupListResp contains details of the available MS database backups.
+type SystemBackupListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dir     string          `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`         // Backup directory on the MS leader host
+	Backups []*SystemBackup `protobuf:"bytes,2,rep,name=backups,proto3" json:"backups,omitempty"` // Backups ordered oldest to newest
+}
+
+func (x *SystemBackupListResp) Reset() {
+	*x = SystemBackupListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemBackupListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBackupListResp) ProtoMessage() {}
+
+func (x *SystemBackupListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBackupListResp.ProtoReflect.Descriptor instead.
+func (*SystemBackupListResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SystemBackupListResp) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *SystemBackupListResp) GetBackups() []*SystemBackup {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
+// SystemReplica contains details of a MS replica.
+type SystemReplica struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`      // Control plane address of the replica
+	State  string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`    // Raft membership state (voter, pending or removing)
+	Leader bool   `protobuf:"varint,3,opt,name=leader,proto3" json:"leader,omitempty"` // True if the replica is the current MS leader
+}
+
+func (x *SystemReplica) Reset() {
+	*x = SystemReplica{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplica) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplica) ProtoMessage() {}
+
+func (x *SystemReplica) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplica.ProtoReflect.Descriptor instead.
+func (*SystemReplica) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SystemReplica) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *SystemReplica) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SystemReplica) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+// SystemReplicasListReq contains a request to list the MS replicas.
+type SystemReplicasListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+}
+
+func (x *SystemReplicasListReq) Reset() {
+	*x = SystemReplicasListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplicasListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplicasListReq) ProtoMessage() {}
+
+func (x *SystemReplicasListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplicasListReq.ProtoReflect.Descriptor instead.
+func (*SystemReplicasListReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SystemReplicasListReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemReplicasListResp contains details of the MS replicas.
+type SystemReplicasListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replicas []*SystemReplica `protobuf:"bytes,1,rep,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *SystemReplicasListResp) Reset() {
+	*x = SystemReplicasListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplicasListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplicasListResp) ProtoMessage() {}
+
+func (x *SystemReplicasListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplicasListResp.ProtoReflect.Descriptor instead.
+func (*SystemReplicasListResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SystemReplicasListResp) GetReplicas() []*SystemReplica {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+// SystemReplicasUpdateReq contains a request to change the MS replica set.
+type SystemReplicasUpdateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys    string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Add    []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`       // Control plane addresses of replicas to add
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"` // Control plane addresses of replicas to remove
+	Force  bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`  // Allow an even number of replicas
+}
+
+func (x *SystemReplicasUpdateReq) Reset() {
+	*x = SystemReplicasUpdateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplicasUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplicasUpdateReq) ProtoMessage() {}
+
+func (x *SystemReplicasUpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplicasUpdateReq.ProtoReflect.Descriptor instead.
+func (*SystemReplicasUpdateReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SystemReplicasUpdateReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemReplicasUpdateReq) GetAdd() []string {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *SystemReplicasUpdateReq) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *SystemReplicasUpdateReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// SystemReplicasUpdateResp contains the result of a MS replica set change.
+type SystemReplicasUpdateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replicas     []string          `protobuf:"bytes,1,rep,name=replicas,proto3" json:"replicas,omitempty"`                                                                                                               // Updated MS replica set
+	RestartHosts []string          `protobuf:"bytes,2,rep,name=restart_hosts,json=restartHosts,proto3" json:"restart_hosts,omitempty"`                                                                                   // Hosts requiring a control plane restart
+	HostErrors   map[string]string `protobuf:"bytes,3,rep,name=host_errors,json=hostErrors,proto3" json:"host_errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Hosts that failed to apply the change
+}
+
+func (x *SystemReplicasUpdateResp) Reset() {
+	*x = SystemReplicasUpdateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplicasUpdateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplicasUpdateResp) ProtoMessage() {}
+
+func (x *SystemReplicasUpdateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplicasUpdateResp.ProtoReflect.Descriptor instead.
+func (*SystemReplicasUpdateResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SystemReplicasUpdateResp) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *SystemReplicasUpdateResp) GetRestartHosts() []string {
+	if x != nil {
+		return x.RestartHosts
+	}
+	return nil
+}
+
+func (x *SystemReplicasUpdateResp) GetHostErrors() map[string]string {
+	if x != nil {
+		return x.HostErrors
+	}
+	return nil
+}
+
+// SetMgmtSvcReplicasReq contains a request from the MS leader to update the
+// replica set on a control plane server.
+type SetMgmtSvcReplicasReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Replicas []string `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *SetMgmtSvcReplicasReq) Reset() {
+	*x = SetMgmtSvcReplicasReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMgmtSvcReplicasReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMgmtSvcReplicasReq) ProtoMessage() {}
+
+func (x *SetMgmtSvcReplicasReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMgmtSvcReplicasReq.ProtoReflect.Descriptor instead.
+func (*SetMgmtSvcReplicasReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetMgmtSvcReplicasReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SetMgmtSvcReplicasReq) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+// SetMgmtSvcReplicasResp indicates whether the server must be restarted in
+// order to start a local MS replica.
+type SetMgmtSvcReplicasResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RestartRequired bool `protobuf:"varint,1,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+}
+
+func (x *SetMgmtSvcReplicasResp) Reset() {
+	*x = SetMgmtSvcReplicasResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMgmtSvcReplicasResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMgmtSvcReplicasResp) ProtoMessage() {}
+
+func (x *SetMgmtSvcReplicasResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMgmtSvcReplicasResp.ProtoReflect.Descriptor instead.
+func (*SetMgmtSvcReplicasResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetMgmtSvcReplicasResp) GetRestartRequired() bool {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return false
+}
+
+// TenantQuota contains the storage quota for a tenant, identified by an owner
+// principal, along with the tenant's current usage. Zero limits are unlimited.
+type TenantQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principal     string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`                                 // Owner principal, e.g. "u:bob@" or "g:builders@"
+	MaxScmBytes   uint64 `protobuf:"varint,2,opt,name=max_scm_bytes,json=maxScmBytes,proto3" json:"max_scm_bytes,omitempty"`       // Maximum total SCM bytes across owned pools
+	MaxNvmeBytes  uint64 `protobuf:"varint,3,opt,name=max_nvme_bytes,json=maxNvmeBytes,proto3" json:"max_nvme_bytes,omitempty"`    // Maximum total NVMe bytes across owned pools
+	MaxPools      uint32 `protobuf:"varint,4,opt,name=max_pools,json=maxPools,proto3" json:"max_pools,omitempty"`                  // Maximum number of owned pools
+	UsedScmBytes  uint64 `protobuf:"varint,5,opt,name=used_scm_bytes,json=usedScmBytes,proto3" json:"used_scm_bytes,omitempty"`    // Total SCM bytes of owned pools
+	UsedNvmeBytes uint64 `protobuf:"varint,6,opt,name=used_nvme_bytes,json=usedNvmeBytes,proto3" json:"used_nvme_bytes,omitempty"` // Total NVMe bytes of owned pools
+	UsedPools     uint32 `protobuf:"varint,7,opt,name=used_pools,json=usedPools,proto3" json:"used_pools,omitempty"`               // Number of owned pools
+}
+
+func (x *TenantQuota) Reset() {
+	*x = TenantQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantQuota) ProtoMessage() {}
+
+func (x *TenantQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantQuota.ProtoReflect.Descriptor instead.
+func (*TenantQuota) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TenantQuota) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *TenantQuota) GetMaxScmBytes() uint64 {
+	if x != nil {
+		return x.MaxScmBytes
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxNvmeBytes() uint64 {
+	if x != nil {
+		return x.MaxNvmeBytes
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxPools() uint32 {
+	if x != nil {
+		return x.MaxPools
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetUsedScmBytes() uint64 {
+	if x != nil {
+		return x.UsedScmBytes
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetUsedNvmeBytes() uint64 {
+	if x != nil {
+		return x.UsedNvmeBytes
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetUsedPools() uint32 {
+	if x != nil {
+		return x.UsedPools
+	}
+	return 0
+}
+
+// SystemSetQuotaReq contains a request to set or remove a tenant quota.
+type SystemSetQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys          string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Principal    string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"` // Owner principal, e.g. "u:bob@" or "g:builders@"
+	MaxScmBytes  uint64 `protobuf:"varint,3,opt,name=max_scm_bytes,json=maxScmBytes,proto3" json:"max_scm_bytes,omitempty"`
+	MaxNvmeBytes uint64 `protobuf:"varint,4,opt,name=max_nvme_bytes,json=maxNvmeBytes,proto3" json:"max_nvme_bytes,omitempty"`
+	MaxPools     uint32 `protobuf:"varint,5,opt,name=max_pools,json=maxPools,proto3" json:"max_pools,omitempty"`
+	Remove       bool   `protobuf:"varint,6,opt,name=remove,proto3" json:"remove,omitempty"` // Remove the quota for the principal
+}
+
+func (x *SystemSetQuotaReq) Reset() {
+	*x = SystemSetQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSetQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSetQuotaReq) ProtoMessage() {}
+
+func (x *SystemSetQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSetQuotaReq.ProtoReflect.Descriptor instead.
+func (*SystemSetQuotaReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SystemSetQuotaReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemSetQuotaReq) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *SystemSetQuotaReq) GetMaxScmBytes() uint64 {
+	if x != nil {
+		return x.MaxScmBytes
+	}
+	return 0
+}
+
+func (x *SystemSetQuotaReq) GetMaxNvmeBytes() uint64 {
+	if x != nil {
+		return x.MaxNvmeBytes
+	}
+	return 0
+}
+
+func (x *SystemSetQuotaReq) GetMaxPools() uint32 {
+	if x != nil {
+		return x.MaxPools
+	}
+	return 0
+}
+
+func (x *SystemSetQuotaReq) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+// SystemGetQuotaReq contains a request to get tenant quotas. If no principals
+// are supplied, all quotas are returned.
+type SystemGetQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys        string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Principals []string `protobuf:"bytes,2,rep,name=principals,proto3" json:"principals,omitempty"`
+}
+
+func (x *SystemGetQuotaReq) Reset() {
+	*x = SystemGetQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetQuotaReq) ProtoMessage() {}
+
+func (x *SystemGetQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetQuotaReq.ProtoReflect.Descriptor instead.
+func (*SystemGetQuotaReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SystemGetQuotaReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemGetQuotaReq) GetPrincipals() []string {
+	if x != nil {
+		return x.Principals
+	}
+	return nil
+}
+
+// SystemGetQuotaResp contains a list of tenant quotas.
+type SystemGetQuotaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quotas []*TenantQuota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+}
+
+func (x *SystemGetQuotaResp) Reset() {
+	*x = SystemGetQuotaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetQuotaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetQuotaResp) ProtoMessage() {}
+
+func (x *SystemGetQuotaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetQuotaResp.ProtoReflect.Descriptor instead.
+func (*SystemGetQuotaResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SystemGetQuotaResp) GetQuotas() []*TenantQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+type SystemCleanupResp_CleanupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`              // Status of the evict on the specific pool
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                     // Error message if status indicates an error
+	PoolId string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // uuid of pool
+	Count  uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                // number of pool handles cleaned up
+}
+
+func (x *SystemCleanupResp_CleanupResult) Reset() {
+	*x = SystemCleanupResp_CleanupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemCleanupResp_CleanupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCleanupResp_CleanupResult) ProtoMessage() {}
+
+func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCleanupResp_CleanupResult.ProtoReflect.Descriptor instead.
+func (*SystemCleanupResp_CleanupResult) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_mgmt_system_proto protoreflect.FileDescriptor
+
+var file_mgmt_system_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x1a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xf8, 0x02, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x62, 0x72,
+	0x69, 0x63, 0x55, 0x72, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79,
+	0x46, 0x61, 0x62, 0x72, 0x69, 0x63, 0x55, 0x72, 0x69, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa4, 0x01, 0x0a,
+	0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x70, 0x72, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
+	0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e,
+	0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73,
+	0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x52, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x22, 0x6d, 0x0a,
+	0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x83, 0x01, 0x0a,
+	0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x22, 0x7f, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x22, 0x76, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x0b,
+	0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x22, 0x75, 0x0a, 0x14, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x65, 0x78,
+	0x69, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x0a, 0x50, 0x6f,
+	0x6f, 0x6c, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x5f,
+	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64,
+	0x61, 0x6e, 0x63, 0x79, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x76, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x66, 0x5f, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x66, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x76, 0x63, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x6f, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x76, 0x63, 0x4d, 0x61, 0x6a, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x69, 0x0a, 0x0e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x7d, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x69, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x33,
+	0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0xc4, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e,
+	0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73,
+	0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x0e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x3f, 0x0a,
+	0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3e,
+	0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xbe,
+	0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x68, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52,
+	0x65, 0x71, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a,
+	0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01,
+	0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x01, 0x0a, 0x0e,
+	0x52, 0x41, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x22, 0x57, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x2c, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x52, 0x41, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x81, 0x01, 0x0a, 0x13,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x52, 0x41, 0x53,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22,
+	0x40, 0x0a, 0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x52, 0x41, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x8d, 0x02, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x6f, 0x6c,
+	0x73, 0x22, 0x29, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x44, 0x0a, 0x16,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x22, 0x27, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x73, 0x22, 0x51, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79,
+	0x73, 0x22, 0x49, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x6b, 0x0a, 0x17,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x64, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x18, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x68, 0x6f,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x45, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4d, 0x67,
+	0x6d, 0x74, 0x53, 0x76, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x43,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x4d, 0x67, 0x6d, 0x74, 0x53, 0x76, 0x63, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x22, 0xff, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6d, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6d,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x76, 0x6d,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x4e, 0x76, 0x6d, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x73, 0x63, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x63, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x76, 0x6d, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x4e, 0x76, 0x6d,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x63, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x76, 0x6d, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4e, 0x76, 0x6d, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x73, 0x22, 0x3f, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x73, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73,
+	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mgmt_system_proto_rawDescOnce sync.Once
+	file_mgmt_system_proto_rawDescData = file_mgmt_system_proto_rawDesc
+)
+
+func file_mgmt_system_proto_rawDescGZIP() []byte {
+	file_mgmt_system_proto_rawDescOnce.Do(func() {
+		file_mgmt_system_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_system_proto_rawDescData)
+	})
+	return file_mgmt_system_proto_rawDescData
+}
+
+var file_mgmt_system_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_mgmt_system_proto_goTypes = []interface{}{
+	(*SystemMember)(nil),                    // 0: mgmt.SystemMember
+	(*SystemStopReq)(nil),                   // 1: mgmt.SystemStopReq
+	(*SystemStopResp)(nil),                  // 2: mgmt.SystemStopResp
+	(*SystemStartReq)(nil),                  // 3: mgmt.SystemStartReq
+	(*SystemStartResp)(nil),                 // 4: mgmt.SystemStartResp
+	(*SystemExcludeReq)(nil),                // 5: mgmt.SystemExcludeReq
+	(*SystemExcludeResp)(nil),               // 6: mgmt.SystemExcludeResp
+	(*SystemMaintenanceReq)(nil),            // 7: mgmt.SystemMaintenanceReq
+	(*SystemMaintenanceResp)(nil),           // 8: mgmt.SystemMaintenanceResp
+	(*PoolImpact)(nil),                      // 9: mgmt.PoolImpact
+	(*PoolRankResult)(nil),                  // 10: mgmt.PoolRankResult
+	(*SystemDrainReq)(nil),                  // 11: mgmt.SystemDrainReq
+	(*SystemDrainResp)(nil),                 // 12: mgmt.SystemDrainResp
+	(*SystemQueryReq)(nil),                  // 13: mgmt.SystemQueryReq
+	(*SystemQueryResp)(nil),                 // 14: mgmt.SystemQueryResp
+	(*SystemEraseReq)(nil),                  // 15: mgmt.SystemEraseReq
+	(*SystemEraseResp)(nil),                 // 16: mgmt.SystemEraseResp
+	(*SystemCleanupReq)(nil),                // 17: mgmt.SystemCleanupReq
+	(*SystemCleanupResp)(nil),               // 18: mgmt.SystemCleanupResp
+	(*SystemSetAttrReq)(nil),                // 19: mgmt.SystemSetAttrReq
+	(*SystemGetAttrReq)(nil),                // 20: mgmt.SystemGetAttrReq
+	(*SystemGetAttrResp)(nil),               // 21: mgmt.SystemGetAttrResp
+	(*SystemSetPropReq)(nil),                // 22: mgmt.SystemSetPropReq
+	(*SystemGetPropReq)(nil),                // 23: mgmt.SystemGetPropReq
+	(*SystemGetPropResp)(nil),               // 24: mgmt.SystemGetPropResp
+	(*RASEventFilter)(nil),                  // 25: mgmt.RASEventFilter
+	(*SystemEventsFollowReq)(nil),           // 26: mgmt.SystemEventsFollowReq
+	(*SystemEventsListReq)(nil),             // 27: mgmt.SystemEventsListReq
+	(*SystemEventsListResp)(nil),            // 28: mgmt.SystemEventsListResp
+	(*SystemBackup)(nil),                    // 29: mgmt.SystemBackup
+	(*SystemBackupCreateReq)(nil),           // 30: mgmt.SystemBackupCreateReq
+	(*SystemBackupCreateResp)(nil),          // 31: mgmt.SystemBackupCreateResp
+	(*SystemBackupListReq)(nil),             // 32: mgmt.SystemBackupListReq
+	(*SystemBackupListResp)(nil),            // 33: mgmt.SystemBackupListResp
+	(*SystemReplica)(nil),                   // 34: mgmt.SystemReplica
+	(*SystemReplicasListReq)(nil),           // 35: mgmt.SystemReplicasListReq
+	(*SystemReplicasListResp)(nil),          // 36: mgmt.SystemReplicasListResp
+	(*SystemReplicasUpdateReq)(nil),         // 37: mgmt.SystemReplicasUpdateReq
+	(*SystemReplicasUpdateResp)(nil),        // 38: mgmt.SystemReplicasUpdateResp
+	(*SetMgmtSvcReplicasReq)(nil),           // 39: mgmt.SetMgmtSvcReplicasReq
+	(*SetMgmtSvcReplicasResp)(nil),          // 40: mgmt.SetMgmtSvcReplicasResp
+	(*TenantQuota)(nil),                     // 41: mgmt.TenantQuota
+	(*SystemSetQuotaReq)(nil),               // 42: mgmt.SystemSetQuotaReq
+	(*SystemGetQuotaReq)(nil),               // 43: mgmt.SystemGetQuotaReq
+	(*SystemGetQuotaResp)(nil),              // 44: mgmt.SystemGetQuotaResp
+	(*SystemCleanupResp_CleanupResult)(nil), // 45: mgmt.SystemCleanupResp.CleanupResult
+	nil,                                     // 46: mgmt.SystemSetAttrReq.AttributesEntry
+	nil,                                     // 47: mgmt.SystemGetAttrResp.AttributesEntry
+	nil,                                     // 48: mgmt.SystemSetPropReq.PropertiesEntry
+	nil,                                     // 49: mgmt.SystemGetPropResp.PropertiesEntry
+	nil,                                     // 50: mgmt.SystemReplicasUpdateResp.HostErrorsEntry
+	(*shared.RankResult)(nil),               // 51: shared.RankResult
+	(*shared.RASEvent)(nil),                 // 52: shared.RASEvent
+}
+var file_mgmt_system_proto_depIdxs = []int32{
+	51, // 0: mgmt.SystemStopResp.results:type_name -> shared.RankResult
+	9,  // 1: mgmt.SystemStopResp.pool_impacts:type_name -> mgmt.PoolImpact
+	51, // 2: mgmt.SystemStartResp.results:type_name -> shared.RankResult
+	51, // 3: mgmt.SystemExcludeResp.results:type_name -> shared.RankResult
+	9,  // 4: mgmt.SystemExcludeResp.pool_impacts:type_name -> mgmt.PoolImpact
+	51, // 5: mgmt.SystemMaintenanceResp.results:type_name -> shared.RankResult
+	10, // 6: mgmt.SystemDrainResp.results:type_name -> mgmt.PoolRankResult
+	9,  // 7: mgmt.SystemDrainResp.pool_impacts:type_name -> mgmt.PoolImpact
+	0,  // 8: mgmt.SystemQueryResp.members:type_name -> mgmt.SystemMember
+	51, // 9: mgmt.SystemEraseResp.results:type_name -> shared.RankResult
+	45, // 10: mgmt.SystemCleanupResp.results:type_name -> mgmt.SystemCleanupResp.CleanupResult
+	46, // 11: mgmt.SystemSetAttrReq.attributes:type_name -> mgmt.SystemSetAttrReq.AttributesEntry
+	47, // 12: mgmt.SystemGetAttrResp.attributes:type_name -> mgmt.SystemGetAttrResp.AttributesEntry
+	48, // 13: mgmt.SystemSetPropReq.properties:type_name -> mgmt.SystemSetPropReq.PropertiesEntry
+	49, // 14: mgmt.SystemGetPropResp.properties:type_name -> mgmt.SystemGetPropResp.PropertiesEntry
+	25, // 15: mgmt.SystemEventsFollowReq.filter:type_name -> mgmt.RASEventFilter
+	25, // 16: mgmt.SystemEventsListReq.filter:type_name -> mgmt.RASEventFilter
+	52, // 17: mgmt.SystemEventsListResp.events:type_name -> shared.RASEvent
+	29, // 18: mgmt.SystemBackupCreateResp.backup:type_name -> mgmt.SystemBackup
+	29, // 19: mgmt.SystemBackupListResp.backups:type_name -> mgmt.SystemBackup
+	34, // 20: mgmt.SystemReplicasListResp.replicas:type_name -> mgmt.SystemReplica
+	50, // 21: mgmt.SystemReplicasUpdateResp.host_errors:type_name -> mgmt.SystemReplicasUpdateResp.HostErrorsEntry
+	41, // 22: mgmt.SystemGetQuotaResp.quotas:type_name -> mgmt.TenantQuota
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_mgmt_system_proto_init() }
+func file_mgmt_system_proto_init() {
+	if File_mgmt_system_proto != nil {
+		return
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_mgmt_system_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -1778,8 +3572,44 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStopReq); i {
+		file_mgmt_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStartResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1790,8 +3620,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStopResp); i {
+		file_mgmt_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemExcludeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1802,8 +3632,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStartReq); i {
+		file_mgmt_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemExcludeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1814,8 +3644,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStartResp); i {
+		file_mgmt_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemMaintenanceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1826,8 +3656,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemExcludeReq); i {
+		file_mgmt_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemMaintenanceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1838,8 +3668,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemExcludeResp); i {
+		file_mgmt_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolImpact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1850,7 +3680,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PoolRankResult); i {
 			case 0:
 				return &v.state
@@ -1862,7 +3692,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemDrainReq); i {
 			case 0:
 				return &v.state
@@ -1874,7 +3704,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemDrainResp); i {
 			case 0:
 				return &v.state
@@ -1886,7 +3716,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemQueryReq); i {
 			case 0:
 				return &v.state
@@ -1898,7 +3728,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemQueryResp); i {
 			case 0:
 				return &v.state
@@ -1910,7 +3740,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemEraseReq); i {
 			case 0:
 				return &v.state
@@ -1922,7 +3752,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemEraseResp); i {
 			case 0:
 				return &v.state
@@ -1934,7 +3764,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemCleanupReq); i {
 			case 0:
 				return &v.state
@@ -1946,7 +3776,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemCleanupResp); i {
 			case 0:
 				return &v.state
@@ -1958,7 +3788,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemSetAttrReq); i {
 			case 0:
 				return &v.state
@@ -1970,7 +3800,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetAttrReq); i {
 			case 0:
 				return &v.state
@@ -1982,7 +3812,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetAttrResp); i {
 			case 0:
 				return &v.state
@@ -1994,7 +3824,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemSetPropReq); i {
 			case 0:
 				return &v.state
@@ -2006,7 +3836,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetPropReq); i {
 			case 0:
 				return &v.state
@@ -2018,7 +3848,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetPropResp); i {
 			case 0:
 				return &v.state
@@ -2030,7 +3860,247 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RASEventFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemEventsFollowReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemEventsListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemEventsListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemBackup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemBackupCreateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemBackupCreateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemBackupListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemBackupListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplica); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplicasListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplicasListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplicasUpdateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplicasUpdateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMgmtSvcReplicasReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMgmtSvcReplicasResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetQuotaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemCleanupResp_CleanupResult); i {
 			case 0:
 				return &v.state
@@ -2049,7 +4119,7 @@ func file_mgmt_system_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_system_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -135,6 +135,8 @@ func (m MgmtMethod) String() string {
 		MethodPoolExclude:          "PoolExclude",
 		MethodPoolDrain:            "PoolDrain",
 		MethodPoolExtend:           "PoolExtend",
+		MethodPoolResize:           "PoolResize",
+		MethodPoolUndelete:         "PoolUndelete",
 		MethodPoolReintegrate:      "PoolReintegrate",
 		MethodBioHealth:            "BioHealth",
 		MethodSetUp:                "SetUp",
@@ -202,6 +204,10 @@ const (
 	MethodPoolReintegrate MgmtMethod = C.DRPC_METHOD_MGMT_POOL_REINT
 	// MethodPoolExtend is a ModuleMgmt method for extending pool
 	MethodPoolExtend MgmtMethod = C.DRPC_METHOD_MGMT_POOL_EXTEND
+	// MethodPoolResize is a ModuleMgmt method for resizing pool storage
+	MethodPoolResize MgmtMethod = C.DRPC_METHOD_MGMT_POOL_RESIZE
+	// MethodPoolUndelete is a ModuleMgmt method for reopening a pool pending destroy
+	MethodPoolUndelete MgmtMethod = C.DRPC_METHOD_MGMT_POOL_UNDELETE
 	// MethodBioHealth is a ModuleMgmt method
 	MethodBioHealth MgmtMethod = C.DRPC_METHOD_MGMT_BIO_HEALTH_QUERY
 	// MethodSetUp is a ModuleMgmt method
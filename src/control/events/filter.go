@@ -0,0 +1,164 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+const unknownRASIDStr = "unknown_unknown"
+
+// RASIDFromString returns the RASID matching the supplied event name
+// (e.g. "engine_died") or numeric identifier.
+func RASIDFromString(in string) (RASID, error) {
+	in = strings.TrimSpace(in)
+	if n, err := strconv.ParseUint(in, 10, 32); err == nil {
+		id := RASID(n)
+		if id.String() == unknownRASIDStr {
+			return RASUnknownEvent, errors.Errorf("unknown RAS event ID %d", n)
+		}
+		return id, nil
+	}
+
+	for _, id := range allRASIDs() {
+		if strings.EqualFold(id.String(), in) {
+			return id, nil
+		}
+	}
+
+	return RASUnknownEvent, errors.Errorf("unknown RAS event %q", in)
+}
+
+// allRASIDs returns all known RAS event IDs.
+func allRASIDs() []RASID {
+	var ids []RASID
+	for id := RASUnknownEvent + 1; id.String() != unknownRASIDStr; id++ {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// RASTypeFromString returns the RASTypeID matching the supplied type name
+// (e.g. "STATE_CHANGE").
+func RASTypeFromString(in string) (RASTypeID, error) {
+	for _, typ := range []RASTypeID{RASTypeStateChange, RASTypeInfoOnly} {
+		if strings.EqualFold(typ.String(), strings.TrimSpace(in)) {
+			return typ, nil
+		}
+	}
+
+	return RASTypeAny, errors.Errorf("unknown RAS event type %q", in)
+}
+
+// RASSeverityFromString returns the RASSeverityID matching the supplied
+// severity name (e.g. "ERROR").
+func RASSeverityFromString(in string) (RASSeverityID, error) {
+	for _, sev := range []RASSeverityID{RASSeverityError, RASSeverityWarning, RASSeverityNotice} {
+		if strings.EqualFold(sev.String(), strings.TrimSpace(in)) {
+			return sev, nil
+		}
+	}
+
+	return RASSeverityUnknown, errors.Errorf("unknown RAS event severity %q", in)
+}
+
+// Filter describes criteria used to select RAS events. Empty fields match
+// any value.
+type Filter struct {
+	IDs        []RASID
+	Types      []RASTypeID
+	Severities []RASSeverityID
+	Ranks      *ranklist.RankSet
+	Hosts      *hostlist.HostSet
+}
+
+func (f *Filter) matchesRank(rank uint32) bool {
+	if f.Ranks == nil || f.Ranks.Count() == 0 {
+		return true
+	}
+	for _, r := range f.Ranks.Ranks() {
+		if r.Equals(ranklist.Rank(rank)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *Filter) matchesHost(host string) bool {
+	if f.Hosts == nil || f.Hosts.Count() == 0 {
+		return true
+	}
+	if host == "" {
+		return false
+	}
+
+	// Allow events from fully-qualified hostnames to match short names.
+	for _, h := range []string{host, strings.Split(host, ".")[0]} {
+		if ok, err := f.Hosts.Within(h); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches returns true if the supplied event satisfies all filter criteria.
+func (f *Filter) Matches(evt *RASEvent) bool {
+	if evt == nil {
+		return false
+	}
+	if f == nil {
+		return true
+	}
+
+	if len(f.IDs) > 0 && !containsID(f.IDs, evt.ID) {
+		return false
+	}
+	if len(f.Types) > 0 && !containsType(f.Types, evt.Type) {
+		return false
+	}
+	if len(f.Severities) > 0 && !containsSeverity(f.Severities, evt.Severity) {
+		return false
+	}
+
+	return f.matchesRank(evt.Rank) && f.matchesHost(evt.Hostname)
+}
+
+func containsID(ids []RASID, id RASID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsType(types []RASTypeID, typ RASTypeID) bool {
+	for _, t := range types {
+		if t == RASTypeAny || t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSeverity(sevs []RASSeverityID, sev RASSeverityID) bool {
+	for _, s := range sevs {
+		if s == sev {
+			return true
+		}
+	}
+	return false
+}
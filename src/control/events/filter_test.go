@@ -0,0 +1,167 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestEvents_RASIDFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expID  RASID
+		expErr error
+	}{
+		"name": {
+			in:    "engine_died",
+			expID: RASEngineDied,
+		},
+		"mixed case name": {
+			in:    "Swim_Rank_Dead",
+			expID: RASSwimRankDead,
+		},
+		"numeric": {
+			in:    "2",
+			expID: RASEngineDied,
+		},
+		"unknown name": {
+			in:     "foo_bar",
+			expErr: errors.New("unknown RAS event"),
+		},
+		"unknown numeric": {
+			in:     "9999",
+			expErr: errors.New("unknown RAS event ID"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotID, gotErr := RASIDFromString(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expID, gotID, "unexpected RAS ID")
+		})
+	}
+}
+
+func TestEvents_RASSeverityAndTypeFromString(t *testing.T) {
+	sev, err := RASSeverityFromString("warning")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, RASSeverityWarning, sev, "unexpected severity")
+
+	if _, err := RASSeverityFromString("fatal"); err == nil {
+		t.Fatal("expected error for unknown severity")
+	}
+
+	typ, err := RASTypeFromString("state_change")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, RASTypeStateChange, typ, "unexpected type")
+
+	if _, err := RASTypeFromString("any"); err == nil {
+		t.Fatal("expected error for unknown type")
+	}
+}
+
+func TestEvents_Filter_Matches(t *testing.T) {
+	evtDied := mockEvtDied(t) // rank 1 on host foo, error severity
+	evtFQDN := mockEvtDied(t)
+	evtFQDN.Hostname = "foo.example.com"
+
+	for name, tc := range map[string]struct {
+		filter   *Filter
+		evt      *RASEvent
+		expMatch bool
+	}{
+		"nil filter": {
+			evt:      evtDied,
+			expMatch: true,
+		},
+		"nil event": {
+			filter: &Filter{},
+		},
+		"empty filter": {
+			filter:   &Filter{},
+			evt:      evtDied,
+			expMatch: true,
+		},
+		"id match": {
+			filter:   &Filter{IDs: []RASID{RASSwimRankDead, RASEngineDied}},
+			evt:      evtDied,
+			expMatch: true,
+		},
+		"id mismatch": {
+			filter: &Filter{IDs: []RASID{RASSwimRankDead}},
+			evt:    evtDied,
+		},
+		"type any": {
+			filter:   &Filter{Types: []RASTypeID{RASTypeAny}},
+			evt:      evtDied,
+			expMatch: true,
+		},
+		"type mismatch": {
+			filter: &Filter{Types: []RASTypeID{RASTypeInfoOnly}},
+			evt:    evtDied,
+		},
+		"severity match": {
+			filter:   &Filter{Severities: []RASSeverityID{RASSeverityError}},
+			evt:      evtDied,
+			expMatch: true,
+		},
+		"severity mismatch": {
+			filter: &Filter{Severities: []RASSeverityID{RASSeverityNotice}},
+			evt:    evtDied,
+		},
+		"rank match": {
+			filter:   &Filter{Ranks: ranklist.MustCreateRankSet("0-2")},
+			evt:      evtDied,
+			expMatch: true,
+		},
+		"rank mismatch": {
+			filter: &Filter{Ranks: ranklist.MustCreateRankSet("2-3")},
+			evt:    evtDied,
+		},
+		"host match": {
+			filter:   &Filter{Hosts: hostlist.MustCreateSet("foo")},
+			evt:      evtDied,
+			expMatch: true,
+		},
+		"host match short name": {
+			filter:   &Filter{Hosts: hostlist.MustCreateSet("foo")},
+			evt:      evtFQDN,
+			expMatch: true,
+		},
+		"host mismatch": {
+			filter: &Filter{Hosts: hostlist.MustCreateSet("bar[1-3]")},
+			evt:    evtDied,
+		},
+		"all criteria match": {
+			filter: &Filter{
+				IDs:        []RASID{RASEngineDied},
+				Types:      []RASTypeID{RASTypeStateChange},
+				Severities: []RASSeverityID{RASSeverityError},
+				Ranks:      ranklist.MustCreateRankSet("1"),
+				Hosts:      hostlist.MustCreateSet("foo"),
+			},
+			evt:      evtDied,
+			expMatch: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expMatch, tc.filter.Matches(tc.evt), "unexpected match result")
+		})
+	}
+}
@@ -0,0 +1,38 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewNVMeFaultPolicyEvent creates an event indicating that the health of an
+// NVMe device has breached the thresholds of the NVMe fault policy. If
+// setFaulty is true, the device has been set faulty as a result, otherwise
+// the breach is only being reported. The reasons the policy was triggered are
+// included in the extended info.
+func NewNVMeFaultPolicyEvent(hostname string, rank uint32, devUUID, pciAddr string, setFaulty bool, reasons []string) *RASEvent {
+	msg := fmt.Sprintf("NVMe device %s at %q breached fault policy thresholds", devUUID, pciAddr)
+	typ := RASTypeInfoOnly
+	sev := RASSeverityWarning
+	if setFaulty {
+		msg = fmt.Sprintf("NVMe device %s at %q set faulty by fault policy", devUUID, pciAddr)
+		typ = RASTypeStateChange
+		sev = RASSeverityError
+	}
+
+	return fill(&RASEvent{
+		Msg:          msg,
+		ID:           RASNVMeFaultPolicy,
+		Hostname:     hostname,
+		Rank:         rank,
+		Type:         typ,
+		Severity:     sev,
+		ExtendedInfo: NewStrInfo(strings.Join(reasons, "; ")),
+	})
+}
@@ -0,0 +1,60 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestEvents_NewNVMeFaultPolicyEvent(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setFaulty bool
+		expMsg    string
+		expType   RASTypeID
+		expSev    RASSeverityID
+	}{
+		"warn only": {
+			expMsg:  `NVMe device 00000001-0001-0001-0001-000000000001 at "0000:01:00.0" breached fault policy thresholds`,
+			expType: RASTypeInfoOnly,
+			expSev:  RASSeverityWarning,
+		},
+		"set faulty": {
+			setFaulty: true,
+			expMsg:    `NVMe device 00000001-0001-0001-0001-000000000001 at "0000:01:00.0" set faulty by fault policy`,
+			expType:   RASTypeStateChange,
+			expSev:    RASSeverityError,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			event := NewNVMeFaultPolicyEvent(tHost, tRank, test.MockUUID(1), test.MockPCIAddr(1),
+				tc.setFaulty, []string{"media errors 10 >= 5", "critical warning: read only"})
+
+			test.AssertEqual(t, RASNVMeFaultPolicy, event.ID, "unexpected event ID")
+			test.AssertEqual(t, tc.expMsg, event.Msg, "unexpected message")
+			test.AssertEqual(t, tc.expType, event.Type, "unexpected type")
+			test.AssertEqual(t, tc.expSev, event.Severity, "unexpected severity")
+			test.AssertEqual(t, "media errors 10 >= 5; critical warning: read only",
+				string(*event.GetStrInfo()), "unexpected extended info")
+
+			pbEvent, err := event.ToProto()
+			if err != nil {
+				t.Fatal(err)
+			}
+			returnedEvent := new(RASEvent)
+			if err := returnedEvent.FromProto(pbEvent); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+				t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	defaultSinkBatchSize     = 1
+	defaultSinkBatchInterval = 5 * time.Second
+	defaultSinkMaxRetries    = 3
+	defaultSinkRetryInterval = 1 * time.Second
+	defaultSinkTimeout       = 10 * time.Second
+	sinkQueueSize            = 1024
+)
+
+// SinkConfig defines the configuration for an external RAS event sink that
+// delivers selected events either to an HTTP webhook or to a local program.
+type SinkConfig struct {
+	Name          string            `yaml:"name"`
+	Webhook       string            `yaml:"webhook,omitempty"`
+	Headers       map[string]string `yaml:"headers,omitempty"`
+	Exec          string            `yaml:"exec,omitempty"`
+	IDs           []string          `yaml:"ids,omitempty"`
+	Types         []string          `yaml:"types,omitempty"`
+	Severities    []string          `yaml:"severities,omitempty"`
+	BatchSize     int               `yaml:"batch_size,omitempty"`
+	BatchInterval time.Duration     `yaml:"batch_interval,omitempty"`
+	MaxRetries    *int              `yaml:"max_retries,omitempty"` // nil selects the default; 0 disables retries
+	RetryInterval time.Duration     `yaml:"retry_interval,omitempty"`
+	Timeout       time.Duration     `yaml:"timeout,omitempty"`
+	Debounce      time.Duration     `yaml:"debounce,omitempty"`
+}
+
+// Validate asserts that the sink configuration is usable.
+func (cfg *SinkConfig) Validate() error {
+	if cfg == nil {
+		return errors.New("nil event sink config")
+	}
+	if cfg.Name == "" {
+		return errors.New("event sink name must be set")
+	}
+
+	switch {
+	case cfg.Webhook == "" && cfg.Exec == "":
+		return errors.Errorf("event sink %q: one of webhook or exec must be set", cfg.Name)
+	case cfg.Webhook != "" && cfg.Exec != "":
+		return errors.Errorf("event sink %q: webhook and exec cannot both be set", cfg.Name)
+	case cfg.Webhook != "":
+		u, err := url.Parse(cfg.Webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("event sink %q: invalid webhook URL %q", cfg.Name, cfg.Webhook)
+		}
+	case !filepath.IsAbs(cfg.Exec):
+		return errors.Errorf("event sink %q: exec path %q must be absolute", cfg.Name, cfg.Exec)
+	}
+	if len(cfg.Headers) > 0 && cfg.Webhook == "" {
+		return errors.Errorf("event sink %q: headers are only valid with webhook", cfg.Name)
+	}
+
+	switch {
+	case cfg.BatchSize < 0:
+		return errors.Errorf("event sink %q: batch_size must not be negative", cfg.Name)
+	case cfg.MaxRetries != nil && *cfg.MaxRetries < 0:
+		return errors.Errorf("event sink %q: max_retries must not be negative", cfg.Name)
+	case cfg.BatchInterval < 0, cfg.RetryInterval < 0, cfg.Timeout < 0, cfg.Debounce < 0:
+		return errors.Errorf("event sink %q: durations must not be negative", cfg.Name)
+	}
+
+	if _, err := cfg.filter(); err != nil {
+		return errors.Wrapf(err, "event sink %q", cfg.Name)
+	}
+
+	return nil
+}
+
+// filter returns an event filter built from the configured event selectors.
+func (cfg *SinkConfig) filter() (*Filter, error) {
+	filter := new(Filter)
+	for _, str := range cfg.IDs {
+		id, err := RASIDFromString(str)
+		if err != nil {
+			return nil, err
+		}
+		filter.IDs = append(filter.IDs, id)
+	}
+	for _, str := range cfg.Types {
+		typ, err := RASTypeFromString(str)
+		if err != nil {
+			return nil, err
+		}
+		filter.Types = append(filter.Types, typ)
+	}
+	for _, str := range cfg.Severities {
+		sev, err := RASSeverityFromString(str)
+		if err != nil {
+			return nil, err
+		}
+		filter.Severities = append(filter.Severities, sev)
+	}
+
+	return filter, nil
+}
+
+// withDefaults returns a copy of the configuration with defaults applied to
+// any unset values.
+func (cfg *SinkConfig) withDefaults() *SinkConfig {
+	out := *cfg
+	if out.BatchSize == 0 {
+		out.BatchSize = defaultSinkBatchSize
+	}
+	if out.BatchInterval == 0 {
+		out.BatchInterval = defaultSinkBatchInterval
+	}
+	if out.MaxRetries == nil {
+		maxRetries := defaultSinkMaxRetries
+		out.MaxRetries = &maxRetries
+	}
+	if out.RetryInterval == 0 {
+		out.RetryInterval = defaultSinkRetryInterval
+	}
+	if out.Timeout == 0 {
+		out.Timeout = defaultSinkTimeout
+	}
+
+	return &out
+}
+
+type sinkDeliverFn func(context.Context, []byte) error
+
+// Sink implements the Handler interface and delivers events that match its
+// configured selectors to an external webhook or program. Events are queued
+// and delivered asynchronously in batches, with failed deliveries retried.
+type Sink struct {
+	log     logging.Logger
+	cfg     *SinkConfig
+	filter  *Filter
+	dbnc    *PubSub
+	queue   chan *RASEvent
+	deliver sinkDeliverFn
+}
+
+// NewSink creates and starts a new event sink using the supplied configuration.
+// The sink stops processing events when the supplied context is canceled.
+func NewSink(ctx context.Context, log logging.Logger, cfg *SinkConfig) (*Sink, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg = cfg.withDefaults()
+
+	filter, err := cfg.filter()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Sink{
+		log:    log,
+		cfg:    cfg,
+		filter: filter,
+		queue:  make(chan *RASEvent, sinkQueueSize),
+	}
+	if cfg.Webhook != "" {
+		s.deliver = s.postWebhook
+	} else {
+		s.deliver = s.runExec
+	}
+
+	// A private PubSub provides debounce of duplicate events without
+	// affecting delivery to any other handlers.
+	s.dbnc = NewPubSub(ctx, log)
+	s.dbnc.Subscribe(RASTypeAny, HandlerFunc(s.enqueue))
+	if cfg.Debounce > 0 {
+		ids := filter.IDs
+		if len(ids) == 0 {
+			ids = allRASIDs()
+		}
+		for _, id := range ids {
+			s.dbnc.Debounce(id, cfg.Debounce, sinkDebounceKey)
+		}
+	}
+
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// sinkDebounceKey treats events of the same ID as duplicates if they were
+// raised by the same rank on the same host against the same resource.
+func sinkDebounceKey(evt *RASEvent) string {
+	return fmt.Sprintf("%s:%d:%s:%s:%s", evt.Hostname, evt.Rank, evt.PoolUUID, evt.ContUUID, evt.HWID)
+}
+
+// Name returns the configured name of the sink.
+func (s *Sink) Name() string {
+	return s.cfg.Name
+}
+
+// OnEvent implements the Handler interface.
+func (s *Sink) OnEvent(_ context.Context, evt *RASEvent) {
+	if !s.filter.Matches(evt) {
+		return
+	}
+
+	s.dbnc.Publish(evt)
+}
+
+func (s *Sink) enqueue(_ context.Context, evt *RASEvent) {
+	select {
+	case s.queue <- evt:
+	default:
+		s.log.Noticef("event sink %q queue is full; dropping %s event", s.cfg.Name, evt.ID)
+	}
+}
+
+// run collects queued events into batches and delivers them when either the
+// batch is full or the batch interval expires.
+func (s *Sink) run(ctx context.Context) {
+	batch := make([]*RASEvent, 0, s.cfg.BatchSize)
+	ticker := time.NewTicker(s.cfg.BatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if len(batch) > 0 {
+				s.log.Debugf("event sink %q stopped with %d undelivered events", s.cfg.Name, len(batch))
+			}
+			return
+		case evt := <-s.queue:
+			batch = append(batch, evt)
+			if len(batch) < s.cfg.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		s.send(ctx, batch)
+		batch = make([]*RASEvent, 0, s.cfg.BatchSize)
+	}
+}
+
+// encode returns the JSON payload for a batch of events. When batching is not
+// enabled, a single event object is produced, otherwise an array of events.
+func (s *Sink) encode(batch []*RASEvent) ([]byte, error) {
+	if s.cfg.BatchSize == 1 && len(batch) == 1 {
+		return json.Marshal(batch[0])
+	}
+	return json.Marshal(batch)
+}
+
+// send delivers a batch of events, retrying with exponential backoff on
+// failure.
+func (s *Sink) send(ctx context.Context, batch []*RASEvent) {
+	payload, err := s.encode(batch)
+	if err != nil {
+		s.log.Errorf("event sink %q: failed to encode %d events: %s", s.cfg.Name, len(batch), err)
+		return
+	}
+
+	limit := uint64(*s.cfg.MaxRetries) + 1
+	for try := uint64(0); ; try++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(common.ExpBackoffWithJitter(s.cfg.RetryInterval, s.cfg.RetryInterval/4, try, limit)):
+		}
+
+		err = s.deliver(ctx, payload)
+		if err == nil {
+			return
+		}
+		if try+1 >= limit {
+			break
+		}
+		s.log.Debugf("event sink %q: delivery attempt %d failed: %s", s.cfg.Name, try+1, err)
+	}
+
+	s.log.Errorf("event sink %q: dropping %d events after %d failed delivery attempts: %s",
+		s.cfg.Name, len(batch), limit, err)
+}
+
+// postWebhook delivers the payload as the body of an HTTP POST request.
+func (s *Sink) postWebhook(parent context.Context, payload []byte) error {
+	ctx, cancel := context.WithTimeout(parent, s.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.Webhook, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("webhook returned %s", resp.Status)
+	}
+
+	return nil
+}
+
+// runExec delivers the payload on the stdin of the configured program.
+func (s *Sink) runExec(parent context.Context, payload []byte) error {
+	ctx, cancel := context.WithTimeout(parent, s.cfg.Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, s.cfg.Exec)
+	cmd.Stdin = bytes.NewReader(payload)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "%s: %s", s.cfg.Exec, bytes.TrimSpace(out))
+	}
+
+	return nil
+}
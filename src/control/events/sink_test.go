@@ -0,0 +1,325 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestEvents_SinkConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *SinkConfig
+		expErr error
+	}{
+		"nil config": {
+			expErr: errors.New("nil event sink config"),
+		},
+		"missing name": {
+			cfg:    &SinkConfig{Webhook: "http://localhost/hook"},
+			expErr: errors.New("name must be set"),
+		},
+		"missing destination": {
+			cfg:    &SinkConfig{Name: "test"},
+			expErr: errors.New("one of webhook or exec"),
+		},
+		"both destinations": {
+			cfg: &SinkConfig{
+				Name:    "test",
+				Webhook: "http://localhost/hook",
+				Exec:    "/bin/true",
+			},
+			expErr: errors.New("cannot both be set"),
+		},
+		"bad webhook scheme": {
+			cfg:    &SinkConfig{Name: "test", Webhook: "ftp://localhost/hook"},
+			expErr: errors.New("invalid webhook URL"),
+		},
+		"relative exec path": {
+			cfg:    &SinkConfig{Name: "test", Exec: "bin/alert"},
+			expErr: errors.New("must be absolute"),
+		},
+		"headers with exec": {
+			cfg: &SinkConfig{
+				Name:    "test",
+				Exec:    "/bin/true",
+				Headers: map[string]string{"foo": "bar"},
+			},
+			expErr: errors.New("only valid with webhook"),
+		},
+		"negative max retries": {
+			cfg: &SinkConfig{
+				Name:       "test",
+				Exec:       "/bin/true",
+				MaxRetries: func() *int { n := -1; return &n }(),
+			},
+			expErr: errors.New("max_retries"),
+		},
+		"negative batch size": {
+			cfg:    &SinkConfig{Name: "test", Exec: "/bin/true", BatchSize: -1},
+			expErr: errors.New("batch_size"),
+		},
+		"negative duration": {
+			cfg:    &SinkConfig{Name: "test", Exec: "/bin/true", Debounce: -time.Second},
+			expErr: errors.New("durations must not be negative"),
+		},
+		"unknown event id": {
+			cfg:    &SinkConfig{Name: "test", Exec: "/bin/true", IDs: []string{"foo"}},
+			expErr: errors.New("unknown RAS event"),
+		},
+		"unknown severity": {
+			cfg:    &SinkConfig{Name: "test", Exec: "/bin/true", Severities: []string{"fatal"}},
+			expErr: errors.New("unknown RAS event severity"),
+		},
+		"valid webhook": {
+			cfg: &SinkConfig{
+				Name:       "test",
+				Webhook:    "https://alerts.example.com/daos",
+				Headers:    map[string]string{"Authorization": "Bearer token"},
+				IDs:        []string{"engine_died", "device_set_faulty"},
+				Types:      []string{"STATE_CHANGE"},
+				Severities: []string{"error"},
+			},
+		},
+		"valid exec": {
+			cfg: &SinkConfig{Name: "test", Exec: "/usr/local/bin/alert"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+type mockWebhook struct {
+	sync.Mutex
+	failures int
+	bodies   [][]byte
+}
+
+func (mw *mockWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mw.Lock()
+	defer mw.Unlock()
+
+	if mw.failures > 0 {
+		mw.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	mw.bodies = append(mw.bodies, body)
+}
+
+func (mw *mockWebhook) waitBodies(t *testing.T, count int) [][]byte {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		mw.Lock()
+		if len(mw.bodies) >= count {
+			defer mw.Unlock()
+			return mw.bodies
+		}
+		mw.Unlock()
+
+		select {
+		case <-timeout:
+			t.Fatalf("timed out waiting for %d webhook deliveries", count)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func decodeEventIDs(t *testing.T, body []byte, batch bool) []RASID {
+	t.Helper()
+
+	var raw []json.RawMessage
+	if batch {
+		if err := json.Unmarshal(body, &raw); err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		raw = []json.RawMessage{body}
+	}
+
+	var ids []RASID
+	for _, r := range raw {
+		var evt struct {
+			ID RASID `json:"id"`
+		}
+		if err := json.Unmarshal(r, &evt); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, evt.ID)
+	}
+
+	return ids
+}
+
+func TestEvents_Sink_Webhook(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg       *SinkConfig
+		failures  int
+		evts      []*RASEvent
+		expBodies int
+		expIDs    []RASID
+	}{
+		"single events": {
+			cfg: &SinkConfig{},
+			evts: []*RASEvent{
+				mockEvtDied(t),
+				mockEvtFmtReq(t),
+			},
+			expBodies: 2,
+			expIDs:    []RASID{RASEngineDied, RASEngineFormatRequired},
+		},
+		"filtered by id": {
+			cfg: &SinkConfig{IDs: []string{"engine_died"}},
+			evts: []*RASEvent{
+				mockEvtFmtReq(t),
+				mockEvtDied(t),
+			},
+			expBodies: 1,
+			expIDs:    []RASID{RASEngineDied},
+		},
+		"batched": {
+			cfg: &SinkConfig{BatchSize: 3},
+			evts: []*RASEvent{
+				mockEvtDied(t),
+				mockEvtFmtReq(t),
+			},
+			expBodies: 1,
+			expIDs:    []RASID{RASEngineDied, RASEngineFormatRequired},
+		},
+		"retried": {
+			cfg:       &SinkConfig{},
+			failures:  2,
+			evts:      []*RASEvent{mockEvtDied(t)},
+			expBodies: 1,
+			expIDs:    []RASID{RASEngineDied},
+		},
+		"retries disabled": {
+			cfg:      &SinkConfig{MaxRetries: new(int)},
+			failures: 1,
+			evts: []*RASEvent{
+				mockEvtDied(t),
+				mockEvtFmtReq(t),
+			},
+			expBodies: 1,
+			expIDs:    []RASID{RASEngineFormatRequired},
+		},
+		"debounced": {
+			cfg: &SinkConfig{Debounce: time.Hour},
+			evts: []*RASEvent{
+				mockEvtDied(t),
+				mockEvtDied(t),
+				mockEvtFmtReq(t),
+			},
+			expBodies: 2,
+			expIDs:    []RASID{RASEngineDied, RASEngineFormatRequired},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+
+			hook := &mockWebhook{failures: tc.failures}
+			srv := httptest.NewServer(hook)
+			defer srv.Close()
+
+			tc.cfg.Name = "test"
+			tc.cfg.Webhook = srv.URL
+			tc.cfg.BatchInterval = 50 * time.Millisecond
+			tc.cfg.RetryInterval = time.Millisecond
+
+			sink, err := NewSink(ctx, log, tc.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, evt := range tc.evts {
+				sink.OnEvent(ctx, evt)
+				// Allow each event to be queued before the next so that
+				// delivery order is deterministic.
+				time.Sleep(20 * time.Millisecond)
+			}
+
+			bodies := hook.waitBodies(t, tc.expBodies)
+			// Give any unexpected deliveries a chance to arrive.
+			time.Sleep(100 * time.Millisecond)
+
+			hook.Lock()
+			defer hook.Unlock()
+			test.AssertEqual(t, tc.expBodies, len(hook.bodies), "unexpected number of deliveries")
+
+			var gotIDs []RASID
+			for _, body := range bodies {
+				gotIDs = append(gotIDs, decodeEventIDs(t, body, tc.cfg.BatchSize > 1)...)
+			}
+			test.AssertEqual(t, len(tc.expIDs), len(gotIDs), "unexpected number of events")
+			for i, id := range tc.expIDs {
+				test.AssertEqual(t, id, gotIDs[i], "unexpected event ID")
+			}
+		})
+	}
+}
+
+func TestEvents_Sink_Exec(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	outFile := filepath.Join(testDir, "event.json")
+	script := filepath.Join(testDir, "sink.sh")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\ncat > "+outFile+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	defer cancel()
+
+	sink, err := NewSink(ctx, log, &SinkConfig{Name: "test", Exec: script})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.OnEvent(ctx, mockEvtDied(t))
+
+	timeout := time.After(5 * time.Second)
+	for {
+		data, err := os.ReadFile(outFile)
+		if err == nil && len(data) > 0 {
+			ids := decodeEventIDs(t, data, false)
+			test.AssertEqual(t, []RASID{RASEngineDied}, ids, "unexpected events")
+			return
+		}
+
+		select {
+		case <-timeout:
+			t.Fatal("timed out waiting for exec delivery")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -114,6 +114,7 @@ const (
 	SystemUnknown Code = iota + 400
 	SystemBadFaultDomainDepth
 	SystemPoolLocked
+	SystemQuotaExceeded
 )
 
 // client fault codes
@@ -156,6 +157,8 @@ const (
 	ServerHugepagesDisabled
 	ServerPoolMemRatioNoRoles
 	ServerBadFaultDomainLabels
+	ServerPoolDestroyPending
+	ServerPoolResizeUnsupported
 )
 
 // server config fault codes
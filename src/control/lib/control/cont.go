@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,11 +10,14 @@ package control
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 // ContSetOwnerReq contains the parameters for the set owner request
@@ -62,3 +66,87 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 
 	return errors.Wrap(ur.getMSError(), "container set-owner failed")
 }
+
+// ListContainersReq contains the parameters for the list containers request.
+type ListContainersReq struct {
+	msRequest
+	unaryRequest
+	PoolID  string // UUID or label of the pool
+	Details bool   // Include container ownership and redundancy details
+}
+
+// ContainerListEntry describes a container in a pool. Ownership and
+// redundancy details are only set if they were requested.
+type ContainerListEntry struct {
+	UUID          uuid.UUID   `json:"uuid"`
+	Label         string      `json:"label,omitempty"`
+	OwnerUser     string      `json:"owner_user,omitempty"`
+	OwnerGroup    string      `json:"owner_group,omitempty"`
+	RedundancyFac uint64      `json:"rd_fac"`
+	RedundancyLvl uint64      `json:"rd_lvl"`
+	Status        daos.Status `json:"status"` // set if details could not be retrieved
+}
+
+// ListContainersResp contains the containers in a pool.
+type ListContainersResp struct {
+	Containers []*ContainerListEntry `json:"containers"`
+}
+
+// ListContainers lists the containers in a DAOS pool.
+func ListContainers(ctx context.Context, rpcClient UnaryInvoker, req *ListContainersReq) (*ListContainersResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.PoolID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	pbReq := &mgmtpb.ListContReq{
+		Sys:     req.getSystem(rpcClient),
+		Id:      req.PoolID,
+		Details: req.Details,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ListContainers(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("List DAOS containers request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "container list failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.ListContResp)
+	if !ok {
+		return nil, errors.New("unable to extract ListContResp from MS response")
+	}
+	if pbResp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(pbResp.Status), "container list failed")
+	}
+
+	resp := &ListContainersResp{
+		Containers: make([]*ContainerListEntry, 0, len(pbResp.Containers)),
+	}
+	for _, cont := range pbResp.Containers {
+		contUUID, err := uuid.Parse(cont.Uuid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid container UUID %q", cont.Uuid)
+		}
+		resp.Containers = append(resp.Containers, &ContainerListEntry{
+			UUID:          contUUID,
+			Label:         cont.Label,
+			OwnerUser:     cont.OwnerUser,
+			OwnerGroup:    cont.OwnerGroup,
+			RedundancyFac: cont.RedunFac,
+			RedundancyLvl: cont.RedunLvl,
+			Status:        daos.Status(cont.Status),
+		})
+	}
+
+	return resp, nil
+}
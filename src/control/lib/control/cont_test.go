@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,11 +10,13 @@ package control
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -132,3 +135,144 @@ func TestControl_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ListContainers(t *testing.T) {
+	testContUUIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ListContainersReq
+		expResp *ListContainersResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no pool ID": {
+			req:    &ListContainersReq{},
+			expErr: errors.New("pool label or UUID"),
+		},
+		"local failure": {
+			req: &ListContainersReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &ListContainersReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"DAOS status failure": {
+			req: &ListContainersReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{Status: int32(daos.NoPermission)},
+				),
+			},
+			expErr: daos.NoPermission,
+		},
+		"bad container UUID": {
+			req: &ListContainersReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{
+						Containers: []*mgmtpb.ListContResp_Cont{{Uuid: "bad"}},
+					},
+				),
+			},
+			expErr: errors.New("invalid container UUID"),
+		},
+		"no containers": {
+			req: &ListContainersReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.ListContResp{}),
+			},
+			expResp: &ListContainersResp{Containers: []*ContainerListEntry{}},
+		},
+		"success": {
+			req: &ListContainersReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{
+						Containers: []*mgmtpb.ListContResp_Cont{
+							{Uuid: testContUUIDs[0].String(), Label: "one"},
+							{Uuid: testContUUIDs[1].String()},
+						},
+					},
+				),
+			},
+			expResp: &ListContainersResp{
+				Containers: []*ContainerListEntry{
+					{UUID: testContUUIDs[0], Label: "one"},
+					{UUID: testContUUIDs[1]},
+				},
+			},
+		},
+		"success with details": {
+			req: &ListContainersReq{PoolID: "pool1", Details: true},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{
+						Containers: []*mgmtpb.ListContResp_Cont{
+							{
+								Uuid:       testContUUIDs[0].String(),
+								Label:      "one",
+								OwnerUser:  "alice@",
+								OwnerGroup: "research@",
+								RedunFac:   2,
+								RedunLvl:   2,
+							},
+							{
+								Uuid:   testContUUIDs[1].String(),
+								Status: int32(daos.Nonexistent),
+							},
+						},
+					},
+				),
+			},
+			expResp: &ListContainersResp{
+				Containers: []*ContainerListEntry{
+					{
+						UUID:          testContUUIDs[0],
+						Label:         "one",
+						OwnerUser:     "alice@",
+						OwnerGroup:    "research@",
+						RedundancyFac: 2,
+						RedundancyLvl: 2,
+					},
+					{
+						UUID:   testContUUIDs[1],
+						Status: daos.Nonexistent,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ListContainers(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -10,11 +10,13 @@ import (
 	"context"
 	"log"
 	"log/syslog"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/events"
@@ -67,6 +69,151 @@ func eventNotify(ctx context.Context, rpcClient UnaryInvoker, seq uint64, evt *e
 	return convertMSResponse(ur, new(EventNotifyResp))
 }
 
+// eventFilterRequest is an embeddable struct to be used by requests which
+// select RAS events by ID, type, severity, rank or host.
+type eventFilterRequest struct {
+	sysRequest
+	IDs        []events.RASID
+	Types      []events.RASTypeID
+	Severities []events.RASSeverityID
+}
+
+func (r *eventFilterRequest) getFilter() *mgmtpb.RASEventFilter {
+	pbFilter := &mgmtpb.RASEventFilter{
+		Ranks: r.Ranks.String(),
+		Hosts: r.Hosts.String(),
+	}
+	for _, id := range r.IDs {
+		pbFilter.Ids = append(pbFilter.Ids, id.Uint32())
+	}
+	for _, typ := range r.Types {
+		pbFilter.Types = append(pbFilter.Types, typ.Uint32())
+	}
+	for _, sev := range r.Severities {
+		pbFilter.Severities = append(pbFilter.Severities, sev.Uint32())
+	}
+
+	return pbFilter
+}
+
+// SystemEventsFollowReq contains the inputs for a request to stream RAS
+// events as they are published on the MS leader.
+type SystemEventsFollowReq struct {
+	streamRequest
+	msRequest
+	eventFilterRequest
+	Handler events.Handler `json:"-"` // called for each event received
+}
+
+// SystemEventsFollow streams RAS events matching the request filter from the
+// MS leader and passes them to the request handler. The call blocks until the
+// context is canceled or the stream is closed by the server.
+func SystemEventsFollow(ctx context.Context, rpcClient StreamInvoker, req *SystemEventsFollowReq) error {
+	switch {
+	case req == nil:
+		return errors.Errorf("nil %T request", req)
+	case common.InterfaceIsNil(req.Handler):
+		return errors.New("nil event handler")
+	}
+
+	pbReq := &mgmtpb.SystemEventsFollowReq{
+		Sys:    req.getSystem(rpcClient),
+		Filter: req.getFilter(),
+	}
+	req.setStreamRPC(func(ctx context.Context, conn *grpc.ClientConn) (streamRecvFn, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemEventsFollow(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		return func() (proto.Message, error) {
+			return stream.Recv()
+		}, nil
+	}, func(msg proto.Message) error {
+		pbEvt, ok := msg.(*sharedpb.RASEvent)
+		if !ok {
+			return errors.Errorf("unexpected stream message type %T", msg)
+		}
+		evt, err := events.NewFromProto(pbEvt)
+		if err != nil {
+			return errors.Wrap(err, "converting event from proto")
+		}
+		req.Handler.OnEvent(ctx, evt)
+		return nil
+	})
+
+	rpcClient.Debugf("DAOS system events follow request: %+v", pbReq)
+	return rpcClient.InvokeStreamRPC(ctx, req)
+}
+
+type (
+	// SystemEventsListReq contains the inputs for a request to list RAS
+	// events retained in the MS event history.
+	SystemEventsListReq struct {
+		unaryRequest
+		msRequest
+		eventFilterRequest
+		Since time.Time // exclude events published before this time
+		Until time.Time // exclude events published after this time
+	}
+
+	// SystemEventsListResp contains the RAS events matching the request,
+	// ordered from oldest to newest.
+	SystemEventsListResp struct {
+		Events []*events.RASEvent `json:"events"`
+	}
+)
+
+// SystemEventsList retrieves RAS events matching the request filter and time
+// range from the MS event history.
+func SystemEventsList(ctx context.Context, rpcClient UnaryInvoker, req *SystemEventsListReq) (*SystemEventsListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemEventsListReq{
+		Sys:    req.getSystem(rpcClient),
+		Filter: req.getFilter(),
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = req.Since.UnixNano()
+	}
+	if !req.Until.IsZero() {
+		pbReq.Until = req.Until.UnixNano()
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemEventsList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system events list request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "system events list failed")
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemEventsListResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+
+	resp := &SystemEventsListResp{
+		Events: make([]*events.RASEvent, 0, len(pbResp.GetEvents())),
+	}
+	for _, pbEvt := range pbResp.GetEvents() {
+		evt, err := events.NewFromProto(pbEvt)
+		if err != nil {
+			return nil, errors.Wrap(err, "converting event from proto")
+		}
+		resp.Events = append(resp.Events, evt)
+	}
+
+	return resp, nil
+}
+
 // EventForwarder implements the events.Handler interface, increments sequence
 // number for each event forwarded and distributes requests to MS replicas.
 type EventForwarder struct {
@@ -7,20 +7,27 @@
 package control
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"log/syslog"
 	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -84,6 +91,167 @@ func TestControl_eventNotify(t *testing.T) {
 	}
 }
 
+func TestControl_SystemEventsFollow(t *testing.T) {
+	pbEvtDied, err := mockEvtEngineDied(t).ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req       *SystemEventsFollowReq
+		nilHdlr   bool
+		msgs      []proto.Message
+		streamErr error
+		expEvtIDs []events.RASID
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.SystemEventsFollowReq request"),
+		},
+		"nil handler": {
+			req:     &SystemEventsFollowReq{},
+			nilHdlr: true,
+			expErr:  errors.New("nil event handler"),
+		},
+		"stream failure": {
+			req:       &SystemEventsFollowReq{},
+			streamErr: errors.New("stream failed"),
+			expErr:    errors.New("stream failed"),
+		},
+		"unexpected message type": {
+			req:    &SystemEventsFollowReq{},
+			msgs:   []proto.Message{&mgmtpb.SystemQueryResp{}},
+			expErr: errors.New("unexpected stream message type"),
+		},
+		"events received": {
+			req:       &SystemEventsFollowReq{},
+			msgs:      []proto.Message{pbEvtDied, pbEvtDied},
+			expEvtIDs: []events.RASID{events.RASEngineDied, events.RASEngineDied},
+		},
+		"events received then failure": {
+			req:       &SystemEventsFollowReq{},
+			msgs:      []proto.Message{pbEvtDied},
+			streamErr: errors.New("stream failed"),
+			expEvtIDs: []events.RASID{events.RASEngineDied},
+			expErr:    errors.New("stream failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var gotEvtIDs []events.RASID
+			if tc.req != nil && !tc.nilHdlr {
+				tc.req.Handler = events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+					gotEvtIDs = append(gotEvtIDs, evt.ID)
+				})
+			}
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				StreamMessages: tc.msgs,
+				StreamError:    tc.streamErr,
+			})
+
+			gotErr := SystemEventsFollow(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expEvtIDs, gotEvtIDs); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemEventsList(t *testing.T) {
+	pbEvtDied, err := mockEvtEngineDied(t).ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req       *SystemEventsListReq
+		uResp     *UnaryResponse
+		expEvtIDs []events.RASID
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.SystemEventsListReq request"),
+		},
+		"local failure": {
+			req:    &SystemEventsListReq{},
+			uResp:  MockMSResponse("host1", errors.New("local failed"), nil),
+			expErr: errors.New("local failed"),
+		},
+		"unexpected response type": {
+			req:    &SystemEventsListReq{},
+			uResp:  MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{}),
+			expErr: errors.New("unexpected response type"),
+		},
+		"no events": {
+			req:       &SystemEventsListReq{},
+			uResp:     MockMSResponse("host1", nil, &mgmtpb.SystemEventsListResp{}),
+			expEvtIDs: []events.RASID{},
+		},
+		"events returned": {
+			req: &SystemEventsListReq{
+				Since: time.Now().Add(-time.Hour),
+				Until: time.Now(),
+			},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemEventsListResp{
+				Events: []*sharedpb.RASEvent{pbEvtDied, pbEvtDied},
+			}),
+			expEvtIDs: []events.RASID{events.RASEngineDied, events.RASEngineDied},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemEventsList(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotEvtIDs := []events.RASID{}
+			for _, evt := range gotResp.Events {
+				gotEvtIDs = append(gotEvtIDs, evt.ID)
+			}
+			if diff := cmp.Diff(tc.expEvtIDs, gotEvtIDs); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_eventFilterRequest_getFilter(t *testing.T) {
+	req := &SystemEventsFollowReq{
+		eventFilterRequest: eventFilterRequest{
+			IDs:        []events.RASID{events.RASEngineDied},
+			Types:      []events.RASTypeID{events.RASTypeStateChange},
+			Severities: []events.RASSeverityID{events.RASSeverityError},
+		},
+	}
+	req.SetRanks(ranklist.MustCreateRankSet("0-3"))
+	req.SetHosts(hostlist.MustCreateSet("foo[1-2]"))
+
+	expFilter := &mgmtpb.RASEventFilter{
+		Ids:        []uint32{events.RASEngineDied.Uint32()},
+		Types:      []uint32{events.RASTypeStateChange.Uint32()},
+		Severities: []uint32{events.RASSeverityError.Uint32()},
+		Ranks:      "0-3",
+		Hosts:      "foo[1-2]",
+	}
+
+	if diff := cmp.Diff(expFilter, req.getFilter(), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected filter (-want, +got):\n%s\n", diff)
+	}
+}
+
 func TestControl_EventForwarder_OnEvent(t *testing.T) {
 	rasEventEngineDied := mockEvtEngineDied(t).WithForwardable(false)
 	rasEventEngineDiedFwdable := mockEvtEngineDied(t).WithForwardable(true)
@@ -0,0 +1,472 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// FirmwareRollingStage identifies a stage in the firmware update of a fault
+// domain.
+type FirmwareRollingStage string
+
+// Stages of a rolling firmware update, in the order that they are performed
+// for each fault domain.
+const (
+	FirmwareRollingStagePending     FirmwareRollingStage = "pending"
+	FirmwareRollingStageQuery       FirmwareRollingStage = "query"
+	FirmwareRollingStageStop        FirmwareRollingStage = "stop"
+	FirmwareRollingStageUpdate      FirmwareRollingStage = "update"
+	FirmwareRollingStageVerify      FirmwareRollingStage = "verify"
+	FirmwareRollingStageStart       FirmwareRollingStage = "start"
+	FirmwareRollingStageRejoin      FirmwareRollingStage = "rejoin"
+	FirmwareRollingStageReintegrate FirmwareRollingStage = "reintegrate"
+	FirmwareRollingStageRebuild     FirmwareRollingStage = "rebuild"
+	FirmwareRollingStageDone        FirmwareRollingStage = "done"
+	FirmwareRollingStageSkipped     FirmwareRollingStage = "skipped"
+)
+
+type (
+	// FirmwareRollingDomain describes the progress of the firmware update
+	// of the devices on the hosts in a single fault domain.
+	FirmwareRollingDomain struct {
+		Domain string               `json:"domain"`
+		Hosts  []string             `json:"hosts"`
+		Ranks  *ranklist.RankSet    `json:"ranks"`
+		Stage  FirmwareRollingStage `json:"stage"`
+		// Devices is the number of devices selected for update.
+		Devices int    `json:"devices"`
+		Error   string `json:"error,omitempty"`
+
+		// stopRanks are the ranks that were joined before the update
+		// and are restarted once it has completed.
+		stopRanks *ranklist.RankSet
+	}
+
+	// FirmwareRollingReportFn is called each time a fault domain enters a
+	// new stage of a rolling firmware update.
+	FirmwareRollingReportFn func(*FirmwareRollingDomain)
+
+	// FirmwareRollingUpdateReq contains the inputs for a firmware update of
+	// the storage devices on the system hosts, one fault domain at a time.
+	FirmwareRollingUpdateReq struct {
+		sysRequest
+		FirmwarePath string
+		Type         DeviceType
+		Devices      []string // Specific devices to update
+		ModelID      string   // Update only devices of specific model
+		FirmwareRev  string   // Update only devices with a specific current firmware
+		// NewFirmwareRev is the firmware revision that updated devices
+		// are expected to report. If unset, updated devices are only
+		// required to report a revision different from the original.
+		NewFirmwareRev string
+		// DomainLevel is the fault domain level used to group hosts,
+		// counting from the top of the fault domain tree. Zero selects
+		// the lowest level above the ranks (typically the host).
+		DomainLevel int
+		// RejoinTimeout is the maximum time to wait for restarted ranks
+		// to rejoin the system.
+		RejoinTimeout time.Duration
+		// RebuildTimeout is the maximum time to wait for pool rebuilds
+		// to complete. Zero waits indefinitely.
+		RebuildTimeout time.Duration
+		// PollInterval is the interval between rank and pool checks.
+		PollInterval time.Duration
+		// ReportFn is an optional callback for progress reporting.
+		ReportFn FirmwareRollingReportFn
+	}
+
+	// FirmwareRollingUpdateResp contains the results of a rolling firmware
+	// update.
+	FirmwareRollingUpdateResp struct {
+		Domains []*FirmwareRollingDomain `json:"domains"`
+	}
+
+	// hostDeviceRevs maps a host to the firmware revisions of its devices,
+	// keyed by device identifier.
+	hostDeviceRevs map[string]map[string]string
+)
+
+func (req *FirmwareRollingUpdateReq) report(fd *FirmwareRollingDomain, stage FirmwareRollingStage) {
+	fd.Stage = stage
+	if req.ReportFn != nil {
+		req.ReportFn(fd)
+	}
+}
+
+// firmwareRollingDomains groups the selected members by fault domain and
+// returns the hosts and ranks in each. Every rank on a selected host must be
+// selected, as firmware can only be updated when all engines on a host are
+// stopped.
+func firmwareRollingDomains(selected, all system.Members, level int) ([]*FirmwareRollingDomain, error) {
+	byRank := make(map[ranklist.Rank]*system.Member)
+	hosts := make(map[string]struct{})
+	for _, m := range selected {
+		if m.Addr == nil {
+			return nil, errors.Errorf("rank %d has no address", m.Rank)
+		}
+		byRank[m.Rank] = m
+		hosts[m.Addr.String()] = struct{}{}
+	}
+
+	missing := ranklist.NewRankSet()
+	for _, m := range all {
+		if m.Addr == nil {
+			continue
+		}
+		if _, found := hosts[m.Addr.String()]; found && byRank[m.Rank] == nil {
+			missing.Add(m.Rank)
+		}
+	}
+	if missing.Count() > 0 {
+		return nil, errors.Errorf("ranks %s share a host with the selected ranks and must also be selected",
+			missing)
+	}
+
+	rrDomains, err := rollingRestartDomains(selected, level)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]*FirmwareRollingDomain, 0, len(rrDomains))
+	for _, rd := range rrDomains {
+		fd := &FirmwareRollingDomain{
+			Domain:    rd.Domain,
+			Ranks:     rd.Ranks,
+			Stage:     FirmwareRollingStagePending,
+			stopRanks: ranklist.NewRankSet(),
+		}
+		domainHosts := make(map[string]struct{})
+		for _, rank := range rd.Ranks.Ranks() {
+			m := byRank[rank]
+			if m.State == system.MemberStateJoined {
+				fd.stopRanks.Add(rank)
+			}
+			domainHosts[m.Addr.String()] = struct{}{}
+		}
+		for host := range domainHosts {
+			fd.Hosts = append(fd.Hosts, host)
+		}
+		sort.Strings(fd.Hosts)
+		domains = append(domains, fd)
+	}
+
+	return domains, nil
+}
+
+func (req *FirmwareRollingUpdateReq) queryDevices(ctx context.Context, rpcClient UnaryInvoker, hosts, devices []string, fwRev string) (hostDeviceRevs, error) {
+	queryReq := &FirmwareQueryReq{
+		NVMe:        true,
+		Devices:     devices,
+		ModelID:     req.ModelID,
+		FirmwareRev: fwRev,
+	}
+	queryReq.SetHostList(hosts)
+	resp, err := FirmwareQuery(ctx, rpcClient, queryReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Errors(); err != nil {
+		return nil, err
+	}
+
+	revs := make(hostDeviceRevs)
+	for host, results := range resp.HostNVMeFirmware {
+		revs[host] = make(map[string]string)
+		for _, res := range results {
+			revs[host][res.Device.PciAddr] = res.Device.FwRev
+		}
+	}
+
+	return revs, nil
+}
+
+// devices returns the sorted set of device identifiers across all hosts.
+func (hdr hostDeviceRevs) devices() []string {
+	set := make(map[string]struct{})
+	for _, revs := range hdr {
+		for dev := range revs {
+			set[dev] = struct{}{}
+		}
+	}
+
+	devs := make([]string, 0, len(set))
+	for dev := range set {
+		devs = append(devs, dev)
+	}
+	sort.Strings(devs)
+	return devs
+}
+
+func (hdr hostDeviceRevs) count() (count int) {
+	for _, revs := range hdr {
+		count += len(revs)
+	}
+	return
+}
+
+func (req *FirmwareRollingUpdateReq) updateDevices(ctx context.Context, rpcClient UnaryInvoker, hosts, devices []string) error {
+	updateReq := &FirmwareUpdateReq{
+		FirmwarePath: req.FirmwarePath,
+		Type:         req.Type,
+		Devices:      devices,
+		ModelID:      req.ModelID,
+		FirmwareRev:  req.FirmwareRev,
+	}
+	updateReq.SetHostList(hosts)
+	resp, err := FirmwareUpdate(ctx, rpcClient, updateReq)
+	if err != nil {
+		return err
+	}
+	if err := resp.Errors(); err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, host := range resp.HostNVMeResult.Keys() {
+		for _, res := range resp.HostNVMeResult[host] {
+			if res.Error != nil {
+				failed = append(failed, host+":"+res.DevicePCIAddr+": "+res.Error.Error())
+			}
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("firmware update failed on %d device(s): %s", len(failed),
+			strings.Join(failed, "; "))
+	}
+
+	return nil
+}
+
+// verifyDevices checks that every device selected for update reports the
+// expected new firmware revision.
+func (req *FirmwareRollingUpdateReq) verifyDevices(before, after hostDeviceRevs) error {
+	hosts := make([]string, 0, len(before))
+	for host := range before {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	for _, host := range hosts {
+		devs := make([]string, 0, len(before[host]))
+		for dev := range before[host] {
+			devs = append(devs, dev)
+		}
+		sort.Strings(devs)
+
+		for _, dev := range devs {
+			oldRev := before[host][dev]
+			newRev, found := after[host][dev]
+			switch {
+			case !found:
+				return errors.Errorf("device %s on host %s not found after update", dev, host)
+			case req.NewFirmwareRev != "" && newRev != req.NewFirmwareRev:
+				return errors.Errorf("device %s on host %s reports firmware revision %q, expected %q",
+					dev, host, newRev, req.NewFirmwareRev)
+			case req.NewFirmwareRev == "" && newRev == oldRev:
+				return errors.Errorf("device %s on host %s still reports firmware revision %q",
+					dev, host, oldRev)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (req *FirmwareRollingUpdateReq) updateDomain(ctx context.Context, rpcClient UnaryInvoker, fd *FirmwareRollingDomain) error {
+	req.report(fd, FirmwareRollingStageQuery)
+	before, err := req.queryDevices(ctx, rpcClient, fd.Hosts, req.Devices, req.FirmwareRev)
+	if err != nil {
+		return err
+	}
+	fd.Devices = before.count()
+	if fd.Devices == 0 {
+		req.report(fd, FirmwareRollingStageSkipped)
+		return nil
+	}
+
+	if fd.stopRanks.Count() > 0 {
+		req.report(fd, FirmwareRollingStageStop)
+		stopReq := new(SystemStopReq)
+		stopReq.Ranks.Replace(fd.stopRanks)
+		stopResp, err := SystemStop(ctx, rpcClient, stopReq)
+		if err != nil {
+			return err
+		}
+		if err := stopResp.Errors(); err != nil {
+			return err
+		}
+	}
+
+	devices := before.devices()
+	req.report(fd, FirmwareRollingStageUpdate)
+	if err := req.updateDevices(ctx, rpcClient, fd.Hosts, devices); err != nil {
+		return err
+	}
+
+	req.report(fd, FirmwareRollingStageVerify)
+	after, err := req.queryDevices(ctx, rpcClient, fd.Hosts, devices, "")
+	if err != nil {
+		return err
+	}
+	if err := req.verifyDevices(before, after); err != nil {
+		return err
+	}
+
+	if fd.stopRanks.Count() > 0 {
+		req.report(fd, FirmwareRollingStageStart)
+		startReq := new(SystemStartReq)
+		startReq.Ranks.Replace(fd.stopRanks)
+		startResp, err := SystemStart(ctx, rpcClient, startReq)
+		if err != nil {
+			return err
+		}
+		if err := startResp.Errors(); err != nil {
+			return err
+		}
+
+		req.report(fd, FirmwareRollingStageRejoin)
+		if err := pollUntil(ctx, req.PollInterval, req.RejoinTimeout,
+			"ranks "+fd.stopRanks.String()+" to rejoin",
+			func() (bool, error) {
+				return ranksJoined(ctx, rpcClient, fd.stopRanks)
+			}); err != nil {
+			return err
+		}
+
+		req.report(fd, FirmwareRollingStageReintegrate)
+		reintReq := &SystemDrainReq{Reint: true}
+		reintReq.Ranks.Replace(fd.stopRanks)
+		reintResp, err := SystemDrain(ctx, rpcClient, reintReq)
+		if err != nil {
+			return err
+		}
+		if err := reintResp.Errors(); err != nil {
+			return err
+		}
+
+		req.report(fd, FirmwareRollingStageRebuild)
+		if err := pollUntil(ctx, req.PollInterval, req.RebuildTimeout, "pool rebuild to complete",
+			func() (bool, error) {
+				return ranksReintegrated(ctx, rpcClient, fd.stopRanks)
+			}); err != nil {
+			return err
+		}
+	}
+
+	req.report(fd, FirmwareRollingStageDone)
+	return nil
+}
+
+// FirmwareRollingUpdate updates the firmware of the storage devices on the
+// system hosts one fault domain at a time, so that a bad firmware image
+// cannot take out the devices in more than one fault domain. For each fault
+// domain, the joined ranks are stopped, the devices are updated and the new
+// firmware revision is verified before the ranks are restarted, reintegrated
+// into their pools and any resulting pool rebuilds have completed. Ranks that
+// were already stopped or administratively excluded are left down. The update
+// is aborted on the first failure, leaving the ranks of the failed fault
+// domain stopped and any remaining fault domains untouched. Only NVMe devices
+// are supported.
+func FirmwareRollingUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareRollingUpdateReq) (*FirmwareRollingUpdateResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.FirmwarePath == "" {
+		return nil, errors.New("firmware file path missing")
+	}
+	if _, err := req.Type.toCtlPBType(); err != nil {
+		return nil, err
+	}
+	// Staged SCM firmware is only activated by a power cycle, so restarting
+	// the ranks would neither run nor verify the new firmware.
+	if req.Type == DeviceTypeSCM {
+		return nil, errors.New("rolling firmware update is not supported for SCM modules")
+	}
+	if req.PollInterval == 0 {
+		req.PollInterval = defaultRollingRestartPollInterval
+	}
+	if req.RejoinTimeout == 0 {
+		req.RejoinTimeout = defaultRollingRestartRejoinTimeout
+	}
+
+	queryReq := new(SystemQueryReq)
+	queryReq.Ranks.Replace(&req.Ranks)
+	queryReq.Hosts.Replace(&req.Hosts)
+	queryResp, err := SystemQuery(ctx, rpcClient, queryReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := queryResp.Errors(); err != nil {
+		return nil, err
+	}
+	if len(queryResp.Members) == 0 {
+		return nil, errors.New("no ranks to update")
+	}
+
+	notReady := ranklist.NewRankSet()
+	for _, m := range queryResp.Members {
+		switch m.State {
+		case system.MemberStateJoined, system.MemberStateStopped, system.MemberStateAdminExcluded:
+		default:
+			notReady.Add(m.Rank)
+		}
+	}
+	if notReady.Count() > 0 {
+		return nil, errors.Errorf("rolling firmware update requires all ranks to be joined, stopped or admin-excluded; ranks %s are not",
+			notReady)
+	}
+
+	allMembers := queryResp.Members
+	if req.Ranks.Count() > 0 || req.Hosts.Count() > 0 {
+		allResp, err := SystemQuery(ctx, rpcClient, new(SystemQueryReq))
+		if err != nil {
+			return nil, err
+		}
+		if err := allResp.Errors(); err != nil {
+			return nil, err
+		}
+		allMembers = allResp.Members
+	}
+
+	rebuilt, err := poolsRebuilt(ctx, rpcClient)
+	if err != nil {
+		return nil, err
+	}
+	if !rebuilt {
+		return nil, errors.New("rolling firmware update requires all pools to be ready with no rebuild in progress")
+	}
+
+	domains, err := firmwareRollingDomains(queryResp.Members, allMembers, req.DomainLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &FirmwareRollingUpdateResp{Domains: domains}
+	for _, fd := range domains {
+		rpcClient.Debugf("rolling firmware update of fault domain %s (hosts %s)", fd.Domain,
+			strings.Join(fd.Hosts, ","))
+		if err := req.updateDomain(ctx, rpcClient, fd); err != nil {
+			fd.Error = err.Error()
+			if req.ReportFn != nil {
+				req.ReportFn(fd)
+			}
+			return resp, errors.Wrapf(err, "rolling firmware update aborted in fault domain %s (ranks %s)",
+				fd.Domain, fd.Ranks)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,328 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_firmwareRollingDomains(t *testing.T) {
+	member := func(rank uint32, addr, fd string, state system.MemberState) *system.Member {
+		m := mockRollingRestartMember(t, rank, addr, fd)
+		m.State = state
+		return m
+	}
+
+	type expDomain struct {
+		Hosts     []string
+		Ranks     string
+		StopRanks string
+	}
+
+	allMembers := system.Members{
+		member(0, "10.0.0.1:10001", "/rack0/host1", system.MemberStateJoined),
+		member(1, "10.0.0.1:10001", "/rack0/host1", system.MemberStateStopped),
+		member(2, "10.0.0.2:10001", "/rack0/host2", system.MemberStateJoined),
+		member(3, "10.0.0.3:10001", "/rack1/host3", system.MemberStateAdminExcluded),
+	}
+
+	for name, tc := range map[string]struct {
+		selected   system.Members
+		level      int
+		expDomains map[string]expDomain
+		expErr     error
+	}{
+		"host level by default": {
+			selected: allMembers,
+			expDomains: map[string]expDomain{
+				"/rack0/host1": {Hosts: []string{"10.0.0.1:10001"}, Ranks: "0-1", StopRanks: "0"},
+				"/rack0/host2": {Hosts: []string{"10.0.0.2:10001"}, Ranks: "2", StopRanks: "2"},
+				"/rack1/host3": {Hosts: []string{"10.0.0.3:10001"}, Ranks: "3", StopRanks: ""},
+			},
+		},
+		"rack level": {
+			selected: allMembers,
+			level:    1,
+			expDomains: map[string]expDomain{
+				"/rack0": {
+					Hosts:     []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+					Ranks:     "0-2",
+					StopRanks: "0,2",
+				},
+				"/rack1": {Hosts: []string{"10.0.0.3:10001"}, Ranks: "3", StopRanks: ""},
+			},
+		},
+		"subset of hosts": {
+			selected: allMembers[2:],
+			expDomains: map[string]expDomain{
+				"/rack0/host2": {Hosts: []string{"10.0.0.2:10001"}, Ranks: "2", StopRanks: "2"},
+				"/rack1/host3": {Hosts: []string{"10.0.0.3:10001"}, Ranks: "3", StopRanks: ""},
+			},
+		},
+		"rank on host not selected": {
+			selected: allMembers[:1],
+			expErr:   errors.New("ranks 1 share a host"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			domains, gotErr := firmwareRollingDomains(tc.selected, allMembers, tc.level)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotDomains := make(map[string]expDomain)
+			for _, fd := range domains {
+				gotDomains[fd.Domain] = expDomain{
+					Hosts:     fd.Hosts,
+					Ranks:     fd.Ranks.String(),
+					StopRanks: fd.stopRanks.String(),
+				}
+			}
+			if diff := cmp.Diff(tc.expDomains, gotDomains); diff != "" {
+				t.Fatalf("unexpected domains (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_FirmwareRollingUpdate(t *testing.T) {
+	host1 := "10.0.0.1:10001"
+	host2 := "10.0.0.2:10001"
+	pbMember := func(rank uint32, addr, fd string, state system.MemberState) *mgmtpb.SystemMember {
+		return &mgmtpb.SystemMember{
+			Rank:        rank,
+			Uuid:        test.MockUUID(int32(rank)),
+			State:       state.String(),
+			Addr:        addr,
+			FaultDomain: fd,
+		}
+	}
+	queryResp := MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			pbMember(0, host1, "/host1", system.MemberStateJoined),
+			pbMember(1, host2, "/host2", system.MemberStateJoined),
+		},
+	})
+	notJoinedResp := MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			pbMember(0, host1, "/host1", system.MemberStateStopped),
+		},
+	})
+	joinedResp := MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{})
+	poolsResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{})
+	stopResp := MockMSResponse("host1", nil, &mgmtpb.SystemStopResp{})
+	startResp := MockMSResponse("host1", nil, &mgmtpb.SystemStartResp{})
+	reintResp := MockMSResponse("host1", nil, &mgmtpb.SystemDrainResp{})
+
+	fwQueryResp := func(host string, revs ...string) *UnaryResponse {
+		pbResp := new(ctlpb.FirmwareQueryResp)
+		for i, rev := range revs {
+			pbResp.NvmeResults = append(pbResp.NvmeResults, &ctlpb.NvmeFirmwareQueryResp{
+				Device: &ctlpb.NvmeController{
+					PciAddr: test.MockPCIAddr(int32(i + 1)),
+					FwRev:   rev,
+				},
+			})
+		}
+		return &UnaryResponse{
+			Responses: []*HostResponse{{Addr: host, Message: pbResp}},
+		}
+	}
+	fwUpdateResp := func(host string, devErr string) *UnaryResponse {
+		return &UnaryResponse{
+			Responses: []*HostResponse{
+				{
+					Addr: host,
+					Message: &ctlpb.FirmwareUpdateResp{
+						NvmeResults: []*ctlpb.NvmeFirmwareUpdateResp{
+							{PciAddr: test.MockPCIAddr(1), Error: devErr},
+						},
+					},
+				},
+			},
+		}
+	}
+	nvmeReq := func() *FirmwareRollingUpdateReq {
+		return &FirmwareRollingUpdateReq{
+			FirmwarePath: "/tmp/fw.bin",
+			Type:         DeviceTypeNVMe,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req       *FirmwareRollingUpdateReq
+		uResps    []*UnaryResponse
+		uResp     *UnaryResponse
+		expStages map[string]FirmwareRollingStage
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.FirmwareRollingUpdateReq request"),
+		},
+		"no firmware path": {
+			req:    &FirmwareRollingUpdateReq{Type: DeviceTypeNVMe},
+			expErr: errors.New("firmware file path missing"),
+		},
+		"no device type": {
+			req:    &FirmwareRollingUpdateReq{FirmwarePath: "/tmp/fw.bin"},
+			expErr: errors.New("invalid device type"),
+		},
+		"scm device type": {
+			req: &FirmwareRollingUpdateReq{
+				FirmwarePath: "/tmp/fw.bin",
+				Type:         DeviceTypeSCM,
+			},
+			expErr: errors.New("not supported for SCM"),
+		},
+		"ranks not ready": {
+			req: nvmeReq(),
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+					Members: []*mgmtpb.SystemMember{
+						pbMember(0, host1, "/host1", system.MemberStateExcluded),
+					},
+				}),
+			},
+			expErr: errors.New("ranks 0 are not"),
+		},
+		"success": {
+			req: nvmeReq(),
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				fwQueryResp(host1, "A"), stopResp, fwUpdateResp(host1, ""), fwQueryResp(host1, "B"),
+				startResp, notJoinedResp, joinedResp, reintResp, poolsResp,
+				fwQueryResp(host2, "A"), stopResp, fwUpdateResp(host2, ""), fwQueryResp(host2, "B"),
+				startResp, joinedResp, reintResp, poolsResp,
+			},
+			expStages: map[string]FirmwareRollingStage{
+				"/host1": FirmwareRollingStageDone,
+				"/host2": FirmwareRollingStageDone,
+			},
+		},
+		"no matching devices on host": {
+			req: nvmeReq(),
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				fwQueryResp(host1),
+				fwQueryResp(host2, "A"), stopResp, fwUpdateResp(host2, ""), fwQueryResp(host2, "B"),
+				startResp, joinedResp, reintResp, poolsResp,
+			},
+			expStages: map[string]FirmwareRollingStage{
+				"/host1": FirmwareRollingStageSkipped,
+				"/host2": FirmwareRollingStageDone,
+			},
+		},
+		"device update fails": {
+			req: nvmeReq(),
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				fwQueryResp(host1, "A"), stopResp, fwUpdateResp(host1, "bad image"),
+			},
+			expStages: map[string]FirmwareRollingStage{
+				"/host1": FirmwareRollingStageUpdate,
+				"/host2": FirmwareRollingStagePending,
+			},
+			expErr: errors.New("bad image"),
+		},
+		"revision unchanged": {
+			req: nvmeReq(),
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				fwQueryResp(host1, "A"), stopResp, fwUpdateResp(host1, ""), fwQueryResp(host1, "A"),
+			},
+			expStages: map[string]FirmwareRollingStage{
+				"/host1": FirmwareRollingStageVerify,
+				"/host2": FirmwareRollingStagePending,
+			},
+			expErr: errors.New("still reports firmware revision \"A\""),
+		},
+		"unexpected new revision": {
+			req: &FirmwareRollingUpdateReq{
+				FirmwarePath:   "/tmp/fw.bin",
+				Type:           DeviceTypeNVMe,
+				NewFirmwareRev: "C",
+			},
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				fwQueryResp(host1, "A"), stopResp, fwUpdateResp(host1, ""), fwQueryResp(host1, "B"),
+			},
+			expStages: map[string]FirmwareRollingStage{
+				"/host1": FirmwareRollingStageVerify,
+				"/host2": FirmwareRollingStagePending,
+			},
+			expErr: errors.New("expected \"C\""),
+		},
+		"device missing after update": {
+			req: nvmeReq(),
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				fwQueryResp(host1, "A"), stopResp, fwUpdateResp(host1, ""), fwQueryResp(host1),
+			},
+			expStages: map[string]FirmwareRollingStage{
+				"/host1": FirmwareRollingStageVerify,
+				"/host2": FirmwareRollingStagePending,
+			},
+			expErr: errors.New("not found after update"),
+		},
+		"rejoin times out": {
+			req: &FirmwareRollingUpdateReq{
+				FirmwarePath:  "/tmp/fw.bin",
+				Type:          DeviceTypeNVMe,
+				RejoinTimeout: 50 * time.Millisecond,
+			},
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				fwQueryResp(host1, "A"), stopResp, fwUpdateResp(host1, ""), fwQueryResp(host1, "B"),
+				startResp,
+			},
+			uResp: notJoinedResp,
+			expStages: map[string]FirmwareRollingStage{
+				"/host1": FirmwareRollingStageRejoin,
+				"/host2": FirmwareRollingStagePending,
+			},
+			expErr: errors.New("timed out"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+				UnaryResponse:    tc.uResp,
+			})
+
+			if tc.req != nil {
+				tc.req.PollInterval = time.Millisecond
+			}
+			gotResp, gotErr := FirmwareRollingUpdate(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expStages == nil {
+				return
+			}
+
+			gotStages := make(map[string]FirmwareRollingStage)
+			for _, fd := range gotResp.Domains {
+				gotStages[fd.Domain] = fd.Stage
+			}
+			if diff := cmp.Diff(tc.expStages, gotStages); diff != "" {
+				t.Fatalf("unexpected stages (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ package control
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -43,6 +44,28 @@ func connErrToFault(st *status.Status, target string) error {
 	}
 }
 
+// unwrapClientStream wraps a grpc.ClientStream in order to return unwrapped
+// errors from received messages.
+type unwrapClientStream struct {
+	grpc.ClientStream
+	target string
+}
+
+// RecvMsg receives a message from the stream and returns any unwrapped errors.
+func (cs *unwrapClientStream) RecvMsg(m interface{}) error {
+	err := cs.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+
+	st := status.Convert(err)
+	err = proto.UnwrapError(st)
+	if err.Error() != st.Err().Error() {
+		return err
+	}
+	return connErrToFault(st, cs.target)
+}
+
 // streamErrorInterceptor calls the specified streaming RPC and returns any unwrapped errors.
 func streamErrorInterceptor() grpc.DialOption {
 	return grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
@@ -55,7 +78,7 @@ func streamErrorInterceptor() grpc.DialOption {
 			}
 			return cs, connErrToFault(st, cc.Target())
 		}
-		return cs, nil
+		return &unwrapClientStream{ClientStream: cs, target: cc.Target()}, nil
 	})
 }
 
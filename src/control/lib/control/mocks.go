@@ -59,6 +59,8 @@ type (
 		HostResponses       HostResponseChan
 		ReqTimeout          time.Duration
 		RetryTimeout        time.Duration
+		StreamMessages      []proto.Message
+		StreamError         error
 	}
 
 	// MockInvoker implements the Invoker interface in order
@@ -121,6 +123,27 @@ func (mi *MockInvoker) InvokeUnaryRPC(ctx context.Context, uReq UnaryRequest) (*
 	return invokeUnaryRPC(ctx, mi.log, mi, uReq, nil)
 }
 
+// InvokeStreamRPC passes each of the configured stream messages to the
+// request's handler and then returns the configured stream error.
+func (mi *MockInvoker) InvokeStreamRPC(ctx context.Context, sReq StreamRequest) error {
+	mi.invokeCountMutex.Lock()
+	mi.invokeCount++
+	mi.invokeCountMutex.Unlock()
+
+	for _, msg := range mi.cfg.StreamMessages {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if err := sReq.onStreamMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	return mi.cfg.StreamError
+}
+
 func (mi *MockInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq UnaryRequest) (HostResponseChan, error) {
 	if mi.cfg.HostResponses != nil || mi.cfg.UnaryError != nil {
 		return mi.cfg.HostResponses, mi.cfg.UnaryError
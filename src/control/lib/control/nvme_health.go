@@ -0,0 +1,127 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+type (
+	// NvmeHealthHistoryReq contains the parameters for a request for the
+	// health history of NVMe devices.
+	NvmeHealthHistoryReq struct {
+		unaryRequest
+		UUID string        // Constrain query to this device UUID
+		Rank ranklist.Rank // Constrain query to devices on this rank
+		// IncludeSamples retains the health samples in the response,
+		// otherwise only the health trends are returned.
+		IncludeSamples bool
+	}
+
+	// HostNvmeHealthHistory contains the health history of an NVMe device on
+	// a host, along with a summary of the trends in the device's health.
+	HostNvmeHealthHistory struct {
+		Host string `json:"host"`
+		storage.NvmeHealthHistory
+		Trend *storage.NvmeHealthTrend `json:"trend"`
+	}
+
+	// NvmeHealthHistoryResp contains the results of an NVMe health history
+	// request, ordered by host, rank and device UUID.
+	NvmeHealthHistoryResp struct {
+		HostErrorsResp
+		Devices []*HostNvmeHealthHistory `json:"devices"`
+	}
+)
+
+func (resp *NvmeHealthHistoryResp) addHostResponse(hr *HostResponse, includeSamples bool) error {
+	pbResp, ok := hr.Message.(*ctlpb.NvmeHealthHistoryResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	for _, pbDev := range pbResp.Devices {
+		dev := &HostNvmeHealthHistory{Host: hr.Addr}
+		if err := convert.Types(pbDev, &dev.NvmeHealthHistory); err != nil {
+			return errors.Wrapf(err, "unable to convert device %s health history", pbDev.Uuid)
+		}
+		dev.Trend = storage.CalcNvmeHealthTrend(dev.Samples)
+		if !includeSamples {
+			dev.Samples = nil
+		}
+		resp.Devices = append(resp.Devices, dev)
+	}
+
+	return nil
+}
+
+// NvmeHealthHistory concurrently requests the NVMe device health samples
+// retained by all hosts supplied in the request's hostlist, or all configured
+// hosts if not explicitly specified. Each server samples the health of its
+// NVMe devices periodically, and the samples are used to calculate the trends
+// in device health and to project when each device will be worn out.
+func NvmeHealthHistory(ctx context.Context, rpcClient UnaryInvoker, req *NvmeHealthHistoryReq) (*NvmeHealthHistoryResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.UUID != "" {
+		if err := checkUUID(req.UUID); err != nil {
+			return nil, errors.Wrap(err, "invalid UUID")
+		}
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageNvmeHealthHistory(ctx, &ctlpb.NvmeHealthHistoryReq{
+			Uuid: req.UUID,
+			Rank: req.Rank.Uint32(),
+		})
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &NvmeHealthHistoryResp{
+		Devices: []*HostNvmeHealthHistory{},
+	}
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hostResp, req.IncludeSamples); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(resp.Devices, func(i, j int) bool {
+		di, dj := resp.Devices[i], resp.Devices[j]
+		if di.Host != dj.Host {
+			return di.Host < dj.Host
+		}
+		if di.Rank != dj.Rank {
+			return di.Rank < dj.Rank
+		}
+		return di.UUID < dj.UUID
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestControl_NvmeHealthHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) uint64 {
+		return uint64(start.Add(time.Duration(d) * 24 * time.Hour).Unix())
+	}
+	eol := start.Add(450 * 24 * time.Hour)
+
+	pbHistory := func(idx int32, rank uint32) *ctlpb.NvmeHealthHistory {
+		return &ctlpb.NvmeHealthHistory{
+			Uuid:    test.MockUUID(idx),
+			PciAddr: test.MockPCIAddr(idx),
+			Rank:    rank,
+			Samples: []*ctlpb.NvmeHealthSample{
+				{Timestamp: day(0), MediaWearRaw: 10 * 1024},
+				{Timestamp: day(10), MediaWearRaw: 12 * 1024},
+			},
+		}
+	}
+	history := func(host string, idx int32, rank uint32, withSamples bool) *HostNvmeHealthHistory {
+		hist := &HostNvmeHealthHistory{
+			Host: host,
+			NvmeHealthHistory: storage.NvmeHealthHistory{
+				UUID:    test.MockUUID(idx),
+				PciAddr: test.MockPCIAddr(idx),
+				Rank:    ranklist.Rank(rank),
+			},
+			Trend: &storage.NvmeHealthTrend{
+				NumSamples:       2,
+				Period:           10 * 24 * 60 * 60,
+				LifeUsed:         12,
+				WearRate:         0.2,
+				DaysUntilWornOut: 440,
+				EndOfLife:        &eol,
+			},
+		}
+		if withSamples {
+			hist.Samples = []*storage.NvmeHealthSample{
+				{Timestamp: day(0), MediaWearRaw: 10 * 1024},
+				{Timestamp: day(10), MediaWearRaw: 12 * 1024},
+			}
+		}
+		return hist
+	}
+	hostResps := &UnaryResponse{
+		Responses: []*HostResponse{
+			{
+				Addr: "host2",
+				Message: &ctlpb.NvmeHealthHistoryResp{
+					Devices: []*ctlpb.NvmeHealthHistory{pbHistory(3, 2)},
+				},
+			},
+			{
+				Addr: "host1",
+				Message: &ctlpb.NvmeHealthHistoryResp{
+					Devices: []*ctlpb.NvmeHealthHistory{pbHistory(2, 1), pbHistory(1, 0)},
+				},
+			},
+			{
+				Addr:  "host3",
+				Error: errors.New("remote failed"),
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		req     *NvmeHealthHistoryReq
+		uResp   *UnaryResponse
+		uErr    error
+		expResp *NvmeHealthHistoryResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.NvmeHealthHistoryReq request"),
+		},
+		"invalid uuid": {
+			req:    &NvmeHealthHistoryReq{UUID: "bad"},
+			expErr: errors.New("invalid UUID"),
+		},
+		"local failure": {
+			req:    &NvmeHealthHistoryReq{Rank: ranklist.NilRank},
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"trends only": {
+			req:   &NvmeHealthHistoryReq{Rank: ranklist.NilRank},
+			uResp: hostResps,
+			expResp: &NvmeHealthHistoryResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host3", "remote failed"}),
+				Devices: []*HostNvmeHealthHistory{
+					history("host1", 1, 0, false),
+					history("host1", 2, 1, false),
+					history("host2", 3, 2, false),
+				},
+			},
+		},
+		"with samples": {
+			req:   &NvmeHealthHistoryReq{Rank: ranklist.NilRank, IncludeSamples: true},
+			uResp: hostResps,
+			expResp: &NvmeHealthHistoryResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host3", "remote failed"}),
+				Devices: []*HostNvmeHealthHistory{
+					history("host1", 1, 0, true),
+					history("host1", 2, 1, true),
+					history("host2", 3, 2, true),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := NvmeHealthHistory(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.EquateApprox(0, 1e-9),
+				cmpopts.EquateApproxTime(time.Second),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, append(cmpOpts, defResCmpOpts()...)...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -225,6 +225,10 @@ type (
 		Ranks      []ranklist.Rank      `json:"ranks"`       // Manual-sizing param
 		TierBytes  []uint64             `json:"tier_bytes"`  // Per-rank values
 		MemRatio   float32              `json:"mem_ratio"`   // mem_file_size:meta_blob_size
+		// Fault domain placement constraints
+		Domains      []string `json:"domains"`       // Restrict ranks to these domains
+		SpreadAcross string   `json:"spread_across"` // Spread ranks across this domain level
+		MinDomains   uint32   `json:"min_domains"`   // Minimum distinct domains to span
 	}
 
 	// PoolCreateResp contains the response from a pool create request.
@@ -363,15 +367,32 @@ type PoolDestroyReq struct {
 	ID        string
 	Recursive bool // Remove pool and any child containers.
 	Force     bool
+	Immediate bool // Skip any configured destroy grace period.
+}
+
+// PoolDestroyResp contains the results of a pool destroy operation.
+type PoolDestroyResp struct {
+	// DestroyAfter is set if the pool has been soft-deleted and will be
+	// permanently destroyed after the grace period has expired.
+	DestroyAfter time.Time `json:"destroy_after,omitempty"`
 }
 
 // PoolDestroy performs a pool destroy operation on a DAOS Management Server instance.
 func PoolDestroy(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyReq) error {
+	_, err := PoolDestroyWithResp(ctx, rpcClient, req)
+	return err
+}
+
+// PoolDestroyWithResp performs a pool destroy operation on a DAOS Management
+// Server instance and returns the result, which indicates whether the pool was
+// soft-deleted rather than destroyed.
+func PoolDestroyWithResp(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyReq) (*PoolDestroyResp, error) {
 	pbReq := &mgmtpb.PoolDestroyReq{
 		Sys:       req.getSystem(rpcClient),
 		Id:        req.ID,
 		Recursive: req.Recursive,
 		Force:     req.Force,
+		Immediate: req.Immediate,
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolDestroy(ctx, pbReq)
@@ -380,17 +401,58 @@ func PoolDestroy(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyRe
 	rpcClient.Debugf("Destroy DAOS pool request: %s\n", pbUtil.Debug(pbReq))
 	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := ur.getMSError(); err != nil {
+	resp := new(PoolDestroyResp)
+	msResp, err := ur.getMSResponse()
+	if err != nil {
 		// If the error is due to a retried destroy failing to find
 		// the pool, then we can assume that the pool was destroyed
 		// via a server-side cleanup and we can intercept it. Everything
 		// else is still an error.
 		if !(ur.retryCount > 0 && system.IsPoolNotFound(err)) {
-			return errors.Wrap(err, "pool destroy failed")
+			return nil, errors.Wrap(err, "pool destroy failed")
 		}
+		return resp, nil
+	}
+
+	if pbResp, ok := msResp.(*mgmtpb.PoolDestroyResp); ok && pbResp.DestroyAfter > 0 {
+		resp.DestroyAfter = time.Unix(pbResp.DestroyAfter, 0)
+	}
+
+	return resp, nil
+}
+
+// PoolUndeleteReq contains the parameters for a pool undelete request.
+type PoolUndeleteReq struct {
+	poolRequest
+	ID string
+}
+
+// PoolUndelete restores a pool that is pending destroy.
+func PoolUndelete(ctx context.Context, rpcClient UnaryInvoker, req *PoolUndeleteReq) error {
+	pbReq := &mgmtpb.PoolUndeleteReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolUndelete(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Undelete DAOS pool request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return errors.Wrap(err, "pool undelete failed")
+	}
+
+	if pbResp, ok := msResp.(*mgmtpb.DaosResp); ok && pbResp.Status != 0 {
+		return errors.Wrap(daos.Status(pbResp.Status), "pool undelete failed")
 	}
 
 	return nil
@@ -850,6 +912,137 @@ func PoolExtend(ctx context.Context, rpcClient UnaryInvoker, req *PoolExtendReq)
 	return errors.Wrap(ur.getMSError(), "pool extend failed")
 }
 
+type (
+	// PoolResizeReq contains the parameters for a pool resize request. Either TotalBytes or
+	// per-rank TierBytes should be set, a zero tier size retains the current allocation.
+	PoolResizeReq struct {
+		poolRequest
+		ID         string
+		TotalBytes uint64
+		TierBytes  []uint64
+	}
+
+	// PoolResizeRankResult contains the result of resizing the pool on a rank.
+	PoolResizeRankResult struct {
+		Rank   ranklist.Rank `json:"rank"`
+		Status int32         `json:"status"`
+	}
+
+	// PoolResizeResp contains the pool resize response.
+	PoolResizeResp struct {
+		Status      int32                   `json:"status"`
+		TierBytes   []uint64                `json:"tier_bytes"`
+		RankResults []*PoolResizeRankResult `json:"rank_results"`
+	}
+)
+
+// PoolResize grows the per-rank storage allocation of the DAOS pool on all of its current ranks.
+// The response is returned together with any error so that the per-rank results can be reported
+// when the pool could only be resized on some ranks.
+func PoolResize(ctx context.Context, rpcClient UnaryInvoker, req *PoolResizeReq) (*PoolResizeResp, error) {
+	if err := checkPoolResizeSpace(ctx, rpcClient, req); err != nil {
+		return nil, errors.Wrap(err, "pool resize failed")
+	}
+
+	pbReq := &mgmtpb.PoolResizeReq{
+		Sys:        req.getSystem(rpcClient),
+		Id:         req.ID,
+		TotalBytes: req.TotalBytes,
+		TierBytes:  req.TierBytes,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolResize(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Resize DAOS pool request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolResizeResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool resize failed")
+	}
+	if resp.Status != 0 {
+		return resp, errors.Wrap(daos.Status(resp.Status), "pool resize failed")
+	}
+
+	return resp, nil
+}
+
+// checkPoolResizeSpace verifies that each enabled engine of the pool has enough free NVMe space
+// to grow the per-rank NVMe allocation of the pool as requested. The current allocation is derived
+// from the NVMe usage of the active targets of the pool. Requests that don't grow the allocation
+// are left for the management service to validate.
+func checkPoolResizeSpace(ctx context.Context, rpcClient UnaryInvoker, req *PoolResizeReq) error {
+	pqr, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{
+		ID: req.ID,
+		QueryMask: daos.MustNewPoolQueryMask(daos.PoolQueryOptionSpace,
+			daos.PoolQueryOptionEnabledEngines),
+	})
+	if err != nil {
+		return err
+	}
+	if pqr.Status != 0 {
+		return daos.Status(pqr.Status)
+	}
+	if len(pqr.TierStats) < 2 || pqr.ActiveTargets == 0 || pqr.TotalEngines == 0 ||
+		pqr.EnabledRanks == nil {
+		return nil
+	}
+
+	tgtsPerRank := uint64(pqr.TotalTargets / pqr.TotalEngines)
+	rankTierBytes := func(tier int) uint64 {
+		return pqr.TierStats[tier].Total / uint64(pqr.ActiveTargets) * tgtsPerRank
+	}
+	curNvme := rankTierBytes(1)
+	var newNvme uint64
+	switch {
+	case len(req.TierBytes) > 1:
+		newNvme = req.TierBytes[1]
+	case req.TotalBytes > 0:
+		rankBytes := req.TotalBytes / uint64(pqr.TotalEngines)
+		if curScm := rankTierBytes(0); rankBytes > curScm {
+			newNvme = rankBytes - curScm
+		}
+	}
+	if newNvme <= curNvme {
+		return nil
+	}
+	growBytes := newNvme - curNvme
+
+	scanResp, err := StorageScan(ctx, rpcClient, &StorageScanReq{Usage: true})
+	if err != nil {
+		return err
+	}
+
+	ranks := pqr.EnabledRanks.Ranks()
+	filterRank := newFilterRankFunc(ranklist.RankList(ranks))
+	rankNVMeFreeSpace := make(rankFreeSpaceMap)
+	for _, key := range scanResp.HostStorage.Keys() {
+		hostStorage := scanResp.HostStorage[key].HostStorage
+
+		if _, err := processSCMSpaceStats(rpcClient, filterRank, hostStorage.ScmNamespaces, rankNVMeFreeSpace); err != nil {
+			return err
+		}
+		if err := processNVMeSpaceStats(rpcClient, filterRank, hostStorage.NvmeDevices, rankNVMeFreeSpace); err != nil {
+			return err
+		}
+	}
+
+	for _, rank := range ranks {
+		if free := rankNVMeFreeSpace[rank]; free < growBytes {
+			return errors.Errorf("rank %d has %s of NVMe available, %s required to grow "+
+				"pool NVMe from %s to %s per rank", rank, humanize.IBytes(free),
+				humanize.IBytes(growBytes), humanize.IBytes(curNvme),
+				humanize.IBytes(newNvme))
+		}
+	}
+
+	return nil
+}
+
 // PoolReintegrateReq struct contains request
 type PoolReintegrateReq struct {
 	poolRequest
@@ -0,0 +1,430 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// Free space percentages below which a pool storage tier is reported.
+	poolDoctorSpaceWarnPct     = 10
+	poolDoctorSpaceCriticalPct = 5
+
+	// Health score deductions for each finding of a given severity.
+	poolDoctorWarningPenalty  = 10
+	poolDoctorCriticalPenalty = 30
+)
+
+// PoolFindingSeverity indicates the severity of a pool doctor finding.
+type PoolFindingSeverity int
+
+const (
+	// PoolFindingInfo indicates a finding that does not affect pool health.
+	PoolFindingInfo PoolFindingSeverity = iota
+	// PoolFindingWarning indicates a finding that degrades pool health.
+	PoolFindingWarning
+	// PoolFindingCritical indicates a finding that puts pool data or
+	// availability at risk.
+	PoolFindingCritical
+)
+
+func (pfs PoolFindingSeverity) String() string {
+	switch pfs {
+	case PoolFindingInfo:
+		return "info"
+	case PoolFindingWarning:
+		return "warning"
+	case PoolFindingCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("unknown severity %d", int(pfs))
+	}
+}
+
+func (pfs PoolFindingSeverity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pfs.String())
+}
+
+type (
+	// PoolDoctorReq contains the inputs for a pool doctor request.
+	PoolDoctorReq struct {
+		ID string
+	}
+
+	// PoolFinding describes a problem found with a pool, along with the
+	// commands suggested to remedy it.
+	PoolFinding struct {
+		Severity    PoolFindingSeverity `json:"severity"`
+		Summary     string              `json:"summary"`
+		Details     string              `json:"details,omitempty"`
+		Remediation []string            `json:"remediation,omitempty"`
+	}
+
+	// PoolDoctorResp contains the findings of a pool doctor request, most
+	// severe first. HealthScore ranges from 100 for a healthy pool to 0.
+	PoolDoctorResp struct {
+		PoolUUID    uuid.UUID      `json:"pool_uuid"`
+		PoolLabel   string         `json:"pool_label,omitempty"`
+		HealthScore int            `json:"health_score"`
+		Findings    []*PoolFinding `json:"findings"`
+	}
+)
+
+func (resp *PoolDoctorResp) addFinding(sev PoolFindingSeverity, summary, details string, remediation ...string) {
+	resp.Findings = append(resp.Findings, &PoolFinding{
+		Severity:    sev,
+		Summary:     summary,
+		Details:     details,
+		Remediation: remediation,
+	})
+}
+
+// setScore sorts the findings by severity and calculates the health score.
+func (resp *PoolDoctorResp) setScore() {
+	sort.SliceStable(resp.Findings, func(i, j int) bool {
+		return resp.Findings[i].Severity > resp.Findings[j].Severity
+	})
+
+	resp.HealthScore = 100
+	for _, f := range resp.Findings {
+		switch f.Severity {
+		case PoolFindingWarning:
+			resp.HealthScore -= poolDoctorWarningPenalty
+		case PoolFindingCritical:
+			resp.HealthScore -= poolDoctorCriticalPenalty
+		}
+	}
+	if resp.HealthScore < 0 {
+		resp.HealthScore = 0
+	}
+}
+
+// poolDoctor holds the state gathered while diagnosing a pool.
+type poolDoctor struct {
+	rpcClient UnaryInvoker
+	resp      *PoolDoctorResp
+	poolID    string
+	pool      *PoolQueryResp
+	members   map[ranklist.Rank]*system.Member
+}
+
+// rankStartRemedy returns the commands suggested to bring a rank's engine
+// back into the system, based on its member state.
+func (pd *poolDoctor) rankStartRemedy(rank ranklist.Rank) []string {
+	m, found := pd.members[rank]
+	if !found {
+		return []string{fmt.Sprintf("dmg system query --ranks %d --verbose", rank)}
+	}
+
+	switch m.State {
+	case system.MemberStateJoined:
+		return nil
+	case system.MemberStateAdminExcluded:
+		return []string{
+			fmt.Sprintf("dmg system clear-exclude --ranks %d", rank),
+			fmt.Sprintf("dmg system start --ranks %d", rank),
+		}
+	default:
+		return []string{fmt.Sprintf("dmg system start --ranks %d", rank)}
+	}
+}
+
+func (pd *poolDoctor) memberDetails(rank ranklist.Rank) string {
+	m, found := pd.members[rank]
+	if !found {
+		return "engine state unknown"
+	}
+
+	details := fmt.Sprintf("engine state %s", m.State)
+	if m.Addr != nil {
+		details += fmt.Sprintf(" on %s", m.Addr.IP)
+	}
+	if m.Info != "" {
+		details += fmt.Sprintf(" (%s)", m.Info)
+	}
+	if m.Maintenance {
+		details += ", host is in maintenance"
+	}
+	return details
+}
+
+// downTargets returns the indices of the targets on the rank that are down,
+// or nil if they could not be determined.
+func (pd *poolDoctor) downTargets(ctx context.Context, rank ranklist.Rank) ([]uint32, error) {
+	if pd.pool.TotalEngines == 0 {
+		return nil, nil
+	}
+
+	var tgts []uint32
+	for i := uint32(0); i < pd.pool.TotalTargets/pd.pool.TotalEngines; i++ {
+		tgts = append(tgts, i)
+	}
+
+	resp, err := PoolQueryTargets(ctx, pd.rpcClient, &PoolQueryTargetReq{
+		ID:      pd.poolID,
+		Rank:    rank,
+		Targets: tgts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		return nil, daos.Status(resp.Status)
+	}
+
+	down := []uint32{}
+	for i, info := range resp.Infos {
+		if i >= len(tgts) || info == nil {
+			break
+		}
+		switch info.State {
+		case daos.PoolTargetStateDown, daos.PoolTargetStateDownOut:
+			down = append(down, tgts[i])
+		}
+	}
+	return down, nil
+}
+
+func (pd *poolDoctor) checkMembers(ctx context.Context) {
+	sqr, err := SystemQuery(ctx, pd.rpcClient, &SystemQueryReq{})
+	if err != nil {
+		pd.resp.addFinding(PoolFindingWarning, "Unable to query system membership", err.Error(),
+			"dmg system query --verbose")
+		return
+	}
+
+	pd.members = make(map[ranklist.Rank]*system.Member)
+	for _, m := range sqr.Members {
+		pd.members[m.Rank] = m
+	}
+}
+
+func (pd *poolDoctor) checkRanks(ctx context.Context) {
+	pi := pd.pool
+
+	dead := make(map[ranklist.Rank]bool)
+	if pi.DeadRanks != nil {
+		for _, rank := range pi.DeadRanks.Ranks() {
+			dead[rank] = true
+			pd.resp.addFinding(PoolFindingCritical, fmt.Sprintf("Rank %d is dead", rank),
+				pd.memberDetails(rank), pd.rankStartRemedy(rank)...)
+		}
+	}
+
+	if pi.EnabledRanks != nil {
+		for _, rank := range pi.EnabledRanks.Ranks() {
+			m, found := pd.members[rank]
+			if !found || m.State == system.MemberStateJoined || dead[rank] {
+				continue
+			}
+			sev := PoolFindingCritical
+			if m.Maintenance {
+				sev = PoolFindingWarning
+			}
+			pd.resp.addFinding(sev, fmt.Sprintf("Rank %d engine is not joined", rank),
+				pd.memberDetails(rank), pd.rankStartRemedy(rank)...)
+		}
+	}
+
+	if pi.DisabledRanks == nil || pi.DisabledRanks.Count() == 0 {
+		if pi.DisabledTargets > 0 {
+			pd.resp.addFinding(PoolFindingWarning,
+				fmt.Sprintf("%d of %d targets are disabled", pi.DisabledTargets, pi.TotalTargets), "",
+				fmt.Sprintf("dmg pool query-targets %s --rank <rank>", pd.poolID))
+		}
+		return
+	}
+
+	for _, rank := range pi.DisabledRanks.Ranks() {
+		if dead[rank] {
+			continue
+		}
+
+		details := pd.memberDetails(rank)
+		reint := fmt.Sprintf("dmg pool reintegrate %s --rank %d", pd.poolID, rank)
+		down, err := pd.downTargets(ctx, rank)
+		switch {
+		case err != nil:
+			details += fmt.Sprintf("; unable to query targets: %s", err)
+		case len(down) > 0:
+			idxs := make([]string, len(down))
+			for i, idx := range down {
+				idxs[i] = fmt.Sprintf("%d", idx)
+			}
+			details += fmt.Sprintf("; down targets %s", strings.Join(idxs, ","))
+			if uint32(len(down)) < pi.TotalTargets/pi.TotalEngines {
+				reint += " --target-idx " + strings.Join(idxs, ",")
+			}
+		}
+
+		pd.resp.addFinding(PoolFindingWarning, fmt.Sprintf("Targets on rank %d are disabled", rank),
+			details, append(pd.rankStartRemedy(rank), reint)...)
+	}
+}
+
+func (pd *poolDoctor) checkRebuild() {
+	rs := pd.pool.Rebuild
+	switch {
+	case rs == nil:
+	case rs.Status != 0:
+		pd.resp.addFinding(PoolFindingCritical, "Rebuild failed", daos.Status(rs.Status).Error(),
+			fmt.Sprintf("dmg pool rebuild-status --history %s", pd.poolID))
+	case rs.State == daos.PoolRebuildStateBusy:
+		pd.resp.addFinding(PoolFindingInfo, "Rebuild is in progress",
+			fmt.Sprintf("%d objects and %d records rebuilt", rs.Objects, rs.Records),
+			fmt.Sprintf("dmg pool rebuild-status --watch %s", pd.poolID))
+	}
+}
+
+func (pd *poolDoctor) checkSpace() {
+	for _, ts := range pd.pool.TierStats {
+		if ts == nil || ts.Total == 0 {
+			continue
+		}
+
+		freePct := 100 * float64(ts.Free) / float64(ts.Total)
+		var sev PoolFindingSeverity
+		switch {
+		case freePct < poolDoctorSpaceCriticalPct:
+			sev = PoolFindingCritical
+		case freePct < poolDoctorSpaceWarnPct:
+			sev = PoolFindingWarning
+		default:
+			continue
+		}
+
+		pd.resp.addFinding(sev, fmt.Sprintf("%s tier is low on free space", strings.ToUpper(ts.MediaType.String())),
+			fmt.Sprintf("%.1f%% free", freePct),
+			fmt.Sprintf("dmg pool query --forecast %s", pd.poolID),
+			fmt.Sprintf("dmg pool extend %s --ranks <ranks>", pd.poolID))
+	}
+}
+
+func (pd *poolDoctor) checkUpgrade() {
+	pi := pd.pool
+	if pi.UpgradeLayoutVer > pi.PoolLayoutVer {
+		pd.resp.addFinding(PoolFindingInfo, "Pool layout can be upgraded",
+			fmt.Sprintf("layout version %d, latest %d", pi.PoolLayoutVer, pi.UpgradeLayoutVer),
+			fmt.Sprintf("dmg pool upgrade %s", pd.poolID))
+	}
+}
+
+// poolRanks returns all ranks that host the pool's targets.
+func (pd *poolDoctor) poolRanks() map[ranklist.Rank]bool {
+	ranks := make(map[ranklist.Rank]bool)
+	for _, rs := range []*ranklist.RankSet{pd.pool.EnabledRanks, pd.pool.DisabledRanks, pd.pool.DeadRanks} {
+		if rs == nil {
+			continue
+		}
+		for _, rank := range rs.Ranks() {
+			ranks[rank] = true
+		}
+	}
+	return ranks
+}
+
+func (pd *poolDoctor) checkDevices(ctx context.Context) {
+	resp, err := SmdQuery(ctx, pd.rpcClient, &SmdQueryReq{Rank: ranklist.NilRank})
+	if err != nil {
+		pd.resp.addFinding(PoolFindingWarning, "Unable to query storage devices", err.Error(),
+			"dmg storage query list-devices")
+		return
+	}
+	if resp.Errors() != nil {
+		pd.resp.addFinding(PoolFindingWarning, "Unable to query storage devices on some hosts",
+			resp.Errors().Error(), "dmg storage query list-devices")
+	}
+
+	ranks := pd.poolRanks()
+	for _, key := range resp.HostStorage.Keys() {
+		hss := resp.HostStorage[key]
+		if hss.HostStorage == nil || hss.HostStorage.SmdInfo == nil {
+			continue
+		}
+		host := hss.HostSet.String()
+
+		for _, dev := range hss.HostStorage.SmdInfo.Devices {
+			if !ranks[dev.Rank] {
+				continue
+			}
+			switch dev.Ctrlr.NvmeState {
+			case storage.NvmeStateFaulty:
+				pd.resp.addFinding(PoolFindingCritical,
+					fmt.Sprintf("NVMe device %s on rank %d is faulty", dev.UUID, dev.Rank),
+					fmt.Sprintf("host %s, targets %v", host, dev.TargetIDs),
+					fmt.Sprintf("dmg storage led identify --host-list %s %s", host, dev.UUID),
+					fmt.Sprintf("dmg storage replace nvme --host %s --old-uuid %s --new-uuid <new device UUID>",
+						host, dev.UUID))
+			case storage.NvmeStateUnplugged:
+				pd.resp.addFinding(PoolFindingCritical,
+					fmt.Sprintf("NVMe device %s on rank %d is unplugged", dev.UUID, dev.Rank),
+					fmt.Sprintf("host %s, targets %v", host, dev.TargetIDs),
+					fmt.Sprintf("dmg storage query list-devices --host-list %s --uuid %s", host, dev.UUID))
+			}
+		}
+	}
+}
+
+// PoolDoctor diagnoses the health of a pool by combining the results of pool,
+// target, system membership and storage device queries. It returns a list of
+// findings with suggested remediation commands, and an overall health score.
+// Only read-only queries are performed.
+func PoolDoctor(ctx context.Context, rpcClient UnaryInvoker, req *PoolDoctorReq) (*PoolDoctorResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.ID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	pqr, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{
+		ID: req.ID,
+		QueryMask: daos.MustNewPoolQueryMask(daos.PoolQueryOptionSpace, daos.PoolQueryOptionRebuild,
+			daos.PoolQueryOptionEnabledEngines, daos.PoolQueryOptionDisabledEngines,
+			daos.PoolQueryOptionDeadEngines),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pool query failed")
+	}
+	if pqr.Status != 0 {
+		return nil, errors.Wrap(daos.Status(pqr.Status), "pool query failed")
+	}
+
+	pd := &poolDoctor{
+		rpcClient: rpcClient,
+		resp: &PoolDoctorResp{
+			PoolUUID:  pqr.UUID,
+			PoolLabel: pqr.Label,
+			Findings:  []*PoolFinding{},
+		},
+		poolID: req.ID,
+		pool:   pqr,
+	}
+
+	pd.checkMembers(ctx)
+	pd.checkRanks(ctx)
+	pd.checkRebuild()
+	pd.checkSpace()
+	pd.checkUpgrade()
+	pd.checkDevices(ctx)
+
+	pd.resp.setScore()
+	return pd.resp, nil
+}
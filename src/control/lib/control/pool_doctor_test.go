@@ -0,0 +1,264 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_PoolDoctor(t *testing.T) {
+	member := func(rank uint32, state system.MemberState) *mgmtpb.SystemMember {
+		return &mgmtpb.SystemMember{
+			Rank:  rank,
+			Uuid:  test.MockUUID(int32(rank)),
+			State: state.String(),
+			Addr:  "10.0.0.1:10001",
+		}
+	}
+	healthyQueryResp := MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+		Uuid:          test.MockUUID(1),
+		Label:         "tank",
+		TotalTargets:  8,
+		ActiveTargets: 8,
+		TotalEngines:  2,
+		EnabledRanks:  "[0-1]",
+		TierStats: []*mgmtpb.StorageUsageStats{
+			{Total: 100, Free: 50, MediaType: mgmtpb.StorageMediaType_SCM},
+		},
+	})
+	healthySysResp := MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			member(0, system.MemberStateJoined),
+			member(1, system.MemberStateJoined),
+		},
+	})
+	smdResp := func(rankResps ...*ctlpb.SmdQueryResp_RankResp) *UnaryResponse {
+		return &UnaryResponse{
+			Responses: []*HostResponse{
+				{
+					Addr:    "host-0",
+					Message: &ctlpb.SmdQueryResp{Ranks: rankResps},
+				},
+			},
+		}
+	}
+	faultyDev := func(rank uint32, idx int32) *ctlpb.SmdQueryResp_RankResp {
+		return &ctlpb.SmdQueryResp_RankResp{
+			Rank: rank,
+			Devices: []*ctlpb.SmdDevice{
+				{
+					Uuid:   test.MockUUID(idx),
+					TgtIds: []int32{1, 2},
+					Ctrlr: &ctlpb.NvmeController{
+						PciAddr:  test.MockPCIAddr(idx),
+						DevState: ctlpb.NvmeDevState_EVICTED,
+					},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req     *PoolDoctorReq
+		uResps  []*UnaryResponse
+		cmpOpts []cmp.Option
+		expResp *PoolDoctorResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.PoolDoctorReq request"),
+		},
+		"no pool ID": {
+			req:    new(PoolDoctorReq),
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"pool query fails": {
+			req: &PoolDoctorReq{ID: "tank"},
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"pool query bad status": {
+			req: &PoolDoctorReq{ID: "tank"},
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Status: int32(daos.NoPermission),
+				}),
+			},
+			expErr: daos.NoPermission,
+		},
+		"healthy": {
+			req: &PoolDoctorReq{ID: "tank"},
+			uResps: []*UnaryResponse{
+				healthyQueryResp,
+				healthySysResp,
+				smdResp(),
+			},
+			expResp: &PoolDoctorResp{
+				PoolUUID:    test.MockPoolUUID(1),
+				PoolLabel:   "tank",
+				HealthScore: 100,
+				Findings:    []*PoolFinding{},
+			},
+		},
+		"queries fail": {
+			req: &PoolDoctorReq{ID: "tank"},
+			uResps: []*UnaryResponse{
+				healthyQueryResp,
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+				{
+					Responses: []*HostResponse{
+						{Addr: "host-0", Error: errors.New("host failed")},
+					},
+				},
+			},
+			cmpOpts: []cmp.Option{cmpopts.IgnoreFields(PoolFinding{}, "Details")},
+			expResp: &PoolDoctorResp{
+				PoolUUID:    test.MockPoolUUID(1),
+				PoolLabel:   "tank",
+				HealthScore: 80,
+				Findings: []*PoolFinding{
+					{
+						Severity:    PoolFindingWarning,
+						Summary:     "Unable to query system membership",
+						Remediation: []string{"dmg system query --verbose"},
+					},
+					{
+						Severity:    PoolFindingWarning,
+						Summary:     "Unable to query storage devices on some hosts",
+						Remediation: []string{"dmg storage query list-devices"},
+					},
+				},
+			},
+		},
+		"degraded": {
+			req: &PoolDoctorReq{ID: "tank"},
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Uuid:             test.MockUUID(1),
+					Label:            "tank",
+					TotalTargets:     16,
+					ActiveTargets:    10,
+					DisabledTargets:  6,
+					TotalEngines:     4,
+					EnabledRanks:     "[0-2]",
+					DisabledRanks:    "[1,3]",
+					DeadRanks:        "[3]",
+					PoolLayoutVer:    1,
+					UpgradeLayoutVer: 2,
+					Rebuild: &mgmtpb.PoolRebuildStatus{
+						State:   mgmtpb.PoolRebuildStatus_BUSY,
+						Objects: 10,
+						Records: 20,
+					},
+					TierStats: []*mgmtpb.StorageUsageStats{
+						{Total: 100, Free: 8, MediaType: mgmtpb.StorageMediaType_SCM},
+						{Total: 1000, Free: 500, MediaType: mgmtpb.StorageMediaType_NVME},
+					},
+				}),
+				MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+					Members: []*mgmtpb.SystemMember{
+						member(0, system.MemberStateJoined),
+						member(1, system.MemberStateJoined),
+						member(2, system.MemberStateJoined),
+						member(3, system.MemberStateExcluded),
+					},
+				}),
+				MockMSResponse("host1", nil, &mgmtpb.PoolQueryTargetResp{
+					Infos: []*mgmtpb.PoolQueryTargetInfo{
+						{State: mgmtpb.PoolQueryTargetInfo_UP_IN},
+						{State: mgmtpb.PoolQueryTargetInfo_DOWN_OUT},
+						{State: mgmtpb.PoolQueryTargetInfo_DOWN_OUT},
+						{State: mgmtpb.PoolQueryTargetInfo_UP_IN},
+					},
+				}),
+				smdResp(faultyDev(1, 5), faultyDev(7, 6)),
+			},
+			expResp: &PoolDoctorResp{
+				PoolUUID:    test.MockPoolUUID(1),
+				PoolLabel:   "tank",
+				HealthScore: 20,
+				Findings: []*PoolFinding{
+					{
+						Severity:    PoolFindingCritical,
+						Summary:     "Rank 3 is dead",
+						Details:     "engine state Excluded on 10.0.0.1",
+						Remediation: []string{"dmg system start --ranks 3"},
+					},
+					{
+						Severity: PoolFindingCritical,
+						Summary:  "NVMe device " + test.MockUUID(5) + " on rank 1 is faulty",
+						Details:  "host host-0, targets [1 2]",
+						Remediation: []string{
+							"dmg storage led identify --host-list host-0 " + test.MockUUID(5),
+							"dmg storage replace nvme --host host-0 --old-uuid " + test.MockUUID(5) +
+								" --new-uuid <new device UUID>",
+						},
+					},
+					{
+						Severity:    PoolFindingWarning,
+						Summary:     "Targets on rank 1 are disabled",
+						Details:     "engine state Joined on 10.0.0.1; down targets 1,2",
+						Remediation: []string{"dmg pool reintegrate tank --rank 1 --target-idx 1,2"},
+					},
+					{
+						Severity: PoolFindingWarning,
+						Summary:  "SCM tier is low on free space",
+						Details:  "8.0% free",
+						Remediation: []string{
+							"dmg pool query --forecast tank",
+							"dmg pool extend tank --ranks <ranks>",
+						},
+					},
+					{
+						Severity:    PoolFindingInfo,
+						Summary:     "Rebuild is in progress",
+						Details:     "10 objects and 20 records rebuilt",
+						Remediation: []string{"dmg pool rebuild-status --watch tank"},
+					},
+					{
+						Severity:    PoolFindingInfo,
+						Summary:     "Pool layout can be upgraded",
+						Details:     "layout version 1, latest 2",
+						Remediation: []string{"dmg pool upgrade tank"},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			gotResp, gotErr := PoolDoctor(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, tc.cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+type (
+	// PoolForecastReq contains the inputs for a request to forecast pool
+	// capacity. If no pool IDs are supplied, all pools are included.
+	PoolForecastReq struct {
+		unaryRequest
+		msRequest
+		IDs []string
+	}
+
+	// PoolTierForecast describes the growth of a pool storage tier.
+	PoolTierForecast struct {
+		MediaType daos.StorageMediaType `json:"media_type"`
+		Total     uint64                `json:"total"`
+		Free      uint64                `json:"free"`
+		// GrowthRate is the change in used bytes per day.
+		GrowthRate float64 `json:"growth_rate"`
+		// DaysUntilFull is negative if the tier usage is not growing.
+		DaysUntilFull float64 `json:"days_until_full"`
+	}
+
+	// PoolUsageForecast describes the capacity forecast for a pool.
+	PoolUsageForecast struct {
+		UUID       string `json:"uuid"`
+		Label      string `json:"label"`
+		NumSamples uint32 `json:"num_samples"`
+		// Period is the number of seconds covered by the usage samples.
+		Period int64               `json:"period"`
+		Tiers  []*PoolTierForecast `json:"tiers"`
+	}
+
+	// PoolForecastResp contains the results of a pool forecast request.
+	PoolForecastResp struct {
+		Forecasts []*PoolUsageForecast `json:"forecasts"`
+	}
+)
+
+// PoolForecast reports the usage growth rate and the projected number of
+// days until each storage tier is full for the requested pools. Forecasts are
+// calculated from usage samples taken periodically by the MS leader.
+func PoolForecast(ctx context.Context, rpcClient UnaryInvoker, req *PoolForecastReq) (*PoolForecastResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.PoolForecastReq{
+		Sys: req.getSystem(rpcClient),
+		Ids: req.IDs,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolForecast(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolForecast request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolForecastResp)
+	return resp, convertMSResponse(ur, resp)
+}
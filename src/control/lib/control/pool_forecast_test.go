@@ -0,0 +1,116 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_PoolForecast(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *PoolForecastReq
+		uErr    error
+		uResp   *UnaryResponse
+		expErr  error
+		expResp *PoolForecastResp
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.PoolForecastReq request"),
+		},
+		"local failure": {
+			req:    new(PoolForecastReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    new(PoolForecastReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolForecastReq{IDs: []string{test.MockUUID(1)}},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.PoolForecastResp{
+				Forecasts: []*mgmtpb.PoolForecast{
+					{
+						Uuid:       test.MockUUID(1),
+						Label:      "pool1",
+						NumSamples: 24,
+						Period:     82800,
+						Tiers: []*mgmtpb.PoolTierForecast{
+							{
+								MediaType:     mgmtpb.StorageMediaType_SCM,
+								Total:         1024,
+								Free:          512,
+								GrowthRate:    64,
+								DaysUntilFull: 8,
+							},
+							{
+								MediaType:     mgmtpb.StorageMediaType_NVME,
+								Total:         4096,
+								Free:          4096,
+								DaysUntilFull: -1,
+							},
+						},
+					},
+				},
+			}),
+			expResp: &PoolForecastResp{
+				Forecasts: []*PoolUsageForecast{
+					{
+						UUID:       test.MockUUID(1),
+						Label:      "pool1",
+						NumSamples: 24,
+						Period:     82800,
+						Tiers: []*PoolTierForecast{
+							{
+								MediaType:     daos.StorageMediaTypeScm,
+								Total:         1024,
+								Free:          512,
+								GrowthRate:    64,
+								DaysUntilFull: 8,
+							},
+							{
+								MediaType:     daos.StorageMediaTypeNvme,
+								Total:         4096,
+								Free:          4096,
+								DaysUntilFull: -1,
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := PoolForecast(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,542 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+var (
+	// Default to 6% SCM:94% NVMe when a manifest does not specify tier ratios.
+	defaultManifestTierRatios = []float64{6, 94}
+
+	// poolReadOnlyProps are reported by pools but cannot be set by the
+	// administrator, so they are never included in a manifest.
+	poolReadOnlyProps = map[string]bool{
+		"label":          true, // set from the manifest entry label
+		"global_version": true,
+		"upgrade_status": true,
+		"svc_list":       true,
+	}
+
+	// poolCreateOnlyProps can only be set when a pool is created.
+	poolCreateOnlyProps = map[string]bool{
+		"perf_domain": true,
+		"rd_fac":      true,
+		"ec_pda":      true,
+		"rp_pda":      true,
+	}
+)
+
+type (
+	// PoolManifestPool describes the desired state of a single pool. The
+	// size, tier ratio, ranks, service replica count and owner are only
+	// used when the pool is created.
+	PoolManifestPool struct {
+		Label      string            `yaml:"label" json:"label"`
+		Size       string            `yaml:"size,omitempty" json:"size,omitempty"`             // Total size or percentage of available storage
+		TierRatio  []float64         `yaml:"tier_ratio,omitempty" json:"tier_ratio,omitempty"` // Percentage of size per storage tier
+		Ranks      string            `yaml:"ranks,omitempty" json:"ranks,omitempty"`
+		NumSvcReps uint32            `yaml:"nsvc,omitempty" json:"nsvc,omitempty"`
+		User       string            `yaml:"user,omitempty" json:"user,omitempty"`
+		Group      string            `yaml:"group,omitempty" json:"group,omitempty"`
+		Properties map[string]string `yaml:"properties,omitempty" json:"properties,omitempty"`
+		ACL        []string          `yaml:"acl,omitempty" json:"acl,omitempty"`
+	}
+
+	// PoolManifest describes the desired state of a set of pools.
+	PoolManifest struct {
+		Pools []*PoolManifestPool `yaml:"pools" json:"pools"`
+	}
+)
+
+// properties returns the validated pool properties from the manifest entry.
+func (mp *PoolManifestPool) properties() ([]*daos.PoolProperty, error) {
+	propHdlrs := daos.PoolProperties()
+	deprecated := daos.PoolDeprecatedProperties()
+
+	names := make([]string, 0, len(mp.Properties))
+	for name := range mp.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	props := make([]*daos.PoolProperty, 0, len(names))
+	for _, name := range names {
+		key := name
+		if newKey, found := deprecated[key]; found {
+			key = newKey
+		}
+		if poolReadOnlyProps[key] {
+			return nil, errors.Errorf("property %q cannot be set in a manifest", name)
+		}
+
+		prop, err := propHdlrs.GetProperty(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := prop.SetValue(mp.Properties[name]); err != nil {
+			return nil, err
+		}
+		props = append(props, prop)
+	}
+
+	return props, nil
+}
+
+// createReq generates a pool create request from the manifest entry.
+func (mp *PoolManifestPool) createReq() (*PoolCreateReq, error) {
+	props, err := mp.properties()
+	if err != nil {
+		return nil, err
+	}
+	label, err := daos.PoolProperties().GetProperty("label")
+	if err != nil {
+		return nil, err
+	}
+	if err := label.SetValue(mp.Label); err != nil {
+		return nil, err
+	}
+
+	req := &PoolCreateReq{
+		User:       mp.User,
+		UserGroup:  mp.Group,
+		NumSvcReps: mp.NumSvcReps,
+		Properties: append(props, label),
+	}
+
+	if mp.Ranks != "" {
+		rs, err := ranklist.CreateRankSet(mp.Ranks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid ranks %q", mp.Ranks)
+		}
+		req.Ranks = rs.Ranks()
+	}
+	if len(mp.ACL) > 0 {
+		req.ACL = &AccessControlList{Entries: mp.ACL}
+	}
+
+	size := strings.TrimSpace(mp.Size)
+	if strings.HasSuffix(size, "%") {
+		if len(mp.TierRatio) > 0 {
+			return nil, errors.New("tier_ratio cannot be used with a percentage size")
+		}
+		ratio, err := strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(size, "%")), 10, 64)
+		if err != nil || ratio == 0 || ratio > 100 {
+			return nil, errors.Errorf("invalid size ratio %q: allowed range 0 < ratio <= 100", mp.Size)
+		}
+		availFrac := float64(ratio) / 100
+		req.TierRatio = []float64{availFrac, availFrac}
+
+		return req, nil
+	}
+
+	if req.TotalBytes, err = humanize.ParseBytes(size); err != nil {
+		return nil, errors.Wrapf(err, "invalid size %q", mp.Size)
+	}
+
+	ratios := mp.TierRatio
+	if len(ratios) == 0 {
+		ratios = defaultManifestTierRatios
+	}
+	if len(ratios) != 2 {
+		return nil, errors.Errorf("expected 2 tier ratios, got %d", len(ratios))
+	}
+	if math.Abs(ratios[0]+ratios[1]-100) > 1 {
+		return nil, errors.Errorf("tier ratios must add up to 100 (got %.2f)", ratios[0]+ratios[1])
+	}
+	for _, r := range ratios {
+		req.TierRatio = append(req.TierRatio, r/100)
+	}
+
+	return req, nil
+}
+
+// Validate checks that the manifest is well formed.
+func (pm *PoolManifest) Validate() error {
+	if pm == nil || len(pm.Pools) == 0 {
+		return errors.New("no pools in manifest")
+	}
+
+	seen := make(map[string]bool)
+	for i, mp := range pm.Pools {
+		if mp == nil || mp.Label == "" {
+			return errors.Errorf("pool %d in manifest has no label", i)
+		}
+		if seen[mp.Label] {
+			return errors.Errorf("duplicate pool %q in manifest", mp.Label)
+		}
+		seen[mp.Label] = true
+
+		if mp.Size == "" {
+			return errors.Errorf("pool %q: no size specified", mp.Label)
+		}
+		if _, err := mp.createReq(); err != nil {
+			return errors.Wrapf(err, "pool %q", mp.Label)
+		}
+	}
+
+	return nil
+}
+
+// ParsePoolManifest parses and validates a YAML pool manifest.
+func ParsePoolManifest(data []byte) (*PoolManifest, error) {
+	pm := new(PoolManifest)
+	if err := yaml.UnmarshalStrict(data, pm); err != nil {
+		return nil, errors.Wrap(err, "parsing pool manifest")
+	}
+
+	return pm, pm.Validate()
+}
+
+// ReadPoolManifest reads and validates a YAML pool manifest file.
+func ReadPoolManifest(path string) (*PoolManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pool manifest")
+	}
+
+	return ParsePoolManifest(data)
+}
+
+// PoolApplyAction identifies a change made to a pool by a manifest apply.
+type PoolApplyAction string
+
+// Changes that can be made to converge pools with a manifest.
+const (
+	PoolApplyActionCreate  PoolApplyAction = "create"
+	PoolApplyActionSetProp PoolApplyAction = "set-prop"
+	PoolApplyActionSetACL  PoolApplyAction = "set-acl"
+)
+
+type (
+	// PoolApplyChange describes a change to be made to a single pool.
+	PoolApplyChange struct {
+		Pool    string          `json:"pool"`
+		Action  PoolApplyAction `json:"action"`
+		Details []string        `json:"details"`
+
+		createReq *PoolCreateReq
+		props     []*daos.PoolProperty
+		acl       *AccessControlList
+	}
+
+	// PoolApplyPlan contains the changes needed to converge the pools in
+	// the system with a manifest, along with warnings about differences
+	// that cannot be converged.
+	PoolApplyPlan struct {
+		Changes  []*PoolApplyChange `json:"changes"`
+		Warnings []string           `json:"warnings,omitempty"`
+	}
+)
+
+func normalizePrincipal(name string) string {
+	if name != "" && !strings.Contains(name, "@") {
+		return name + "@"
+	}
+	return name
+}
+
+// planPoolChanges compares the manifest entry of an existing pool with its
+// current state and adds the differences to the plan.
+func planPoolChanges(plan *PoolApplyPlan, mp *PoolManifestPool, pool *daos.PoolInfo, curProps []*daos.PoolProperty, curACL *AccessControlList) error {
+	warnf := func(format string, args ...interface{}) {
+		plan.Warnings = append(plan.Warnings,
+			fmt.Sprintf("pool %s: ", mp.Label)+fmt.Sprintf(format, args...))
+	}
+
+	if mp.NumSvcReps != 0 && int(mp.NumSvcReps) != len(pool.ServiceReplicas) {
+		warnf("has %d service replicas, manifest specifies %d", len(pool.ServiceReplicas),
+			mp.NumSvcReps)
+	}
+	if curACL != nil {
+		if mp.User != "" && normalizePrincipal(mp.User) != curACL.Owner {
+			warnf("owner user is %s, manifest specifies %s", curACL.Owner, mp.User)
+		}
+		if mp.Group != "" && normalizePrincipal(mp.Group) != curACL.OwnerGroup {
+			warnf("owner group is %s, manifest specifies %s", curACL.OwnerGroup, mp.Group)
+		}
+	}
+
+	props, err := mp.properties()
+	if err != nil {
+		return err
+	}
+	curVals := make(map[string]string)
+	for _, prop := range curProps {
+		curVals[prop.Name] = prop.StringValue()
+	}
+
+	propChange := &PoolApplyChange{Pool: mp.Label, Action: PoolApplyActionSetProp}
+	for _, prop := range props {
+		curVal, found := curVals[prop.Name]
+		if found && curVal == prop.StringValue() {
+			continue
+		}
+		if poolCreateOnlyProps[prop.Name] {
+			warnf("%s is %s, manifest specifies %s (cannot be changed on an existing pool)",
+				prop.Name, curVal, prop.StringValue())
+			continue
+		}
+		if !found {
+			curVal = "unset"
+		}
+		propChange.Details = append(propChange.Details,
+			fmt.Sprintf("%s: %s -> %s", prop.Name, curVal, prop.StringValue()))
+		propChange.props = append(propChange.props, prop)
+	}
+	if len(propChange.props) > 0 {
+		plan.Changes = append(plan.Changes, propChange)
+	}
+
+	if mp.ACL == nil || curACL == nil {
+		return nil
+	}
+	curEntries := make(map[string]bool)
+	for _, ace := range curACL.Entries {
+		curEntries[ace] = true
+	}
+	wantEntries := make(map[string]bool)
+	aclChange := &PoolApplyChange{
+		Pool:   mp.Label,
+		Action: PoolApplyActionSetACL,
+		acl:    &AccessControlList{Entries: mp.ACL},
+	}
+	for _, ace := range mp.ACL {
+		wantEntries[ace] = true
+		if !curEntries[ace] {
+			aclChange.Details = append(aclChange.Details, "+"+ace)
+		}
+	}
+	for _, ace := range curACL.Entries {
+		if !wantEntries[ace] {
+			aclChange.Details = append(aclChange.Details, "-"+ace)
+		}
+	}
+	if len(aclChange.Details) > 0 {
+		plan.Changes = append(plan.Changes, aclChange)
+	}
+
+	return nil
+}
+
+// PlanPoolApply compares the pools in the manifest with the pools in the
+// system and returns the changes needed to converge them. Pools in the system
+// that are not in the manifest are left untouched.
+func PlanPoolApply(ctx context.Context, rpcClient UnaryInvoker, manifest *PoolManifest) (*PoolApplyPlan, error) {
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	lpr, err := ListPools(ctx, rpcClient, &ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return nil, err
+	}
+	pools := make(map[string]*daos.PoolInfo)
+	for _, p := range lpr.Pools {
+		if p.Label != "" {
+			pools[p.Label] = p
+		}
+	}
+
+	plan := &PoolApplyPlan{Changes: []*PoolApplyChange{}}
+	for _, mp := range manifest.Pools {
+		pool, exists := pools[mp.Label]
+		if !exists {
+			req, err := mp.createReq()
+			if err != nil {
+				return nil, errors.Wrapf(err, "pool %q", mp.Label)
+			}
+			details := []string{"size " + mp.Size}
+			if mp.NumSvcReps != 0 {
+				details = append(details, fmt.Sprintf("%d service replicas", mp.NumSvcReps))
+			}
+			for _, prop := range req.Properties {
+				if prop.Name != "label" {
+					details = append(details, prop.String())
+				}
+			}
+			plan.Changes = append(plan.Changes, &PoolApplyChange{
+				Pool:      mp.Label,
+				Action:    PoolApplyActionCreate,
+				Details:   details,
+				createReq: req,
+			})
+			continue
+		}
+
+		var curProps []*daos.PoolProperty
+		if len(mp.Properties) > 0 {
+			curProps, err = PoolGetProp(ctx, rpcClient, &PoolGetPropReq{ID: pool.UUID.String()})
+			if err != nil {
+				return nil, errors.Wrapf(err, "pool %q: get-prop failed", mp.Label)
+			}
+		}
+		var curACL *AccessControlList
+		if mp.ACL != nil || mp.User != "" || mp.Group != "" {
+			resp, err := PoolGetACL(ctx, rpcClient, &PoolGetACLReq{ID: pool.UUID.String()})
+			if err != nil {
+				return nil, errors.Wrapf(err, "pool %q: get-acl failed", mp.Label)
+			}
+			curACL = resp.ACL
+		}
+
+		if err := planPoolChanges(plan, mp, pool, curProps, curACL); err != nil {
+			return nil, errors.Wrapf(err, "pool %q", mp.Label)
+		}
+	}
+
+	return plan, nil
+}
+
+// PoolApply makes the changes in the supplied plan, stopping at the first
+// failure.
+func PoolApply(ctx context.Context, rpcClient UnaryInvoker, plan *PoolApplyPlan) error {
+	if plan == nil {
+		return errors.Errorf("nil %T", plan)
+	}
+
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case PoolApplyActionCreate:
+			_, err = PoolCreate(ctx, rpcClient, change.createReq)
+		case PoolApplyActionSetProp:
+			err = PoolSetProp(ctx, rpcClient, &PoolSetPropReq{
+				ID:         change.Pool,
+				Properties: change.props,
+			})
+		case PoolApplyActionSetACL:
+			_, err = PoolOverwriteACL(ctx, rpcClient, &PoolOverwriteACLReq{
+				ID:  change.Pool,
+				ACL: change.acl,
+			})
+		default:
+			err = errors.Errorf("unknown action %q", change.Action)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "pool %s: %s", change.Pool, change.Action)
+		}
+	}
+
+	return nil
+}
+
+// PoolManifestExportReq contains the inputs for a pool manifest export.
+type PoolManifestExportReq struct {
+	// Pools restricts the export to the pools with the given labels or
+	// UUIDs. All pools are exported if empty.
+	Pools []string
+}
+
+// manifestPoolFromInfo generates a manifest entry from the current state of a
+// pool.
+func manifestPoolFromInfo(pool *daos.PoolInfo, props []*daos.PoolProperty, acl *AccessControlList) *PoolManifestPool {
+	mp := &PoolManifestPool{
+		Label:      pool.Label,
+		NumSvcReps: uint32(len(pool.ServiceReplicas)),
+		Properties: make(map[string]string),
+	}
+
+	var total uint64
+	for _, ts := range pool.TierStats {
+		total += ts.Total
+	}
+	if total > 0 {
+		mp.Size = humanize.IBytes(total)
+		for _, ts := range pool.TierStats {
+			mp.TierRatio = append(mp.TierRatio,
+				math.Round(float64(ts.Total)*10000/float64(total))/100)
+		}
+	}
+	if pool.EnabledRanks != nil && pool.EnabledRanks.Count() > 0 {
+		mp.Ranks = pool.EnabledRanks.String()
+	}
+
+	for _, prop := range props {
+		if poolReadOnlyProps[prop.Name] || !prop.Value.IsSet() {
+			continue
+		}
+		mp.Properties[prop.Name] = prop.StringValue()
+	}
+
+	if acl != nil {
+		mp.User = acl.Owner
+		mp.Group = acl.OwnerGroup
+		mp.ACL = acl.Entries
+	}
+
+	return mp
+}
+
+// PoolManifestExport generates a manifest describing the current state of
+// the pools in the system.
+func PoolManifestExport(ctx context.Context, rpcClient UnaryInvoker, req *PoolManifestExportReq) (*PoolManifest, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	lpr, err := ListPools(ctx, rpcClient, new(ListPoolsReq))
+	if err != nil {
+		return nil, err
+	}
+	if err := lpr.Errors(); err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool)
+	for _, id := range req.Pools {
+		wanted[id] = true
+	}
+
+	manifest := &PoolManifest{Pools: []*PoolManifestPool{}}
+	for _, pool := range lpr.Pools {
+		if len(wanted) > 0 && !wanted[pool.Label] && !wanted[pool.UUID.String()] {
+			continue
+		}
+		if pool.Label == "" {
+			return nil, errors.Errorf("pool %s has no label and cannot be exported", pool.UUID)
+		}
+		delete(wanted, pool.Label)
+		delete(wanted, pool.UUID.String())
+
+		props, err := PoolGetProp(ctx, rpcClient, &PoolGetPropReq{ID: pool.UUID.String()})
+		if err != nil {
+			return nil, errors.Wrapf(err, "pool %q: get-prop failed", pool.Label)
+		}
+		aclResp, err := PoolGetACL(ctx, rpcClient, &PoolGetACLReq{ID: pool.UUID.String()})
+		if err != nil {
+			return nil, errors.Wrapf(err, "pool %q: get-acl failed", pool.Label)
+		}
+
+		manifest.Pools = append(manifest.Pools, manifestPoolFromInfo(pool, props, aclResp.ACL))
+	}
+
+	if len(wanted) > 0 {
+		missing := make([]string, 0, len(wanted))
+		for id := range wanted {
+			missing = append(missing, id)
+		}
+		sort.Strings(missing)
+		return nil, errors.Errorf("unknown pool(s): %s", strings.Join(missing, ", "))
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,464 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_ParsePoolManifest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input  string
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("no pools"),
+		},
+		"unknown field": {
+			input: `
+pools:
+- label: tank
+  size: 1TB
+  color: blue
+`,
+			expErr: errors.New("field color not found"),
+		},
+		"missing label": {
+			input: `
+pools:
+- size: 1TB
+`,
+			expErr: errors.New("has no label"),
+		},
+		"duplicate label": {
+			input: `
+pools:
+- label: tank
+  size: 1TB
+- label: tank
+  size: 2TB
+`,
+			expErr: errors.New("duplicate pool"),
+		},
+		"missing size": {
+			input: `
+pools:
+- label: tank
+`,
+			expErr: errors.New("no size"),
+		},
+		"invalid size": {
+			input: `
+pools:
+- label: tank
+  size: lots
+`,
+			expErr: errors.New("invalid size"),
+		},
+		"invalid size ratio": {
+			input: `
+pools:
+- label: tank
+  size: 120%
+`,
+			expErr: errors.New("invalid size ratio"),
+		},
+		"tier ratio with size ratio": {
+			input: `
+pools:
+- label: tank
+  size: 50%
+  tier_ratio: [6, 94]
+`,
+			expErr: errors.New("cannot be used with a percentage"),
+		},
+		"bad tier ratio sum": {
+			input: `
+pools:
+- label: tank
+  size: 1TB
+  tier_ratio: [6, 90]
+`,
+			expErr: errors.New("must add up to 100"),
+		},
+		"unknown property": {
+			input: `
+pools:
+- label: tank
+  size: 1TB
+  properties:
+    quack: yes
+`,
+			expErr: errors.New("unknown property"),
+		},
+		"read-only property": {
+			input: `
+pools:
+- label: tank
+  size: 1TB
+  properties:
+    svc_list: 0-2
+`,
+			expErr: errors.New("cannot be set in a manifest"),
+		},
+		"invalid property value": {
+			input: `
+pools:
+- label: tank
+  size: 1TB
+  properties:
+    reclaim: sometimes
+`,
+			expErr: errors.New("invalid value"),
+		},
+		"invalid ranks": {
+			input: `
+pools:
+- label: tank
+  size: 1TB
+  ranks: a-b
+`,
+			expErr: errors.New("invalid ranks"),
+		},
+		"valid": {
+			input: `
+pools:
+- label: tank
+  size: 10TB
+  tier_ratio: [3, 97]
+  ranks: 0-3
+  nsvc: 3
+  properties:
+    rf: 1
+    reclaim: lazy
+  acl:
+  - A::OWNER@:rw
+- label: scratch
+  size: 50%
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := ParsePoolManifest([]byte(tc.input))
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_PoolManifestPool_createReq(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mp      *PoolManifestPool
+		expReq  *PoolCreateReq
+		expErr  error
+		expProp []string
+	}{
+		"total size with default tier ratio": {
+			mp: &PoolManifestPool{
+				Label:      "tank",
+				Size:       "1TB",
+				NumSvcReps: 3,
+				Ranks:      "0-1",
+				User:       "bob",
+			},
+			expReq: &PoolCreateReq{
+				User:       "bob",
+				NumSvcReps: 3,
+				TotalBytes: 1000000000000,
+				TierRatio:  []float64{0.06, 0.94},
+				Ranks:      []ranklist.Rank{0, 1},
+			},
+			expProp: []string{"label:tank"},
+		},
+		"percentage size": {
+			mp: &PoolManifestPool{
+				Label:      "tank",
+				Size:       "50%",
+				Properties: map[string]string{"reclaim": "time"},
+				ACL:        []string{"A::OWNER@:rw"},
+			},
+			expReq: &PoolCreateReq{
+				TierRatio: []float64{0.5, 0.5},
+				ACL:       &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			},
+			expProp: []string{"reclaim:time", "label:tank"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotReq, gotErr := tc.mp.createReq()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotProps []string
+			for _, prop := range gotReq.Properties {
+				gotProps = append(gotProps, prop.String())
+			}
+			if diff := cmp.Diff(tc.expProp, gotProps); diff != "" {
+				t.Fatalf("unexpected properties (-want, +got):\n%s\n", diff)
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(PoolCreateReq{}),
+				cmpopts.IgnoreFields(PoolCreateReq{}, "Properties"),
+			}
+			if diff := cmp.Diff(tc.expReq, gotReq, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected request (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_planPoolChanges(t *testing.T) {
+	pool := &daos.PoolInfo{
+		UUID:            test.MockPoolUUID(1),
+		Label:           "tank",
+		ServiceReplicas: []ranklist.Rank{0, 1, 2},
+	}
+
+	for name, tc := range map[string]struct {
+		mp      *PoolManifestPool
+		props   []*daos.PoolProperty
+		acl     *AccessControlList
+		expPlan *PoolApplyPlan
+	}{
+		"no changes": {
+			mp: &PoolManifestPool{
+				Label:      "tank",
+				Size:       "1TB",
+				NumSvcReps: 3,
+				User:       "owner",
+				Properties: map[string]string{"reclaim": "lazy"},
+				ACL:        MockACL.Entries,
+			},
+			props:   []*daos.PoolProperty{propWithVal("reclaim", "lazy")},
+			acl:     MockACL,
+			expPlan: &PoolApplyPlan{},
+		},
+		"property and acl changes": {
+			mp: &PoolManifestPool{
+				Label: "tank",
+				Size:  "1TB",
+				Properties: map[string]string{
+					"reclaim":  "time",
+					"space_rb": "5",
+				},
+				ACL: []string{"A::OWNER@:rw", "A::bob@:r"},
+			},
+			props: []*daos.PoolProperty{
+				propWithVal("reclaim", "lazy"),
+				propWithVal("space_rb", "5"),
+			},
+			acl: MockACL,
+			expPlan: &PoolApplyPlan{
+				Changes: []*PoolApplyChange{
+					{
+						Pool:    "tank",
+						Action:  PoolApplyActionSetProp,
+						Details: []string{"reclaim: lazy -> time"},
+					},
+					{
+						Pool:    "tank",
+						Action:  PoolApplyActionSetACL,
+						Details: []string{"+A::bob@:r", "-A:G:GROUP@:rw"},
+					},
+				},
+			},
+		},
+		"unchangeable differences": {
+			mp: &PoolManifestPool{
+				Label:      "tank",
+				Size:       "1TB",
+				NumSvcReps: 5,
+				Group:      "admins",
+				Properties: map[string]string{"rd_fac": "2"},
+			},
+			props: []*daos.PoolProperty{propWithVal("rd_fac", "1")},
+			acl:   MockACL,
+			expPlan: &PoolApplyPlan{
+				Warnings: []string{
+					"pool tank: has 3 service replicas, manifest specifies 5",
+					"pool tank: owner group is group@, manifest specifies admins",
+					"pool tank: rd_fac is 1, manifest specifies 2 (cannot be changed on an existing pool)",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotPlan := new(PoolApplyPlan)
+			if err := planPoolChanges(gotPlan, tc.mp, pool, tc.props, tc.acl); err != nil {
+				t.Fatal(err)
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(PoolApplyChange{}),
+				cmpopts.EquateEmpty(),
+			}
+			if diff := cmp.Diff(tc.expPlan, gotPlan, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected plan (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PlanPoolApply(t *testing.T) {
+	manifest := &PoolManifest{
+		Pools: []*PoolManifestPool{
+			{
+				Label:      "tank",
+				Size:       "1TB",
+				Properties: map[string]string{"reclaim": "time"},
+			},
+			{
+				Label: "scratch",
+				Size:  "10%",
+			},
+		},
+	}
+	listResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{
+				Uuid:    test.MockUUID(1),
+				Label:   "tank",
+				SvcReps: []uint32{0},
+				State:   daos.PoolServiceStateReady.String(),
+			},
+		},
+	})
+	propResp := MockMSResponse("host1", nil, &mgmtpb.PoolGetPropResp{
+		Properties: []*mgmtpb.PoolProperty{
+			{
+				Number: daos.PoolPropertySpaceReclaim,
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: daos.PoolSpaceReclaimLazy},
+			},
+		},
+	})
+
+	for name, tc := range map[string]struct {
+		manifest *PoolManifest
+		uResps   []*UnaryResponse
+		expPlan  *PoolApplyPlan
+		expErr   error
+	}{
+		"invalid manifest": {
+			manifest: &PoolManifest{},
+			expErr:   errors.New("no pools"),
+		},
+		"list pools fails": {
+			manifest: manifest,
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"get-prop fails": {
+			manifest: manifest,
+			uResps: []*UnaryResponse{
+				listResp,
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("get-prop failed"),
+		},
+		"create and update": {
+			manifest: manifest,
+			uResps:   []*UnaryResponse{listResp, propResp},
+			expPlan: &PoolApplyPlan{
+				Changes: []*PoolApplyChange{
+					{
+						Pool:    "tank",
+						Action:  PoolApplyActionSetProp,
+						Details: []string{"reclaim: lazy -> time"},
+					},
+					{
+						Pool:    "scratch",
+						Action:  PoolApplyActionCreate,
+						Details: []string{"size 10%"},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			gotPlan, gotErr := PlanPoolApply(test.Context(t), mi, tc.manifest)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(PoolApplyChange{}),
+				cmpopts.EquateEmpty(),
+			}
+			if diff := cmp.Diff(tc.expPlan, gotPlan, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected plan (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_manifestPoolFromInfo(t *testing.T) {
+	pool := &daos.PoolInfo{
+		UUID:            test.MockPoolUUID(1),
+		Label:           "tank",
+		ServiceReplicas: []ranklist.Rank{0, 1, 2},
+		TierStats: []*daos.StorageUsageStats{
+			{Total: 6 * humanize.GiByte},
+			{Total: 94 * humanize.GiByte},
+		},
+	}
+	props := []*daos.PoolProperty{
+		propWithVal("label", "tank"),
+		propWithVal("reclaim", "lazy"),
+		propWithVal("rd_fac", "1"),
+		propWithVal("upgrade_status", ""),
+	}
+
+	expPool := &PoolManifestPool{
+		Label:      "tank",
+		Size:       "100 GiB",
+		TierRatio:  []float64{6, 94},
+		NumSvcReps: 3,
+		User:       MockACL.Owner,
+		Group:      MockACL.OwnerGroup,
+		Properties: map[string]string{
+			"reclaim": "lazy",
+			"rd_fac":  "1",
+		},
+		ACL: MockACL.Entries,
+	}
+
+	gotPool := manifestPoolFromInfo(pool, props, MockACL)
+	if diff := cmp.Diff(expPool, gotPool); diff != "" {
+		t.Fatalf("unexpected manifest pool (-want, +got):\n%s\n", diff)
+	}
+
+	// An exported pool must re-apply without changes.
+	plan := new(PoolApplyPlan)
+	if err := planPoolChanges(plan, gotPool, pool, props, MockACL); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(plan.Changes), "unexpected changes")
+	test.AssertEqual(t, 0, len(plan.Warnings), "unexpected warnings")
+}
@@ -0,0 +1,240 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+const defaultRebuildWatchInterval = 5 * time.Second
+
+type (
+	// PoolRebuildHistoryReq contains the inputs for a pool rebuild history
+	// request.
+	PoolRebuildHistoryReq struct {
+		unaryRequest
+		msRequest
+		ID string
+	}
+
+	// PoolRebuildRecord describes a single rebuild of a pool. Started is
+	// nil if the start of the rebuild was not recorded and Finished is nil
+	// if the rebuild has not finished.
+	PoolRebuildRecord struct {
+		Operation  string     `json:"operation"`
+		MapVersion uint32     `json:"map_version"`
+		Started    *time.Time `json:"started,omitempty"`
+		Finished   *time.Time `json:"finished,omitempty"`
+		Failed     bool       `json:"failed"`
+		Message    string     `json:"message,omitempty"`
+	}
+
+	// PoolRebuildHistoryResp contains the rebuild history of a pool,
+	// oldest first.
+	PoolRebuildHistoryResp struct {
+		Records []*PoolRebuildRecord `json:"records"`
+	}
+
+	// PoolRebuildWatchReq contains the inputs for a request to watch the
+	// rebuild progress of a pool.
+	PoolRebuildWatchReq struct {
+		ID string
+		// PollInterval is the interval between pool queries.
+		PollInterval time.Duration
+		// Handler is called with the rebuild progress after each query.
+		Handler func(*PoolRebuildProgress)
+	}
+
+	// PoolRebuildProgress describes the progress of a pool rebuild, as
+	// calculated from successive pool queries.
+	PoolRebuildProgress struct {
+		Time    time.Time               `json:"time"`
+		Rebuild *daos.PoolRebuildStatus `json:"rebuild"`
+		// ObjectRate is the number of objects rebuilt per second.
+		ObjectRate float64 `json:"object_rate"`
+		// RecordRate is the number of records rebuilt per second.
+		RecordRate float64 `json:"record_rate"`
+		// PercentDone is negative if the number of objects to be rebuilt
+		// is unknown.
+		PercentDone float64 `json:"percent_done"`
+		// ETA is the estimated time until the rebuild completes and is
+		// negative if it cannot be estimated.
+		ETA time.Duration `json:"eta"`
+	}
+)
+
+// Duration returns the duration of a finished rebuild, or zero if either the
+// start or finish time is unknown.
+func (prr *PoolRebuildRecord) Duration() time.Duration {
+	if prr.Started == nil || prr.Finished == nil {
+		return 0
+	}
+	return prr.Finished.Sub(*prr.Started)
+}
+
+func timeFromUnix(secs int64) *time.Time {
+	if secs == 0 {
+		return nil
+	}
+	t := time.Unix(secs, 0)
+	return &t
+}
+
+// PoolRebuildHistory returns the rebuild history of a pool, as recorded by
+// the MS from pool rebuild RAS events.
+func PoolRebuildHistory(ctx context.Context, rpcClient UnaryInvoker, req *PoolRebuildHistoryReq) (*PoolRebuildHistoryResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.ID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	pbReq := &mgmtpb.PoolRebuildHistoryReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolRebuildHistory(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolRebuildHistory request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "pool rebuild history failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.PoolRebuildHistoryResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msResp)
+	}
+
+	resp := &PoolRebuildHistoryResp{
+		Records: make([]*PoolRebuildRecord, 0, len(pbResp.GetRecords())),
+	}
+	for _, pbRec := range pbResp.GetRecords() {
+		resp.Records = append(resp.Records, &PoolRebuildRecord{
+			Operation:  pbRec.GetOperation(),
+			MapVersion: pbRec.GetMapVersion(),
+			Started:    timeFromUnix(pbRec.GetStarted()),
+			Finished:   timeFromUnix(pbRec.GetFinished()),
+			Failed:     pbRec.GetFailed(),
+			Message:    pbRec.GetMessage(),
+		})
+	}
+
+	return resp, nil
+}
+
+// PoolRebuildTracker calculates the progress of a pool rebuild from
+// successive rebuild status samples. Rates are averaged over the samples
+// taken since the rebuild was first seen in progress.
+type PoolRebuildTracker struct {
+	startTime time.Time
+	start     *daos.PoolRebuildStatus
+}
+
+// Update calculates the rebuild progress from the rebuild status sampled at
+// the supplied time.
+func (prt *PoolRebuildTracker) Update(now time.Time, rs *daos.PoolRebuildStatus) *PoolRebuildProgress {
+	prog := &PoolRebuildProgress{
+		Time:        now,
+		Rebuild:     rs,
+		PercentDone: -1,
+		ETA:         -1,
+	}
+	if rs == nil {
+		prt.start = nil
+		return prog
+	}
+
+	switch {
+	case rs.State == daos.PoolRebuildStateDone:
+		prog.PercentDone = 100
+		prog.ETA = 0
+	case rs.TotalObjects > 0:
+		prog.PercentDone = 100 * float64(rs.Objects) / float64(rs.TotalObjects)
+		if prog.PercentDone > 100 {
+			prog.PercentDone = 100
+		}
+	}
+
+	if rs.State != daos.PoolRebuildStateBusy || rs.Status != 0 {
+		prt.start = nil
+		return prog
+	}
+
+	// Restart the measurement if this is a new rebuild.
+	if prt.start == nil || rs.Objects < prt.start.Objects || rs.Records < prt.start.Records {
+		prt.startTime = now
+		prt.start = rs
+		return prog
+	}
+
+	elapsed := now.Sub(prt.startTime).Seconds()
+	if elapsed <= 0 {
+		return prog
+	}
+	prog.ObjectRate = float64(rs.Objects-prt.start.Objects) / elapsed
+	prog.RecordRate = float64(rs.Records-prt.start.Records) / elapsed
+
+	if prog.ObjectRate > 0 && rs.TotalObjects >= rs.Objects {
+		remaining := float64(rs.TotalObjects-rs.Objects) / prog.ObjectRate
+		prog.ETA = time.Duration(remaining * float64(time.Second)).Round(time.Second)
+	}
+
+	return prog
+}
+
+// PoolRebuildWatch queries the pool periodically and reports the rebuild
+// progress to the request handler until the rebuild is no longer in progress
+// or the context is canceled.
+func PoolRebuildWatch(ctx context.Context, rpcClient UnaryInvoker, req *PoolRebuildWatchReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.ID == "" {
+		return errors.New("no pool label or UUID specified")
+	}
+	if req.Handler == nil {
+		return errors.New("nil rebuild progress handler")
+	}
+	interval := req.PollInterval
+	if interval == 0 {
+		interval = defaultRebuildWatchInterval
+	}
+
+	tracker := new(PoolRebuildTracker)
+	return pollUntil(ctx, interval, 0, "pool rebuild to complete", func() (bool, error) {
+		resp, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{
+			ID:        req.ID,
+			QueryMask: daos.HealthOnlyPoolQueryMask,
+		})
+		if err != nil {
+			return false, err
+		}
+		if resp.Rebuild == nil {
+			return false, errors.New("pool query returned no rebuild status")
+		}
+
+		req.Handler(tracker.Update(time.Now(), resp.Rebuild))
+		return resp.Rebuild.State != daos.PoolRebuildStateBusy, nil
+	})
+}
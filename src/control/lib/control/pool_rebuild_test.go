@@ -0,0 +1,322 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_PoolRebuildHistory(t *testing.T) {
+	started := time.Unix(1735689600, 0)
+	finished := started.Add(time.Hour)
+
+	for name, tc := range map[string]struct {
+		req     *PoolRebuildHistoryReq
+		uErr    error
+		uResp   *UnaryResponse
+		expErr  error
+		expResp *PoolRebuildHistoryResp
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.PoolRebuildHistoryReq request"),
+		},
+		"no pool ID": {
+			req:    new(PoolRebuildHistoryReq),
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"local failure": {
+			req:    &PoolRebuildHistoryReq{ID: "pool1"},
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    &PoolRebuildHistoryReq{ID: "pool1"},
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolRebuildHistoryReq{ID: "pool1"},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.PoolRebuildHistoryResp{
+				Records: []*mgmtpb.PoolRebuildRecord{
+					{
+						Operation:  "Rebuild",
+						MapVersion: 4,
+						Started:    started.Unix(),
+						Finished:   finished.Unix(),
+					},
+					{
+						Operation:  "Rebuild",
+						MapVersion: 6,
+						Finished:   finished.Unix(),
+						Failed:     true,
+						Message:    "Pool rebuild failed",
+					},
+					{
+						Operation:  "Reclaim",
+						MapVersion: 7,
+						Started:    started.Unix(),
+					},
+				},
+			}),
+			expResp: &PoolRebuildHistoryResp{
+				Records: []*PoolRebuildRecord{
+					{
+						Operation:  "Rebuild",
+						MapVersion: 4,
+						Started:    &started,
+						Finished:   &finished,
+					},
+					{
+						Operation:  "Rebuild",
+						MapVersion: 6,
+						Finished:   &finished,
+						Failed:     true,
+						Message:    "Pool rebuild failed",
+					},
+					{
+						Operation:  "Reclaim",
+						MapVersion: 7,
+						Started:    &started,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := PoolRebuildHistory(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolRebuildTracker(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	busy := func(objects, records, total uint64) *daos.PoolRebuildStatus {
+		return &daos.PoolRebuildStatus{
+			State:        daos.PoolRebuildStateBusy,
+			Objects:      objects,
+			Records:      records,
+			TotalObjects: total,
+		}
+	}
+	type sample struct {
+		offset time.Duration
+		status *daos.PoolRebuildStatus
+	}
+
+	for name, tc := range map[string]struct {
+		samples []sample
+		expProg *PoolRebuildProgress
+	}{
+		"no rebuild status": {
+			samples: []sample{
+				{0, nil},
+			},
+			expProg: &PoolRebuildProgress{
+				PercentDone: -1,
+				ETA:         -1,
+			},
+		},
+		"idle": {
+			samples: []sample{
+				{0, &daos.PoolRebuildStatus{State: daos.PoolRebuildStateIdle}},
+			},
+			expProg: &PoolRebuildProgress{
+				Rebuild:     &daos.PoolRebuildStatus{State: daos.PoolRebuildStateIdle},
+				PercentDone: -1,
+				ETA:         -1,
+			},
+		},
+		"done": {
+			samples: []sample{
+				{0, busy(10, 100, 100)},
+				{time.Minute, &daos.PoolRebuildStatus{State: daos.PoolRebuildStateDone}},
+			},
+			expProg: &PoolRebuildProgress{
+				Rebuild:     &daos.PoolRebuildStatus{State: daos.PoolRebuildStateDone},
+				PercentDone: 100,
+			},
+		},
+		"first busy sample": {
+			samples: []sample{
+				{0, busy(10, 100, 100)},
+			},
+			expProg: &PoolRebuildProgress{
+				Rebuild:     busy(10, 100, 100),
+				PercentDone: 10,
+				ETA:         -1,
+			},
+		},
+		"rates and eta": {
+			samples: []sample{
+				{0, busy(10, 100, 100)},
+				{5 * time.Second, busy(20, 600, 100)},
+				{10 * time.Second, busy(30, 1100, 100)},
+			},
+			expProg: &PoolRebuildProgress{
+				Rebuild:     busy(30, 1100, 100),
+				ObjectRate:  2,
+				RecordRate:  100,
+				PercentDone: 30,
+				ETA:         35 * time.Second,
+			},
+		},
+		"total unknown": {
+			samples: []sample{
+				{0, busy(10, 100, 0)},
+				{10 * time.Second, busy(30, 1100, 0)},
+			},
+			expProg: &PoolRebuildProgress{
+				Rebuild:     busy(30, 1100, 0),
+				ObjectRate:  2,
+				RecordRate:  100,
+				PercentDone: -1,
+				ETA:         -1,
+			},
+		},
+		"new rebuild restarts measurement": {
+			samples: []sample{
+				{0, busy(10, 100, 100)},
+				{10 * time.Second, busy(90, 900, 100)},
+				{20 * time.Second, busy(5, 50, 50)},
+			},
+			expProg: &PoolRebuildProgress{
+				Rebuild:     busy(5, 50, 50),
+				PercentDone: 10,
+				ETA:         -1,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tracker := new(PoolRebuildTracker)
+
+			var gotProg *PoolRebuildProgress
+			for _, s := range tc.samples {
+				gotProg = tracker.Update(start.Add(s.offset), s.status)
+			}
+			tc.expProg.Time = start.Add(tc.samples[len(tc.samples)-1].offset)
+
+			if diff := cmp.Diff(tc.expProg, gotProg); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolRebuildWatch(t *testing.T) {
+	queryResp := func(state mgmtpb.PoolRebuildStatus_State, objects uint64) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid: test.MockUUID(1),
+			Rebuild: &mgmtpb.PoolRebuildStatus{
+				State:        state,
+				Objects:      objects,
+				TotalObjects: 100,
+			},
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		req       *PoolRebuildWatchReq
+		noHandler bool
+		uResps    []*UnaryResponse
+		expStates []daos.PoolRebuildState
+		expErr    error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.PoolRebuildWatchReq request"),
+		},
+		"no pool ID": {
+			req:    new(PoolRebuildWatchReq),
+			expErr: errors.New("no pool label or UUID"),
+		},
+		"no handler": {
+			req:       &PoolRebuildWatchReq{ID: "pool1"},
+			noHandler: true,
+			expErr:    errors.New("nil rebuild progress handler"),
+		},
+		"query fails": {
+			req: &PoolRebuildWatchReq{ID: "pool1"},
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"no rebuild in progress": {
+			req: &PoolRebuildWatchReq{ID: "pool1"},
+			uResps: []*UnaryResponse{
+				queryResp(mgmtpb.PoolRebuildStatus_IDLE, 0),
+			},
+			expStates: []daos.PoolRebuildState{daos.PoolRebuildStateIdle},
+		},
+		"rebuild completes": {
+			req: &PoolRebuildWatchReq{ID: "pool1"},
+			uResps: []*UnaryResponse{
+				queryResp(mgmtpb.PoolRebuildStatus_BUSY, 10),
+				queryResp(mgmtpb.PoolRebuildStatus_BUSY, 50),
+				queryResp(mgmtpb.PoolRebuildStatus_DONE, 100),
+			},
+			expStates: []daos.PoolRebuildState{
+				daos.PoolRebuildStateBusy,
+				daos.PoolRebuildStateBusy,
+				daos.PoolRebuildStateDone,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			var gotStates []daos.PoolRebuildState
+			if tc.req != nil {
+				tc.req.PollInterval = time.Millisecond
+				if !tc.noHandler {
+					tc.req.Handler = func(prog *PoolRebuildProgress) {
+						gotStates = append(gotStates, prog.Rebuild.State)
+					}
+				}
+			}
+
+			gotErr := PoolRebuildWatch(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expStates, gotStates); diff != "" {
+				t.Fatalf("unexpected rebuild states (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
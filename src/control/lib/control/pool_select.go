@@ -0,0 +1,229 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// maxPoolOpConcurrency is the maximum number of pool operations that will be
+// in flight at once when an operation is applied to a set of pools.
+const maxPoolOpConcurrency = 16
+
+type (
+	// PoolSelectReq contains the criteria used to select a set of pools.
+	// Unless All is set, a pool is selected only if it matches every
+	// criterion that has been specified.
+	PoolSelectReq struct {
+		// All selects every pool in the system.
+		All bool
+		// LabelGlob selects pools with labels matching the glob pattern.
+		LabelGlob string
+		// Owner selects pools owned by the user principal.
+		Owner string
+		// Ranks selects pools with storage on any of the ranks.
+		Ranks *ranklist.RankSet
+	}
+
+	// PoolOpResult contains the result of an operation on a single pool.
+	PoolOpResult struct {
+		PoolUUID  uuid.UUID `json:"uuid"`
+		PoolLabel string    `json:"label,omitempty"`
+		Error     string    `json:"error,omitempty"`
+	}
+
+	// PoolOpResp contains the results of an operation applied to a set of
+	// pools, in the order in which the pools were supplied.
+	PoolOpResp struct {
+		Results []*PoolOpResult `json:"results"`
+	}
+)
+
+func (req *PoolSelectReq) hasRanks() bool {
+	return req.Ranks != nil && req.Ranks.Count() > 0
+}
+
+// IsSet returns true if any selection criteria have been specified.
+func (req *PoolSelectReq) IsSet() bool {
+	if req == nil {
+		return false
+	}
+	return req.All || req.LabelGlob != "" || req.Owner != "" || req.hasRanks()
+}
+
+func (req *PoolSelectReq) validate() error {
+	if !req.IsSet() {
+		return errors.New("no pool selection criteria specified")
+	}
+	if req.All && (req.LabelGlob != "" || req.Owner != "" || req.hasRanks()) {
+		return errors.New("all pools selection may not be combined with other selection criteria")
+	}
+	if req.LabelGlob != "" {
+		if _, err := path.Match(req.LabelGlob, ""); err != nil {
+			return errors.Wrapf(err, "invalid label glob %q", req.LabelGlob)
+		}
+	}
+
+	return nil
+}
+
+// ownerPrincipal returns the owner in the principal format used in pool ACLs.
+func ownerPrincipal(owner string) string {
+	if owner != "" && !strings.Contains(owner, "@") {
+		return owner + "@"
+	}
+	return owner
+}
+
+// poolOnRanks returns true if the pool has storage on any of the given ranks.
+func poolOnRanks(ctx context.Context, rpcClient UnaryInvoker, pool *daos.PoolInfo, ranks *ranklist.RankSet) (bool, error) {
+	resp, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{
+		ID: pool.UUID.String(),
+		QueryMask: daos.MustNewPoolQueryMask(daos.PoolQueryOptionEnabledEngines,
+			daos.PoolQueryOptionDisabledEngines, daos.PoolQueryOptionDeadEngines),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	selected := make(map[ranklist.Rank]struct{})
+	for _, rank := range ranks.Ranks() {
+		selected[rank] = struct{}{}
+	}
+	for _, rs := range []*ranklist.RankSet{resp.EnabledRanks, resp.DisabledRanks, resp.DeadRanks} {
+		if rs == nil {
+			continue
+		}
+		for _, rank := range rs.Ranks() {
+			if _, found := selected[rank]; found {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// SelectPools returns the pools in the system that match the selection
+// criteria. Only pools that are ready are considered, as operations on pools
+// that are being created or destroyed would fail.
+func SelectPools(ctx context.Context, rpcClient UnaryInvoker, req *PoolSelectReq) ([]*daos.PoolInfo, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	lpr, err := ListPools(ctx, rpcClient, &ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return nil, err
+	}
+
+	owner := ownerPrincipal(req.Owner)
+	selected := []*daos.PoolInfo{}
+	for _, pool := range lpr.Pools {
+		if pool.State != daos.PoolServiceStateReady {
+			rpcClient.Debugf("skipping selection of pool %s in state %s", pool.UUID, pool.State)
+			continue
+		}
+
+		if req.LabelGlob != "" {
+			// The pattern has already been validated.
+			if matched, _ := path.Match(req.LabelGlob, pool.Label); !matched {
+				continue
+			}
+		}
+
+		if owner != "" {
+			aclResp, err := PoolGetACL(ctx, rpcClient, &PoolGetACLReq{ID: pool.UUID.String()})
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get owner of pool %s", pool.UUID)
+			}
+			if aclResp.ACL == nil || aclResp.ACL.Owner != owner {
+				continue
+			}
+		}
+
+		if req.hasRanks() {
+			onRanks, err := poolOnRanks(ctx, rpcClient, pool, req.Ranks)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get ranks of pool %s", pool.UUID)
+			}
+			if !onRanks {
+				continue
+			}
+		}
+
+		selected = append(selected, pool)
+	}
+
+	return selected, nil
+}
+
+// Errors returns an error summarizing the failed operations, if any.
+func (resp *PoolOpResp) Errors() error {
+	var failed int
+	for _, res := range resp.Results {
+		if res.Error != "" {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+
+	return errors.Errorf("operation failed on %d of %d pools", failed, len(resp.Results))
+}
+
+// PoolOpFanOut applies the operation to each of the supplied pools
+// concurrently and returns the result for each pool. The operation is passed
+// the UUID of the pool to operate on.
+func PoolOpFanOut(ctx context.Context, pools []*daos.PoolInfo, op func(context.Context, string) error) *PoolOpResp {
+	resp := &PoolOpResp{
+		Results: make([]*PoolOpResult, len(pools)),
+	}
+
+	sem := make(chan struct{}, maxPoolOpConcurrency)
+	var wg sync.WaitGroup
+	for i, pool := range pools {
+		res := &PoolOpResult{
+			PoolUUID:  pool.UUID,
+			PoolLabel: pool.Label,
+		}
+		resp.Results[i] = res
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				res.Error = ctx.Err().Error()
+				return
+			}
+
+			if err := op(ctx, res.PoolUUID.String()); err != nil {
+				res.Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return resp
+}
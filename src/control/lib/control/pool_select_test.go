@@ -0,0 +1,225 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_SelectPools(t *testing.T) {
+	listPoolsResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{
+				Uuid:  test.MockUUID(1),
+				Label: "tank-1",
+				State: daos.PoolServiceStateReady.String(),
+			},
+			{
+				Uuid:  test.MockUUID(2),
+				Label: "tank-2",
+				State: daos.PoolServiceStateReady.String(),
+			},
+			{
+				Uuid:  test.MockUUID(3),
+				Label: "scratch",
+				State: daos.PoolServiceStateReady.String(),
+			},
+			{
+				Uuid:  test.MockUUID(4),
+				Label: "tank-old",
+				State: daos.PoolServiceStateDestroyPending.String(),
+			},
+		},
+	})
+	aclResp := func(owner string) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.ACLResp{
+			Acl: &mgmtpb.AccessControlList{OwnerUser: owner},
+		})
+	}
+	queryResp := func(idx int32, enabled, disabled string) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:          test.MockUUID(idx),
+			EnabledRanks:  enabled,
+			DisabledRanks: disabled,
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		req       *PoolSelectReq
+		uResps    []*UnaryResponse
+		expLabels []string
+		expErr    error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.PoolSelectReq request"),
+		},
+		"no criteria": {
+			req:    new(PoolSelectReq),
+			expErr: errors.New("no pool selection criteria"),
+		},
+		"all with other criteria": {
+			req:    &PoolSelectReq{All: true, LabelGlob: "tank-*"},
+			expErr: errors.New("may not be combined"),
+		},
+		"bad label glob": {
+			req:    &PoolSelectReq{LabelGlob: "tank-["},
+			expErr: errors.New("invalid label glob"),
+		},
+		"list pools fails": {
+			req: &PoolSelectReq{All: true},
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"all": {
+			req:       &PoolSelectReq{All: true},
+			uResps:    []*UnaryResponse{listPoolsResp},
+			expLabels: []string{"tank-1", "tank-2", "scratch"},
+		},
+		"label glob": {
+			req:       &PoolSelectReq{LabelGlob: "tank-*"},
+			uResps:    []*UnaryResponse{listPoolsResp},
+			expLabels: []string{"tank-1", "tank-2"},
+		},
+		"no matches": {
+			req:       &PoolSelectReq{LabelGlob: "nope*"},
+			uResps:    []*UnaryResponse{listPoolsResp},
+			expLabels: []string{},
+		},
+		"owner": {
+			req: &PoolSelectReq{Owner: "alice"},
+			uResps: []*UnaryResponse{
+				listPoolsResp,
+				aclResp("alice@"),
+				aclResp("bob@"),
+				aclResp("alice@"),
+			},
+			expLabels: []string{"tank-1", "scratch"},
+		},
+		"owner lookup fails": {
+			req: &PoolSelectReq{Owner: "alice@"},
+			uResps: []*UnaryResponse{
+				listPoolsResp,
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("unable to get owner"),
+		},
+		"label glob and owner": {
+			req: &PoolSelectReq{LabelGlob: "tank-*", Owner: "alice@"},
+			uResps: []*UnaryResponse{
+				listPoolsResp,
+				aclResp("bob@"),
+				aclResp("alice@"),
+			},
+			expLabels: []string{"tank-2"},
+		},
+		"ranks": {
+			req: &PoolSelectReq{Ranks: ranklist.MustCreateRankSet("3")},
+			uResps: []*UnaryResponse{
+				listPoolsResp,
+				queryResp(1, "[0-3]", ""),
+				queryResp(2, "[0-2]", ""),
+				queryResp(3, "[0-2]", "[3]"),
+			},
+			expLabels: []string{"tank-1", "scratch"},
+		},
+		"rank lookup fails": {
+			req: &PoolSelectReq{Ranks: ranklist.MustCreateRankSet("3")},
+			uResps: []*UnaryResponse{
+				listPoolsResp,
+				MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("unable to get ranks"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			gotPools, gotErr := SelectPools(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotLabels := []string{}
+			for _, pool := range gotPools {
+				gotLabels = append(gotLabels, pool.Label)
+			}
+			if diff := cmp.Diff(tc.expLabels, gotLabels); diff != "" {
+				t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolOpFanOut(t *testing.T) {
+	pools := []*daos.PoolInfo{
+		{UUID: test.MockPoolUUID(1), Label: "tank-1"},
+		{UUID: test.MockPoolUUID(2), Label: "tank-2"},
+		{UUID: test.MockPoolUUID(3), Label: "scratch"},
+	}
+
+	for name, tc := range map[string]struct {
+		failIDs   []string
+		expResp   *PoolOpResp
+		expErrors error
+	}{
+		"all succeed": {
+			expResp: &PoolOpResp{
+				Results: []*PoolOpResult{
+					{PoolUUID: test.MockPoolUUID(1), PoolLabel: "tank-1"},
+					{PoolUUID: test.MockPoolUUID(2), PoolLabel: "tank-2"},
+					{PoolUUID: test.MockPoolUUID(3), PoolLabel: "scratch"},
+				},
+			},
+		},
+		"some fail": {
+			failIDs: []string{test.MockUUID(1), test.MockUUID(3)},
+			expResp: &PoolOpResp{
+				Results: []*PoolOpResult{
+					{PoolUUID: test.MockPoolUUID(1), PoolLabel: "tank-1", Error: "op failed"},
+					{PoolUUID: test.MockPoolUUID(2), PoolLabel: "tank-2"},
+					{PoolUUID: test.MockPoolUUID(3), PoolLabel: "scratch", Error: "op failed"},
+				},
+			},
+			expErrors: errors.New("operation failed on 2 of 3 pools"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			op := func(_ context.Context, id string) error {
+				for _, failID := range tc.failIDs {
+					if id == failID {
+						return errors.New("op failed")
+					}
+				}
+				return nil
+			}
+
+			gotResp := PoolOpFanOut(test.Context(t), pools, op)
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.CmpErr(t, tc.expErrors, gotResp.Errors())
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -48,9 +49,10 @@ func rankSetCmpOpt() []cmp.Option {
 
 func TestControl_PoolDestroy(t *testing.T) {
 	for name, tc := range map[string]struct {
-		mic    *MockInvokerConfig
-		req    *PoolDestroyReq
-		expErr error
+		mic     *MockInvokerConfig
+		req     *PoolDestroyReq
+		expResp *PoolDestroyResp
+		expErr  error
 	}{
 		"local failure": {
 			req: &PoolDestroyReq{
@@ -134,6 +136,20 @@ func TestControl_PoolDestroy(t *testing.T) {
 					&mgmtpb.PoolDestroyResp{},
 				),
 			},
+			expResp: &PoolDestroyResp{},
+		},
+		"soft-deleted": {
+			req: &PoolDestroyReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolDestroyResp{DestroyAfter: 1735689600},
+				),
+			},
+			expResp: &PoolDestroyResp{
+				DestroyAfter: time.Unix(1735689600, 0),
+			},
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -146,13 +162,84 @@ func TestControl_PoolDestroy(t *testing.T) {
 			}
 
 			ctx := test.Context(t)
-			mi := NewMockInvoker(log, mic)
 
-			gotErr := PoolDestroy(ctx, mi, tc.req)
+			gotErr := PoolDestroy(ctx, NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			gotResp, gotErr := PoolDestroyWithResp(ctx, NewMockInvoker(log, mic), tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
 			}
+
+			if tc.expResp == nil {
+				tc.expResp = &PoolDestroyResp{}
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolUndelete(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *PoolUndeleteReq
+		expErr error
+	}{
+		"local failure": {
+			req: &PoolUndeleteReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolUndeleteReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"reopen failure": {
+			req: &PoolUndeleteReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.DaosResp{
+					Status: int32(daos.TimedOut),
+				}),
+			},
+			expErr: daos.TimedOut,
+		},
+		"success": {
+			req: &PoolUndeleteReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.DaosResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := PoolUndelete(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
 		})
 	}
 }
@@ -353,6 +440,199 @@ func TestControl_PoolEvict(t *testing.T) {
 	}
 }
 
+func TestControl_PoolResize(t *testing.T) {
+	mockTierBytes := []uint64{humanize.GiByte, 30 * humanize.GiByte}
+	// Two ranks with four targets each and 20GiB of NVMe per rank.
+	queryResp := MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+		Uuid:          test.MockUUID(),
+		TotalTargets:  8,
+		ActiveTargets: 8,
+		TotalEngines:  2,
+		EnabledRanks:  "0-1",
+		TierStats: []*mgmtpb.StorageUsageStats{
+			{Total: 2 * humanize.GiByte},
+			{Total: 40 * humanize.GiByte},
+		},
+	})
+	scanResp := func(rank1Nvme uint64) *UnaryResponse {
+		return &UnaryResponse{
+			Responses: []*HostResponse{
+				{
+					Addr: "host1",
+					Message: MockStorageScanResp(t,
+						[]MockScmConfig{newScmCfg(0)},
+						[]MockNvmeConfig{newNvmeCfg(0, 0)}),
+				},
+				{
+					Addr: "host2",
+					Message: MockStorageScanResp(t,
+						[]MockScmConfig{newScmCfg(1)},
+						[]MockNvmeConfig{newNvmeCfg(1, 0, rank1Nvme)}),
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolResizeReq
+		expResp *PoolResizeResp
+		expErr  error
+	}{
+		"local failure": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, 30 * humanize.GiByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"pool query fails": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, 30 * humanize.GiByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", errors.New("query failed"), nil),
+				},
+			},
+			expErr: errors.New("query failed"),
+		},
+		"not enough free NVMe": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, 30 * humanize.GiByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					queryResp,
+					scanResp(5 * humanize.GiByte),
+				},
+			},
+			expErr: errors.New("rank 1 has 5.0 GiB of NVMe available, 10 GiB required"),
+		},
+		"not enough free NVMe for total size": {
+			req: &PoolResizeReq{
+				ID:         test.MockUUID(),
+				TotalBytes: 2 * (humanize.GiByte + 30*humanize.GiByte),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					queryResp,
+					scanResp(5 * humanize.GiByte),
+				},
+			},
+			expErr: errors.New("rank 1 has 5.0 GiB of NVMe available, 10 GiB required"),
+		},
+		"remote failure": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, 30 * humanize.GiByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					queryResp,
+					scanResp(humanize.TByte),
+					MockMSResponse("host1", errors.New("remote failed"), nil),
+				},
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"failed on some ranks": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, 30 * humanize.GiByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					queryResp,
+					scanResp(humanize.TByte),
+					MockMSResponse("host1", nil,
+						&mgmtpb.PoolResizeResp{
+							Status: int32(daos.NoSpace),
+							RankResults: []*mgmtpb.PoolResizeResp_RankResult{
+								{Rank: 0},
+								{Rank: 1, Status: int32(daos.NoSpace)},
+							},
+						},
+					),
+				},
+			},
+			expResp: &PoolResizeResp{
+				Status: int32(daos.NoSpace),
+				RankResults: []*PoolResizeRankResult{
+					{Rank: 0},
+					{Rank: 1, Status: int32(daos.NoSpace)},
+				},
+			},
+			expErr: daos.NoSpace,
+		},
+		"success": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, 30 * humanize.GiByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					queryResp,
+					scanResp(humanize.TByte),
+					MockMSResponse("host1", nil,
+						&mgmtpb.PoolResizeResp{
+							TierBytes: mockTierBytes,
+							RankResults: []*mgmtpb.PoolResizeResp_RankResult{
+								{Rank: 0},
+								{Rank: 1},
+							},
+						},
+					),
+				},
+			},
+			expResp: &PoolResizeResp{
+				TierBytes: mockTierBytes,
+				RankResults: []*PoolResizeRankResult{
+					{Rank: 0},
+					{Rank: 1},
+				},
+			},
+		},
+		"no growth skips storage scan": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, 20 * humanize.GiByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					queryResp,
+					MockMSResponse("host1", errors.New("can't shrink"), nil),
+				},
+			},
+			expErr: errors.New("can't shrink"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolResize(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func strVal(s string) daos.PoolPropertyValue {
 	v := daos.PoolPropertyValue{}
 	v.SetString(s)
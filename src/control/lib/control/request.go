@@ -74,6 +74,13 @@ type (
 		retryer
 		unaryRPCGetter
 	}
+
+	// StreamRequest defines an interface to be implemented by
+	// server-streaming request types (N responses to 1 request).
+	StreamRequest interface {
+		targetChooser
+		streamRPCGetter
+	}
 )
 
 var (
@@ -8,6 +8,7 @@ package control
 
 import (
 	"context"
+	"io"
 	"math/rand"
 	"os"
 	"sync"
@@ -36,6 +37,7 @@ const (
 	baseMSBackoff      = 250 * time.Millisecond
 	maxMSBackoffFactor = 7 // 8s
 	maxMSCandidates    = 5
+	maxStreamRetries   = 5
 )
 
 type (
@@ -78,6 +80,23 @@ type (
 		getRPC() unaryRPC
 	}
 
+	// streamRecvFn defines the function signature for a closure that
+	// receives the next protobuf message from a server-side stream. It
+	// returns io.EOF when the stream has been closed by the server.
+	streamRecvFn func() (proto.Message, error)
+
+	// streamRPC defines the function signature for a closure that invokes
+	// a server-streaming gRPC method and returns a closure to receive
+	// messages from the stream.
+	streamRPC func(context.Context, *grpc.ClientConn) (streamRecvFn, error)
+
+	// streamRPCGetter defines the interface to be implemented by requests
+	// that can invoke a server-streaming gRPC method.
+	streamRPCGetter interface {
+		getStreamRPC() streamRPC
+		onStreamMsg(proto.Message) error
+	}
+
 	// sysGetter defines an interface to be implemented by clients that can
 	// retrieve the system name field.
 	sysGetter interface {
@@ -94,11 +113,19 @@ type (
 		InvokeUnaryRPCAsync(ctx context.Context, req UnaryRequest) (HostResponseChan, error)
 	}
 
+	// StreamInvoker defines an interface to be implemented by clients
+	// capable of invoking a server-streaming RPC (N responses for 1 request).
+	StreamInvoker interface {
+		sysGetter
+		debugLogger
+		InvokeStreamRPC(ctx context.Context, req StreamRequest) error
+	}
+
 	// Invoker defines an interface to be implemented by clients
 	// capable of invoking unary or stream RPCs.
 	Invoker interface {
 		UnaryInvoker
-		//TODO: StreamInvoker
+		StreamInvoker
 		SetConfig(*Config)
 	}
 )
@@ -120,6 +147,35 @@ func (r *unaryRequest) setRPC(rpc unaryRPC) {
 	r.rpc = rpc
 }
 
+// streamRequest is an embeddable struct to be used by requests which
+// implement the StreamRequest interface.
+type streamRequest struct {
+	request
+	rpc     streamRPC
+	handler func(proto.Message) error
+}
+
+// getStreamRPC returns the request's stream RPC closure.
+func (r *streamRequest) getStreamRPC() streamRPC {
+	return r.rpc
+}
+
+// setStreamRPC sets the request's stream RPC closure and the handler to be
+// called for each message received from the stream.
+func (r *streamRequest) setStreamRPC(rpc streamRPC, handler func(proto.Message) error) {
+	r.rpc = rpc
+	r.handler = handler
+}
+
+// onStreamMsg passes a message received from the stream to the request's
+// handler.
+func (r *streamRequest) onStreamMsg(msg proto.Message) error {
+	if r.handler == nil {
+		return errors.New("request has not set a stream message handler")
+	}
+	return r.handler(msg)
+}
+
 type (
 	// Client implements the Invoker interface and should be provided to
 	// API methods to invoke RPCs.
@@ -530,3 +586,90 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
 	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
 }
+
+// invokeStream dials the given host and invokes the request's stream RPC,
+// passing each received message to the request's handler until the stream
+// is closed. The returned boolean indicates whether any messages were
+// received before an error occurred.
+func (c *Client) invokeStream(ctx context.Context, hostAddr string, req StreamRequest) (bool, error) {
+	opts, err := c.dialOptions()
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := grpc.DialContext(ctx, hostAddr, opts...)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	recv, err := req.getStreamRPC()(ctx, conn)
+	if err != nil {
+		return false, err
+	}
+
+	received := false
+	for {
+		msg, err := recv()
+		if err == io.EOF {
+			return received, nil
+		}
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		if err := req.onStreamMsg(msg); err != nil {
+			return received, err
+		}
+	}
+}
+
+// InvokeStreamRPC performs a synchronous (blocking) invocation of the
+// request's server-streaming RPC on the first host in the request's
+// hostlist. Each message received from the stream is passed to the
+// request's handler. For MS requests, the request is redirected to the
+// current MS leader if the first host is unable to service it.
+func (c *Client) InvokeStreamRPC(ctx context.Context, req StreamRequest) error {
+	hosts, err := getRequestHosts(c.config, req)
+	if err != nil {
+		return err
+	}
+
+	var try uint
+	for {
+		c.Debugf("stream request host: %s", hosts[0])
+		received, err := c.invokeStream(ctx, hosts[0], req)
+		if err == nil || received || !req.isMSRequest() || try >= maxStreamRetries {
+			return err
+		}
+
+		switch e := errors.Cause(err).(type) {
+		case *system.ErrNotLeader:
+			switch {
+			case e.LeaderHint != "":
+				hosts = []string{e.LeaderHint}
+			case len(e.Replicas) > 0:
+				hosts = e.Replicas
+			}
+		case *system.ErrNotReplica:
+			if len(e.Replicas) == 0 {
+				return err
+			}
+			hosts = e.Replicas
+		default:
+			if !system.IsUnavailable(err) {
+				return err
+			}
+		}
+
+		backoff := common.ExpBackoff(baseMSBackoff, uint64(try), maxMSBackoffFactor)
+		c.Debugf("retrying MS stream request on %s after %s", hosts[0], backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		try++
+	}
+}
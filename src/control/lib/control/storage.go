@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -317,6 +318,49 @@ type (
 		HostErrorsResp
 		HostStorage HostStorageMap
 	}
+
+	// MetadataFormatPlan describes what a storage format would do to control plane metadata
+	// storage.
+	MetadataFormatPlan struct {
+		Format   bool     `json:"format"`
+		RootPath string   `json:"root_path"`
+		Device   string   `json:"device"`
+		Paths    []string `json:"paths"`
+	}
+
+	// ScmFormatPlan describes what a storage format would do to an engine's SCM tier.
+	ScmFormatPlan struct {
+		Format      bool     `json:"format"`
+		Class       string   `json:"class"`
+		MountPoint  string   `json:"mount_point"`
+		Devices     []string `json:"devices"`
+		RamdiskSize uint32   `json:"ramdisk_size"`
+		Info        string   `json:"info"`
+	}
+
+	// BdevTierFormatPlan describes the bdevs in an engine's bdev tier.
+	BdevTierFormatPlan struct {
+		Tier    uint32   `json:"tier"`
+		Class   string   `json:"class"`
+		Devices []string `json:"devices"`
+		Roles   string   `json:"roles"`
+	}
+
+	// EngineFormatPlan describes what a storage format would do to an engine's storage.
+	EngineFormatPlan struct {
+		Index       uint32                `json:"index"`
+		Scm         *ScmFormatPlan        `json:"scm"`
+		FormatBdevs bool                  `json:"format_bdevs"`
+		BdevTiers   []*BdevTierFormatPlan `json:"bdev_tiers"`
+		BdevInfo    string                `json:"bdev_info"`
+		NvmeConfig  string                `json:"nvme_config"`
+	}
+
+	// StorageFormatPlan describes what a storage format would do on a host.
+	StorageFormatPlan struct {
+		Metadata *MetadataFormatPlan `json:"metadata"`
+		Engines  []*EngineFormatPlan `json:"engines"`
+	}
 )
 
 // addHostResponse is responsible for validating the given HostResponse
@@ -478,6 +522,77 @@ func StorageFormat(ctx context.Context, rpcClient UnaryInvoker, req *StorageForm
 	return sfr, nil
 }
 
+type (
+	// StorageFormatPlanReq contains the parameters for a storage format plan request.
+	StorageFormatPlanReq struct {
+		unaryRequest
+		Reformat bool `json:"reformat"`
+	}
+
+	// StorageFormatPlanResp contains the response from a storage format plan request.
+	StorageFormatPlanResp struct {
+		HostErrorsResp
+		HostPlans map[string]*StorageFormatPlan `json:"host_plans"`
+	}
+)
+
+// addHostResponse is responsible for validating the given HostResponse
+// and adding it to the StorageFormatPlanResp.
+func (sfpr *StorageFormatPlanResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.StorageFormatPlanResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	plan := new(StorageFormatPlan)
+	if err := convert.Types(pbResp.GetPlan(), plan); err != nil {
+		return errors.Wrapf(err, "unpack format plan from %s", hr.Addr)
+	}
+	if sfpr.HostPlans == nil {
+		sfpr.HostPlans = make(map[string]*StorageFormatPlan)
+	}
+	sfpr.HostPlans[hr.Addr] = plan
+
+	return nil
+}
+
+// GetStorageFormatPlan concurrently requests from all hosts supplied in the
+// request's hostlist, or all configured hosts if not explicitly specified, a
+// plan of what a storage format would do without modifying any storage.
+//
+// Hosts running a server that predates the plan RPC report it as unimplemented
+// in the per-host errors and are left untouched.
+func GetStorageFormatPlan(ctx context.Context, rpcClient UnaryInvoker, req *StorageFormatPlanReq) (*StorageFormatPlanResp, error) {
+	pbReq := new(ctlpb.StorageFormatPlanReq)
+	if err := convert.Types(req, pbReq); err != nil {
+		return nil, err
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageFormatPlan(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	sfpr := new(StorageFormatPlanResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := sfpr.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := sfpr.addHostResponse(hostResp); err != nil {
+			return nil, err
+		}
+	}
+
+	return sfpr, nil
+}
+
 type (
 	// NvmeRebindReq contains the parameters for a storage nvme-rebind request.
 	NvmeRebindReq struct {
@@ -0,0 +1,515 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const defaultNvmeReplacePollInterval = 5 * time.Second
+
+// NvmeReplaceStage identifies a stage in the guided replacement of an NVMe SSD.
+type NvmeReplaceStage string
+
+// Stages of a guided NVMe SSD replacement, in the order that they are performed.
+const (
+	NvmeReplaceStageVerify      NvmeReplaceStage = "verify-evicted"
+	NvmeReplaceStageIdentify    NvmeReplaceStage = "identify"
+	NvmeReplaceStageWaitDevice  NvmeReplaceStage = "wait-new-device"
+	NvmeReplaceStageAddDevice   NvmeReplaceStage = "add-device"
+	NvmeReplaceStageReplace     NvmeReplaceStage = "replace"
+	NvmeReplaceStageReintegrate NvmeReplaceStage = "reintegrate"
+	NvmeReplaceStageRebuild     NvmeReplaceStage = "rebuild"
+	NvmeReplaceStageDone        NvmeReplaceStage = "done"
+)
+
+var nvmeReplaceStages = []NvmeReplaceStage{
+	NvmeReplaceStageVerify,
+	NvmeReplaceStageIdentify,
+	NvmeReplaceStageWaitDevice,
+	NvmeReplaceStageAddDevice,
+	NvmeReplaceStageReplace,
+	NvmeReplaceStageReintegrate,
+	NvmeReplaceStageRebuild,
+	NvmeReplaceStageDone,
+}
+
+func (nrs NvmeReplaceStage) next() NvmeReplaceStage {
+	for i, stage := range nvmeReplaceStages {
+		if stage == nrs && i+1 < len(nvmeReplaceStages) {
+			return nvmeReplaceStages[i+1]
+		}
+	}
+	return NvmeReplaceStageDone
+}
+
+func (nrs NvmeReplaceStage) isValid() bool {
+	for _, stage := range nvmeReplaceStages {
+		if stage == nrs {
+			return true
+		}
+	}
+	return false
+}
+
+type (
+	// NvmeReplaceState records the progress of the guided replacement of an
+	// NVMe SSD so that the replacement can be resumed if interrupted.
+	NvmeReplaceState struct {
+		Host       string        `json:"host"`
+		Rank       ranklist.Rank `json:"rank"`
+		OldUUID    string        `json:"old_uuid"`
+		OldPciAddr string        `json:"old_pci_addr"`
+		OldSerial  string        `json:"old_serial"`
+		// KnownSerials are the serial numbers of the other SSDs on the
+		// host before the replaced SSD was swapped, used to identify the
+		// new SSD once it has been inserted.
+		KnownSerials []string         `json:"known_serials"`
+		NewUUID      string           `json:"new_uuid"`
+		NewPciAddr   string           `json:"new_pci_addr"`
+		NewSerial    string           `json:"new_serial"`
+		Stage        NvmeReplaceStage `json:"stage"`
+		Error        string           `json:"error,omitempty"`
+		Updated      time.Time        `json:"updated"`
+	}
+
+	// NvmeReplaceReportFn is called with a progress message each time a
+	// replacement enters a new stage or requires operator action.
+	NvmeReplaceReportFn func(state *NvmeReplaceState, msg string)
+
+	// NvmeReplaceWizardReq contains the inputs for the guided replacement
+	// of an evicted NVMe SSD.
+	NvmeReplaceWizardReq struct {
+		Host    string // Host with the SSD to be replaced
+		OldUUID string // UUID of the evicted device
+		// NewUUID is the UUID of the replacement device. If unset, the
+		// UUID is discovered once the new SSD has been inserted.
+		NewUUID string
+		// EngineIndex is the index of the engine to add the new SSD to if
+		// it is not at the PCI address of the replaced SSD, or -1 if unset.
+		EngineIndex int32
+		// IdentifyTimeout is the number of minutes to blink the LED of the
+		// SSD to be replaced.
+		IdentifyTimeout uint32
+		// DeviceTimeout is the maximum time to wait for the new SSD to be
+		// inserted, added and reintegrated. Zero waits indefinitely.
+		DeviceTimeout time.Duration
+		// RebuildTimeout is the maximum time to wait for pool rebuilds
+		// to complete. Zero waits indefinitely.
+		RebuildTimeout time.Duration
+		// PollInterval is the interval between device and pool checks.
+		PollInterval time.Duration
+		// State is the saved progress of an interrupted replacement, if any.
+		State *NvmeReplaceState
+		// SaveFn is an optional callback to persist progress each time
+		// the replacement enters a new stage or fails.
+		SaveFn func(*NvmeReplaceState) error
+		// ReportFn is an optional callback for progress reporting.
+		ReportFn NvmeReplaceReportFn
+	}
+)
+
+// LoadNvmeReplaceState reads the saved progress of an NVMe SSD replacement
+// from the file at the given path. A nil state is returned if the file does
+// not exist.
+func LoadNvmeReplaceState(path string) (*NvmeReplaceState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to read NVMe replacement state")
+	}
+
+	state := new(NvmeReplaceState)
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse NVMe replacement state in %q", path)
+	}
+	if !state.Stage.isValid() {
+		return nil, errors.Errorf("invalid stage %q in NVMe replacement state in %q",
+			state.Stage, path)
+	}
+
+	return state, nil
+}
+
+// SaveNvmeReplaceState writes the progress of an NVMe SSD replacement to the
+// file at the given path, creating the parent directory if necessary.
+func SaveNvmeReplaceState(path string, state *NvmeReplaceState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode NVMe replacement state")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrap(err, "failed to create NVMe replacement state directory")
+	}
+
+	return errors.Wrap(common.WriteFileAtomic(path, data, 0600),
+		"failed to write NVMe replacement state")
+}
+
+// firstHostError returns one of the errors in the map, which is sufficient
+// to describe the failure of a request sent to a single host.
+func firstHostError(hem HostErrorsMap) error {
+	for _, hes := range hem {
+		return hes.HostError
+	}
+	return nil
+}
+
+func (req *NvmeReplaceWizardReq) report(state *NvmeReplaceState, format string, args ...interface{}) {
+	if req.ReportFn != nil {
+		req.ReportFn(state, fmt.Sprintf(format, args...))
+	}
+}
+
+func (req *NvmeReplaceWizardReq) save(state *NvmeReplaceState) error {
+	state.Updated = time.Now()
+	if req.SaveFn == nil {
+		return nil
+	}
+	return req.SaveFn(state)
+}
+
+func (req *NvmeReplaceWizardReq) smdDevices(ctx context.Context, rpcClient UnaryInvoker, smdReq *SmdQueryReq) ([]*storage.SmdDevice, error) {
+	smdReq.OmitPools = true
+	smdReq.SetHostList([]string{req.Host})
+	resp, err := SmdQuery(ctx, rpcClient, smdReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := firstHostError(resp.HostErrors); err != nil {
+		return nil, err
+	}
+
+	var devs []*storage.SmdDevice
+	for _, hss := range resp.HostStorage {
+		if hss.HostStorage.SmdInfo != nil {
+			devs = append(devs, hss.HostStorage.SmdInfo.Devices...)
+		}
+	}
+	return devs, nil
+}
+
+func (req *NvmeReplaceWizardReq) smdDevice(ctx context.Context, rpcClient UnaryInvoker, uuid string) (*storage.SmdDevice, error) {
+	devs, err := req.smdDevices(ctx, rpcClient, &SmdQueryReq{UUID: uuid, Rank: ranklist.NilRank})
+	if err != nil {
+		return nil, err
+	}
+	for _, dev := range devs {
+		if dev.UUID == uuid {
+			return dev, nil
+		}
+	}
+	return nil, errors.Errorf("device %s not found on host %s", uuid, req.Host)
+}
+
+func (req *NvmeReplaceWizardReq) nvmeControllers(ctx context.Context, rpcClient UnaryInvoker) (storage.NvmeControllers, error) {
+	scanReq := new(StorageScanReq)
+	scanReq.SetHostList([]string{req.Host})
+	resp, err := StorageScan(ctx, rpcClient, scanReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := firstHostError(resp.HostErrors); err != nil {
+		return nil, err
+	}
+
+	var ctrlrs storage.NvmeControllers
+	for _, hss := range resp.HostStorage {
+		ctrlrs = append(ctrlrs, hss.HostStorage.NvmeDevices...)
+	}
+	return ctrlrs, nil
+}
+
+func (req *NvmeReplaceWizardReq) smdManage(ctx context.Context, rpcClient UnaryInvoker, manageReq *SmdManageReq) error {
+	manageReq.SetHostList([]string{req.Host})
+	resp, err := SmdManage(ctx, rpcClient, manageReq)
+	if err != nil {
+		return err
+	}
+	return firstHostError(resp.HostErrors)
+}
+
+// verifyEvicted checks that the device to be replaced has been evicted and
+// records its location.
+func (req *NvmeReplaceWizardReq) verifyEvicted(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	dev, err := req.smdDevice(ctx, rpcClient, state.OldUUID)
+	if err != nil {
+		return err
+	}
+
+	switch dev.Ctrlr.NvmeState {
+	case storage.NvmeStateFaulty, storage.NvmeStateUnplugged:
+	default:
+		return errors.Errorf("device %s is %s; set it faulty with \"dmg storage set nvme-faulty\" before replacing it",
+			dev.UUID, dev.Ctrlr.NvmeState)
+	}
+
+	state.Rank = dev.Rank
+	state.OldPciAddr = dev.Ctrlr.PciAddr
+	state.OldSerial = dev.Ctrlr.Serial
+	return nil
+}
+
+// identify blinks the LED of the SSD to be replaced and records the serial
+// numbers of the other SSDs on the host. LEDs are only supported on VMD
+// devices, so failing to blink the LED is not fatal.
+func (req *NvmeReplaceWizardReq) identify(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	id := state.OldPciAddr
+	if id == "" {
+		id = state.OldUUID
+	}
+	if err := req.smdManage(ctx, rpcClient, &SmdManageReq{
+		Operation:       LedBlinkOp,
+		IDs:             id,
+		IdentifyTimeout: req.IdentifyTimeout,
+	}); err != nil {
+		req.report(state, "unable to blink LED of SSD at %s (%s); locate the SSD by its PCI address",
+			state.OldPciAddr, err)
+	}
+
+	ctrlrs, err := req.nvmeControllers(ctx, rpcClient)
+	if err != nil {
+		return err
+	}
+	state.KnownSerials = []string{}
+	for _, c := range ctrlrs {
+		if c.PciAddr == state.OldPciAddr || c.Serial == state.OldSerial || c.Serial == "" {
+			continue
+		}
+		state.KnownSerials = append(state.KnownSerials, c.Serial)
+	}
+	return nil
+}
+
+// waitNewDevice waits for an SSD that was not present when the replacement
+// started to appear in the host's storage scan.
+func (req *NvmeReplaceWizardReq) waitNewDevice(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	req.report(state, "replace the SSD at %s on host %s (serial %s) with a new SSD",
+		state.OldPciAddr, state.Host, state.OldSerial)
+
+	known := common.NewStringSet(state.KnownSerials...)
+	if err := pollUntil(ctx, req.PollInterval, req.DeviceTimeout, "a new SSD on host "+req.Host,
+		func() (bool, error) {
+			ctrlrs, err := req.nvmeControllers(ctx, rpcClient)
+			if err != nil {
+				return false, err
+			}
+			for _, c := range ctrlrs {
+				if c.Serial == "" || c.Serial == state.OldSerial || known.Has(c.Serial) {
+					continue
+				}
+				state.NewPciAddr = c.PciAddr
+				state.NewSerial = c.Serial
+				return true, nil
+			}
+			return false, nil
+		}); err != nil {
+		return err
+	}
+
+	if err := req.smdManage(ctx, rpcClient, &SmdManageReq{
+		Operation: LedResetOp,
+		IDs:       state.NewPciAddr,
+	}); err != nil {
+		rpcClient.Debugf("unable to reset LED of SSD at %s: %s", state.NewPciAddr, err)
+	}
+	return nil
+}
+
+// addDevice makes the new SSD available to the engine and waits for it to be
+// detected as a new device. An SSD inserted at a different PCI address to the
+// replaced SSD is first bound to the userspace driver and added to the
+// engine's configuration.
+func (req *NvmeReplaceWizardReq) addDevice(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	if state.NewPciAddr != state.OldPciAddr {
+		if req.EngineIndex < 0 {
+			return errors.Errorf("new SSD at %s is not at the PCI address of the replaced SSD (%s); the index of the engine to add it to is required",
+				state.NewPciAddr, state.OldPciAddr)
+		}
+
+		rebindReq := &NvmeRebindReq{PCIAddr: state.NewPciAddr}
+		rebindReq.SetHostList([]string{req.Host})
+		rebindResp, err := StorageNvmeRebind(ctx, rpcClient, rebindReq)
+		if err != nil {
+			return err
+		}
+		if err := firstHostError(rebindResp.HostErrors); err != nil {
+			return errors.Wrapf(err, "rebind of SSD at %s", state.NewPciAddr)
+		}
+
+		addReq := &NvmeAddDeviceReq{
+			PCIAddr:          state.NewPciAddr,
+			EngineIndex:      uint32(req.EngineIndex),
+			StorageTierIndex: -1,
+		}
+		addReq.SetHostList([]string{req.Host})
+		addResp, err := StorageNvmeAddDevice(ctx, rpcClient, addReq)
+		if err != nil {
+			return err
+		}
+		if err := firstHostError(addResp.HostErrors); err != nil {
+			return errors.Wrapf(err, "adding SSD at %s to engine %d", state.NewPciAddr,
+				req.EngineIndex)
+		}
+	}
+
+	if req.NewUUID != "" {
+		state.NewUUID = req.NewUUID
+		return nil
+	}
+
+	return pollUntil(ctx, req.PollInterval, req.DeviceTimeout, "new SSD at "+state.NewPciAddr+" to be detected",
+		func() (bool, error) {
+			devs, err := req.smdDevices(ctx, rpcClient, &SmdQueryReq{Rank: state.Rank})
+			if err != nil {
+				return false, err
+			}
+			for _, dev := range devs {
+				if dev.UUID == state.OldUUID || dev.Ctrlr.PciAddr != state.NewPciAddr ||
+					dev.Ctrlr.NvmeState != storage.NvmeStateNew {
+					continue
+				}
+				state.NewUUID = dev.UUID
+				return true, nil
+			}
+			return false, nil
+		})
+}
+
+// replace replaces the evicted device with the new device.
+func (req *NvmeReplaceWizardReq) replace(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	return req.smdManage(ctx, rpcClient, &SmdManageReq{
+		Operation:   DevReplaceOp,
+		IDs:         state.OldUUID,
+		ReplaceUUID: state.NewUUID,
+	})
+}
+
+// reintegrate waits for the new device to be in use by the engine.
+func (req *NvmeReplaceWizardReq) reintegrate(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	return pollUntil(ctx, req.PollInterval, req.DeviceTimeout, "device "+state.NewUUID+" to be reintegrated",
+		func() (bool, error) {
+			dev, err := req.smdDevice(ctx, rpcClient, state.NewUUID)
+			if err != nil {
+				return false, err
+			}
+			return dev.Ctrlr.NvmeState == storage.NvmeStateNormal, nil
+		})
+}
+
+// rebuild waits for the pool rebuilds resulting from the reintegration of the
+// device's targets to complete.
+func (req *NvmeReplaceWizardReq) rebuild(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	ranks := ranklist.RankSetFromRanks([]ranklist.Rank{state.Rank})
+	return pollUntil(ctx, req.PollInterval, req.RebuildTimeout, "pool rebuild to complete",
+		func() (bool, error) {
+			return ranksReintegrated(ctx, rpcClient, ranks)
+		})
+}
+
+func (req *NvmeReplaceWizardReq) runStage(ctx context.Context, rpcClient UnaryInvoker, state *NvmeReplaceState) error {
+	switch state.Stage {
+	case NvmeReplaceStageVerify:
+		req.report(state, "verifying that device %s on host %s is evicted", state.OldUUID, state.Host)
+		return req.verifyEvicted(ctx, rpcClient, state)
+	case NvmeReplaceStageIdentify:
+		req.report(state, "identifying SSD at %s on host %s", state.OldPciAddr, state.Host)
+		return req.identify(ctx, rpcClient, state)
+	case NvmeReplaceStageWaitDevice:
+		return req.waitNewDevice(ctx, rpcClient, state)
+	case NvmeReplaceStageAddDevice:
+		req.report(state, "adding new SSD at %s (serial %s) to rank %d", state.NewPciAddr,
+			state.NewSerial, state.Rank)
+		return req.addDevice(ctx, rpcClient, state)
+	case NvmeReplaceStageReplace:
+		req.report(state, "replacing device %s with device %s", state.OldUUID, state.NewUUID)
+		return req.replace(ctx, rpcClient, state)
+	case NvmeReplaceStageReintegrate:
+		req.report(state, "waiting for device %s to be reintegrated", state.NewUUID)
+		return req.reintegrate(ctx, rpcClient, state)
+	case NvmeReplaceStageRebuild:
+		req.report(state, "waiting for pool rebuild to complete")
+		return req.rebuild(ctx, rpcClient, state)
+	default:
+		return errors.Errorf("unknown stage %q", state.Stage)
+	}
+}
+
+// NvmeReplaceWizard guides the replacement of an evicted NVMe SSD. It checks
+// that the device has been evicted, blinks the LED of the SSD to be replaced,
+// waits for a new SSD to be inserted into the host, adds the new SSD to the
+// engine if necessary, replaces the evicted device with the new device and
+// then waits for the device to be reintegrated and any resulting pool
+// rebuilds to complete. Progress is recorded in the returned state, which is
+// passed to the optional save callback each time a stage is completed or
+// fails. The replacement is resumed from the stage recorded in a previously
+// saved state if one is supplied in the request.
+func NvmeReplaceWizard(ctx context.Context, rpcClient UnaryInvoker, req *NvmeReplaceWizardReq) (*NvmeReplaceState, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Host == "" {
+		return nil, errors.New("no host specified")
+	}
+	if err := checkUUID(req.OldUUID); err != nil {
+		return nil, errors.Wrap(err, "invalid old device UUID")
+	}
+	if req.NewUUID != "" {
+		if err := checkUUID(req.NewUUID); err != nil {
+			return nil, errors.Wrap(err, "invalid new device UUID")
+		}
+	}
+	if req.PollInterval == 0 {
+		req.PollInterval = defaultNvmeReplacePollInterval
+	}
+
+	state := req.State
+	if state == nil {
+		state = &NvmeReplaceState{
+			Host:    req.Host,
+			OldUUID: req.OldUUID,
+			Stage:   NvmeReplaceStageVerify,
+		}
+	} else if state.Host != req.Host || state.OldUUID != req.OldUUID {
+		return nil, errors.Errorf("saved replacement state is for device %s on host %s",
+			state.OldUUID, state.Host)
+	}
+
+	for state.Stage != NvmeReplaceStageDone {
+		rpcClient.Debugf("NVMe replacement of device %s on host %s: %s", state.OldUUID,
+			state.Host, state.Stage)
+		if err := req.runStage(ctx, rpcClient, state); err != nil {
+			state.Error = err.Error()
+			if saveErr := req.save(state); saveErr != nil {
+				rpcClient.Debugf("failed to save NVMe replacement state: %s", saveErr)
+			}
+			return state, errors.Wrapf(err, "NVMe replacement failed in %s stage", state.Stage)
+		}
+
+		state.Error = ""
+		state.Stage = state.Stage.next()
+		if err := req.save(state); err != nil {
+			return state, err
+		}
+	}
+	req.report(state, "device %s replaced with device %s", state.OldUUID, state.NewUUID)
+
+	return state, nil
+}
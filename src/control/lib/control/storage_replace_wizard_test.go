@@ -0,0 +1,315 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_NvmeReplaceStateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wizard", test.MockUUID(1)+".json")
+
+	gotState, err := LoadNvmeReplaceState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotState != nil {
+		t.Fatalf("expected nil state, got %+v", gotState)
+	}
+
+	state := &NvmeReplaceState{
+		Host:         "host1",
+		Rank:         1,
+		OldUUID:      test.MockUUID(1),
+		OldPciAddr:   test.MockPCIAddr(1),
+		OldSerial:    "old",
+		KnownSerials: []string{"other"},
+		Stage:        NvmeReplaceStageWaitDevice,
+		Updated:      time.Now(),
+	}
+	if err := SaveNvmeReplaceState(path, state); err != nil {
+		t.Fatal(err)
+	}
+
+	gotState, err = LoadNvmeReplaceState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(state, gotState, cmpopts.EquateApproxTime(time.Second)); diff != "" {
+		t.Fatalf("unexpected state (-want, +got):\n%s\n", diff)
+	}
+
+	state.Stage = "unknown"
+	if err := SaveNvmeReplaceState(path, state); err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadNvmeReplaceState(path)
+	test.CmpErr(t, errors.New("invalid stage"), err)
+}
+
+func TestControl_NvmeReplaceWizard(t *testing.T) {
+	oldUUID := test.MockUUID(1)
+	newUUID := test.MockUUID(2)
+	oldAddr := test.MockPCIAddr(1)
+	otherAddr := test.MockPCIAddr(3)
+
+	smdResp := func(uuid, addr, serial string, state ctlpb.NvmeDevState) *UnaryResponse {
+		return MockMSResponse("host1", nil, &ctlpb.SmdQueryResp{
+			Ranks: []*ctlpb.SmdQueryResp_RankResp{
+				{
+					Rank: 1,
+					Devices: []*ctlpb.SmdDevice{
+						{
+							Uuid: uuid,
+							Ctrlr: &ctlpb.NvmeController{
+								PciAddr:  addr,
+								Serial:   serial,
+								DevState: state,
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	scanResp := func(ctrlrs ...*ctlpb.NvmeController) *UnaryResponse {
+		return MockMSResponse("host1", nil, &ctlpb.StorageScanResp{
+			Nvme: &ctlpb.ScanNvmeResp{
+				Ctrlrs: ctrlrs,
+				State:  new(ctlpb.ResponseState),
+			},
+			Scm: &ctlpb.ScanScmResp{
+				State: new(ctlpb.ResponseState),
+			},
+		})
+	}
+	ctrlr := func(addr, serial string) *ctlpb.NvmeController {
+		return &ctlpb.NvmeController{PciAddr: addr, Serial: serial}
+	}
+	manageResp := MockMSResponse("host1", nil, &ctlpb.SmdManageResp{})
+	poolsResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{})
+
+	for name, tc := range map[string]struct {
+		req       *NvmeReplaceWizardReq
+		uResps    []*UnaryResponse
+		uResp     *UnaryResponse
+		expState  *NvmeReplaceState
+		expStages []NvmeReplaceStage
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.NvmeReplaceWizardReq request"),
+		},
+		"bad old uuid": {
+			req:    &NvmeReplaceWizardReq{Host: "host1", OldUUID: "bad"},
+			expErr: errors.New("invalid old device UUID"),
+		},
+		"saved state for another device": {
+			req: &NvmeReplaceWizardReq{
+				Host:    "host1",
+				OldUUID: oldUUID,
+				State: &NvmeReplaceState{
+					Host:    "host1",
+					OldUUID: newUUID,
+					Stage:   NvmeReplaceStageReplace,
+				},
+			},
+			expErr: errors.New("saved replacement state is for device"),
+		},
+		"device not evicted": {
+			req: &NvmeReplaceWizardReq{Host: "host1", OldUUID: oldUUID, EngineIndex: -1},
+			uResps: []*UnaryResponse{
+				smdResp(oldUUID, oldAddr, "old", ctlpb.NvmeDevState_NORMAL),
+			},
+			expState: &NvmeReplaceState{
+				Host:    "host1",
+				OldUUID: oldUUID,
+				Stage:   NvmeReplaceStageVerify,
+				Error:   "device " + oldUUID + " is NORMAL; set it faulty with \"dmg storage set nvme-faulty\" before replacing it",
+			},
+			expStages: []NvmeReplaceStage{NvmeReplaceStageVerify},
+			expErr:    errors.New("failed in verify-evicted stage"),
+		},
+		"success": {
+			req: &NvmeReplaceWizardReq{Host: "host1", OldUUID: oldUUID, EngineIndex: -1},
+			uResps: []*UnaryResponse{
+				smdResp(oldUUID, oldAddr, "old", ctlpb.NvmeDevState_EVICTED),
+				manageResp,
+				scanResp(ctrlr(otherAddr, "other")),
+				scanResp(ctrlr(otherAddr, "other")),
+				scanResp(ctrlr(otherAddr, "other"), ctrlr(oldAddr, "new")),
+				manageResp,
+				smdResp(newUUID, oldAddr, "new", ctlpb.NvmeDevState_NEW),
+				manageResp,
+				smdResp(newUUID, oldAddr, "new", ctlpb.NvmeDevState_NEW),
+				smdResp(newUUID, oldAddr, "new", ctlpb.NvmeDevState_NORMAL),
+				poolsResp,
+			},
+			expState: &NvmeReplaceState{
+				Host:         "host1",
+				Rank:         1,
+				OldUUID:      oldUUID,
+				OldPciAddr:   oldAddr,
+				OldSerial:    "old",
+				KnownSerials: []string{"other"},
+				NewUUID:      newUUID,
+				NewPciAddr:   oldAddr,
+				NewSerial:    "new",
+				Stage:        NvmeReplaceStageDone,
+			},
+			expStages: []NvmeReplaceStage{
+				NvmeReplaceStageIdentify,
+				NvmeReplaceStageWaitDevice,
+				NvmeReplaceStageAddDevice,
+				NvmeReplaceStageReplace,
+				NvmeReplaceStageReintegrate,
+				NvmeReplaceStageRebuild,
+				NvmeReplaceStageDone,
+			},
+		},
+		"new device at different address; no engine index": {
+			req: &NvmeReplaceWizardReq{
+				Host:        "host1",
+				OldUUID:     oldUUID,
+				EngineIndex: -1,
+				State: &NvmeReplaceState{
+					Host:       "host1",
+					Rank:       1,
+					OldUUID:    oldUUID,
+					OldPciAddr: oldAddr,
+					OldSerial:  "old",
+					NewPciAddr: otherAddr,
+					NewSerial:  "new",
+					Stage:      NvmeReplaceStageAddDevice,
+				},
+			},
+			expState: &NvmeReplaceState{
+				Host:       "host1",
+				Rank:       1,
+				OldUUID:    oldUUID,
+				OldPciAddr: oldAddr,
+				OldSerial:  "old",
+				NewPciAddr: otherAddr,
+				NewSerial:  "new",
+				Stage:      NvmeReplaceStageAddDevice,
+				Error: "new SSD at " + otherAddr + " is not at the PCI address of the replaced SSD (" +
+					oldAddr + "); the index of the engine to add it to is required",
+			},
+			expStages: []NvmeReplaceStage{NvmeReplaceStageAddDevice},
+			expErr:    errors.New("engine to add it to is required"),
+		},
+		"resume at replace stage": {
+			req: &NvmeReplaceWizardReq{
+				Host:        "host1",
+				OldUUID:     oldUUID,
+				EngineIndex: -1,
+				State: &NvmeReplaceState{
+					Host:       "host1",
+					Rank:       1,
+					OldUUID:    oldUUID,
+					OldPciAddr: oldAddr,
+					NewUUID:    newUUID,
+					NewPciAddr: oldAddr,
+					Stage:      NvmeReplaceStageReplace,
+					Error:      "replace failed",
+				},
+			},
+			uResps: []*UnaryResponse{
+				manageResp,
+				smdResp(newUUID, oldAddr, "new", ctlpb.NvmeDevState_NORMAL),
+				poolsResp,
+			},
+			expState: &NvmeReplaceState{
+				Host:       "host1",
+				Rank:       1,
+				OldUUID:    oldUUID,
+				OldPciAddr: oldAddr,
+				NewUUID:    newUUID,
+				NewPciAddr: oldAddr,
+				Stage:      NvmeReplaceStageDone,
+			},
+			expStages: []NvmeReplaceStage{
+				NvmeReplaceStageReintegrate,
+				NvmeReplaceStageRebuild,
+				NvmeReplaceStageDone,
+			},
+		},
+		"new device times out": {
+			req: &NvmeReplaceWizardReq{
+				Host:          "host1",
+				OldUUID:       oldUUID,
+				EngineIndex:   -1,
+				DeviceTimeout: 50 * time.Millisecond,
+				State: &NvmeReplaceState{
+					Host:         "host1",
+					Rank:         1,
+					OldUUID:      oldUUID,
+					OldPciAddr:   oldAddr,
+					OldSerial:    "old",
+					KnownSerials: []string{"other"},
+					Stage:        NvmeReplaceStageWaitDevice,
+				},
+			},
+			uResp: scanResp(ctrlr(otherAddr, "other")),
+			expState: &NvmeReplaceState{
+				Host:         "host1",
+				Rank:         1,
+				OldUUID:      oldUUID,
+				OldPciAddr:   oldAddr,
+				OldSerial:    "old",
+				KnownSerials: []string{"other"},
+				Stage:        NvmeReplaceStageWaitDevice,
+				Error:        "timed out after 50ms waiting for a new SSD on host host1",
+			},
+			expStages: []NvmeReplaceStage{NvmeReplaceStageWaitDevice},
+			expErr:    errors.New("timed out"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+				UnaryResponse:    tc.uResp,
+			})
+
+			var gotStages []NvmeReplaceStage
+			if tc.req != nil {
+				tc.req.PollInterval = time.Millisecond
+				tc.req.SaveFn = func(state *NvmeReplaceState) error {
+					gotStages = append(gotStages, state.Stage)
+					return nil
+				}
+			}
+
+			gotState, gotErr := NvmeReplaceWizard(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(NvmeReplaceState{}, "Updated"),
+			}
+			if diff := cmp.Diff(tc.expState, gotState, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected state (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expStages, gotStages); diff != "" {
+				t.Fatalf("unexpected saved stages (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -762,6 +763,126 @@ func TestControl_StorageFormat(t *testing.T) {
 	}
 }
 
+func TestControl_GetStorageFormatPlan(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic         *MockInvokerConfig
+		reformat    bool
+		expResponse *StorageFormatPlanResp
+		expErr      error
+	}{
+		"local failure": {
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:  "host1",
+							Error: errors.New("remote failed"),
+						},
+					},
+				},
+			},
+			expResponse: &StorageFormatPlanResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "remote failed"}),
+			},
+		},
+		"plan": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.StorageFormatPlanResp{
+								Plan: &ctlpb.StorageFormatPlan{
+									Metadata: &ctlpb.MetadataFormatPlan{
+										Format:   true,
+										RootPath: "/md",
+										Paths:    []string{"/md", "/md/daos_control"},
+									},
+									Engines: []*ctlpb.EngineFormatPlan{
+										{
+											Scm: &ctlpb.ScmFormatPlan{
+												Format:     true,
+												Class:      "dcpm",
+												MountPoint: "/mnt/1",
+												Devices:    []string{"/dev/pmem0"},
+											},
+											FormatBdevs: true,
+											BdevTiers: []*ctlpb.BdevTierFormatPlan{
+												{
+													Tier:    1,
+													Class:   "nvme",
+													Devices: []string{"0000:80:00.0"},
+													Roles:   "NA",
+												},
+											},
+											NvmeConfig: "/mnt/1/daos_nvme.conf",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expResponse: &StorageFormatPlanResp{
+				HostPlans: map[string]*StorageFormatPlan{
+					"host1": {
+						Metadata: &MetadataFormatPlan{
+							Format:   true,
+							RootPath: "/md",
+							Paths:    []string{"/md", "/md/daos_control"},
+						},
+						Engines: []*EngineFormatPlan{
+							{
+								Scm: &ScmFormatPlan{
+									Format:     true,
+									Class:      "dcpm",
+									MountPoint: "/mnt/1",
+									Devices:    []string{"/dev/pmem0"},
+								},
+								FormatBdevs: true,
+								BdevTiers: []*BdevTierFormatPlan{
+									{
+										Tier:    1,
+										Class:   "nvme",
+										Devices: []string{"0000:80:00.0"},
+										Roles:   "NA",
+									},
+								},
+								NvmeConfig: "/mnt/1/daos_nvme.conf",
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := GetStorageFormatPlan(ctx, mi, &StorageFormatPlanReq{Reformat: tc.reformat})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_checkFormatReq(t *testing.T) {
 	reqHosts := func(h ...string) []string {
 		return h
@@ -427,13 +427,15 @@ type SystemStopReq struct {
 	unaryRequest
 	msRequest
 	sysRequest
-	Force bool
+	Force  bool
+	DryRun bool
 }
 
 // SystemStopResp contains the request response.
 type SystemStopResp struct {
 	sysResponse `json:"-"`
 	Results     system.MemberResults
+	PoolImpacts PoolImpacts `json:"pool_impacts,omitempty"`
 }
 
 // UnmarshalJSON unpacks JSON message into SystemStopResp struct.
@@ -471,10 +473,11 @@ func SystemStop(ctx context.Context, rpcClient UnaryInvoker, req *SystemStopReq)
 	}
 
 	pbReq := &mgmtpb.SystemStopReq{
-		Hosts: req.Hosts.String(),
-		Ranks: req.Ranks.String(),
-		Sys:   req.getSystem(rpcClient),
-		Force: req.Force,
+		Hosts:  req.Hosts.String(),
+		Ranks:  req.Ranks.String(),
+		Sys:    req.getSystem(rpcClient),
+		Force:  req.Force,
+		DryRun: req.DryRun,
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemStop(ctx, pbReq)
@@ -523,7 +526,8 @@ type SystemExcludeReq struct {
 	unaryRequest
 	msRequest
 	sysRequest
-	Clear bool
+	Clear  bool
+	DryRun bool
 }
 
 // SystemExcludeResp contains the request response. UnmarshalJSON is not implemented on this type
@@ -532,6 +536,7 @@ type SystemExcludeReq struct {
 type SystemExcludeResp struct {
 	sysResponse `json:"-"`
 	Results     system.MemberResults
+	PoolImpacts PoolImpacts `json:"pool_impacts,omitempty"`
 }
 
 // Errors returns a single error combining all error messages associated with a system exclude
@@ -548,10 +553,11 @@ func SystemExclude(ctx context.Context, rpcClient UnaryInvoker, req *SystemExclu
 	}
 
 	pbReq := &mgmtpb.SystemExcludeReq{
-		Hosts: req.Hosts.String(),
-		Ranks: req.Ranks.String(),
-		Sys:   req.getSystem(rpcClient),
-		Clear: req.Clear,
+		Hosts:  req.Hosts.String(),
+		Ranks:  req.Ranks.String(),
+		Sys:    req.getSystem(rpcClient),
+		Clear:  req.Clear,
+		DryRun: req.DryRun,
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemExclude(ctx, pbReq)
@@ -578,12 +584,45 @@ type PoolRankResult struct {
 // PoolRankResults is an alias for a PoolRankResult slice.
 type PoolRankResults []*PoolRankResult
 
+// PoolImpact describes the predicted impact on a pool of making a set of ranks
+// unavailable.
+type PoolImpact struct {
+	PoolID           string `json:"pool_id"`           // Unique identifier for pool
+	Ranks            string `json:"ranks"`             // Pool ranks that would become unavailable
+	DownRanks        string `json:"down_ranks"`        // Pool ranks that are already unavailable
+	RedundancyFactor uint32 `json:"redundancy_factor"` // Pool redundancy factor
+	SvcRanks         string `json:"svc_ranks"`         // Pool service replicas that would become unavailable
+	SvcReplicas      uint32 `json:"svc_replicas"`      // Total number of pool service replicas
+	Degraded         bool   `json:"degraded"`          // Pool would lose redundancy within its redundancy factor
+	RfBroken         bool   `json:"rf_broken"`         // Unavailable fault domains would exceed the redundancy factor
+	SvcMajorityLost  bool   `json:"svc_majority_lost"` // Pool service would lose its replica majority
+	Msg              string `json:"msg"`               // Error message if the impact could not be determined
+}
+
+// PoolImpacts is an alias for a PoolImpact slice.
+type PoolImpacts []*PoolImpact
+
+// Errors returns a single error describing any pools that would lose data
+// redundancy beyond their redundancy factor or their pool service majority.
+func (pis PoolImpacts) Errors() (err error) {
+	for _, pi := range pis {
+		switch {
+		case pi.RfBroken:
+			err = concatErrs(err, errors.Errorf("pool %s would exceed its redundancy factor", pi.PoolID))
+		case pi.SvcMajorityLost:
+			err = concatErrs(err, errors.Errorf("pool %s would lose its pool service majority", pi.PoolID))
+		}
+	}
+	return
+}
+
 // SystemDrainReq contains the inputs for the system drain request.
 type SystemDrainReq struct {
 	unaryRequest
 	msRequest
 	sysRequest
-	Reint bool
+	Reint  bool
+	DryRun bool
 }
 
 // SystemDrainResp contains the request response. UnmarshalJSON is not implemented on this type
@@ -592,6 +631,7 @@ type SystemDrainReq struct {
 type SystemDrainResp struct {
 	sysResponse `json:"-"`
 	Results     PoolRankResults `json:"results"`
+	PoolImpacts PoolImpacts     `json:"pool_impacts,omitempty"`
 }
 
 // Errors returns a single error combining all error messages associated with pool-rank results.
@@ -615,10 +655,11 @@ func SystemDrain(ctx context.Context, rpcClient UnaryInvoker, req *SystemDrainRe
 	}
 
 	pbReq := &mgmtpb.SystemDrainReq{
-		Hosts: req.Hosts.String(),
-		Ranks: req.Ranks.String(),
-		Sys:   req.getSystem(rpcClient),
-		Reint: req.Reint,
+		Hosts:  req.Hosts.String(),
+		Ranks:  req.Ranks.String(),
+		Sys:    req.getSystem(rpcClient),
+		Reint:  req.Reint,
+		DryRun: req.DryRun,
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemDrain(ctx, pbReq)
@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// SystemBackup contains details of a MS database backup.
+type SystemBackup struct {
+	Name       string    `json:"name"`
+	Path       string    `json:"path"`
+	Created    time.Time `json:"created"`
+	Index      uint64    `json:"index"`
+	Term       uint64    `json:"term"`
+	MapVersion uint32    `json:"map_version"`
+	Size       int64     `json:"size"`
+	Compressed bool      `json:"compressed"`
+	NumMembers uint32    `json:"num_members"`
+	NumPools   uint32    `json:"num_pools"`
+}
+
+func systemBackupFromProto(pb *mgmtpb.SystemBackup) *SystemBackup {
+	return &SystemBackup{
+		Name:       pb.GetName(),
+		Path:       pb.GetPath(),
+		Created:    time.Unix(0, pb.GetCreated()),
+		Index:      pb.GetIndex(),
+		Term:       pb.GetTerm(),
+		MapVersion: pb.GetMapVersion(),
+		Size:       pb.GetSize(),
+		Compressed: pb.GetCompressed(),
+		NumMembers: pb.GetNumMembers(),
+		NumPools:   pb.GetNumPools(),
+	}
+}
+
+type (
+	// SystemBackupCreateReq contains the inputs for a request to create a
+	// MS database backup.
+	SystemBackupCreateReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemBackupCreateResp contains details of the created backup.
+	SystemBackupCreateResp struct {
+		Backup *SystemBackup `json:"backup"`
+	}
+)
+
+// SystemBackupCreate requests that the MS leader write a backup of the system
+// database to its configured backup directory.
+func SystemBackupCreate(ctx context.Context, rpcClient UnaryInvoker, req *SystemBackupCreateReq) (*SystemBackupCreateResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemBackupCreateReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemBackupCreate(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system backup create request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "system backup create failed")
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemBackupCreateResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+	if pbResp.GetBackup() == nil {
+		return nil, errors.New("no backup details in response")
+	}
+
+	return &SystemBackupCreateResp{
+		Backup: systemBackupFromProto(pbResp.GetBackup()),
+	}, nil
+}
+
+type (
+	// SystemBackupListReq contains the inputs for a request to list MS
+	// database backups.
+	SystemBackupListReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemBackupListResp contains details of the available MS database
+	// backups, ordered from oldest to newest.
+	SystemBackupListResp struct {
+		Dir     string          `json:"dir"`
+		Backups []*SystemBackup `json:"backups"`
+	}
+)
+
+// SystemBackupList retrieves details of the MS database backups available on
+// the MS leader.
+func SystemBackupList(ctx context.Context, rpcClient UnaryInvoker, req *SystemBackupListReq) (*SystemBackupListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemBackupListReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemBackupList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system backup list request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "system backup list failed")
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemBackupListResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+
+	resp := &SystemBackupListResp{
+		Dir:     pbResp.GetDir(),
+		Backups: make([]*SystemBackup, 0, len(pbResp.GetBackups())),
+	}
+	for _, pbBackup := range pbResp.GetBackups() {
+		resp.Backups = append(resp.Backups, systemBackupFromProto(pbBackup))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,174 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func mockPBSystemBackup(name string, created time.Time) *mgmtpb.SystemBackup {
+	return &mgmtpb.SystemBackup{
+		Name:       name,
+		Path:       "/backups/" + name,
+		Created:    created.UnixNano(),
+		Index:      42,
+		Term:       3,
+		MapVersion: 7,
+		Size:       1024,
+		Compressed: true,
+		NumMembers: 4,
+		NumPools:   2,
+	}
+}
+
+func TestControl_SystemBackupCreate(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		req     *SystemBackupCreateReq
+		uResp   *UnaryResponse
+		expResp *SystemBackupCreateResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.SystemBackupCreateReq request"),
+		},
+		"local failure": {
+			req:    &SystemBackupCreateReq{},
+			uResp:  MockMSResponse("host1", errors.New("local failed"), nil),
+			expErr: errors.New("local failed"),
+		},
+		"unexpected response type": {
+			req:    &SystemBackupCreateReq{},
+			uResp:  MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{}),
+			expErr: errors.New("unexpected response type"),
+		},
+		"missing backup details": {
+			req:    &SystemBackupCreateReq{},
+			uResp:  MockMSResponse("host1", nil, &mgmtpb.SystemBackupCreateResp{}),
+			expErr: errors.New("no backup details"),
+		},
+		"success": {
+			req: &SystemBackupCreateReq{},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemBackupCreateResp{
+				Backup: mockPBSystemBackup("backup-1", created),
+			}),
+			expResp: &SystemBackupCreateResp{
+				Backup: &SystemBackup{
+					Name:       "backup-1",
+					Path:       "/backups/backup-1",
+					Created:    created,
+					Index:      42,
+					Term:       3,
+					MapVersion: 7,
+					Size:       1024,
+					Compressed: true,
+					NumMembers: 4,
+					NumPools:   2,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemBackupCreate(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y time.Time) bool { return x.Equal(y) }),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemBackupList(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		req      *SystemBackupListReq
+		uResp    *UnaryResponse
+		expDir   string
+		expNames []string
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.SystemBackupListReq request"),
+		},
+		"local failure": {
+			req:    &SystemBackupListReq{},
+			uResp:  MockMSResponse("host1", errors.New("local failed"), nil),
+			expErr: errors.New("local failed"),
+		},
+		"unexpected response type": {
+			req:    &SystemBackupListReq{},
+			uResp:  MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{}),
+			expErr: errors.New("unexpected response type"),
+		},
+		"no backups": {
+			req:      &SystemBackupListReq{},
+			uResp:    MockMSResponse("host1", nil, &mgmtpb.SystemBackupListResp{Dir: "/backups"}),
+			expDir:   "/backups",
+			expNames: []string{},
+		},
+		"backups returned": {
+			req: &SystemBackupListReq{},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemBackupListResp{
+				Dir: "/backups",
+				Backups: []*mgmtpb.SystemBackup{
+					mockPBSystemBackup("backup-1", created),
+					mockPBSystemBackup("backup-2", created.Add(time.Hour)),
+				},
+			}),
+			expDir:   "/backups",
+			expNames: []string{"backup-1", "backup-2"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemBackupList(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expDir, gotResp.Dir, "unexpected backup dir")
+			gotNames := []string{}
+			for _, b := range gotResp.Backups {
+				gotNames = append(gotNames, b.Name)
+			}
+			if diff := cmp.Diff(tc.expNames, gotNames); diff != "" {
+				t.Fatalf("unexpected backups (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
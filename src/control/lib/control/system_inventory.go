@@ -0,0 +1,203 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// inventoryPoolProps are the pool properties reported in the inventory.
+var inventoryPoolProps = []string{"rd_fac", "svc_rf"}
+
+// InventoryRowType identifies the type of object described by an inventory row.
+type InventoryRowType string
+
+const (
+	// InventoryRowPool indicates that the row describes a pool.
+	InventoryRowPool InventoryRowType = "pool"
+	// InventoryRowContainer indicates that the row describes a container.
+	InventoryRowContainer InventoryRowType = "container"
+)
+
+type (
+	// SystemInventoryReq contains the inputs for a system inventory request.
+	SystemInventoryReq struct {
+		// NoContainers excludes container rows from the inventory.
+		NoContainers bool
+	}
+
+	// SystemInventoryRow describes a single pool or container in the
+	// system inventory. DAOS does not track the space used by or the
+	// creation time of individual containers, so these are only reported
+	// for pools. CreationTime is nil for pools whose creation time was
+	// never recorded. If any details could not be retrieved, the row is
+	// populated on a best-effort basis and Error is set.
+	SystemInventoryRow struct {
+		Type          InventoryRowType `json:"type"`
+		PoolUUID      uuid.UUID        `json:"pool_uuid"`
+		PoolLabel     string           `json:"pool_label"`
+		ContUUID      string           `json:"cont_uuid,omitempty"`
+		ContLabel     string           `json:"cont_label,omitempty"`
+		State         string           `json:"state,omitempty"`
+		OwnerUser     string           `json:"owner_user,omitempty"`
+		OwnerGroup    string           `json:"owner_group,omitempty"`
+		TotalBytes    uint64           `json:"total_bytes"`
+		FreeBytes     uint64           `json:"free_bytes"`
+		RedundancyFac string           `json:"rd_fac,omitempty"`
+		SvcRedundancy string           `json:"svc_rf,omitempty"`
+		CreationTime  *time.Time       `json:"creation_time,omitempty"`
+		Error         string           `json:"error,omitempty"`
+	}
+
+	// SystemInventoryResp contains the results of a system inventory request.
+	SystemInventoryResp struct {
+		Rows []*SystemInventoryRow `json:"rows"`
+	}
+)
+
+func (sir *SystemInventoryRow) addError(err error) {
+	if sir.Error != "" {
+		sir.Error += "; "
+	}
+	sir.Error += err.Error()
+}
+
+func newPoolInventoryRow(log debugLogger, pool *daos.PoolInfo) *SystemInventoryRow {
+	row := &SystemInventoryRow{
+		Type:      InventoryRowPool,
+		PoolUUID:  pool.UUID,
+		PoolLabel: pool.Label,
+		State:     pool.State.String(),
+	}
+	for _, ts := range pool.TierStats {
+		row.TotalBytes += ts.Total
+		row.FreeBytes += ts.Free
+	}
+	// Pools created by older versions have no recorded creation time;
+	// leave it unset rather than reporting the Unix epoch.
+	if pool.CreationTime <= 0 {
+		log.Debugf("no creation time recorded for pool %s", pool.UUID)
+	} else {
+		created := time.Unix(pool.CreationTime, 0)
+		row.CreationTime = &created
+	}
+
+	return row
+}
+
+// addPoolInventoryDetails adds the pool properties and ownership to a pool
+// inventory row and returns the rows for the pool's containers.
+func addPoolInventoryDetails(ctx context.Context, rpcClient UnaryInvoker, req *SystemInventoryReq, row *SystemInventoryRow) []*SystemInventoryRow {
+	poolID := row.PoolUUID.String()
+
+	allProps := daos.PoolProperties()
+	propReq := &PoolGetPropReq{ID: poolID}
+	for _, name := range inventoryPoolProps {
+		prop, err := allProps.GetProperty(name)
+		if err != nil {
+			row.addError(err)
+			continue
+		}
+		propReq.Properties = append(propReq.Properties, prop)
+	}
+	props, err := PoolGetProp(ctx, rpcClient, propReq)
+	if err != nil {
+		row.addError(errors.Wrap(err, "get-prop failed"))
+	}
+	for _, prop := range props {
+		switch prop.Name {
+		case "rd_fac":
+			row.RedundancyFac = prop.StringValue()
+		case "svc_rf":
+			row.SvcRedundancy = prop.StringValue()
+		}
+	}
+
+	aclResp, err := PoolGetACL(ctx, rpcClient, &PoolGetACLReq{ID: poolID})
+	if err != nil {
+		row.addError(errors.Wrap(err, "get-acl failed"))
+	} else if aclResp.ACL != nil {
+		row.OwnerUser = aclResp.ACL.Owner
+		row.OwnerGroup = aclResp.ACL.OwnerGroup
+	}
+
+	if req.NoContainers {
+		return nil
+	}
+
+	lcr, err := ListContainers(ctx, rpcClient, &ListContainersReq{PoolID: poolID, Details: true})
+	if err != nil {
+		row.addError(err)
+		return nil
+	}
+
+	contRows := make([]*SystemInventoryRow, 0, len(lcr.Containers))
+	for _, cont := range lcr.Containers {
+		contRow := &SystemInventoryRow{
+			Type:      InventoryRowContainer,
+			PoolUUID:  row.PoolUUID,
+			PoolLabel: row.PoolLabel,
+			ContUUID:  cont.UUID.String(),
+			ContLabel: cont.Label,
+		}
+		if cont.Status != daos.Success {
+			contRow.addError(errors.Wrap(cont.Status, "container details failed"))
+		} else {
+			contRow.OwnerUser = cont.OwnerUser
+			contRow.OwnerGroup = cont.OwnerGroup
+			contRow.RedundancyFac = strconv.FormatUint(cont.RedundancyFac, 10)
+		}
+		contRows = append(contRows, contRow)
+	}
+
+	return contRows
+}
+
+// SystemInventory walks all pools in the system and their containers to
+// produce an inventory suitable for usage accounting. Pools that cannot be
+// reached are included in the results with the error that occurred.
+func SystemInventory(ctx context.Context, rpcClient UnaryInvoker, req *SystemInventoryReq) (*SystemInventoryResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	lpr, err := ListPools(ctx, rpcClient, new(ListPoolsReq))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SystemInventoryResp{Rows: []*SystemInventoryRow{}}
+	for _, pool := range lpr.Pools {
+		row := newPoolInventoryRow(rpcClient, pool)
+		resp.Rows = append(resp.Rows, row)
+
+		if err := lpr.PoolQueryError(pool.UUID); err != nil {
+			row.addError(errors.Wrap(err, "query failed"))
+			continue
+		}
+
+		// Only pools with a running pool service can report details.
+		switch pool.State {
+		case daos.PoolServiceStateReady, daos.PoolServiceStateDegraded:
+		default:
+			rpcClient.Debugf("skipping inventory details of pool %s in state %s",
+				pool.UUID, pool.State)
+			continue
+		}
+
+		resp.Rows = append(resp.Rows, addPoolInventoryDetails(ctx, rpcClient, req, row)...)
+	}
+
+	return resp, nil
+}
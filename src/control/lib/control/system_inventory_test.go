@@ -0,0 +1,225 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_SystemInventory(t *testing.T) {
+	creationTime := time.Unix(1735689600, 0)
+
+	listPoolsResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{
+				Uuid:         test.MockUUID(1),
+				Label:        "a",
+				State:        daos.PoolServiceStateReady.String(),
+				CreationTime: creationTime.Unix(),
+			},
+			{
+				Uuid:  test.MockUUID(2),
+				Label: "b",
+				State: daos.PoolServiceStateReady.String(),
+			},
+			{
+				Uuid:  test.MockUUID(3),
+				Label: "c",
+				State: daos.PoolServiceStateDestroying.String(),
+			},
+		},
+	})
+	queryResps := []*UnaryResponse{
+		MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:          test.MockUUID(1),
+			TotalTargets:  8,
+			ActiveTargets: 8,
+			TierStats: []*mgmtpb.StorageUsageStats{
+				{Total: 100, Free: 40, MediaType: mgmtpb.StorageMediaType_SCM},
+				{Total: 1000, Free: 600, MediaType: mgmtpb.StorageMediaType_NVME},
+			},
+		}),
+		MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Status: int32(daos.NoPermission),
+		}),
+	}
+	getPropResp := MockMSResponse("host1", nil, &mgmtpb.PoolGetPropResp{
+		Properties: []*mgmtpb.PoolProperty{
+			{
+				Number: daos.PoolPropertyRedunFac,
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: 1},
+			},
+			{
+				Number: daos.PoolPropertySvcRedunFac,
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: 2},
+			},
+		},
+	})
+	getACLResp := MockMSResponse("host1", nil, &mgmtpb.ACLResp{
+		Acl: &mgmtpb.AccessControlList{
+			OwnerUser:  "alice@",
+			OwnerGroup: "research@",
+		},
+	})
+	listContResp := MockMSResponse("host1", nil, &mgmtpb.ListContResp{
+		Containers: []*mgmtpb.ListContResp_Cont{
+			{
+				Uuid:       test.MockUUID(11),
+				Label:      "results",
+				OwnerUser:  "bob@",
+				OwnerGroup: "research@",
+				RedunFac:   2,
+				RedunLvl:   2,
+			},
+			{
+				Uuid:   test.MockUUID(12),
+				Status: int32(daos.NoPermission),
+			},
+		},
+	})
+
+	expPoolRow := &SystemInventoryRow{
+		Type:          InventoryRowPool,
+		PoolUUID:      test.MockPoolUUID(1),
+		PoolLabel:     "a",
+		State:         daos.PoolServiceStateReady.String(),
+		OwnerUser:     "alice@",
+		OwnerGroup:    "research@",
+		TotalBytes:    1100,
+		FreeBytes:     640,
+		RedundancyFac: "1",
+		SvcRedundancy: "2",
+		CreationTime:  &creationTime,
+	}
+	expContRow := &SystemInventoryRow{
+		Type:          InventoryRowContainer,
+		PoolUUID:      test.MockPoolUUID(1),
+		PoolLabel:     "a",
+		ContUUID:      test.MockUUID(11),
+		ContLabel:     "results",
+		OwnerUser:     "bob@",
+		OwnerGroup:    "research@",
+		RedundancyFac: "2",
+	}
+	expContErrRow := &SystemInventoryRow{
+		Type:      InventoryRowContainer,
+		PoolUUID:  test.MockPoolUUID(1),
+		PoolLabel: "a",
+		ContUUID:  test.MockUUID(12),
+		Error:     "container details failed: " + daos.NoPermission.Error(),
+	}
+	expErrRow := &SystemInventoryRow{
+		Type:      InventoryRowPool,
+		PoolUUID:  test.MockPoolUUID(2),
+		PoolLabel: "b",
+		State:     daos.PoolServiceStateReady.String(),
+		Error:     "query failed: " + daos.NoPermission.Error(),
+	}
+	expDestroyingRow := &SystemInventoryRow{
+		Type:      InventoryRowPool,
+		PoolUUID:  test.MockPoolUUID(3),
+		PoolLabel: "c",
+		State:     daos.PoolServiceStateDestroying.String(),
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *SystemInventoryReq
+		expResp *SystemInventoryResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"list pools fails": {
+			req: &SystemInventoryReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"no pools": {
+			req: &SystemInventoryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{}),
+			},
+			expResp: &SystemInventoryResp{Rows: []*SystemInventoryRow{}},
+		},
+		"pools and containers": {
+			req: &SystemInventoryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: append(append([]*UnaryResponse{listPoolsResp}, queryResps...),
+					getPropResp, getACLResp, listContResp),
+			},
+			expResp: &SystemInventoryResp{
+				Rows: []*SystemInventoryRow{
+					expPoolRow, expContRow, expContErrRow, expErrRow, expDestroyingRow,
+				},
+			},
+		},
+		"no containers": {
+			req: &SystemInventoryReq{NoContainers: true},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: append(append([]*UnaryResponse{listPoolsResp}, queryResps...),
+					getPropResp, getACLResp),
+			},
+			expResp: &SystemInventoryResp{
+				Rows: []*SystemInventoryRow{expPoolRow, expErrRow, expDestroyingRow},
+			},
+		},
+		"container list fails": {
+			req: &SystemInventoryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: append(append([]*UnaryResponse{listPoolsResp}, queryResps...),
+					getPropResp, getACLResp,
+					MockMSResponse("host1", errors.New("remote failed"), nil)),
+			},
+			expResp: &SystemInventoryResp{
+				Rows: []*SystemInventoryRow{
+					func() *SystemInventoryRow {
+						row := *expPoolRow
+						row.Error = "container list failed: remote failed"
+						return &row
+					}(),
+					expErrRow, expDestroyingRow,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := SystemInventory(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// SystemMaintenanceReq contains the inputs for a request to place the
+	// members on a set of hosts or in a fault domain in maintenance, or to
+	// take them out of it.
+	SystemMaintenanceReq struct {
+		unaryRequest
+		msRequest
+		Hosts       hostlist.HostSet
+		FaultDomain string
+		Exit        bool
+	}
+
+	// SystemMaintenanceResp contains the results of a system maintenance
+	// request.
+	SystemMaintenanceResp struct {
+		Results system.MemberResults `json:"results"`
+	}
+)
+
+// Errors returns a single error combining all error messages associated with a
+// system maintenance response.
+func (resp *SystemMaintenanceResp) Errors() error {
+	return resp.Results.Errors()
+}
+
+// SystemMaintenance marks the members on the specified hosts or in the
+// specified fault domain as being in maintenance, or clears the mark if
+// Exit is set in the request.
+func SystemMaintenance(ctx context.Context, rpcClient UnaryInvoker, req *SystemMaintenanceReq) (*SystemMaintenanceResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemMaintenanceReq{
+		Sys:         req.getSystem(rpcClient),
+		Hosts:       req.Hosts.String(),
+		FaultDomain: req.FaultDomain,
+		Exit:        req.Exit,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemMaintenance(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system maintenance request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemMaintenanceResp)
+	return resp, convertMSResponse(ur, resp)
+}
@@ -0,0 +1,98 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_SystemMaintenance(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        *SystemMaintenanceReq
+		uErr       error
+		uResp      *UnaryResponse
+		expErr     error
+		expResp    *SystemMaintenanceResp
+		expRespErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.SystemMaintenanceReq request"),
+		},
+		"local failure": {
+			req:    new(SystemMaintenanceReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    new(SystemMaintenanceReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"dual rank": {
+			req: &SystemMaintenanceReq{FaultDomain: "/rack0"},
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemMaintenanceResp{
+				Results: []*sharedpb.RankResult{
+					{Rank: 0, Action: "enter maintenance", State: "joined"},
+					{Rank: 1, Action: "enter maintenance", State: "stopped"},
+				},
+			}),
+			expResp: &SystemMaintenanceResp{
+				Results: system.MemberResults{
+					{Rank: 0, Action: "enter maintenance", State: system.MemberStateJoined},
+					{Rank: 1, Action: "enter maintenance", State: system.MemberStateStopped},
+				},
+			},
+		},
+		"with errors": {
+			req: new(SystemMaintenanceReq),
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemMaintenanceResp{
+				Results: []*sharedpb.RankResult{
+					{Rank: 3, Errored: true, Msg: "fail", State: "joined"},
+				},
+			}),
+			expResp: &SystemMaintenanceResp{
+				Results: system.MemberResults{
+					{Rank: 3, Errored: true, Msg: "fail", State: system.MemberStateJoined},
+				},
+			},
+			expRespErr: errors.New("failed rank 3"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemMaintenance(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{cmpopts.IgnoreUnexported(system.MemberResult{})}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			test.CmpErr(t, tc.expRespErr, gotResp.Errors())
+		})
+	}
+}
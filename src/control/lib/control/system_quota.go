@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// SystemSetQuotaReq contains the inputs for a request to set or remove
+	// the storage quota for a tenant. Zero limits are unlimited.
+	SystemSetQuotaReq struct {
+		unaryRequest
+		msRequest
+		Principal    string
+		MaxScmBytes  uint64
+		MaxNvmeBytes uint64
+		MaxPools     uint32
+		Remove       bool
+	}
+
+	// SystemGetQuotaReq contains the inputs for a request to get tenant
+	// quotas. If no principals are supplied, all quotas are returned.
+	SystemGetQuotaReq struct {
+		unaryRequest
+		msRequest
+		Principals []string
+	}
+
+	// TenantQuota describes the storage quota for a tenant and the
+	// tenant's current usage.
+	TenantQuota struct {
+		Principal     string `json:"principal"`
+		MaxScmBytes   uint64 `json:"max_scm_bytes"`
+		MaxNvmeBytes  uint64 `json:"max_nvme_bytes"`
+		MaxPools      uint32 `json:"max_pools"`
+		UsedScmBytes  uint64 `json:"used_scm_bytes"`
+		UsedNvmeBytes uint64 `json:"used_nvme_bytes"`
+		UsedPools     uint32 `json:"used_pools"`
+	}
+
+	// SystemGetQuotaResp contains the results of a get quota request.
+	SystemGetQuotaResp struct {
+		Quotas []*TenantQuota `json:"quotas"`
+	}
+)
+
+// SystemSetQuota sets or removes the storage quota for a tenant. The quota
+// limits the total storage and number of pools owned by the tenant, and is
+// enforced on pool create and extend.
+func SystemSetQuota(ctx context.Context, rpcClient UnaryInvoker, req *SystemSetQuotaReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if err := system.ValidateQuotaPrincipal(req.Principal); err != nil {
+		return err
+	}
+
+	pbReq := &mgmtpb.SystemSetQuotaReq{
+		Sys:          req.getSystem(rpcClient),
+		Principal:    req.Principal,
+		MaxScmBytes:  req.MaxScmBytes,
+		MaxNvmeBytes: req.MaxNvmeBytes,
+		MaxPools:     req.MaxPools,
+		Remove:       req.Remove,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemSetQuota(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemSetQuota request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+// SystemGetQuota gets tenant storage quotas along with the current usage of
+// each tenant.
+func SystemGetQuota(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetQuotaReq) (*SystemGetQuotaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemGetQuotaReq{
+		Sys:        req.getSystem(rpcClient),
+		Principals: req.Principals,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemGetQuota(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemGetQuota request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemGetQuotaResp)
+	return resp, convertMSResponse(ur, resp)
+}
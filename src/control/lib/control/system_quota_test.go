@@ -0,0 +1,133 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_SystemSetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *SystemSetQuotaReq
+		uErr   error
+		uResp  *UnaryResponse
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.SystemSetQuotaReq request"),
+		},
+		"invalid principal": {
+			req:    &SystemSetQuotaReq{Principal: "bob@"},
+			expErr: errors.New("invalid quota principal"),
+		},
+		"local failure": {
+			req:    &SystemSetQuotaReq{Principal: "u:bob@", MaxPools: 1},
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    &SystemSetQuotaReq{Principal: "u:bob@", MaxPools: 1},
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req:   &SystemSetQuotaReq{Principal: "g:builders@", MaxScmBytes: 1024},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.DaosResp{}),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotErr := SystemSetQuota(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_SystemGetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemGetQuotaReq
+		uErr    error
+		uResp   *UnaryResponse
+		expErr  error
+		expResp *SystemGetQuotaResp
+	}{
+		"nil req": {
+			expErr: errors.New("nil *control.SystemGetQuotaReq request"),
+		},
+		"local failure": {
+			req:    new(SystemGetQuotaReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    new(SystemGetQuotaReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &SystemGetQuotaReq{Principals: []string{"u:bob@"}},
+			uResp: MockMSResponse("host1", nil, &mgmtpb.SystemGetQuotaResp{
+				Quotas: []*mgmtpb.TenantQuota{
+					{
+						Principal:     "u:bob@",
+						MaxScmBytes:   2048,
+						MaxPools:      4,
+						UsedScmBytes:  1024,
+						UsedNvmeBytes: 4096,
+						UsedPools:     2,
+					},
+				},
+			}),
+			expResp: &SystemGetQuotaResp{
+				Quotas: []*TenantQuota{
+					{
+						Principal:     "u:bob@",
+						MaxScmBytes:   2048,
+						MaxPools:      4,
+						UsedScmBytes:  1024,
+						UsedNvmeBytes: 4096,
+						UsedPools:     2,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemGetQuota(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
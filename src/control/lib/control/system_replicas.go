@@ -0,0 +1,206 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// SystemReplica contains details of a MS replica.
+type SystemReplica struct {
+	Addr   string `json:"addr"`
+	State  string `json:"state"`
+	Leader bool   `json:"leader"`
+}
+
+type (
+	// SystemReplicasListReq contains the inputs for a request to list the
+	// MS replicas.
+	SystemReplicasListReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemReplicasListResp contains details of the MS replicas.
+	SystemReplicasListResp struct {
+		Replicas []*SystemReplica `json:"replicas"`
+	}
+)
+
+// SystemReplicasList retrieves the MS replica set along with the raft
+// membership state of each replica.
+func SystemReplicasList(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicasListReq) (*SystemReplicasListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemReplicasListReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplicasList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system replicas list request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "system replicas list failed")
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemReplicasListResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+
+	resp := &SystemReplicasListResp{
+		Replicas: make([]*SystemReplica, 0, len(pbResp.GetReplicas())),
+	}
+	for _, pbRep := range pbResp.GetReplicas() {
+		resp.Replicas = append(resp.Replicas, &SystemReplica{
+			Addr:   pbRep.GetAddr(),
+			State:  pbRep.GetState(),
+			Leader: pbRep.GetLeader(),
+		})
+	}
+
+	return resp, nil
+}
+
+type (
+	// SystemReplicasUpdateReq contains the inputs for a request to add or
+	// remove MS replicas.
+	SystemReplicasUpdateReq struct {
+		unaryRequest
+		msRequest
+		Add    []string
+		Remove []string
+		Force  bool
+	}
+
+	// SystemReplicasUpdateResp contains the results of a MS replica set
+	// change.
+	SystemReplicasUpdateResp struct {
+		Replicas     []string          `json:"replicas"`
+		RestartHosts []string          `json:"restart_hosts"`
+		HostErrors   map[string]string `json:"host_errors"`
+	}
+)
+
+// SystemReplicasUpdate requests that the MS leader add or remove MS replicas.
+// Newly-added replicas join the raft cluster once their control plane has been
+// restarted; the hosts requiring a restart are listed in the response.
+func SystemReplicasUpdate(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicasUpdateReq) (*SystemReplicasUpdateResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if len(req.Add) == 0 && len(req.Remove) == 0 {
+		return nil, errors.New("no replicas to add or remove")
+	}
+
+	pbReq := &mgmtpb.SystemReplicasUpdateReq{
+		Sys:    req.getSystem(rpcClient),
+		Add:    req.Add,
+		Remove: req.Remove,
+		Force:  req.Force,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplicasUpdate(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system replicas update request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "system replicas update failed")
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemReplicasUpdateResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+
+	return &SystemReplicasUpdateResp{
+		Replicas:     pbResp.GetReplicas(),
+		RestartHosts: pbResp.GetRestartHosts(),
+		HostErrors:   pbResp.GetHostErrors(),
+	}, nil
+}
+
+type (
+	// SetMgmtSvcReplicasReq contains the inputs for a request sent by the MS
+	// leader to update the replica set on control plane servers.
+	SetMgmtSvcReplicasReq struct {
+		unaryRequest
+		Replicas []string
+	}
+
+	// SetMgmtSvcReplicasResp contains the results of a replica set update
+	// on each of the requested hosts.
+	SetMgmtSvcReplicasResp struct {
+		HostErrorsResp
+		RestartHosts []string `json:"restart_hosts"`
+	}
+)
+
+// SetMgmtSvcReplicas sends the updated MS replica set to each host in the
+// request hostlist.
+func SetMgmtSvcReplicas(ctx context.Context, rpcClient UnaryInvoker, req *SetMgmtSvcReplicasReq) (*SetMgmtSvcReplicasResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SetMgmtSvcReplicasReq{
+		Sys:      req.getSystem(rpcClient),
+		Replicas: req.Replicas,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SetMgmtSvcReplicas(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS set MS replicas request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SetMgmtSvcReplicasResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*mgmtpb.SetMgmtSvcReplicasResp)
+		if !ok {
+			return nil, errors.Errorf("unexpected response type: %T", hostResp.Message)
+		}
+		if pbResp.GetRestartRequired() {
+			resp.RestartHosts = append(resp.RestartHosts, hostResp.Addr)
+		}
+	}
+	sort.Strings(resp.RestartHosts)
+
+	return resp, nil
+}
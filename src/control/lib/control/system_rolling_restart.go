@@ -0,0 +1,428 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	defaultRollingRestartPollInterval  = 5 * time.Second
+	defaultRollingRestartRejoinTimeout = 10 * time.Minute
+)
+
+// RollingRestartStage identifies a stage in the restart of a fault domain.
+type RollingRestartStage string
+
+// Stages of a rolling restart, in the order that they are performed for each
+// fault domain.
+const (
+	RollingRestartStagePending     RollingRestartStage = "pending"
+	RollingRestartStageStop        RollingRestartStage = "stop"
+	RollingRestartStageStart       RollingRestartStage = "start"
+	RollingRestartStageRejoin      RollingRestartStage = "rejoin"
+	RollingRestartStageReintegrate RollingRestartStage = "reintegrate"
+	RollingRestartStageRebuild     RollingRestartStage = "rebuild"
+	RollingRestartStageDone        RollingRestartStage = "done"
+)
+
+type (
+	// RollingRestartDomain describes the progress of the restart of the
+	// ranks in a single fault domain.
+	RollingRestartDomain struct {
+		Domain string              `json:"domain"`
+		Ranks  *ranklist.RankSet   `json:"ranks"`
+		Stage  RollingRestartStage `json:"stage"`
+		Error  string              `json:"error,omitempty"`
+	}
+
+	// RollingRestartReportFn is called each time a fault domain enters a
+	// new stage of a rolling restart.
+	RollingRestartReportFn func(*RollingRestartDomain)
+
+	// SystemRollingRestartReq contains the inputs for a rolling restart of
+	// the system ranks, one fault domain at a time.
+	SystemRollingRestartReq struct {
+		sysRequest
+		// DomainLevel is the fault domain level used to group ranks,
+		// counting from the top of the fault domain tree. Zero selects
+		// the lowest level above the ranks (typically the host).
+		DomainLevel int
+		// RejoinTimeout is the maximum time to wait for restarted ranks
+		// to rejoin the system.
+		RejoinTimeout time.Duration
+		// RebuildTimeout is the maximum time to wait for pool rebuilds
+		// to complete. Zero waits indefinitely.
+		RebuildTimeout time.Duration
+		// PollInterval is the interval between rank and pool checks.
+		PollInterval time.Duration
+		// ReportFn is an optional callback for progress reporting.
+		ReportFn RollingRestartReportFn
+	}
+
+	// SystemRollingRestartResp contains the results of a rolling restart.
+	SystemRollingRestartResp struct {
+		Domains []*RollingRestartDomain `json:"domains"`
+	}
+)
+
+func (req *SystemRollingRestartReq) report(rd *RollingRestartDomain, stage RollingRestartStage) {
+	rd.Stage = stage
+	if req.ReportFn != nil {
+		req.ReportFn(rd)
+	}
+}
+
+// memberDomain returns the fault domain of a member, falling back to the
+// member's host address if no fault domain has been set.
+func memberDomain(m *system.Member) (*system.FaultDomain, error) {
+	if m.FaultDomain != nil && !m.FaultDomain.Empty() {
+		return m.FaultDomain, nil
+	}
+	if m.Addr == nil {
+		return nil, errors.Errorf("rank %d has no fault domain or address", m.Rank)
+	}
+	return system.NewFaultDomain(m.Addr.IP.String())
+}
+
+func faultDomainTreeNodes(t *system.FaultDomainTree, depth int) []*system.FaultDomainTree {
+	if depth == 0 {
+		return []*system.FaultDomainTree{t}
+	}
+
+	var nodes []*system.FaultDomainTree
+	for _, child := range t.Children {
+		nodes = append(nodes, faultDomainTreeNodes(child, depth-1)...)
+	}
+	return nodes
+}
+
+// rollingRestartDomains groups the supplied members by fault domain at the
+// requested level of the fault domain tree.
+func rollingRestartDomains(members system.Members, level int) ([]*RollingRestartDomain, error) {
+	tree := system.NewFaultDomainTree()
+	rankDomains := make(map[string]ranklist.Rank)
+	for _, m := range members {
+		fd, err := memberDomain(m)
+		if err != nil {
+			return nil, err
+		}
+		rd := fd.MustCreateChild(fmt.Sprintf("%s%d", system.RankFaultDomainPrefix, m.Rank))
+		if err := tree.AddDomain(rd); err != nil {
+			return nil, err
+		}
+		rankDomains[rd.String()] = m.Rank
+	}
+
+	if !tree.IsBalanced() {
+		return nil, errors.New("fault domain tree is not balanced")
+	}
+	maxLevel := tree.Depth() - 1
+	if level < 0 || level > maxLevel {
+		return nil, errors.Errorf("invalid fault domain level %d (valid levels 1-%d, or 0 for the default)",
+			level, maxLevel)
+	}
+	if level == 0 {
+		level = maxLevel
+	}
+
+	var domains []*RollingRestartDomain
+	for _, node := range faultDomainTreeNodes(tree, level) {
+		rd := &RollingRestartDomain{
+			Domain: node.Domain.String(),
+			Ranks:  ranklist.NewRankSet(),
+			Stage:  RollingRestartStagePending,
+		}
+		for _, leaf := range node.Domains() {
+			if rank, found := rankDomains[leaf.String()]; found {
+				rd.Ranks.Add(rank)
+			}
+		}
+		domains = append(domains, rd)
+	}
+
+	return domains, nil
+}
+
+// pollUntil calls the check function every interval until it returns true,
+// an error, or the timeout expires. A zero timeout waits indefinitely.
+func pollUntil(ctx context.Context, interval, timeout time.Duration, desc string, check func() (bool, error)) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return errors.Errorf("timed out after %s waiting for %s", timeout, desc)
+			}
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ranksJoined returns true if all of the supplied ranks are joined.
+func ranksJoined(ctx context.Context, rpcClient UnaryInvoker, ranks *ranklist.RankSet) (bool, error) {
+	req := &SystemQueryReq{NotOK: true}
+	req.Ranks.Replace(ranks)
+	resp, err := SystemQuery(ctx, rpcClient, req)
+	if err != nil {
+		return false, err
+	}
+
+	return len(resp.Members) == 0, nil
+}
+
+// poolsRebuilt returns true if no pool has a rebuild in progress. Pools that
+// cannot be queried are treated as not yet rebuilt. Pools pending destroy are
+// closed to connections and cannot be queried so are ignored.
+func poolsRebuilt(ctx context.Context, rpcClient UnaryInvoker) (bool, error) {
+	resp, err := ListPools(ctx, rpcClient, &ListPoolsReq{})
+	if err != nil {
+		return false, err
+	}
+	if len(resp.QueryErrors) > 0 {
+		return false, nil
+	}
+
+	for _, p := range resp.Pools {
+		if p.State == daos.PoolServiceStateDestroyPending {
+			continue
+		}
+		if p.State != daos.PoolServiceStateReady {
+			return false, nil
+		}
+		if p.Rebuild != nil && p.Rebuild.State == daos.PoolRebuildStateBusy {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ranksReintegrated returns true once the supplied ranks have been fully
+// reintegrated into each pool that they belong to and no pool has a rebuild
+// in progress. The targets of a reintegrated rank remain in the UP state
+// until the resulting rebuild has completed and only then move to UP_IN, so
+// unlike the pool rebuild state this cannot pass before the rebuild has been
+// scheduled. Pools that cannot be queried are treated as not yet rebuilt and
+// pools pending destroy are ignored.
+func ranksReintegrated(ctx context.Context, rpcClient UnaryInvoker, ranks *ranklist.RankSet) (bool, error) {
+	resp, err := ListPools(ctx, rpcClient, &ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return false, err
+	}
+
+	queryMask := daos.MustNewPoolQueryMask(daos.PoolQueryOptionRebuild,
+		daos.PoolQueryOptionEnabledEngines, daos.PoolQueryOptionDisabledEngines)
+	for _, p := range resp.Pools {
+		if p.State == daos.PoolServiceStateDestroyPending {
+			continue
+		}
+		if p.State != daos.PoolServiceStateReady {
+			return false, nil
+		}
+
+		pqr, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{ID: p.UUID.String(), QueryMask: queryMask})
+		if err != nil {
+			rpcClient.Debugf("pool %s query failed: %s", p.UUID, err)
+			return false, nil
+		}
+		if pqr.Status != 0 {
+			return false, nil
+		}
+		if pqr.Rebuild != nil && pqr.Rebuild.State == daos.PoolRebuildStateBusy {
+			return false, nil
+		}
+
+		inPool := make(map[ranklist.Rank]bool)
+		if pqr.DisabledRanks != nil {
+			for _, r := range pqr.DisabledRanks.Ranks() {
+				inPool[r] = false
+			}
+		}
+		if pqr.EnabledRanks != nil {
+			for _, r := range pqr.EnabledRanks.Ranks() {
+				inPool[r] = true
+			}
+		}
+		if pqr.TotalEngines == 0 {
+			return false, nil
+		}
+		tgtIDs := make([]uint32, pqr.TotalTargets/pqr.TotalEngines)
+		for i := range tgtIDs {
+			tgtIDs[i] = uint32(i)
+		}
+
+		for _, r := range ranks.Ranks() {
+			enabled, found := inPool[r]
+			if !found {
+				continue
+			}
+			if !enabled {
+				return false, nil
+			}
+
+			tqr, err := PoolQueryTargets(ctx, rpcClient, &PoolQueryTargetReq{
+				ID:      p.UUID.String(),
+				Rank:    r,
+				Targets: tgtIDs,
+			})
+			if err != nil || tqr.Status != 0 {
+				return false, nil
+			}
+			for _, ti := range tqr.Infos {
+				if ti.State != daos.PoolTargetStateUpIn {
+					return false, nil
+				}
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func (req *SystemRollingRestartReq) restartDomain(ctx context.Context, rpcClient UnaryInvoker, rd *RollingRestartDomain) error {
+	req.report(rd, RollingRestartStageStop)
+	stopReq := new(SystemStopReq)
+	stopReq.Ranks.Replace(rd.Ranks)
+	stopResp, err := SystemStop(ctx, rpcClient, stopReq)
+	if err != nil {
+		return err
+	}
+	if err := stopResp.Errors(); err != nil {
+		return err
+	}
+
+	req.report(rd, RollingRestartStageStart)
+	startReq := new(SystemStartReq)
+	startReq.Ranks.Replace(rd.Ranks)
+	startResp, err := SystemStart(ctx, rpcClient, startReq)
+	if err != nil {
+		return err
+	}
+	if err := startResp.Errors(); err != nil {
+		return err
+	}
+
+	req.report(rd, RollingRestartStageRejoin)
+	if err := pollUntil(ctx, req.PollInterval, req.RejoinTimeout, "ranks "+rd.Ranks.String()+" to rejoin",
+		func() (bool, error) {
+			return ranksJoined(ctx, rpcClient, rd.Ranks)
+		}); err != nil {
+		return err
+	}
+
+	req.report(rd, RollingRestartStageReintegrate)
+	reintReq := &SystemDrainReq{Reint: true}
+	reintReq.Ranks.Replace(rd.Ranks)
+	reintResp, err := SystemDrain(ctx, rpcClient, reintReq)
+	if err != nil {
+		return err
+	}
+	if err := reintResp.Errors(); err != nil {
+		return err
+	}
+
+	req.report(rd, RollingRestartStageRebuild)
+	return pollUntil(ctx, req.PollInterval, req.RebuildTimeout, "pool rebuild to complete",
+		func() (bool, error) {
+			return ranksReintegrated(ctx, rpcClient, rd.Ranks)
+		})
+}
+
+// SystemRollingRestart restarts the system ranks one fault domain at a time.
+// After the ranks in a fault domain have been restarted, it waits for them
+// to rejoin the system, reintegrates them into their pools and waits for
+// any resulting pool rebuilds to complete before moving on to the next fault
+// domain. The restart is aborted on the first failure, leaving any remaining
+// fault domains untouched.
+func SystemRollingRestart(ctx context.Context, rpcClient UnaryInvoker, req *SystemRollingRestartReq) (*SystemRollingRestartResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.PollInterval == 0 {
+		req.PollInterval = defaultRollingRestartPollInterval
+	}
+	if req.RejoinTimeout == 0 {
+		req.RejoinTimeout = defaultRollingRestartRejoinTimeout
+	}
+
+	queryReq := new(SystemQueryReq)
+	queryReq.Ranks.Replace(&req.Ranks)
+	queryReq.Hosts.Replace(&req.Hosts)
+	queryResp, err := SystemQuery(ctx, rpcClient, queryReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := queryResp.Errors(); err != nil {
+		return nil, err
+	}
+	if len(queryResp.Members) == 0 {
+		return nil, errors.New("no ranks to restart")
+	}
+
+	notJoined := ranklist.NewRankSet()
+	for _, m := range queryResp.Members {
+		if m.State != system.MemberStateJoined {
+			notJoined.Add(m.Rank)
+		}
+	}
+	if notJoined.Count() > 0 {
+		return nil, errors.Errorf("rolling restart requires all ranks to be joined; ranks %s are not",
+			notJoined)
+	}
+
+	rebuilt, err := poolsRebuilt(ctx, rpcClient)
+	if err != nil {
+		return nil, err
+	}
+	if !rebuilt {
+		return nil, errors.New("rolling restart requires all pools to be ready with no rebuild in progress")
+	}
+
+	domains, err := rollingRestartDomains(queryResp.Members, req.DomainLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SystemRollingRestartResp{Domains: domains}
+	for _, rd := range domains {
+		rpcClient.Debugf("rolling restart of fault domain %s (ranks %s)", rd.Domain, rd.Ranks)
+		if err := req.restartDomain(ctx, rpcClient, rd); err != nil {
+			rd.Error = err.Error()
+			if req.ReportFn != nil {
+				req.ReportFn(rd)
+			}
+			return resp, errors.Wrapf(err, "rolling restart aborted in fault domain %s (ranks %s)",
+				rd.Domain, rd.Ranks)
+		}
+		req.report(rd, RollingRestartStageDone)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,424 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockRollingRestartMember(t *testing.T, rank uint32, addr, fd string) *system.Member {
+	t.Helper()
+
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := system.MockMemberFullSpec(t, ranklist.Rank(rank), test.MockUUID(int32(rank)), "",
+		tcpAddr, system.MemberStateJoined)
+	m.FaultDomain = system.MustCreateFaultDomainFromString(fd)
+	return m
+}
+
+func TestControl_rollingRestartDomains(t *testing.T) {
+	for name, tc := range map[string]struct {
+		members    system.Members
+		level      int
+		expDomains map[string]string
+		expErr     error
+	}{
+		"host level by default": {
+			members: system.Members{
+				mockRollingRestartMember(t, 0, "10.0.0.1:10001", "/rack0/host1"),
+				mockRollingRestartMember(t, 1, "10.0.0.1:10001", "/rack0/host1"),
+				mockRollingRestartMember(t, 2, "10.0.0.2:10001", "/rack0/host2"),
+				mockRollingRestartMember(t, 3, "10.0.0.3:10001", "/rack1/host3"),
+			},
+			expDomains: map[string]string{
+				"/rack0/host1": "0-1",
+				"/rack0/host2": "2",
+				"/rack1/host3": "3",
+			},
+		},
+		"rack level": {
+			members: system.Members{
+				mockRollingRestartMember(t, 0, "10.0.0.1:10001", "/rack0/host1"),
+				mockRollingRestartMember(t, 1, "10.0.0.1:10001", "/rack0/host1"),
+				mockRollingRestartMember(t, 2, "10.0.0.2:10001", "/rack0/host2"),
+				mockRollingRestartMember(t, 3, "10.0.0.3:10001", "/rack1/host3"),
+			},
+			level: 1,
+			expDomains: map[string]string{
+				"/rack0": "0-2",
+				"/rack1": "3",
+			},
+		},
+		"no fault domain uses host address": {
+			members: system.Members{
+				mockRollingRestartMember(t, 0, "10.0.0.1:10001", ""),
+				mockRollingRestartMember(t, 1, "10.0.0.2:10001", ""),
+			},
+			expDomains: map[string]string{
+				"/10.0.0.1": "0",
+				"/10.0.0.2": "1",
+			},
+		},
+		"level too deep": {
+			members: system.Members{
+				mockRollingRestartMember(t, 0, "10.0.0.1:10001", "/rack0/host1"),
+			},
+			level:  3,
+			expErr: errors.New("invalid fault domain level"),
+		},
+		"unbalanced tree": {
+			members: system.Members{
+				mockRollingRestartMember(t, 0, "10.0.0.1:10001", "/rack0/host1"),
+				mockRollingRestartMember(t, 1, "10.0.0.2:10001", "/host2"),
+			},
+			expErr: errors.New("not balanced"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			domains, gotErr := rollingRestartDomains(tc.members, tc.level)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotDomains := make(map[string]string)
+			for _, rd := range domains {
+				gotDomains[rd.Domain] = rd.Ranks.String()
+			}
+			if diff := cmp.Diff(tc.expDomains, gotDomains); diff != "" {
+				t.Fatalf("unexpected domains (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemRollingRestart(t *testing.T) {
+	pbMembers := []*mgmtpb.SystemMember{
+		{
+			Rank:        0,
+			Uuid:        test.MockUUID(0),
+			State:       system.MemberStateJoined.String(),
+			Addr:        "10.0.0.1:10001",
+			FaultDomain: "/host1",
+		},
+		{
+			Rank:        1,
+			Uuid:        test.MockUUID(1),
+			State:       system.MemberStateJoined.String(),
+			Addr:        "10.0.0.2:10001",
+			FaultDomain: "/host2",
+		},
+	}
+	queryResp := MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{Members: pbMembers})
+	notJoinedResp := MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			{
+				Rank:        0,
+				Uuid:        test.MockUUID(0),
+				State:       system.MemberStateStopped.String(),
+				Addr:        "10.0.0.1:10001",
+				FaultDomain: "/host1",
+			},
+		},
+	})
+	joinedResp := MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{})
+	poolsResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{})
+	stopResp := MockMSResponse("host1", nil, &mgmtpb.SystemStopResp{})
+	startResp := MockMSResponse("host1", nil, &mgmtpb.SystemStartResp{})
+	reintResp := MockMSResponse("host1", nil, &mgmtpb.SystemDrainResp{})
+
+	for name, tc := range map[string]struct {
+		req       *SystemRollingRestartReq
+		uResps    []*UnaryResponse
+		uResp     *UnaryResponse
+		expStages map[string]RollingRestartStage
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.SystemRollingRestartReq request"),
+		},
+		"ranks not joined": {
+			req:    &SystemRollingRestartReq{},
+			uResps: []*UnaryResponse{notJoinedResp},
+			expErr: errors.New("requires all ranks to be joined"),
+		},
+		"success": {
+			req: &SystemRollingRestartReq{},
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				stopResp, startResp, notJoinedResp, joinedResp, reintResp, poolsResp,
+				stopResp, startResp, joinedResp, reintResp, poolsResp,
+			},
+			expStages: map[string]RollingRestartStage{
+				"/host1": RollingRestartStageDone,
+				"/host2": RollingRestartStageDone,
+			},
+		},
+		"stop fails": {
+			req: &SystemRollingRestartReq{},
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				MockMSResponse("host1", errors.New("stop failed"), nil),
+			},
+			expStages: map[string]RollingRestartStage{
+				"/host1": RollingRestartStageStop,
+				"/host2": RollingRestartStagePending,
+			},
+			expErr: errors.New("stop failed"),
+		},
+		"rejoin times out": {
+			req: &SystemRollingRestartReq{
+				RejoinTimeout: 50 * time.Millisecond,
+			},
+			uResps: []*UnaryResponse{
+				queryResp, poolsResp,
+				stopResp, startResp,
+			},
+			uResp: notJoinedResp,
+			expStages: map[string]RollingRestartStage{
+				"/host1": RollingRestartStageRejoin,
+				"/host2": RollingRestartStagePending,
+			},
+			expErr: errors.New("timed out"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+				UnaryResponse:    tc.uResp,
+			})
+
+			if tc.req != nil {
+				tc.req.PollInterval = time.Millisecond
+			}
+			gotResp, gotErr := SystemRollingRestart(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expStages == nil {
+				return
+			}
+
+			gotStages := make(map[string]RollingRestartStage)
+			for _, rd := range gotResp.Domains {
+				gotStages[rd.Domain] = rd.Stage
+			}
+			if diff := cmp.Diff(tc.expStages, gotStages); diff != "" {
+				t.Fatalf("unexpected stages (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ranksReintegrated(t *testing.T) {
+	poolsResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{
+				Uuid:  test.MockUUID(1),
+				State: system.PoolServiceStateReady.String(),
+			},
+		},
+	})
+	queryResp := func(enabled, disabled string, rebuild mgmtpb.PoolRebuildStatus_State) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:          test.MockUUID(1),
+			TotalTargets:  8,
+			TotalEngines:  4,
+			EnabledRanks:  enabled,
+			DisabledRanks: disabled,
+			Rebuild:       &mgmtpb.PoolRebuildStatus{State: rebuild},
+		})
+	}
+	targetsResp := func(states ...mgmtpb.PoolQueryTargetInfo_TargetState) *UnaryResponse {
+		resp := new(mgmtpb.PoolQueryTargetResp)
+		for _, state := range states {
+			resp.Infos = append(resp.Infos, &mgmtpb.PoolQueryTargetInfo{
+				State: state,
+				Space: []*mgmtpb.StorageTargetUsage{{}, {}},
+			})
+		}
+		return MockMSResponse("host1", nil, resp)
+	}
+
+	for name, tc := range map[string]struct {
+		uResps    []*UnaryResponse
+		expResult bool
+		expErr    error
+	}{
+		"list pools fails": {
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", errors.New("list failed"), nil),
+			},
+			expErr: errors.New("list failed"),
+		},
+		"no pools": {
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{}),
+			},
+			expResult: true,
+		},
+		"rebuild busy": {
+			uResps: []*UnaryResponse{
+				poolsResp,
+				queryResp("0-3", "", mgmtpb.PoolRebuildStatus_BUSY),
+			},
+		},
+		"pool pending destroy ignored": {
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+					Pools: []*mgmtpb.ListPoolsResp_Pool{
+						{
+							Uuid:  test.MockUUID(1),
+							State: system.PoolServiceStateDestroyPending.String(),
+						},
+					},
+				}),
+			},
+			expResult: true,
+		},
+		"rank still disabled": {
+			uResps: []*UnaryResponse{
+				poolsResp,
+				queryResp("0-1,3", "2", mgmtpb.PoolRebuildStatus_DONE),
+			},
+		},
+		"rebuild not yet started": {
+			uResps: []*UnaryResponse{
+				poolsResp,
+				queryResp("0-3", "", mgmtpb.PoolRebuildStatus_DONE),
+				targetsResp(mgmtpb.PoolQueryTargetInfo_UP, mgmtpb.PoolQueryTargetInfo_UP),
+			},
+		},
+		"rank not in pool": {
+			uResps: []*UnaryResponse{
+				poolsResp,
+				queryResp("0-1", "", mgmtpb.PoolRebuildStatus_DONE),
+			},
+			expResult: true,
+		},
+		"reintegrated": {
+			uResps: []*UnaryResponse{
+				poolsResp,
+				queryResp("0-3", "", mgmtpb.PoolRebuildStatus_DONE),
+				targetsResp(mgmtpb.PoolQueryTargetInfo_UP_IN, mgmtpb.PoolQueryTargetInfo_UP_IN),
+			},
+			expResult: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			gotResult, gotErr := ranksReintegrated(test.Context(t), mi, ranklist.MustCreateRankSet("2"))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expResult, gotResult, "unexpected result")
+		})
+	}
+}
+
+func TestControl_poolsRebuilt(t *testing.T) {
+	listResp := func(states ...system.PoolServiceState) *UnaryResponse {
+		resp := new(mgmtpb.ListPoolsResp)
+		for i, state := range states {
+			resp.Pools = append(resp.Pools, &mgmtpb.ListPoolsResp_Pool{
+				Uuid:  test.MockUUID(int32(i + 1)),
+				State: state.String(),
+			})
+		}
+		return MockMSResponse("host1", nil, resp)
+	}
+	queryResp := func(idx int32, rebuild mgmtpb.PoolRebuildStatus_State) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:    test.MockUUID(idx),
+			Rebuild: &mgmtpb.PoolRebuildStatus{State: rebuild},
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		uResps    []*UnaryResponse
+		expResult bool
+		expErr    error
+	}{
+		"list pools fails": {
+			uResps: []*UnaryResponse{
+				MockMSResponse("host1", errors.New("list failed"), nil),
+			},
+			expErr: errors.New("list failed"),
+		},
+		"no pools": {
+			uResps: []*UnaryResponse{
+				listResp(),
+			},
+			expResult: true,
+		},
+		"rebuild busy": {
+			uResps: []*UnaryResponse{
+				listResp(system.PoolServiceStateReady),
+				queryResp(1, mgmtpb.PoolRebuildStatus_BUSY),
+			},
+		},
+		"pool being created": {
+			uResps: []*UnaryResponse{
+				listResp(system.PoolServiceStateCreating),
+			},
+		},
+		"pool pending destroy ignored": {
+			uResps: []*UnaryResponse{
+				listResp(system.PoolServiceStateReady, system.PoolServiceStateDestroyPending),
+				queryResp(1, mgmtpb.PoolRebuildStatus_DONE),
+			},
+			expResult: true,
+		},
+		"rebuilt": {
+			uResps: []*UnaryResponse{
+				listResp(system.PoolServiceStateReady),
+				queryResp(1, mgmtpb.PoolRebuildStatus_DONE),
+			},
+			expResult: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			gotResult, gotErr := poolsRebuilt(test.Context(t), mi)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expResult, gotResult, "unexpected result")
+		})
+	}
+}
@@ -1102,6 +1102,35 @@ func TestControl_SystemDrain(t *testing.T) {
 				},
 			},
 		},
+		"dry run": {
+			req: &SystemDrainReq{DryRun: true},
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemDrainResp{
+				PoolImpacts: []*mgmtpb.PoolImpact{
+					{
+						PoolId: test.MockUUID(1), Ranks: "1",
+						RedundancyFactor: 1, SvcRanks: "1", SvcReplicas: 3,
+						Degraded: true,
+					},
+					{
+						PoolId: test.MockUUID(2), Ranks: "1-2",
+						RedundancyFactor: 1, Degraded: true, RfBroken: true,
+					},
+				},
+			}),
+			expResp: &SystemDrainResp{
+				PoolImpacts: PoolImpacts{
+					{
+						PoolID: test.MockUUID(1), Ranks: "1",
+						RedundancyFactor: 1, SvcRanks: "1", SvcReplicas: 3,
+						Degraded: true,
+					},
+					{
+						PoolID: test.MockUUID(2), Ranks: "1-2",
+						RedundancyFactor: 1, Degraded: true, RfBroken: true,
+					},
+				},
+			},
+		},
 		"dual pools; single rank; with errors": {
 			req: new(SystemDrainReq),
 			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemDrainResp{
@@ -84,6 +84,7 @@ type (
 		UpgradeLayoutVer uint32               `json:"upgrade_layout_ver"`
 		MemFileBytes     uint64               `json:"mem_file_bytes"`
 		MdOnSsdActive    bool                 `json:"md_on_ssd_active"`
+		CreationTime     int64                `json:"creation_time,omitempty"` // Unix seconds
 	}
 
 	PoolQueryTargetType  int32
@@ -319,6 +320,8 @@ const (
 	PoolServiceStateDegraded = PoolServiceState(mgmtpb.PoolServiceState_Degraded)
 	// PoolServiceStateUnknown indicates that the pool service state is unknown
 	PoolServiceStateUnknown = PoolServiceState(mgmtpb.PoolServiceState_Unknown)
+	// PoolServiceStateDestroyPending indicates that the pool is awaiting destroy
+	PoolServiceStateDestroyPending = PoolServiceState(mgmtpb.PoolServiceState_DestroyPending)
 )
 
 func (pss PoolServiceState) String() string {
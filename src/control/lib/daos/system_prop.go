@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -149,6 +151,56 @@ func (pv *IntPropVal) copy() SystemPropertyValue {
 	}
 }
 
+// DurationPropVal is a non-negative time duration property value.
+type DurationPropVal struct {
+	value time.Duration
+}
+
+// NewDurationPropVal returns a new DurationPropVal initialized to a default value.
+func NewDurationPropVal(defVal time.Duration) *DurationPropVal {
+	return &DurationPropVal{
+		value: defVal,
+	}
+}
+
+func (pv *DurationPropVal) Handler(val string) error {
+	if pv == nil {
+		return errors.Errorf("%T is nil", pv)
+	}
+
+	v, err := time.ParseDuration(strings.TrimSpace(val))
+	if err != nil {
+		return errors.Wrapf(err, "invalid value %q", val)
+	}
+	if v < 0 {
+		return errors.Errorf("invalid value %q (must not be negative)", val)
+	}
+
+	pv.value = v
+	return nil
+}
+
+func (pv *DurationPropVal) Choices() []string {
+	return nil
+}
+
+func (pv *DurationPropVal) String() string {
+	if pv == nil {
+		return "(nil)"
+	}
+	return pv.value.String()
+}
+
+func (pv *DurationPropVal) Value() time.Duration {
+	return pv.value
+}
+
+func (pv *DurationPropVal) copy() SystemPropertyValue {
+	return &DurationPropVal{
+		value: pv.value,
+	}
+}
+
 // StringPropVal is a string-based property value.
 type StringPropVal struct {
 	value        string
@@ -299,10 +351,16 @@ func (sp SystemPropertyKey) IsValid() bool {
 
 func (sp SystemPropertyKey) String() string {
 	if str, found := map[SystemPropertyKey]string{
-		SystemPropertyDaosVersion:     "daos_version",
-		SystemPropertyDaosSystem:      "daos_system",
-		SystemPropertyPoolScrubMode:   "pool_scrub_mode",
-		SystemPropertyPoolScrubThresh: "pool_scrub_thresh",
+		SystemPropertyDaosVersion:        "daos_version",
+		SystemPropertyDaosSystem:         "daos_system",
+		SystemPropertyPoolScrubMode:      "pool_scrub_mode",
+		SystemPropertyPoolScrubThresh:    "pool_scrub_thresh",
+		SystemPropertyPoolDestroyGrace:   "pool_destroy_grace_period",
+		SystemPropertyNvmeFaultMode:      "nvme_fault_mode",
+		SystemPropertyNvmeFaultMediaErrs: "nvme_fault_media_errs",
+		SystemPropertyNvmeFaultCsumErrs:  "nvme_fault_checksum_errs",
+		SystemPropertyNvmeFaultTemp:      "nvme_fault_temperature",
+		SystemPropertyNvmeFaultCritWarn:  "nvme_fault_critical_warnings",
 	}[sp]; found {
 		return str
 	}
@@ -342,6 +400,24 @@ const (
 	SystemPropertyPoolScrubMode
 	// SystemPropertyPoolScrubThresh sets or retrieves the scrubbing error threshold for each pool in the system.
 	SystemPropertyPoolScrubThresh
+	// SystemPropertyPoolDestroyGrace sets or retrieves the period for which destroyed pools
+	// are retained before being permanently destroyed.
+	SystemPropertyPoolDestroyGrace
+	// SystemPropertyNvmeFaultMode sets or retrieves the NVMe fault policy mode, overriding
+	// the mode in each server's configuration.
+	SystemPropertyNvmeFaultMode
+	// SystemPropertyNvmeFaultMediaErrs sets or retrieves the NVMe fault policy media error
+	// threshold, overriding the threshold in each server's configuration.
+	SystemPropertyNvmeFaultMediaErrs
+	// SystemPropertyNvmeFaultCsumErrs sets or retrieves the NVMe fault policy checksum error
+	// threshold, overriding the threshold in each server's configuration.
+	SystemPropertyNvmeFaultCsumErrs
+	// SystemPropertyNvmeFaultTemp sets or retrieves the NVMe fault policy temperature
+	// threshold, overriding the threshold in each server's configuration.
+	SystemPropertyNvmeFaultTemp
+	// SystemPropertyNvmeFaultCritWarn sets or retrieves whether NVMe critical warnings trigger
+	// the NVMe fault policy, overriding the setting in each server's configuration.
+	SystemPropertyNvmeFaultCritWarn
 	// NB: This must be the last entry.
 	systemPropertyMax
 )
@@ -504,5 +580,35 @@ func SystemProperties() SystemPropertyMap {
 		},
 		SystemPropertyPoolScrubThresh: pph2sp(SystemPropertyPoolScrubThresh, poolProps["scrub_thresh"], "0"),
 		SystemPropertyPoolScrubMode:   pph2sp(SystemPropertyPoolScrubMode, poolProps["scrub"], "off"),
+		SystemPropertyPoolDestroyGrace: SystemProperty{
+			Key:         SystemPropertyPoolDestroyGrace,
+			Value:       NewDurationPropVal(0),
+			Description: "Period to retain destroyed pools before permanent removal (0 disables)",
+		},
+		SystemPropertyNvmeFaultMode: SystemProperty{
+			Key:         SystemPropertyNvmeFaultMode,
+			Value:       NewStringPropVal("config", "config", "off", "warn", "auto"),
+			Description: "NVMe fault policy mode (config uses server config)",
+		},
+		SystemPropertyNvmeFaultMediaErrs: SystemProperty{
+			Key:         SystemPropertyNvmeFaultMediaErrs,
+			Value:       NewIntPropVal(-1),
+			Description: "NVMe fault policy media error threshold (-1 uses server config, 0 disables)",
+		},
+		SystemPropertyNvmeFaultCsumErrs: SystemProperty{
+			Key:         SystemPropertyNvmeFaultCsumErrs,
+			Value:       NewIntPropVal(-1),
+			Description: "NVMe fault policy checksum error threshold (-1 uses server config, 0 disables)",
+		},
+		SystemPropertyNvmeFaultTemp: SystemProperty{
+			Key:         SystemPropertyNvmeFaultTemp,
+			Value:       NewIntPropVal(-1),
+			Description: "NVMe fault policy temperature threshold in Kelvin (-1 uses server config, 0 disables)",
+		},
+		SystemPropertyNvmeFaultCritWarn: SystemProperty{
+			Key:         SystemPropertyNvmeFaultCritWarn,
+			Value:       NewStringPropVal("config", "config", "true", "false"),
+			Description: "NVMe fault policy triggered by critical warnings (config uses server config)",
+		},
 	}
 }
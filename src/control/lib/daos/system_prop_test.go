@@ -9,6 +9,7 @@ package daos
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -18,6 +19,7 @@ var (
 	_ SystemPropertyValue = NewStringPropVal("default")
 	_ SystemPropertyValue = NewCompPropVal(func() string { return "computed" })
 	_ SystemPropertyValue = NewIntPropVal(0)
+	_ SystemPropertyValue = NewDurationPropVal(0)
 )
 
 func TestDaos_BoolPropVal(t *testing.T) {
@@ -135,6 +137,41 @@ func TestDaos_IntPropVal(t *testing.T) {
 	}
 }
 
+func TestDaos_DurationPropVal(t *testing.T) {
+	pv := NewDurationPropVal(0)
+
+	if pv == nil {
+		t.Fatal("expected non-nil DurationPropVal")
+	}
+
+	if pv.Choices() != nil {
+		t.Fatalf("expected nil choices, got %v", pv.Choices())
+	}
+	if pv.String() != "0s" {
+		t.Fatalf("expected string %q, got %q", "0s", pv.String())
+	}
+	if err := pv.Handler("24h"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if pv.String() != "24h0m0s" {
+		t.Fatalf("expected string %q, got %q", "24h0m0s", pv.String())
+	}
+	if pv.Value() != 24*time.Hour {
+		t.Fatalf("expected value %s, got %s", 24*time.Hour, pv.Value())
+	}
+	if err := pv.Handler("-1h"); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+	if err := pv.Handler("invalid"); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	var nilPV *DurationPropVal
+	if nilPV.String() != "(nil)" {
+		t.Fatalf("%T stringer should handle nil", nilPV)
+	}
+}
+
 func TestDaos_CompPropVal(t *testing.T) {
 	compVal := "computed"
 	pv := NewCompPropVal(func() string { return compVal })
@@ -0,0 +1,242 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+package promexp
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const controlNamespace = "control"
+
+type (
+	// ControlSource provides the control plane state exported by a
+	// ControlCollector. It is implemented by the system database.
+	ControlSource interface {
+		IsReplica() bool
+		IsLeader() bool
+		CurrentTerm() (uint64, error)
+		CurMapVersion() (uint32, error)
+		AllMembers() ([]*system.Member, error)
+		PoolServiceList(bool) ([]*system.PoolService, error)
+	}
+
+	// ControlCollector collects metrics describing the control plane's
+	// view of the system, e.g. membership and MS leadership. It also
+	// implements the events.Handler interface in order to count RAS
+	// events raised on the local host.
+	ControlCollector struct {
+		log       logging.Logger
+		src       ControlSource
+		rasEvents *prometheus.CounterVec
+		msLeader  *prometheus.Desc
+		msTerm    *prometheus.Desc
+		mapVer    *prometheus.Desc
+		members   *prometheus.Desc
+		pools     *prometheus.Desc
+		svcReps   *prometheus.Desc
+		svcRepsUp *prometheus.Desc
+	}
+)
+
+// NewControlCollector creates a new ControlCollector instance.
+func NewControlCollector(log logging.Logger, src ControlSource) (*ControlCollector, error) {
+	if common.InterfaceIsNil(src) {
+		return nil, errors.New("nil control source")
+	}
+
+	return &ControlCollector{
+		log: log,
+		src: src,
+		rasEvents: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: controlNamespace,
+				Subsystem: "ras",
+				Name:      "events_total",
+				Help:      "Number of RAS events raised on this host.",
+			},
+			[]string{"id", "type", "severity"},
+		),
+		msLeader: prometheus.NewDesc(
+			prometheus.BuildFQName(controlNamespace, "ms", "leader"),
+			"Set to 1 if this MS replica is the current leader, 0 otherwise.",
+			nil, nil,
+		),
+		msTerm: prometheus.NewDesc(
+			prometheus.BuildFQName(controlNamespace, "ms", "raft_term"),
+			"Current raft term as seen by this MS replica.",
+			nil, nil,
+		),
+		mapVer: prometheus.NewDesc(
+			prometheus.BuildFQName(controlNamespace, "system", "map_version"),
+			"Current system group map version.",
+			nil, nil,
+		),
+		members: prometheus.NewDesc(
+			prometheus.BuildFQName(controlNamespace, "system", "members"),
+			"Number of system members in each state.",
+			[]string{"state"}, nil,
+		),
+		pools: prometheus.NewDesc(
+			prometheus.BuildFQName(controlNamespace, "system", "pool_services"),
+			"Number of pool services in each state.",
+			[]string{"state"}, nil,
+		),
+		svcReps: prometheus.NewDesc(
+			prometheus.BuildFQName(controlNamespace, "pool", "svc_replicas"),
+			"Number of configured pool service replicas.",
+			[]string{"pool", "label"}, nil,
+		),
+		svcRepsUp: prometheus.NewDesc(
+			prometheus.BuildFQName(controlNamespace, "pool", "svc_replicas_up"),
+			"Number of pool service replicas whose rank is joined.",
+			[]string{"pool", "label"}, nil,
+		),
+	}, nil
+}
+
+// OnEvent implements the events.Handler interface. Events forwarded from other
+// hosts are not counted, so that each event is only counted once across the
+// system.
+func (c *ControlCollector) OnEvent(_ context.Context, evt *events.RASEvent) {
+	if evt == nil || evt.IsForwarded() {
+		return
+	}
+
+	c.rasEvents.WithLabelValues(evt.ID.String(), evt.Type.String(), evt.Severity.String()).Inc()
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *ControlCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.rasEvents.Describe(ch)
+	ch <- c.msLeader
+	ch <- c.msTerm
+	ch <- c.mapVer
+	ch <- c.members
+	ch <- c.pools
+	ch <- c.svcReps
+	ch <- c.svcRepsUp
+}
+
+// Collect implements the prometheus.Collector interface. MS metrics are only
+// exported by replicas, and system metrics only by the MS leader in order to
+// avoid duplicate series for the same system.
+func (c *ControlCollector) Collect(ch chan<- prometheus.Metric) {
+	c.rasEvents.Collect(ch)
+
+	if !c.src.IsReplica() {
+		return
+	}
+
+	isLeader := c.src.IsLeader()
+	var leaderVal float64
+	if isLeader {
+		leaderVal = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.msLeader, prometheus.GaugeValue, leaderVal)
+
+	if term, err := c.src.CurrentTerm(); err != nil {
+		c.log.Debugf("unable to get raft term: %s", err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.msTerm, prometheus.GaugeValue, float64(term))
+	}
+
+	if !isLeader {
+		return
+	}
+
+	if mapVer, err := c.src.CurMapVersion(); err != nil {
+		c.log.Debugf("unable to get map version: %s", err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.mapVer, prometheus.GaugeValue, float64(mapVer))
+	}
+
+	members, membersErr := c.src.AllMembers()
+	if membersErr != nil {
+		c.log.Debugf("unable to get members: %s", membersErr)
+	} else {
+		c.collectMembers(ch, members)
+	}
+
+	poolSvcs, err := c.src.PoolServiceList(true)
+	if err != nil {
+		c.log.Debugf("unable to get pool services: %s", err)
+		return
+	}
+	c.collectPools(ch, poolSvcs)
+	if membersErr == nil {
+		c.collectPoolReplicas(ch, poolSvcs, members)
+	}
+}
+
+func (c *ControlCollector) collectMembers(ch chan<- prometheus.Metric, members []*system.Member) {
+	counts := make(map[system.MemberState]int)
+	for _, m := range members {
+		counts[m.State]++
+	}
+
+	// Report every state so that series drop to zero rather than vanish.
+	for state := system.MemberStateAwaitFormat; state < system.MemberStateMax; state <<= 1 {
+		ch <- prometheus.MustNewConstMetric(c.members, prometheus.GaugeValue,
+			float64(counts[state]), state.String())
+	}
+}
+
+func (c *ControlCollector) collectPools(ch chan<- prometheus.Metric, poolSvcs []*system.PoolService) {
+	counts := make(map[system.PoolServiceState]int)
+	for _, ps := range poolSvcs {
+		counts[ps.State]++
+	}
+
+	for _, state := range []system.PoolServiceState{
+		system.PoolServiceStateCreating,
+		system.PoolServiceStateReady,
+		system.PoolServiceStateDestroying,
+		system.PoolServiceStateDestroyPending,
+	} {
+		ch <- prometheus.MustNewConstMetric(c.pools, prometheus.GaugeValue,
+			float64(counts[state]), state.String())
+	}
+}
+
+// collectPoolReplicas reports, for each ready pool, the number of configured
+// service replicas and how many of them are on joined ranks.
+func (c *ControlCollector) collectPoolReplicas(ch chan<- prometheus.Metric, poolSvcs []*system.PoolService, members []*system.Member) {
+	joined := make(map[ranklist.Rank]bool)
+	for _, m := range members {
+		joined[m.Rank] = m.State == system.MemberStateJoined
+	}
+
+	for _, ps := range poolSvcs {
+		if ps.State != system.PoolServiceStateReady {
+			continue
+		}
+
+		var up int
+		for _, rank := range ps.Replicas {
+			if joined[rank] {
+				up++
+			}
+		}
+		poolID := ps.PoolUUID.String()
+		ch <- prometheus.MustNewConstMetric(c.svcReps, prometheus.GaugeValue,
+			float64(len(ps.Replicas)), poolID, ps.PoolLabel)
+		ch <- prometheus.MustNewConstMetric(c.svcRepsUp, prometheus.GaugeValue,
+			float64(up), poolID, ps.PoolLabel)
+	}
+}
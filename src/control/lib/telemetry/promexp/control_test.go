@@ -0,0 +1,246 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package promexp
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type mockControlSource struct {
+	replica  bool
+	leader   bool
+	term     uint64
+	termErr  error
+	mapVer   uint32
+	members  []*system.Member
+	poolSvcs []*system.PoolService
+}
+
+func (m *mockControlSource) IsReplica() bool {
+	return m.replica
+}
+
+func (m *mockControlSource) IsLeader() bool {
+	return m.leader
+}
+
+func (m *mockControlSource) CurrentTerm() (uint64, error) {
+	return m.term, m.termErr
+}
+
+func (m *mockControlSource) CurMapVersion() (uint32, error) {
+	return m.mapVer, nil
+}
+
+func (m *mockControlSource) AllMembers() ([]*system.Member, error) {
+	return m.members, nil
+}
+
+func (m *mockControlSource) PoolServiceList(bool) ([]*system.PoolService, error) {
+	return m.poolSvcs, nil
+}
+
+// collectControlMetrics returns the non-zero values collected, keyed by
+// metric name and labels.
+func collectControlMetrics(t *testing.T, c *ControlCollector) map[string]float64 {
+	t.Helper()
+
+	ch := make(chan prometheus.Metric, 128)
+	c.Collect(ch)
+	close(ch)
+
+	fqNameRe := regexp.MustCompile(`fqName: "(\w*)"`)
+	out := make(map[string]float64)
+	for m := range ch {
+		pb := new(dto.Metric)
+		if err := m.Write(pb); err != nil {
+			t.Fatal(err)
+		}
+
+		key := fqNameRe.FindStringSubmatch(m.Desc().String())[1]
+		var labels []string
+		for _, lp := range pb.GetLabel() {
+			labels = append(labels, fmt.Sprintf("%s=%s", lp.GetName(), lp.GetValue()))
+		}
+		if len(labels) > 0 {
+			key += "{" + strings.Join(labels, ",") + "}"
+		}
+
+		var val float64
+		switch {
+		case pb.Gauge != nil:
+			val = pb.Gauge.GetValue()
+		case pb.Counter != nil:
+			val = pb.Counter.GetValue()
+		}
+		// Only the leader and term metrics are expected to be zero.
+		if val != 0 || len(labels) == 0 {
+			out[key] = val
+		}
+	}
+
+	return out
+}
+
+func TestPromExp_NewControlCollector(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	_, err := NewControlCollector(log, nil)
+	test.CmpErr(t, errors.New("nil control source"), err)
+
+	var nilSrc *mockControlSource
+	_, err = NewControlCollector(log, nilSrc)
+	test.CmpErr(t, errors.New("nil control source"), err)
+}
+
+func TestPromExp_ControlCollector_Collect(t *testing.T) {
+	members := []*system.Member{
+		system.MockMember(t, 0, system.MemberStateJoined),
+		system.MockMember(t, 1, system.MemberStateJoined),
+		system.MockMember(t, 2, system.MemberStateExcluded),
+	}
+	poolSvcs := []*system.PoolService{
+		{State: system.PoolServiceStateReady},
+		{State: system.PoolServiceStateReady},
+		{State: system.PoolServiceStateDestroying},
+		{State: system.PoolServiceStateDestroyPending},
+	}
+
+	poolID := test.MockUUID(1) + "}"
+
+	for name, tc := range map[string]struct {
+		src        *mockControlSource
+		evts       []*events.RASEvent
+		expMetrics map[string]float64
+	}{
+		"not a replica": {
+			src:        &mockControlSource{},
+			expMetrics: map[string]float64{},
+		},
+		"follower replica": {
+			src: &mockControlSource{
+				replica: true,
+				term:    3,
+				members: members,
+			},
+			expMetrics: map[string]float64{
+				"control_ms_leader":    0,
+				"control_ms_raft_term": 3,
+			},
+		},
+		"follower replica; term unavailable": {
+			src: &mockControlSource{
+				replica: true,
+				termErr: errors.New("no term"),
+			},
+			expMetrics: map[string]float64{
+				"control_ms_leader": 0,
+			},
+		},
+		"leader": {
+			src: &mockControlSource{
+				replica:  true,
+				leader:   true,
+				term:     5,
+				mapVer:   42,
+				members:  members,
+				poolSvcs: poolSvcs,
+			},
+			expMetrics: map[string]float64{
+				"control_ms_leader":                                  1,
+				"control_ms_raft_term":                               5,
+				"control_system_map_version":                         42,
+				"control_system_members{state=Joined}":               2,
+				"control_system_members{state=Excluded}":             1,
+				"control_system_pool_services{state=Ready}":          2,
+				"control_system_pool_services{state=Destroying}":     1,
+				"control_system_pool_services{state=DestroyPending}": 1,
+			},
+		},
+		"leader; pool service replicas": {
+			src: &mockControlSource{
+				replica: true,
+				leader:  true,
+				members: members,
+				poolSvcs: []*system.PoolService{
+					{
+						PoolUUID:  test.MockPoolUUID(1),
+						PoolLabel: "pool1",
+						State:     system.PoolServiceStateReady,
+						Replicas:  []ranklist.Rank{0, 1, 2},
+					},
+					{
+						PoolUUID:  test.MockPoolUUID(2),
+						PoolLabel: "pool2",
+						State:     system.PoolServiceStateCreating,
+						Replicas:  []ranklist.Rank{0},
+					},
+				},
+			},
+			expMetrics: map[string]float64{
+				"control_ms_leader":                                       1,
+				"control_ms_raft_term":                                    0,
+				"control_system_map_version":                              0,
+				"control_system_members{state=Joined}":                    2,
+				"control_system_members{state=Excluded}":                  1,
+				"control_system_pool_services{state=Ready}":               1,
+				"control_system_pool_services{state=Creating}":            1,
+				"control_pool_svc_replicas{label=pool1,pool=" + poolID:    3,
+				"control_pool_svc_replicas_up{label=pool1,pool=" + poolID: 2,
+			},
+		},
+		"events counted": {
+			src: &mockControlSource{},
+			evts: []*events.RASEvent{
+				events.NewGenericEvent(events.RASEngineDied, events.RASSeverityError, "died", ""),
+				events.NewGenericEvent(events.RASEngineDied, events.RASSeverityError, "died", ""),
+				events.NewGenericEvent(events.RASSwimRankDead, events.RASSeverityError, "dead", "").
+					WithRank(1),
+				// Forwarded events are counted on the originating host.
+				events.NewGenericEvent(events.RASSwimRankDead, events.RASSeverityError, "dead", "").
+					WithForwarded(true),
+			},
+			expMetrics: map[string]float64{
+				"control_ras_events_total{id=engine_died,severity=ERROR,type=INFO}":    2,
+				"control_ras_events_total{id=swim_rank_dead,severity=ERROR,type=INFO}": 1,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c, err := NewControlCollector(log, tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, evt := range tc.evts {
+				c.OnEvent(test.Context(t), evt)
+			}
+
+			if diff := cmp.Diff(tc.expMetrics, collectControlMetrics(t, c)); diff != "" {
+				t.Fatalf("unexpected metrics (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -55,8 +55,10 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 	testCases := map[string][]Component{
 		"/ctl.CtlSvc/StorageScan":                {ComponentAdmin},
 		"/ctl.CtlSvc/StorageFormat":              {ComponentAdmin},
+		"/ctl.CtlSvc/StorageFormatPlan":          {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeRebind":          {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeAddDevice":       {ComponentAdmin},
+		"/ctl.CtlSvc/StorageNvmeHealthHistory":   {ComponentAdmin},
 		"/ctl.CtlSvc/NetworkScan":                {ComponentAdmin},
 		"/ctl.CtlSvc/CollectLog":                 {ComponentAdmin},
 		"/ctl.CtlSvc/FirmwareQuery":              {ComponentAdmin},
@@ -92,6 +94,7 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/PoolReintegrate":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolEvict":                {ComponentAdmin, ComponentAgent},
 		"/mgmt.MgmtSvc/PoolExtend":               {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolResize":               {ComponentAdmin},
 		"/mgmt.MgmtSvc/GetAttachInfo":            {ComponentAgent},
 		"/mgmt.MgmtSvc/ListPools":                {ComponentAdmin},
 		"/mgmt.MgmtSvc/ListContainers":           {ComponentAdmin},
@@ -113,6 +116,19 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/SystemGetAttr":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetProp":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetProp":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemEventsFollow":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemEventsList":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemBackupCreate":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemBackupList":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemReplicasList":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemReplicasUpdate":     {ComponentAdmin},
+		"/mgmt.MgmtSvc/SetMgmtSvcReplicas":       {ComponentServer},
+		"/mgmt.MgmtSvc/SystemMaintenance":        {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemSetQuota":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemGetQuota":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolForecast":             {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolRebuildHistory":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolUndelete":             {ComponentAdmin},
 		"/RaftTransport/AppendEntries":           {ComponentServer},
 		"/RaftTransport/AppendEntriesPipeline":   {ComponentServer},
 		"/RaftTransport/RequestVote":             {ComponentServer},
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -13,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -20,6 +22,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -40,6 +43,82 @@ type SupportConfig struct {
 	FileTransferExec string `yaml:"file_transfer_exec,omitempty"`
 }
 
+// MgmtSvcBackupConfig describes the policy for scheduled backups of the
+// management service database.
+type MgmtSvcBackupConfig struct {
+	Dir      string        `yaml:"dir"`
+	Interval time.Duration `yaml:"interval,omitempty"`
+	Keep     int           `yaml:"keep,omitempty"`
+	Compress bool          `yaml:"compress,omitempty"`
+}
+
+// Validate returns an error if the backup configuration is invalid.
+func (bc *MgmtSvcBackupConfig) Validate() error {
+	switch {
+	case bc.Dir == "":
+		return errors.New("dir must be set")
+	case !filepath.IsAbs(bc.Dir):
+		return errors.Errorf("dir %q must be an absolute path", bc.Dir)
+	case bc.Interval < 0:
+		return errors.New("interval must not be negative")
+	case bc.Interval > 0 && bc.Interval < time.Minute:
+		return errors.Errorf("interval must be at least %s", time.Minute)
+	case bc.Keep < 0:
+		return errors.New("keep must not be negative")
+	}
+
+	return nil
+}
+
+// NvmeFaultMode describes how NVMe devices that breach the fault policy
+// thresholds are handled.
+type NvmeFaultMode string
+
+// NVMe fault policy modes.
+const (
+	// NvmeFaultModeOff disables the NVMe fault policy.
+	NvmeFaultModeOff NvmeFaultMode = "off"
+	// NvmeFaultModeWarn raises a RAS event for devices that breach the
+	// thresholds but leaves them in use.
+	NvmeFaultModeWarn NvmeFaultMode = "warn"
+	// NvmeFaultModeAuto sets devices that breach the thresholds faulty.
+	NvmeFaultModeAuto NvmeFaultMode = "auto"
+)
+
+// NvmeFaultPolicy describes the thresholds on NVMe device health statistics
+// at which a device is considered to be failing. A threshold of zero is
+// disabled.
+type NvmeFaultPolicy struct {
+	Mode             NvmeFaultMode `yaml:"mode,omitempty"`
+	MediaErrors      uint64        `yaml:"media_errs,omitempty"`
+	ChecksumErrors   uint32        `yaml:"checksum_errs,omitempty"`
+	Temperature      uint32        `yaml:"temperature,omitempty"` // Kelvin
+	CriticalWarnings bool          `yaml:"critical_warnings,omitempty"`
+}
+
+// Enabled returns true if the policy will act on devices that breach its
+// thresholds.
+func (fp *NvmeFaultPolicy) Enabled() bool {
+	if fp == nil {
+		return false
+	}
+	return (fp.Mode == NvmeFaultModeWarn || fp.Mode == NvmeFaultModeAuto) &&
+		(fp.MediaErrors > 0 || fp.ChecksumErrors > 0 || fp.Temperature > 0 ||
+			fp.CriticalWarnings)
+}
+
+// Validate returns an error if the NVMe fault policy is invalid.
+func (fp *NvmeFaultPolicy) Validate() error {
+	switch fp.Mode {
+	case "", NvmeFaultModeOff, NvmeFaultModeWarn, NvmeFaultModeAuto:
+	default:
+		return errors.Errorf("unknown mode %q (valid: %s,%s,%s)", fp.Mode,
+			NvmeFaultModeOff, NvmeFaultModeWarn, NvmeFaultModeAuto)
+	}
+
+	return nil
+}
+
 type deprecatedParams struct {
 	AccessPoints []string `yaml:"access_points,omitempty"` // deprecated in 2.8
 }
@@ -68,6 +147,9 @@ type Server struct {
 	CoreDumpFilter    uint8                     `yaml:"core_dump_filter,omitempty"`
 	ClientEnvVars     []string                  `yaml:"client_env_vars,omitempty"`
 	SupportConfig     SupportConfig             `yaml:"support_config,omitempty"`
+	EventSinks        []*events.SinkConfig      `yaml:"event_sinks,omitempty"`
+	MgmtSvcBackup     *MgmtSvcBackupConfig      `yaml:"mgmt_svc_backup,omitempty"`
+	NvmeFaultPolicy   *NvmeFaultPolicy          `yaml:"nvme_fault_policy,omitempty"`
 
 	// duplicated in engine.Config
 	SystemName string              `yaml:"name"`
@@ -323,6 +405,26 @@ func (cfg *Server) WithTelemetryPort(port int) *Server {
 	return cfg
 }
 
+// WithEventSinks sets the external RAS event sink configurations.
+func (cfg *Server) WithEventSinks(sinks ...*events.SinkConfig) *Server {
+	cfg.EventSinks = sinks
+	return cfg
+}
+
+// WithMgmtSvcBackup sets the policy for scheduled management service database
+// backups.
+func (cfg *Server) WithMgmtSvcBackup(bc *MgmtSvcBackupConfig) *Server {
+	cfg.MgmtSvcBackup = bc
+	return cfg
+}
+
+// WithNvmeFaultPolicy sets the policy for automatic handling of failing NVMe
+// devices.
+func (cfg *Server) WithNvmeFaultPolicy(fp *NvmeFaultPolicy) *Server {
+	cfg.NvmeFaultPolicy = fp
+	return cfg
+}
+
 // DefaultServer creates a new instance of configuration struct
 // populated with defaults.
 func DefaultServer() *Server {
@@ -687,6 +789,29 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		return FaultConfigSysRsvdZero
 	}
 
+	sinkNames := make(map[string]struct{})
+	for _, sc := range cfg.EventSinks {
+		if err := sc.Validate(); err != nil {
+			return errors.Wrap(err, "invalid event_sinks config")
+		}
+		if _, exists := sinkNames[sc.Name]; exists {
+			return errors.Errorf("duplicate event sink name %q", sc.Name)
+		}
+		sinkNames[sc.Name] = struct{}{}
+	}
+
+	if cfg.MgmtSvcBackup != nil {
+		if err := cfg.MgmtSvcBackup.Validate(); err != nil {
+			return errors.Wrap(err, "invalid mgmt_svc_backup config")
+		}
+	}
+
+	if cfg.NvmeFaultPolicy != nil {
+		if err := cfg.NvmeFaultPolicy.Validate(); err != nil {
+			return errors.Wrap(err, "invalid nvme_fault_policy config")
+		}
+	}
+
 	// A config without engines is valid when initially discovering hardware prior to adding
 	// per-engine sections with device allocations.
 	if len(cfg.Engines) == 0 {
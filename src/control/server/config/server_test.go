@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -16,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -24,6 +26,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -260,7 +263,33 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithClientEnvVars([]string{"foo=bar"}).
 		WithFabricAuthKey("foo:bar").
 		WithHyperthreads(true). // hyper-threads disabled by default
-		WithSystemRamReserved(5)
+		WithSystemRamReserved(5).
+		WithEventSinks(&events.SinkConfig{
+			Name:          "pager",
+			Webhook:       "https://alerts.example.com/daos",
+			Headers:       map[string]string{"Authorization": "Bearer secret"},
+			IDs:           []string{"engine_died", "device_set_faulty"},
+			Severities:    []string{"error", "warning"},
+			BatchSize:     10,
+			BatchInterval: 30 * time.Second,
+			MaxRetries:    func() *int { n := 5; return &n }(),
+			RetryInterval: 2 * time.Second,
+			Timeout:       5 * time.Second,
+			Debounce:      5 * time.Minute,
+		}).
+		WithMgmtSvcBackup(&MgmtSvcBackupConfig{
+			Dir:      "/var/lib/daos/ms_backups",
+			Interval: 6 * time.Hour,
+			Keep:     10,
+			Compress: true,
+		}).
+		WithNvmeFaultPolicy(&NvmeFaultPolicy{
+			Mode:             NvmeFaultModeAuto,
+			MediaErrors:      10,
+			ChecksumErrors:   1000,
+			Temperature:      358,
+			CriticalWarnings: true,
+		})
 
 	// add engines explicitly to test functionality applied in WithEngines()
 	constructed.Engines = []*engine.Config{
@@ -623,6 +652,76 @@ func TestServerConfig_Validation(t *testing.T) {
 			},
 			expErr: FaultConfigBadTelemetryPort,
 		},
+		"good event sink": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventSinks(&events.SinkConfig{
+					Name:    "pager",
+					Webhook: "https://alerts.example.com/daos",
+				})
+			},
+		},
+		"bad event sink": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventSinks(&events.SinkConfig{Name: "pager"})
+			},
+			expErr: errors.New("one of webhook or exec must be set"),
+		},
+		"duplicate event sink names": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventSinks(
+					&events.SinkConfig{Name: "pager", Exec: "/usr/bin/true"},
+					&events.SinkConfig{Name: "pager", Exec: "/usr/bin/false"},
+				)
+			},
+			expErr: errors.New("duplicate event sink name"),
+		},
+		"good mgmt svc backup": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithMgmtSvcBackup(&MgmtSvcBackupConfig{
+					Dir:      "/var/lib/daos/ms_backups",
+					Interval: time.Hour,
+					Keep:     3,
+				})
+			},
+		},
+		"mgmt svc backup relative dir": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithMgmtSvcBackup(&MgmtSvcBackupConfig{Dir: "ms_backups"})
+			},
+			expErr: errors.New("must be an absolute path"),
+		},
+		"mgmt svc backup short interval": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithMgmtSvcBackup(&MgmtSvcBackupConfig{
+					Dir:      "/var/lib/daos/ms_backups",
+					Interval: time.Second,
+				})
+			},
+			expErr: errors.New("interval must be at least"),
+		},
+		"mgmt svc backup negative keep": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithMgmtSvcBackup(&MgmtSvcBackupConfig{
+					Dir:  "/var/lib/daos/ms_backups",
+					Keep: -1,
+				})
+			},
+			expErr: errors.New("keep must not be negative"),
+		},
+		"good nvme fault policy": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeFaultPolicy(&NvmeFaultPolicy{
+					Mode:        NvmeFaultModeWarn,
+					MediaErrors: 10,
+				})
+			},
+		},
+		"nvme fault policy bad mode": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeFaultPolicy(&NvmeFaultPolicy{Mode: "sometimes"})
+			},
+			expErr: errors.New("unknown mode"),
+		},
 		"different number of bdevs": {
 			extraConfig: func(c *Server) *Server {
 				// add multiple bdevs for engine 0 to create mismatch
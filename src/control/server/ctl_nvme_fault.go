@@ -0,0 +1,309 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// nvmeFaultCheckInterval is the interval between checks of the health of the
+// NVMe devices used by the engines on this server against the fault policy.
+const nvmeFaultCheckInterval = time.Minute
+
+// nvmeFaultMaxAutoPerEngine is the maximum number of devices that the fault
+// policy sets faulty on an engine in a single check. Any further devices that
+// breach the policy are handled in later checks, limiting the damage done by
+// a misconfigured policy.
+const nvmeFaultMaxAutoPerEngine = 1
+
+// nvmeFaultPolicyProps are the system properties that override the NVMe fault
+// policy in the server configuration.
+var nvmeFaultPolicyProps = []daos.SystemPropertyKey{
+	daos.SystemPropertyNvmeFaultMode,
+	daos.SystemPropertyNvmeFaultMediaErrs,
+	daos.SystemPropertyNvmeFaultCsumErrs,
+	daos.SystemPropertyNvmeFaultTemp,
+	daos.SystemPropertyNvmeFaultCritWarn,
+}
+
+// getNvmeFaultPolicy returns the NVMe fault policy from the server
+// configuration with any overrides in the supplied system property values
+// applied. Unset threshold properties have a negative value and mode or
+// critical warning properties with a "config" value are not applied.
+func getNvmeFaultPolicy(base *config.NvmeFaultPolicy, props map[string]string) (*config.NvmeFaultPolicy, error) {
+	fp := &config.NvmeFaultPolicy{Mode: config.NvmeFaultModeOff}
+	if base != nil {
+		*fp = *base
+		if fp.Mode == "" {
+			fp.Mode = config.NvmeFaultModeOff
+		}
+	}
+
+	overrideThresh := func(key daos.SystemPropertyKey, bitSize int, set func(uint64)) error {
+		val := props[key.String()]
+		if val == "" || strings.HasPrefix(val, "-") {
+			return nil
+		}
+		v, err := strconv.ParseUint(val, 10, bitSize)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s value %q", key, val)
+		}
+		set(v)
+		return nil
+	}
+
+	if val := props[daos.SystemPropertyNvmeFaultMode.String()]; val != "" && val != "config" {
+		fp.Mode = config.NvmeFaultMode(val)
+	}
+	if err := overrideThresh(daos.SystemPropertyNvmeFaultMediaErrs, 64, func(v uint64) {
+		fp.MediaErrors = v
+	}); err != nil {
+		return nil, err
+	}
+	if err := overrideThresh(daos.SystemPropertyNvmeFaultCsumErrs, 32, func(v uint64) {
+		fp.ChecksumErrors = uint32(v)
+	}); err != nil {
+		return nil, err
+	}
+	if err := overrideThresh(daos.SystemPropertyNvmeFaultTemp, 32, func(v uint64) {
+		fp.Temperature = uint32(v)
+	}); err != nil {
+		return nil, err
+	}
+	if val := props[daos.SystemPropertyNvmeFaultCritWarn.String()]; val != "" && val != "config" {
+		crit, err := strconv.ParseBool(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s value %q",
+				daos.SystemPropertyNvmeFaultCritWarn, val)
+		}
+		fp.CriticalWarnings = crit
+	}
+
+	if err := fp.Validate(); err != nil {
+		return nil, err
+	}
+	return fp, nil
+}
+
+// checkNvmeFaultPolicy returns the reasons that the supplied device health
+// statistics breach the thresholds of the fault policy, if any.
+func checkNvmeFaultPolicy(fp *config.NvmeFaultPolicy, health *storage.NvmeHealth) []string {
+	var reasons []string
+
+	if fp.MediaErrors > 0 && health.MediaErrors >= fp.MediaErrors {
+		reasons = append(reasons, fmt.Sprintf("media errors %d >= %d",
+			health.MediaErrors, fp.MediaErrors))
+	}
+	if fp.ChecksumErrors > 0 && health.ChecksumErrors >= fp.ChecksumErrors {
+		reasons = append(reasons, fmt.Sprintf("checksum errors %d >= %d",
+			health.ChecksumErrors, fp.ChecksumErrors))
+	}
+	if fp.Temperature > 0 && health.Temperature >= fp.Temperature {
+		reasons = append(reasons, fmt.Sprintf("temperature %dK >= %dK",
+			health.Temperature, fp.Temperature))
+	}
+
+	if !fp.CriticalWarnings {
+		return reasons
+	}
+	for _, warn := range []struct {
+		set  bool
+		name string
+	}{
+		{health.TempWarn, "temperature"},
+		{health.AvailSpareWarn, "available spare"},
+		{health.ReliabilityWarn, "device reliability"},
+		{health.ReadOnlyWarn, "read only"},
+		{health.VolatileWarn, "volatile memory backup"},
+	} {
+		if warn.set {
+			reasons = append(reasons, "critical warning: "+warn.name)
+		}
+	}
+
+	return reasons
+}
+
+// setNvmeFaulty sets the device faulty using the same path as a request from
+// "dmg storage set nvme-faulty".
+var setNvmeFaulty = func(ctx context.Context, svc *ControlService, devUUID string) error {
+	resp, err := svc.SmdManage(ctx, &ctlpb.SmdManageReq{
+		Op: &ctlpb.SmdManageReq_Faulty{
+			Faulty: &ctlpb.SetFaultyReq{Uuid: devUUID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, rr := range resp.Ranks {
+		for _, res := range rr.Results {
+			if res.Status != 0 {
+				return daos.Status(res.Status)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkNvmeFaults checks the health of each NVMe device in use on each ready
+// engine against the fault policy. Devices that breach the policy thresholds
+// are set faulty if the policy mode is auto, and a RAS event is raised
+// describing the breach. In warn mode the event is only raised when the
+// breach is first detected or changes, as recorded in reported. In auto mode
+// at most nvmeFaultMaxAutoPerEngine devices are set faulty on each engine per
+// check, and an engine's last healthy device is never set faulty; its breach
+// is reported as in warn mode instead.
+func (svc *ControlService) checkNvmeFaults(ctx context.Context, fp *config.NvmeFaultPolicy, reported map[string]string) {
+	checked := make(map[string]bool)
+
+	for _, ei := range svc.harness.Instances() {
+		if !ei.IsReady() {
+			continue
+		}
+		rank, err := ei.GetRank()
+		if err != nil {
+			continue
+		}
+
+		smdResp, err := scanSmd(ctx, ei, &ctlpb.SmdDevReq{})
+		if err != nil {
+			svc.log.Debugf("skipping NVMe fault policy check for rank %d: %s", rank, err)
+			continue
+		}
+
+		healthy := 0
+		for _, dev := range smdResp.Devices {
+			if dev != nil && dev.Ctrlr != nil && dev.Ctrlr.DevState == ctlpb.NvmeDevState_NORMAL {
+				healthy++
+			}
+		}
+		faulted := 0
+
+		for _, dev := range smdResp.Devices {
+			if dev == nil || dev.Ctrlr == nil || dev.Ctrlr.DevState != ctlpb.NvmeDevState_NORMAL {
+				continue
+			}
+			checked[dev.Uuid] = true
+
+			bhr, err := scanHealth(ctx, ei, &ctlpb.BioHealthReq{DevUuid: dev.Uuid})
+			if err != nil {
+				svc.log.Debugf("skipping NVMe fault policy check for device %s: %s",
+					dev.Uuid, err)
+				continue
+			}
+			health := new(storage.NvmeHealth)
+			if err := convert.Types(bhr, health); err != nil {
+				svc.log.Errorf("skipping NVMe fault policy check for device %s: %s",
+					dev.Uuid, err)
+				continue
+			}
+
+			reasons := checkNvmeFaultPolicy(fp, health)
+			if len(reasons) == 0 {
+				delete(reported, dev.Uuid)
+				continue
+			}
+
+			summary := strings.Join(reasons, "; ")
+			autoFault := fp.Mode == config.NvmeFaultModeAuto
+			switch {
+			case !autoFault:
+			case healthy-faulted <= 1:
+				svc.log.Debugf("NVMe fault policy not setting last healthy device %s on rank %d faulty",
+					dev.Uuid, rank)
+				autoFault = false
+			case faulted >= nvmeFaultMaxAutoPerEngine:
+				svc.log.Debugf("NVMe fault policy deferring device %s on rank %d to next check",
+					dev.Uuid, rank)
+				continue
+			}
+
+			if !autoFault {
+				if reported[dev.Uuid] == summary {
+					continue
+				}
+				svc.log.Noticef("NVMe device %s on rank %d breached fault policy: %s",
+					dev.Uuid, rank, summary)
+				reported[dev.Uuid] = summary
+				svc.events.Publish(events.NewNVMeFaultPolicyEvent("", rank.Uint32(),
+					dev.Uuid, dev.Ctrlr.PciAddr, false, reasons))
+				continue
+			}
+
+			if err := setNvmeFaulty(ctx, svc, dev.Uuid); err != nil {
+				svc.log.Errorf("NVMe fault policy failed to set device %s faulty: %s",
+					dev.Uuid, err)
+				continue
+			}
+			faulted++
+			svc.log.Noticef("NVMe device %s on rank %d set faulty by fault policy: %s",
+				dev.Uuid, rank, summary)
+			delete(reported, dev.Uuid)
+			svc.events.Publish(events.NewNVMeFaultPolicyEvent("", rank.Uint32(),
+				dev.Uuid, dev.Ctrlr.PciAddr, true, reasons))
+		}
+	}
+
+	for uuid := range reported {
+		if !checked[uuid] {
+			delete(reported, uuid)
+		}
+	}
+}
+
+// nvmeFaultLoop periodically checks the health of the NVMe devices used by
+// the engines on this server against the fault policy. The policy in the
+// server configuration is overridden by any system properties returned by
+// getProps, which are fetched before each check. If the system properties
+// cannot be fetched, e.g. because the MS is unavailable, the policy in the
+// server configuration is used.
+func (svc *ControlService) nvmeFaultLoop(ctx context.Context, getProps func(context.Context) (map[string]string, error)) {
+	ticker := time.NewTicker(nvmeFaultCheckInterval)
+	defer ticker.Stop()
+
+	reported := make(map[string]string)
+
+	svc.log.Debugf("starting nvmeFaultLoop (interval %s)", nvmeFaultCheckInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			svc.log.Debug("stopped nvmeFaultLoop")
+			return
+		case <-ticker.C:
+		}
+
+		props, err := getProps(ctx)
+		if err != nil {
+			svc.log.Debugf("using configured NVMe fault policy: %s", err)
+		}
+		fp, err := getNvmeFaultPolicy(svc.srvCfg.NvmeFaultPolicy, props)
+		if err != nil {
+			svc.log.Errorf("invalid NVMe fault policy: %s", err)
+			continue
+		}
+		if !fp.Enabled() {
+			continue
+		}
+
+		svc.checkNvmeFaults(ctx, fp, reported)
+	}
+}
@@ -0,0 +1,363 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestServer_getNvmeFaultPolicy(t *testing.T) {
+	base := &config.NvmeFaultPolicy{
+		Mode:           config.NvmeFaultModeWarn,
+		MediaErrors:    10,
+		ChecksumErrors: 100,
+	}
+	props := func(mode, media, csum, temp, crit string) map[string]string {
+		return map[string]string{
+			daos.SystemPropertyNvmeFaultMode.String():      mode,
+			daos.SystemPropertyNvmeFaultMediaErrs.String(): media,
+			daos.SystemPropertyNvmeFaultCsumErrs.String():  csum,
+			daos.SystemPropertyNvmeFaultTemp.String():      temp,
+			daos.SystemPropertyNvmeFaultCritWarn.String():  crit,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		base   *config.NvmeFaultPolicy
+		props  map[string]string
+		expFP  *config.NvmeFaultPolicy
+		expErr error
+	}{
+		"no config; no props": {
+			expFP: &config.NvmeFaultPolicy{Mode: config.NvmeFaultModeOff},
+		},
+		"config; no props": {
+			base:  base,
+			expFP: base,
+		},
+		"config; default props": {
+			base:  base,
+			props: props("config", "-1", "-1", "-1", "config"),
+			expFP: base,
+		},
+		"props override config": {
+			base:  base,
+			props: props("auto", "5", "0", "358", "true"),
+			expFP: &config.NvmeFaultPolicy{
+				Mode:             config.NvmeFaultModeAuto,
+				MediaErrors:      5,
+				Temperature:      358,
+				CriticalWarnings: true,
+			},
+		},
+		"props without config": {
+			props: props("warn", "-1", "-1", "-1", "true"),
+			expFP: &config.NvmeFaultPolicy{
+				Mode:             config.NvmeFaultModeWarn,
+				CriticalWarnings: true,
+			},
+		},
+		"invalid mode": {
+			props:  props("sometimes", "-1", "-1", "-1", "config"),
+			expErr: errors.New("unknown mode"),
+		},
+		"invalid threshold": {
+			props:  props("config", "-1", "5000000000", "-1", "config"),
+			expErr: errors.New("invalid nvme_fault_checksum_errs value"),
+		},
+		"invalid critical warnings": {
+			props:  props("config", "-1", "-1", "-1", "maybe"),
+			expErr: errors.New("invalid nvme_fault_critical_warnings value"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotFP, gotErr := getNvmeFaultPolicy(tc.base, tc.props)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expFP, gotFP); diff != "" {
+				t.Fatalf("unexpected policy (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_checkNvmeFaultPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fp         *config.NvmeFaultPolicy
+		health     *storage.NvmeHealth
+		expReasons []string
+	}{
+		"thresholds disabled": {
+			fp: &config.NvmeFaultPolicy{},
+			health: &storage.NvmeHealth{
+				MediaErrors:    100,
+				ChecksumErrors: 100,
+				Temperature:    400,
+				ReadOnlyWarn:   true,
+			},
+		},
+		"below thresholds": {
+			fp: &config.NvmeFaultPolicy{
+				MediaErrors:    10,
+				ChecksumErrors: 10,
+				Temperature:    350,
+			},
+			health: &storage.NvmeHealth{
+				MediaErrors:    9,
+				ChecksumErrors: 9,
+				Temperature:    349,
+				ReadOnlyWarn:   true,
+			},
+		},
+		"thresholds reached": {
+			fp: &config.NvmeFaultPolicy{
+				MediaErrors:    10,
+				ChecksumErrors: 10,
+				Temperature:    350,
+			},
+			health: &storage.NvmeHealth{
+				MediaErrors:    10,
+				ChecksumErrors: 11,
+				Temperature:    350,
+			},
+			expReasons: []string{
+				"media errors 10 >= 10",
+				"checksum errors 11 >= 10",
+				"temperature 350K >= 350K",
+			},
+		},
+		"critical warnings": {
+			fp: &config.NvmeFaultPolicy{CriticalWarnings: true},
+			health: &storage.NvmeHealth{
+				AvailSpareWarn: true,
+				ReadOnlyWarn:   true,
+			},
+			expReasons: []string{
+				"critical warning: available spare",
+				"critical warning: read only",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotReasons := checkNvmeFaultPolicy(tc.fp, tc.health)
+
+			if diff := cmp.Diff(tc.expReasons, gotReasons); diff != "" {
+				t.Fatalf("unexpected reasons (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_checkNvmeFaults(t *testing.T) {
+	smdDev := func(idx int32, state ctlpb.NvmeDevState) *ctlpb.SmdDevice {
+		return &ctlpb.SmdDevice{
+			Uuid: test.MockUUID(idx),
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  test.MockPCIAddr(idx),
+				DevState: state,
+			},
+		}
+	}
+	expEvent := func(idx int32, setFaulty bool, reasons ...string) string {
+		evt := events.NewNVMeFaultPolicyEvent("", 0, test.MockUUID(idx), test.MockPCIAddr(idx),
+			setFaulty, reasons)
+		evt.Timestamp = ""
+		return evt.String()
+	}
+
+	for name, tc := range map[string]struct {
+		mode          config.NvmeFaultMode
+		devices       []*ctlpb.SmdDevice
+		passes        int
+		faultyErr     error
+		reported      map[string]string
+		expFaulty     []string
+		expReported   map[string]string
+		expDispatched []string
+	}{
+		"warn": {
+			mode:      config.NvmeFaultModeWarn,
+			expFaulty: []string{},
+			expReported: map[string]string{
+				test.MockUUID(1): "media errors 10 >= 5",
+			},
+			expDispatched: []string{
+				expEvent(1, false, "media errors 10 >= 5"),
+			},
+		},
+		"warn; previously reported": {
+			mode: config.NvmeFaultModeWarn,
+			reported: map[string]string{
+				test.MockUUID(1): "media errors 10 >= 5",
+				test.MockUUID(2): "media errors 6 >= 5",
+				test.MockUUID(5): "media errors 6 >= 5",
+			},
+			expFaulty: []string{},
+			expReported: map[string]string{
+				test.MockUUID(1): "media errors 10 >= 5",
+			},
+		},
+		"auto": {
+			mode:        config.NvmeFaultModeAuto,
+			expFaulty:   []string{test.MockUUID(1)},
+			expReported: map[string]string{},
+			expDispatched: []string{
+				expEvent(1, true, "media errors 10 >= 5"),
+			},
+		},
+		"auto; set faulty fails": {
+			mode:        config.NvmeFaultModeAuto,
+			faultyErr:   daos.Busy,
+			expFaulty:   []string{test.MockUUID(1), test.MockUUID(1)},
+			expReported: map[string]string{},
+		},
+		"auto; one device per engine per check": {
+			mode: config.NvmeFaultModeAuto,
+			devices: []*ctlpb.SmdDevice{
+				smdDev(1, ctlpb.NvmeDevState_NORMAL),
+				smdDev(2, ctlpb.NvmeDevState_NORMAL),
+				smdDev(4, ctlpb.NvmeDevState_NORMAL),
+			},
+			passes:      1,
+			expFaulty:   []string{test.MockUUID(1)},
+			expReported: map[string]string{},
+			expDispatched: []string{
+				expEvent(1, true, "media errors 10 >= 5"),
+			},
+		},
+		"auto; deferred device set faulty in next check": {
+			mode: config.NvmeFaultModeAuto,
+			devices: []*ctlpb.SmdDevice{
+				smdDev(1, ctlpb.NvmeDevState_NORMAL),
+				smdDev(2, ctlpb.NvmeDevState_NORMAL),
+				smdDev(4, ctlpb.NvmeDevState_NORMAL),
+			},
+			expFaulty:   []string{test.MockUUID(1), test.MockUUID(4)},
+			expReported: map[string]string{},
+			expDispatched: []string{
+				expEvent(1, true, "media errors 10 >= 5"),
+				expEvent(4, true, "media errors 10 >= 5"),
+			},
+		},
+		"auto; last healthy device": {
+			mode: config.NvmeFaultModeAuto,
+			devices: []*ctlpb.SmdDevice{
+				smdDev(1, ctlpb.NvmeDevState_NORMAL),
+				smdDev(3, ctlpb.NvmeDevState_EVICTED),
+			},
+			expFaulty: []string{},
+			expReported: map[string]string{
+				test.MockUUID(1): "media errors 10 >= 5",
+			},
+			expDispatched: []string{
+				expEvent(1, false, "media errors 10 >= 5"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.devices == nil {
+				tc.devices = []*ctlpb.SmdDevice{
+					smdDev(1, ctlpb.NvmeDevState_NORMAL),
+					smdDev(2, ctlpb.NvmeDevState_NORMAL),
+					smdDev(3, ctlpb.NvmeDevState_EVICTED),
+				}
+			}
+			smdResp := &ctlpb.SmdDevResp{Devices: tc.devices}
+			scanSmd = func(_ context.Context, _ Engine, _ *ctlpb.SmdDevReq) (*ctlpb.SmdDevResp, error) {
+				return smdResp, nil
+			}
+			defer func() {
+				scanSmd = listSmdDevices
+			}()
+			scanHealth = func(_ context.Context, _ Engine, req *ctlpb.BioHealthReq) (*ctlpb.BioHealthResp, error) {
+				switch req.DevUuid {
+				case test.MockUUID(1), test.MockUUID(3), test.MockUUID(4):
+					return &ctlpb.BioHealthResp{MediaErrs: 10}, nil
+				default:
+					return &ctlpb.BioHealthResp{MediaErrs: 1}, nil
+				}
+			}
+			defer func() {
+				scanHealth = getBioHealth
+			}()
+			gotFaulty := []string{}
+			origSetNvmeFaulty := setNvmeFaulty
+			setNvmeFaulty = func(_ context.Context, _ *ControlService, devUUID string) error {
+				gotFaulty = append(gotFaulty, devUUID)
+				if tc.faultyErr != nil {
+					return tc.faultyErr
+				}
+				for _, dev := range smdResp.Devices {
+					if dev.Uuid == devUUID {
+						dev.Ctrlr.DevState = ctlpb.NvmeDevState_EVICTED
+					}
+				}
+				return nil
+			}
+			defer func() {
+				setNvmeFaulty = origSetNvmeFaulty
+			}()
+
+			cs := mockControlService(t, log, nil, nil, nil, nil)
+
+			ctx, cancel := context.WithTimeout(test.Context(t), 200*time.Millisecond)
+			defer cancel()
+
+			ps := events.NewPubSub(ctx, log)
+			cs.events = ps
+
+			subscriber := newMockSubscriber(len(tc.expDispatched))
+			cs.events.Subscribe(events.RASTypeAny, subscriber)
+
+			reported := tc.reported
+			if reported == nil {
+				reported = make(map[string]string)
+			}
+			fp := &config.NvmeFaultPolicy{
+				Mode:        tc.mode,
+				MediaErrors: 5,
+			}
+			if tc.passes == 0 {
+				tc.passes = 2
+			}
+			for i := 0; i < tc.passes; i++ {
+				cs.checkNvmeFaults(test.Context(t), fp, reported)
+			}
+
+			<-ctx.Done()
+
+			if diff := cmp.Diff(tc.expFaulty, gotFaulty); diff != "" {
+				t.Fatalf("unexpected devices set faulty (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expReported, reported); diff != "" {
+				t.Fatalf("unexpected reported devices (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expDispatched, subscriber.getRx(), defEvtCmpOpts...); diff != "" {
+				t.Fatalf("unexpected events dispatched (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	// nvmeHealthSampleInterval is the interval between samples of the health
+	// of the NVMe devices used by the engines on this server.
+	nvmeHealthSampleInterval = 4 * time.Hour
+	// maxNvmeHealthSamples is the number of health samples retained for
+	// each device, covering about a year at the sample interval.
+	maxNvmeHealthSamples = 2190
+	// nvmeHealthHistoryFile is the name of the file in which NVMe health
+	// samples are persisted.
+	nvmeHealthHistoryFile = "nvme_health_history.json"
+)
+
+// cfgGetNvmeHealthHistoryPath returns the path of the file in which NVMe
+// health samples are persisted, or an empty string if there is nowhere to
+// persist them.
+func cfgGetNvmeHealthHistoryPath(cfg *config.Server) string {
+	if cfg.Metadata.Path != "" {
+		return filepath.Join(cfg.Metadata.Directory(), nvmeHealthHistoryFile)
+	}
+
+	if len(cfg.Engines) == 0 || len(cfg.Engines[0].Storage.Tiers.ScmConfigs()) == 0 {
+		return ""
+	}
+
+	return filepath.Join(cfg.Engines[0].Storage.Tiers.ScmConfigs()[0].Scm.MountPoint,
+		nvmeHealthHistoryFile)
+}
+
+// nvmeHealthHistory retains a bounded history of health samples for each
+// NVMe device used by the engines on this server. The history is persisted
+// to a local file so that it survives server restarts.
+type nvmeHealthHistory struct {
+	sync.RWMutex
+	log     logging.Logger
+	path    string
+	devices map[string]*storage.NvmeHealthHistory
+}
+
+func newNvmeHealthHistory(log logging.Logger, path string) *nvmeHealthHistory {
+	return &nvmeHealthHistory{
+		log:     log,
+		path:    path,
+		devices: make(map[string]*storage.NvmeHealthHistory),
+	}
+}
+
+// load reads previously persisted samples, if any.
+func (nhh *nvmeHealthHistory) load() error {
+	if nhh.path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(nhh.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to read NVMe health history")
+	}
+
+	var devices []*storage.NvmeHealthHistory
+	if err := json.Unmarshal(data, &devices); err != nil {
+		return errors.Wrapf(err, "failed to decode %s", nhh.path)
+	}
+
+	nhh.Lock()
+	defer nhh.Unlock()
+	for _, dev := range devices {
+		nhh.devices[dev.UUID] = dev
+	}
+
+	return nil
+}
+
+// save persists the retained samples. The file is replaced atomically so
+// that a partially written history is never read back.
+func (nhh *nvmeHealthHistory) save() error {
+	if nhh.path == "" {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Dir(nhh.path)); err != nil {
+		nhh.log.Debugf("not persisting NVMe health history: %s", err)
+		return nil
+	}
+
+	nhh.RLock()
+	data, err := json.Marshal(nhh.list("", uint32(ranklist.NilRank)))
+	nhh.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	tmpPath := nhh.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return errors.Wrap(err, "failed to write NVMe health history")
+	}
+	return errors.Wrap(os.Rename(tmpPath, nhh.path), "failed to write NVMe health history")
+}
+
+// add appends a sample to the history of the device, discarding the oldest
+// samples if the history is full. The device details are updated, as a
+// device may be assigned to a different rank after a reformat.
+func (nhh *nvmeHealthHistory) add(dev *storage.NvmeHealthHistory, sample *storage.NvmeHealthSample) {
+	nhh.Lock()
+	defer nhh.Unlock()
+
+	hist, found := nhh.devices[dev.UUID]
+	if !found {
+		hist = &storage.NvmeHealthHistory{UUID: dev.UUID}
+		nhh.devices[dev.UUID] = hist
+	}
+	hist.PciAddr = dev.PciAddr
+	hist.Model = dev.Model
+	hist.Serial = dev.Serial
+	hist.Rank = dev.Rank
+
+	hist.Samples = append(hist.Samples, sample)
+	if len(hist.Samples) > maxNvmeHealthSamples {
+		hist.Samples = hist.Samples[len(hist.Samples)-maxNvmeHealthSamples:]
+	}
+}
+
+// list returns the histories of the devices matching the device UUID and
+// rank, ordered by rank and UUID. An empty UUID or a nil rank matches all
+// devices. The caller must hold the lock.
+func (nhh *nvmeHealthHistory) list(uuid string, rank uint32) []*storage.NvmeHealthHistory {
+	devices := []*storage.NvmeHealthHistory{}
+	for _, hist := range nhh.devices {
+		if uuid != "" && hist.UUID != uuid {
+			continue
+		}
+		if !queryRank(rank, hist.Rank) {
+			continue
+		}
+		devices = append(devices, hist)
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		if devices[i].Rank != devices[j].Rank {
+			return devices[i].Rank < devices[j].Rank
+		}
+		return devices[i].UUID < devices[j].UUID
+	})
+
+	return devices
+}
+
+// sampleNvmeHealth records the health of each NVMe device that is able to
+// supply health statistics on each ready engine. Engines and devices that
+// cannot be queried are skipped.
+func (svc *ControlService) sampleNvmeHealth(ctx context.Context) error {
+	now := time.Now()
+	for _, ei := range svc.harness.Instances() {
+		if !ei.IsReady() {
+			continue
+		}
+		rank, err := ei.GetRank()
+		if err != nil {
+			continue
+		}
+
+		smdResp, err := scanSmd(ctx, ei, &ctlpb.SmdDevReq{})
+		if err != nil {
+			svc.log.Debugf("skipping NVMe health sample for rank %d: %s", rank, err)
+			continue
+		}
+
+		for _, dev := range smdResp.Devices {
+			if dev == nil || dev.Ctrlr == nil || !dev.Ctrlr.CanSupplyHealthStats() {
+				continue
+			}
+
+			bhr, err := scanHealth(ctx, ei, &ctlpb.BioHealthReq{DevUuid: dev.Uuid})
+			if err != nil {
+				svc.log.Debugf("skipping NVMe health sample for device %s: %s", dev.Uuid, err)
+				continue
+			}
+			health := new(storage.NvmeHealth)
+			if err := convert.Types(bhr, health); err != nil {
+				return errors.Wrap(err, "convert health stats")
+			}
+
+			svc.nvmeHealth.add(&storage.NvmeHealthHistory{
+				UUID:    dev.Uuid,
+				PciAddr: dev.Ctrlr.PciAddr,
+				Model:   dev.Ctrlr.Model,
+				Serial:  dev.Ctrlr.Serial,
+				Rank:    rank,
+			}, storage.NewNvmeHealthSample(now, health))
+		}
+	}
+
+	return svc.nvmeHealth.save()
+}
+
+// nvmeHealthLoop periodically samples the health of the NVMe devices used by
+// the engines on this server, starting with any previously persisted samples.
+func (svc *ControlService) nvmeHealthLoop(ctx context.Context) {
+	if err := svc.nvmeHealth.load(); err != nil {
+		svc.log.Errorf("NVMe health history not loaded: %s", err)
+	}
+
+	ticker := time.NewTicker(nvmeHealthSampleInterval)
+	defer ticker.Stop()
+
+	svc.log.Debugf("starting nvmeHealthLoop (interval %s)", nvmeHealthSampleInterval)
+	for {
+		if err := svc.sampleNvmeHealth(ctx); err != nil {
+			svc.log.Errorf("NVMe health sampling failed: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			svc.log.Debug("stopped nvmeHealthLoop")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// StorageNvmeHealthHistory returns the retained health samples of the NVMe
+// devices used by the engines on this server.
+func (svc *ControlService) StorageNvmeHealthHistory(ctx context.Context, req *ctlpb.NvmeHealthHistoryReq) (*ctlpb.NvmeHealthHistoryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	resp := new(ctlpb.NvmeHealthHistoryResp)
+	if svc.nvmeHealth == nil {
+		return resp, nil
+	}
+
+	svc.nvmeHealth.RLock()
+	defer svc.nvmeHealth.RUnlock()
+
+	if err := convert.Types(svc.nvmeHealth.list(req.Uuid, req.Rank), &resp.Devices); err != nil {
+		return nil, errors.Wrap(err, "convert NVMe health history")
+	}
+
+	return resp, nil
+}
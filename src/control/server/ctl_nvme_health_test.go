@@ -0,0 +1,266 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestServer_CtlSvc_sampleNvmeHealth(t *testing.T) {
+	smdDev := func(idx int32, state ctlpb.NvmeDevState) *ctlpb.SmdDevice {
+		return &ctlpb.SmdDevice{
+			Uuid: test.MockUUID(idx),
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  test.MockPCIAddr(idx),
+				Model:    "model",
+				Serial:   "serial",
+				DevState: state,
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		notStarted bool
+		smdResp    *ctlpb.SmdDevResp
+		smdErr     error
+		expHistory []*storage.NvmeHealthHistory
+	}{
+		"engine not ready": {
+			notStarted: true,
+			smdResp: &ctlpb.SmdDevResp{
+				Devices: []*ctlpb.SmdDevice{smdDev(1, ctlpb.NvmeDevState_NORMAL)},
+			},
+			expHistory: []*storage.NvmeHealthHistory{},
+		},
+		"smd scan fails": {
+			smdErr:     errors.New("scan failed"),
+			expHistory: []*storage.NvmeHealthHistory{},
+		},
+		"devices sampled": {
+			smdResp: &ctlpb.SmdDevResp{
+				Devices: []*ctlpb.SmdDevice{
+					smdDev(2, ctlpb.NvmeDevState_EVICTED),
+					smdDev(1, ctlpb.NvmeDevState_NORMAL),
+					smdDev(3, ctlpb.NvmeDevState_NEW),
+					smdDev(4, ctlpb.NvmeDevState_NORMAL),
+				},
+			},
+			expHistory: []*storage.NvmeHealthHistory{
+				{
+					UUID:    test.MockUUID(1),
+					PciAddr: test.MockPCIAddr(1),
+					Model:   "model",
+					Serial:  "serial",
+					Samples: []*storage.NvmeHealthSample{
+						{MediaErrors: 1, Temperature: 300, WearLevelingCntNorm: 99},
+						{MediaErrors: 1, Temperature: 300, WearLevelingCntNorm: 99},
+					},
+				},
+				{
+					UUID:    test.MockUUID(2),
+					PciAddr: test.MockPCIAddr(2),
+					Model:   "model",
+					Serial:  "serial",
+					Samples: []*storage.NvmeHealthSample{
+						{MediaErrors: 2, Temperature: 300, WearLevelingCntNorm: 99},
+						{MediaErrors: 2, Temperature: 300, WearLevelingCntNorm: 99},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			scanSmd = func(_ context.Context, _ Engine, _ *ctlpb.SmdDevReq) (*ctlpb.SmdDevResp, error) {
+				return tc.smdResp, tc.smdErr
+			}
+			defer func() {
+				scanSmd = listSmdDevices
+			}()
+			scanHealth = func(_ context.Context, _ Engine, req *ctlpb.BioHealthReq) (*ctlpb.BioHealthResp, error) {
+				switch req.DevUuid {
+				case test.MockUUID(1):
+					return &ctlpb.BioHealthResp{MediaErrs: 1, Temperature: 300, WearLevelingCntNorm: 99}, nil
+				case test.MockUUID(2):
+					return &ctlpb.BioHealthResp{MediaErrs: 2, Temperature: 300, WearLevelingCntNorm: 99}, nil
+				default:
+					return nil, errors.New("health failed")
+				}
+			}
+			defer func() {
+				scanHealth = getBioHealth
+			}()
+
+			histPath := filepath.Join(t.TempDir(), nvmeHealthHistoryFile)
+			cs := mockControlService(t, log, nil, nil, nil, nil, tc.notStarted)
+			cs.nvmeHealth = newNvmeHealthHistory(log, histPath)
+
+			for i := 0; i < 2; i++ {
+				if err := cs.sampleNvmeHealth(test.Context(t)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(storage.NvmeHealthSample{}, "Timestamp"),
+			}
+			if diff := cmp.Diff(tc.expHistory, cs.nvmeHealth.list("", uint32(ranklist.NilRank)), cmpOpts...); diff != "" {
+				t.Fatalf("unexpected history (-want, +got):\n%s\n", diff)
+			}
+
+			// Verify that the persisted history can be loaded.
+			loaded := newNvmeHealthHistory(log, histPath)
+			if err := loaded.load(); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expHistory, loaded.list("", uint32(ranklist.NilRank)), cmpOpts...); diff != "" {
+				t.Fatalf("unexpected loaded history (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_nvmeHealthHistory_add(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	nhh := newNvmeHealthHistory(log, "")
+	for i := 0; i < maxNvmeHealthSamples+2; i++ {
+		nhh.add(&storage.NvmeHealthHistory{
+			UUID: test.MockUUID(1),
+			Rank: ranklist.Rank(i / maxNvmeHealthSamples),
+		}, &storage.NvmeHealthSample{Timestamp: uint64(i)})
+	}
+
+	devices := nhh.list("", uint32(ranklist.NilRank))
+	if len(devices) != 1 {
+		t.Fatalf("expected 1 device, got %d", len(devices))
+	}
+	test.AssertEqual(t, ranklist.Rank(1), devices[0].Rank, "rank not updated")
+	test.AssertEqual(t, maxNvmeHealthSamples, len(devices[0].Samples), "unexpected number of samples")
+	test.AssertEqual(t, uint64(2), devices[0].Samples[0].Timestamp, "oldest samples not discarded")
+}
+
+func TestServer_CtlSvc_StorageNvmeHealthHistory(t *testing.T) {
+	history := func(idx int32, rank uint32) *storage.NvmeHealthHistory {
+		return &storage.NvmeHealthHistory{
+			UUID:    test.MockUUID(idx),
+			PciAddr: test.MockPCIAddr(idx),
+			Rank:    ranklist.Rank(rank),
+		}
+	}
+	sample := &storage.NvmeHealthSample{
+		Timestamp:          1735689600,
+		MediaErrors:        3,
+		ReadErrors:         4,
+		Temperature:        310,
+		AvailSpareWarn:     true,
+		WearLevelingCntAvg: 100,
+		MediaWearRaw:       1024,
+	}
+	pbHistory := func(idx int32, rank uint32) *ctlpb.NvmeHealthHistory {
+		return &ctlpb.NvmeHealthHistory{
+			Uuid:    test.MockUUID(idx),
+			PciAddr: test.MockPCIAddr(idx),
+			Rank:    rank,
+			Samples: []*ctlpb.NvmeHealthSample{
+				{
+					Timestamp:          1735689600,
+					MediaErrs:          3,
+					BioReadErrs:        4,
+					Temperature:        310,
+					AvailSpareWarn:     true,
+					WearLevelingCntAvg: 100,
+					MediaWearRaw:       1024,
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		noHistory bool
+		req       *ctlpb.NvmeHealthHistoryReq
+		expResp   *ctlpb.NvmeHealthHistoryResp
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no history": {
+			noHistory: true,
+			req:       &ctlpb.NvmeHealthHistoryReq{Rank: uint32(ranklist.NilRank)},
+			expResp:   &ctlpb.NvmeHealthHistoryResp{},
+		},
+		"all devices": {
+			req: &ctlpb.NvmeHealthHistoryReq{Rank: uint32(ranklist.NilRank)},
+			expResp: &ctlpb.NvmeHealthHistoryResp{
+				Devices: []*ctlpb.NvmeHealthHistory{
+					pbHistory(2, 0),
+					pbHistory(1, 1),
+					pbHistory(3, 1),
+				},
+			},
+		},
+		"filter by rank": {
+			req: &ctlpb.NvmeHealthHistoryReq{Rank: 1},
+			expResp: &ctlpb.NvmeHealthHistoryResp{
+				Devices: []*ctlpb.NvmeHealthHistory{
+					pbHistory(1, 1),
+					pbHistory(3, 1),
+				},
+			},
+		},
+		"filter by uuid": {
+			req: &ctlpb.NvmeHealthHistoryReq{
+				Uuid: test.MockUUID(3),
+				Rank: uint32(ranklist.NilRank),
+			},
+			expResp: &ctlpb.NvmeHealthHistoryResp{
+				Devices: []*ctlpb.NvmeHealthHistory{
+					pbHistory(3, 1),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cs := mockControlService(t, log, nil, nil, nil, nil)
+			if !tc.noHistory {
+				cs.nvmeHealth = newNvmeHealthHistory(log, "")
+				cs.nvmeHealth.add(history(1, 1), sample)
+				cs.nvmeHealth.add(history(2, 0), sample)
+				cs.nvmeHealth.add(history(3, 1), sample)
+			}
+
+			gotResp, gotErr := cs.StorageNvmeHealthHistory(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
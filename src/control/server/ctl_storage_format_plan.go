@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// metadataFormatPlan reports how control plane metadata storage would be formatted. The returned
+// flag indicates whether a format of the control metadata would be performed, which determines
+// whether NVMe would be formatted on instances with SCM that is already formatted.
+func (cs *ControlService) metadataFormatPlan(instances []Engine, reformat bool) (*ctlpb.MetadataFormatPlan, bool, error) {
+	needs, err := cs.storage.ControlMetadataNeedsFormat()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "detecting if metadata format is needed")
+	}
+	mdFormatted := needs || reformat
+
+	if !cs.storage.ControlMetadataPathConfigured() {
+		// Control metadata is stored on SCM.
+		return nil, mdFormatted, nil
+	}
+
+	cm := cs.storage.GetControlMetadata()
+	plan := &ctlpb.MetadataFormatPlan{
+		Format:   mdFormatted,
+		RootPath: cm.Path,
+		Device:   cm.DevicePath,
+	}
+	if mdFormatted {
+		plan.Paths = append(plan.Paths, cm.Path, cm.Directory())
+		for _, ei := range instances {
+			plan.Paths = append(plan.Paths, cm.EngineDirectory(uint(ei.Index())))
+		}
+	}
+
+	return plan, mdFormatted, nil
+}
+
+func scmFormatPlan(cfg *storage.TierConfig, format bool) *ctlpb.ScmFormatPlan {
+	plan := &ctlpb.ScmFormatPlan{
+		Format:     format,
+		Class:      cfg.Class.String(),
+		MountPoint: cfg.Scm.MountPoint,
+	}
+
+	switch cfg.Class {
+	case storage.ClassDcpm:
+		plan.Devices = cfg.Scm.DeviceList
+	case storage.ClassRam:
+		plan.RamdiskSize = uint32(cfg.Scm.RamdiskSize)
+	}
+
+	if !format {
+		plan.Info = msgScmAlreadyFormatted
+	}
+
+	return plan
+}
+
+func bdevTierFormatPlans(cfgs []*storage.TierConfig) []*ctlpb.BdevTierFormatPlan {
+	plans := make([]*ctlpb.BdevTierFormatPlan, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		plans = append(plans, &ctlpb.BdevTierFormatPlan{
+			Tier:    uint32(cfg.Tier),
+			Class:   cfg.Class.String(),
+			Devices: cfg.Bdev.DeviceList.Devices(),
+			Roles:   cfg.Bdev.DeviceRoles.String(),
+		})
+	}
+
+	return plans
+}
+
+// storageFormatPlan performs the same checks as StorageFormat to determine what would be done to
+// each tier of each engine's storage and to control metadata storage, without modifying any
+// storage.
+func (cs *ControlService) storageFormatPlan(instances []Engine, reformat bool) (*ctlpb.StorageFormatPlan, error) {
+	plan := new(ctlpb.StorageFormatPlan)
+	if len(instances) == 0 {
+		return plan, nil
+	}
+
+	mdPlan, mdFormatted, err := cs.metadataFormatPlan(instances, reformat)
+	if err != nil {
+		return nil, err
+	}
+	plan.Metadata = mdPlan
+
+	chk, err := checkScmFormat(formatScmReq{
+		log:        cs.log,
+		reformat:   reformat,
+		instances:  instances,
+		getMemInfo: cs.getMemInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, ei := range instances {
+		formatScm := chk.needFormat[idx] || reformat
+		engPlan := &ctlpb.EngineFormatPlan{
+			Index:     ei.Index(),
+			Scm:       scmFormatPlan(chk.scmCfgs[idx], formatScm),
+			BdevTiers: bdevTierFormatPlans(ei.GetStorage().GetBdevConfigs()),
+		}
+
+		// Mirror the conditions under which formatNvme skips an instance.
+		switch {
+		case cs.srvCfg.DisableHugepages:
+			engPlan.BdevInfo = fmt.Sprintf(msgNvmeFormatSkipHPD, ei.Index())
+		case !formatScm && !chk.emptyTmpfs[idx] && !mdFormatted:
+			engPlan.BdevInfo = fmt.Sprintf(msgNvmeFormatSkipNotDone, ei.Index())
+		case storage.TierConfigs(ei.GetStorage().GetBdevConfigs()).Bdevs().Len() > 0:
+			engPlan.FormatBdevs = true
+			engPlan.NvmeConfig = ei.GetStorage().GetNvmeConfigPath()
+		}
+
+		plan.Engines = append(plan.Engines, engPlan)
+	}
+
+	cs.log.Tracef("StorageFormatPlan: %+v", plan)
+
+	return plan, nil
+}
+
+// StorageFormatPlan reports what StorageFormat would do to the storage of each engine and to
+// control metadata storage on this host, without modifying any storage.
+func (cs *ControlService) StorageFormatPlan(ctx context.Context, req *ctlpb.StorageFormatPlanReq) (*ctlpb.StorageFormatPlanResp, error) {
+	if req == nil {
+		return nil, errNilReq
+	}
+	if cs.srvCfg == nil {
+		return nil, errNoSrvCfg
+	}
+
+	plan, err := cs.storageFormatPlan(cs.harness.Instances(), req.Reformat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctlpb.StorageFormatPlanResp{Plan: plan}, nil
+}
@@ -0,0 +1,268 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/provider/system"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/mount"
+	"github.com/daos-stack/daos/src/control/server/storage/scm"
+)
+
+func TestServer_CtlSvc_StorageFormatPlan(t *testing.T) {
+	mdPath := "/var/daos/config"
+	mdDir := filepath.Join(mdPath, storage.ControlMetadataSubdir)
+	nvmeConf := func(idx int) string {
+		return fmt.Sprintf("/mnt/daos%d/%s", idx, storage.BdevOutConfName)
+	}
+	notEmpty := []system.GetfsUsageRetval{
+		{Total: 1234, Avail: 1233},
+		{Total: 1234, Avail: 1233},
+	}
+	scmPlan := func(idx int, format bool) *ctlpb.ScmFormatPlan {
+		p := &ctlpb.ScmFormatPlan{
+			Format:      format,
+			Class:       storage.ClassRam.String(),
+			MountPoint:  fmt.Sprintf("/mnt/daos%d", idx),
+			RamdiskSize: 16,
+		}
+		if !format {
+			p.Info = msgScmAlreadyFormatted
+		}
+		return p
+	}
+	bdevTiers := func(idx int32) []*ctlpb.BdevTierFormatPlan {
+		return []*ctlpb.BdevTierFormatPlan{
+			{
+				Tier:    1,
+				Class:   storage.ClassNvme.String(),
+				Devices: []string{test.MockPCIAddr(idx)},
+				Roles:   "NA",
+			},
+		}
+	}
+	formatPlan := func(idx int, formatScm bool) *ctlpb.EngineFormatPlan {
+		return &ctlpb.EngineFormatPlan{
+			Index:       uint32(idx),
+			Scm:         scmPlan(idx, formatScm),
+			FormatBdevs: true,
+			BdevTiers:   bdevTiers(int32(idx + 1)),
+			NvmeConfig:  nvmeConf(idx),
+		}
+	}
+	skipPlan := func(idx int, formatScm bool, info string) *ctlpb.EngineFormatPlan {
+		return &ctlpb.EngineFormatPlan{
+			Index:     uint32(idx),
+			Scm:       scmPlan(idx, formatScm),
+			BdevTiers: bdevTiers(int32(idx + 1)),
+			BdevInfo:  fmt.Sprintf(info, idx),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		noEngines     bool
+		scmMounted    bool
+		getfsUsage    []system.GetfsUsageRetval
+		memAvail      uint64
+		mdPath        string
+		mdDevice      string
+		mdNeedsFormat bool
+		mdErr         error
+		disableHPs    bool
+		reformat      bool
+		expPlan       *ctlpb.StorageFormatPlan
+		expErr        error
+	}{
+		"no engines": {
+			noEngines: true,
+			expPlan:   &ctlpb.StorageFormatPlan{},
+		},
+		"unformatted": {
+			expPlan: &ctlpb.StorageFormatPlan{
+				Engines: []*ctlpb.EngineFormatPlan{
+					formatPlan(0, true),
+					formatPlan(1, true),
+				},
+			},
+		},
+		"unformatted; insufficient memory for ramdisks": {
+			memAvail: humanize.GiByte,
+			expErr:   errors.New("insufficient for configured"),
+		},
+		"already formatted": {
+			scmMounted: true,
+			getfsUsage: notEmpty,
+			expPlan: &ctlpb.StorageFormatPlan{
+				Engines: []*ctlpb.EngineFormatPlan{
+					skipPlan(0, false, msgNvmeFormatSkipNotDone),
+					skipPlan(1, false, msgNvmeFormatSkipNotDone),
+				},
+			},
+		},
+		"already formatted; reformat": {
+			scmMounted: true,
+			getfsUsage: notEmpty,
+			reformat:   true,
+			expPlan: &ctlpb.StorageFormatPlan{
+				Engines: []*ctlpb.EngineFormatPlan{
+					formatPlan(0, true),
+					formatPlan(1, true),
+				},
+			},
+		},
+		"tmpfs mounted but empty": {
+			scmMounted: true,
+			expPlan: &ctlpb.StorageFormatPlan{
+				Engines: []*ctlpb.EngineFormatPlan{
+					formatPlan(0, false),
+					formatPlan(1, false),
+				},
+			},
+		},
+		"hugepages disabled": {
+			disableHPs: true,
+			expPlan: &ctlpb.StorageFormatPlan{
+				Engines: []*ctlpb.EngineFormatPlan{
+					skipPlan(0, true, msgNvmeFormatSkipHPD),
+					skipPlan(1, true, msgNvmeFormatSkipHPD),
+				},
+			},
+		},
+		"control metadata check fails": {
+			mdPath: mdPath,
+			mdErr:  errors.New("bad metadata"),
+			expErr: errors.New("bad metadata"),
+		},
+		"control metadata already formatted": {
+			mdPath:     mdPath,
+			scmMounted: true,
+			getfsUsage: notEmpty,
+			expPlan: &ctlpb.StorageFormatPlan{
+				Metadata: &ctlpb.MetadataFormatPlan{
+					RootPath: mdPath,
+				},
+				Engines: []*ctlpb.EngineFormatPlan{
+					skipPlan(0, false, msgNvmeFormatSkipNotDone),
+					skipPlan(1, false, msgNvmeFormatSkipNotDone),
+				},
+			},
+		},
+		"control metadata device needs format": {
+			mdPath:        mdPath,
+			mdDevice:      "/dev/sdb1",
+			mdNeedsFormat: true,
+			scmMounted:    true,
+			getfsUsage:    notEmpty,
+			expPlan: &ctlpb.StorageFormatPlan{
+				Metadata: &ctlpb.MetadataFormatPlan{
+					Format:   true,
+					RootPath: mdPath,
+					Device:   "/dev/sdb1",
+					Paths: []string{
+						mdPath,
+						mdDir,
+						filepath.Join(mdDir, "engine0"),
+						filepath.Join(mdDir, "engine1"),
+					},
+				},
+				Engines: []*ctlpb.EngineFormatPlan{
+					formatPlan(0, false),
+					formatPlan(1, false),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithDisableHugepages(tc.disableHPs)
+			if !tc.noEngines {
+				for i := 0; i < 2; i++ {
+					cfg.Engines = append(cfg.Engines, engine.MockConfig().
+						WithStorage(
+							storage.NewTierConfig().
+								WithStorageClass(storage.ClassRam.String()).
+								WithScmMountPoint(fmt.Sprintf("/mnt/daos%d", i)).
+								WithScmRamdiskSize(16),
+							storage.NewTierConfig().
+								WithStorageClass(storage.ClassNvme.String()).
+								WithBdevDeviceList(test.MockPCIAddr(int32(i+1))),
+						).
+						WithStorageConfigOutputPath(nvmeConf(i)).
+						WithStorageControlMetadataPath(tc.mdPath).
+						WithStorageControlMetadataDevice(tc.mdDevice))
+				}
+			}
+
+			sysProv := system.NewMockSysProvider(log, &system.MockSysConfig{
+				IsMountedBool:   tc.scmMounted,
+				GetfsUsageResps: tc.getfsUsage,
+			})
+			scmProv := scm.NewProvider(log, nil, sysProv, mount.NewProvider(log, sysProv))
+			if tc.memAvail == 0 {
+				tc.memAvail = 64 * humanize.GiByte
+			}
+
+			cs := &ControlService{
+				StorageControlService: StorageControlService{
+					log: log,
+					storage: storage.MockProvider(log, 0, &storage.Config{
+						ControlMetadata: storage.ControlMetadata{
+							Path:       tc.mdPath,
+							DevicePath: tc.mdDevice,
+						},
+					}, sysProv, scmProv, nil, &storage.MockMetadataProvider{
+						NeedsFormatRes: tc.mdNeedsFormat,
+						NeedsFormatErr: tc.mdErr,
+						FormatErr:      errors.New("format called during dry run"),
+					}),
+					getMemInfo: func() (*common.MemInfo, error) {
+						return &common.MemInfo{
+							MemAvailableKiB: int(tc.memAvail / humanize.KiByte),
+						}, nil
+					},
+				},
+				harness: &EngineHarness{log: log},
+				srvCfg:  cfg,
+			}
+			for _, ec := range cfg.Engines {
+				esp := storage.MockProvider(log, 0, &ec.Storage, sysProv, scmProv, nil, nil)
+				ei := NewEngineInstance(log, esp, nil, engine.NewTestRunner(nil, ec), nil)
+				if err := cs.harness.AddInstance(ei); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			resp, err := cs.StorageFormatPlan(test.Context(t), &ctlpb.StorageFormatPlanReq{
+				Reformat: tc.reformat,
+			})
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expPlan, resp.Plan, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected plan (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
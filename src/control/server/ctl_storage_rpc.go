@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -34,6 +35,7 @@ const (
 	msgNvmeFormatSkipHPD     = msgNvmeFormatSkip + ", use of hugepages disabled in config"
 	msgNvmeFormatSkipFail    = msgNvmeFormatSkip + ", SCM format failed"
 	msgNvmeFormatSkipNotDone = msgNvmeFormatSkip + ", SCM was not formatted"
+	msgScmAlreadyFormatted   = "SCM is already formatted"
 	// Storage size reserved for storing DAOS metadata stored on SCM device.
 	//
 	// NOTE This storage size value is larger than the minimal size observed (i.e. 36864B),
@@ -820,52 +822,72 @@ type formatScmReq struct {
 	getMemInfo func() (*common.MemInfo, error)
 }
 
-func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatResp) (map[int]string, map[int]bool, error) {
-	needFormat := make(map[int]bool)
-	emptyTmpfs := make(map[int]bool)
-	scmCfgs := make(map[int]*storage.TierConfig)
+// scmFormatCheck records which engine instances need SCM to be formatted.
+type scmFormatCheck struct {
+	needFormat map[int]bool
+	emptyTmpfs map[int]bool
+	scmCfgs    map[int]*storage.TierConfig
+}
+
+// checkScmFormat determines which instances need SCM to be formatted and verifies that enough
+// memory is available for any ramdisks that would be created.
+func checkScmFormat(req formatScmReq) (*scmFormatCheck, error) {
+	chk := &scmFormatCheck{
+		needFormat: make(map[int]bool),
+		emptyTmpfs: make(map[int]bool),
+		scmCfgs:    make(map[int]*storage.TierConfig),
+	}
 	allNeedFormat := true
 
 	for idx, ei := range req.instances {
 		needs, err := ei.GetStorage().ScmNeedsFormat()
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "detecting if SCM format is needed")
+			return nil, errors.Wrap(err, "detecting if SCM format is needed")
 		}
 		if !needs {
 			allNeedFormat = false
 		}
-		needFormat[idx] = needs
+		chk.needFormat[idx] = needs
 
 		scmCfg, err := ei.GetStorage().GetScmConfig()
 		if err != nil || scmCfg == nil {
-			return nil, nil, errors.Wrap(err, "retrieving SCM config")
+			return nil, errors.Wrap(err, "retrieving SCM config")
 		}
-		scmCfgs[idx] = scmCfg
+		chk.scmCfgs[idx] = scmCfg
 
 		// If the tmpfs was already mounted but empty, record that fact for later usage.
 		if scmCfg.Class == storage.ClassRam && !needs {
 			info, err := ei.GetStorage().GetScmUsage()
 			if err != nil {
-				return nil, nil, errors.Wrapf(err, "failed to check SCM usage for instance %d", idx)
+				return nil, errors.Wrapf(err, "failed to check SCM usage for instance %d", idx)
 			}
-			emptyTmpfs[idx] = info.TotalBytes-info.AvailBytes == 0
+			chk.emptyTmpfs[idx] = info.TotalBytes-info.AvailBytes == 0
 		}
 	}
 
 	if allNeedFormat {
 		// Check available RAM is sufficient before formatting SCM on engines.
-		if err := checkTmpfsMem(req.log, scmCfgs, req.getMemInfo); err != nil {
-			return nil, nil, err
+		if err := checkTmpfsMem(req.log, chk.scmCfgs, req.getMemInfo); err != nil {
+			return nil, err
 		}
 	}
 
+	return chk, nil
+}
+
+func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatResp) (map[int]string, map[int]bool, error) {
+	chk, err := checkScmFormat(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	scmChan := make(chan *ctlpb.ScmMountResult, len(req.instances))
 	errored := make(map[int]string)
 	skipped := make(map[int]bool)
 	formatting := 0
 
 	for idx, ei := range req.instances {
-		if needFormat[idx] || req.reformat {
+		if chk.needFormat[idx] || req.reformat {
 			formatting++
 			go func(e Engine) {
 				scmChan <- e.StorageFormatSCM(ctx, req.reformat)
@@ -876,9 +898,9 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 
 		resp.Mrets = append(resp.Mrets, &ctlpb.ScmMountResult{
 			Instanceidx: uint32(idx),
-			Mntpoint:    scmCfgs[idx].Scm.MountPoint,
+			Mntpoint:    chk.scmCfgs[idx].Scm.MountPoint,
 			State: &ctlpb.ResponseState{
-				Info: "SCM is already formatted",
+				Info: msgScmAlreadyFormatted,
 			},
 		})
 
@@ -888,7 +910,7 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 		// mountedness as a proxy for already-formatted. In the special
 		// case where tmpfs was already mounted but empty, we will treat it
 		// as an indication that the NVMe format needs to occur.
-		if !emptyTmpfs[idx] {
+		if !chk.emptyTmpfs[idx] {
 			skipped[idx] = true
 		}
 	}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -19,10 +20,11 @@ import (
 type ControlService struct {
 	ctlpb.UnimplementedCtlSvcServer
 	StorageControlService
-	harness *EngineHarness
-	srvCfg  *config.Server
-	events  *events.PubSub
-	fabric  *hardware.FabricScanner
+	harness    *EngineHarness
+	srvCfg     *config.Server
+	events     *events.PubSub
+	fabric     *hardware.FabricScanner
+	nvmeHealth *nvmeHealthHistory
 }
 
 // NewControlService returns ControlService to be used as gRPC control service
@@ -42,5 +44,6 @@ func NewControlService(log logging.Logger, h *EngineHarness,
 		srvCfg:                cfg,
 		events:                e,
 		fabric:                f,
+		nvmeHealth:            newNvmeHealthHistory(log, cfgGetNvmeHealthHistoryPath(cfg)),
 	}
 }
@@ -0,0 +1,91 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	eventHistoryQueueSize     = 1024
+	eventHistoryBatchSize     = 64
+	eventHistoryFlushInterval = 500 * time.Millisecond
+)
+
+type eventHistoryDB interface {
+	AddEvents([]*events.RASEvent) error
+}
+
+// eventHistoryRecorder implements the events.Handler interface and retains
+// published events in the MS event history. Events are queued and added in
+// batches from a separate goroutine so that a burst of events results in a
+// small number of raft updates and never blocks event dispatch.
+type eventHistoryRecorder struct {
+	log   logging.Logger
+	db    eventHistoryDB
+	queue chan *events.RASEvent
+}
+
+// newEventHistoryRecorder creates and starts a new event history recorder.
+// The recorder stops processing events when the supplied context is canceled.
+func newEventHistoryRecorder(ctx context.Context, log logging.Logger, db eventHistoryDB) *eventHistoryRecorder {
+	r := &eventHistoryRecorder{
+		log:   log,
+		db:    db,
+		queue: make(chan *events.RASEvent, eventHistoryQueueSize),
+	}
+	go r.run(ctx)
+
+	return r
+}
+
+// OnEvent implements the events.Handler interface.
+func (r *eventHistoryRecorder) OnEvent(_ context.Context, evt *events.RASEvent) {
+	select {
+	case r.queue <- evt:
+	default:
+		r.log.Noticef("event history queue is full; dropping %s event", evt.ID)
+	}
+}
+
+// run collects queued events into batches and adds them to the event history
+// when either the batch is full or the flush interval expires.
+func (r *eventHistoryRecorder) run(ctx context.Context) {
+	batch := make([]*events.RASEvent, 0, eventHistoryBatchSize)
+	ticker := time.NewTicker(eventHistoryFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case evt := <-r.queue:
+			batch = append(batch, evt)
+			if len(batch) < eventHistoryBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		if err := r.db.AddEvents(batch); err != nil {
+			if system.IsNotLeader(err) {
+				r.log.Debugf("dropping %d events from history: %s", len(batch), err)
+			} else {
+				r.log.Errorf("failed to add %d events to history: %s", len(batch), err)
+			}
+		}
+		batch = make([]*events.RASEvent, 0, eventHistoryBatchSize)
+	}
+}
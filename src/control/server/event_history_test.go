@@ -0,0 +1,102 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockEventHistoryDB struct {
+	sync.Mutex
+	block   chan struct{}
+	batches [][]*events.RASEvent
+}
+
+func (db *mockEventHistoryDB) AddEvents(evts []*events.RASEvent) error {
+	if db.block != nil {
+		<-db.block
+	}
+	db.Lock()
+	defer db.Unlock()
+	db.batches = append(db.batches, evts)
+	return nil
+}
+
+func (db *mockEventHistoryDB) count() (batches, evts int) {
+	db.Lock()
+	defer db.Unlock()
+	for _, b := range db.batches {
+		evts += len(b)
+	}
+	return len(db.batches), evts
+}
+
+func TestServer_eventHistoryRecorder(t *testing.T) {
+	mockEvt := func(i int) *events.RASEvent {
+		return events.NewGenericEvent(events.RASEngineDied, events.RASSeverityError,
+			fmt.Sprintf("evt%d", i), "")
+	}
+
+	t.Run("events are batched", func(t *testing.T) {
+		log, buf := logging.NewTestLogger(t.Name())
+		defer test.ShowBufferOnFailure(t, buf)
+
+		db := &mockEventHistoryDB{}
+		r := newEventHistoryRecorder(test.Context(t), log, db)
+
+		numEvts := eventHistoryBatchSize*2 + 1
+		for i := 0; i < numEvts; i++ {
+			r.OnEvent(test.Context(t), mockEvt(i))
+		}
+
+		deadline := time.Now().Add(10 * eventHistoryFlushInterval)
+		for {
+			batches, evts := db.count()
+			if evts == numEvts {
+				test.AssertEqual(t, 3, batches, "unexpected number of history updates")
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("only %d of %d events recorded", evts, numEvts)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+
+	t.Run("slow database does not block dispatch", func(t *testing.T) {
+		log, buf := logging.NewTestLogger(t.Name())
+		defer test.ShowBufferOnFailure(t, buf)
+
+		db := &mockEventHistoryDB{block: make(chan struct{})}
+		defer close(db.block)
+		r := newEventHistoryRecorder(test.Context(t), log, db)
+
+		done := make(chan struct{})
+		go func() {
+			for i := 0; i < eventHistoryQueueSize*2; i++ {
+				r.OnEvent(test.Context(t), mockEvt(i))
+			}
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("event dispatch blocked on event history")
+		}
+		test.AssertTrue(t, strings.Contains(buf.String(), "event history queue is full"),
+			"expected dropped events to be logged")
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 
@@ -131,6 +132,22 @@ func FaultPoolDuplicateLabel(dupe string) *fault.Fault {
 	)
 }
 
+func FaultPoolDestroyPending(poolID string, after time.Time) *fault.Fault {
+	return serverFault(
+		code.ServerPoolDestroyPending,
+		fmt.Sprintf("pool %s is pending destroy after %s", poolID, after.Format(time.RFC3339)),
+		"restore the pool with dmg pool undelete or remove it now with dmg pool destroy --immediate",
+	)
+}
+
+func FaultPoolResizeUnsupported(reason string) *fault.Fault {
+	return serverFault(
+		code.ServerPoolResizeUnsupported,
+		fmt.Sprintf("pool resize not supported: %s", reason),
+		"only growing the NVMe allocation of a pool is supported, use dmg pool extend to add ranks",
+	)
+}
+
 func FaultEngineNUMAImbalance(nodeMap map[int]int) *fault.Fault {
 	return serverFault(
 		code.ServerConfigEngineNUMAImbalance,
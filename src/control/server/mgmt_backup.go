@@ -0,0 +1,124 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+var errBackupNotConfigured = errors.New("MS database backups are not configured (mgmt_svc_backup)")
+
+func backupToProto(bd *raft.BackupDetails) *mgmtpb.SystemBackup {
+	pbBackup := &mgmtpb.SystemBackup{
+		Name:       bd.Name,
+		Path:       bd.Path,
+		Created:    bd.Created.UnixNano(),
+		MapVersion: uint32(bd.MapVersion),
+		Size:       bd.Size,
+		Compressed: bd.Compressed,
+		NumPools:   uint32(len(bd.Pools)),
+	}
+	if bd.Metadata != nil {
+		pbBackup.Index = bd.Metadata.Index
+		pbBackup.Term = bd.Metadata.Term
+	}
+	if bd.MemberRanks != nil {
+		pbBackup.NumMembers = uint32(bd.MemberRanks.Count())
+	}
+
+	return pbBackup
+}
+
+// createBackup writes a new MS database backup and then removes any backups
+// in excess of the configured retention count.
+func (svc *mgmtSvc) createBackup() (*raft.BackupDetails, error) {
+	if svc.backupCfg == nil {
+		return nil, errBackupNotConfigured
+	}
+
+	bd, err := svc.sysdb.Backup(svc.backupCfg.Dir, svc.backupCfg.Compress)
+	if err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("created MS database backup %s (index %d)", bd.Path, bd.Metadata.Index)
+
+	removed, err := raft.PruneBackups(svc.backupCfg.Dir, svc.backupCfg.Keep)
+	for _, name := range removed {
+		svc.log.Debugf("removed expired MS database backup %s", name)
+	}
+	if err != nil {
+		svc.log.Errorf("failed to prune MS database backups: %s", err)
+	}
+
+	return bd, nil
+}
+
+// backupLoop periodically creates MS database backups while this replica is
+// the leader.
+func (svc *mgmtSvc) backupLoop(parent context.Context) {
+	ticker := time.NewTicker(svc.backupCfg.Interval)
+	defer ticker.Stop()
+
+	svc.log.Debugf("starting backupLoop (interval %s)", svc.backupCfg.Interval)
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped backupLoop")
+			return
+		case <-ticker.C:
+			if _, err := svc.createBackup(); err != nil {
+				svc.log.Errorf("scheduled MS database backup failed: %s", err)
+			}
+		}
+	}
+}
+
+// SystemBackupCreate creates a MS database backup on the leader.
+func (svc *mgmtSvc) SystemBackupCreate(ctx context.Context, req *mgmtpb.SystemBackupCreateReq) (*mgmtpb.SystemBackupCreateResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	bd, err := svc.createBackup()
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SystemBackupCreateResp{Backup: backupToProto(bd)}, nil
+}
+
+// SystemBackupList lists the MS database backups available on the leader.
+func (svc *mgmtSvc) SystemBackupList(ctx context.Context, req *mgmtpb.SystemBackupListReq) (*mgmtpb.SystemBackupListResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	if svc.backupCfg == nil {
+		return nil, errBackupNotConfigured
+	}
+
+	backups, err := raft.ListBackups(svc.backupCfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemBackupListResp{
+		Dir:     svc.backupCfg.Dir,
+		Backups: make([]*mgmtpb.SystemBackup, 0, len(backups)),
+	}
+	for _, bd := range backups {
+		resp.Backups = append(resp.Backups, backupToProto(bd))
+	}
+
+	return resp, nil
+}
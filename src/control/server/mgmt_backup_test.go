@@ -0,0 +1,149 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+func TestServer_MgmtSvc_SystemBackupCreate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica    bool
+		noCfg         bool
+		keep          int
+		numBackups    int
+		req           *mgmtpb.SystemBackupCreateReq
+		expNumBackups int
+		expErr        error
+	}{
+		"bad system": {
+			req:    &mgmtpb.SystemBackupCreateReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemBackupCreateReq{},
+			expErr:     errors.New("replica"),
+		},
+		"not configured": {
+			noCfg:  true,
+			req:    &mgmtpb.SystemBackupCreateReq{},
+			expErr: errors.New("not configured"),
+		},
+		"success": {
+			req:           &mgmtpb.SystemBackupCreateReq{},
+			numBackups:    1,
+			expNumBackups: 1,
+		},
+		"retention enforced": {
+			req:           &mgmtpb.SystemBackupCreateReq{},
+			keep:          2,
+			numBackups:    3,
+			expNumBackups: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+			if !tc.noCfg {
+				svc.backupCfg = &config.MgmtSvcBackupConfig{
+					Dir:  filepath.Join(t.TempDir(), "backups"),
+					Keep: tc.keep,
+				}
+			}
+
+			numBackups := tc.numBackups
+			if numBackups == 0 {
+				numBackups = 1
+			}
+			for i := 0; i < numBackups; i++ {
+				resp, gotErr := svc.SystemBackupCreate(test.Context(t), tc.req)
+				test.CmpErr(t, tc.expErr, gotErr)
+				if tc.expErr != nil {
+					return
+				}
+				test.AssertEqual(t, filepath.Join(svc.backupCfg.Dir, resp.Backup.Name),
+					resp.Backup.Path, "unexpected backup path")
+				// Backup names have millisecond resolution.
+				time.Sleep(2 * time.Millisecond)
+			}
+
+			listResp, err := svc.SystemBackupList(test.Context(t), &mgmtpb.SystemBackupListReq{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, svc.backupCfg.Dir, listResp.Dir, "unexpected backup dir")
+			test.AssertEqual(t, tc.expNumBackups, len(listResp.Backups), "unexpected number of backups")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemBackupList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		noCfg      bool
+		req        *mgmtpb.SystemBackupListReq
+		expErr     error
+	}{
+		"bad system": {
+			req:    &mgmtpb.SystemBackupListReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemBackupListReq{},
+			expErr:     errors.New("replica"),
+		},
+		"not configured": {
+			noCfg:  true,
+			req:    &mgmtpb.SystemBackupListReq{},
+			expErr: errors.New("not configured"),
+		},
+		"no backups": {
+			req: &mgmtpb.SystemBackupListReq{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+			if !tc.noCfg {
+				svc.backupCfg = &config.MgmtSvcBackupConfig{
+					Dir: filepath.Join(t.TempDir(), "backups"),
+				}
+			}
+
+			resp, gotErr := svc.SystemBackupList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, 0, len(resp.Backups), "unexpected number of backups")
+		})
+	}
+}
@@ -0,0 +1,216 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// eventStreamBufSize is the number of events that may be queued for a stream
+// before further events are dropped for that stream.
+const eventStreamBufSize = 128
+
+// eventStreamer implements the events.Handler interface and fans out events
+// published on the MS leader to any connected event streams.
+type eventStreamer struct {
+	sync.RWMutex
+	log     logging.Logger
+	nextID  uint64
+	streams map[uint64]chan *events.RASEvent
+}
+
+func newEventStreamer(log logging.Logger) *eventStreamer {
+	return &eventStreamer{
+		log:     log,
+		streams: make(map[uint64]chan *events.RASEvent),
+	}
+}
+
+// OnEvent implements the events.Handler interface.
+func (es *eventStreamer) OnEvent(_ context.Context, evt *events.RASEvent) {
+	if evt == nil {
+		return
+	}
+
+	es.RLock()
+	defer es.RUnlock()
+
+	for id, ch := range es.streams {
+		select {
+		case ch <- evt:
+		default:
+			es.log.Noticef("RAS event stream %d is full; dropping %s event", id, evt.ID)
+		}
+	}
+}
+
+// subscribe registers a new stream and returns its identifier along with the
+// channel on which events will be delivered.
+func (es *eventStreamer) subscribe() (uint64, <-chan *events.RASEvent) {
+	es.Lock()
+	defer es.Unlock()
+
+	es.nextID++
+	ch := make(chan *events.RASEvent, eventStreamBufSize)
+	es.streams[es.nextID] = ch
+
+	return es.nextID, ch
+}
+
+// unsubscribe removes a stream, closing its channel if still open.
+func (es *eventStreamer) unsubscribe(id uint64) {
+	es.Lock()
+	defer es.Unlock()
+
+	if ch, found := es.streams[id]; found {
+		close(ch)
+		delete(es.streams, id)
+	}
+}
+
+// closeAll closes all registered streams, e.g. on loss of leadership.
+func (es *eventStreamer) closeAll() {
+	es.Lock()
+	defer es.Unlock()
+
+	for id, ch := range es.streams {
+		close(ch)
+		delete(es.streams, id)
+	}
+}
+
+// eventFilterFromProto converts a protobuf event filter to native format.
+func eventFilterFromProto(pbFilter *mgmtpb.RASEventFilter) (*events.Filter, error) {
+	filter := new(events.Filter)
+	if pbFilter == nil {
+		return filter, nil
+	}
+
+	for _, id := range pbFilter.GetIds() {
+		filter.IDs = append(filter.IDs, events.RASID(id))
+	}
+	for _, typ := range pbFilter.GetTypes() {
+		filter.Types = append(filter.Types, events.RASTypeID(typ))
+	}
+	for _, sev := range pbFilter.GetSeverities() {
+		filter.Severities = append(filter.Severities, events.RASSeverityID(sev))
+	}
+
+	if pbFilter.GetRanks() != "" {
+		ranks, err := ranklist.CreateRankSet(pbFilter.GetRanks())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid rank filter")
+		}
+		filter.Ranks = ranks
+	}
+	if pbFilter.GetHosts() != "" {
+		hosts, err := hostlist.CreateSet(pbFilter.GetHosts())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid host filter")
+		}
+		filter.Hosts = hosts
+	}
+
+	return filter, nil
+}
+
+// SystemEventsFollow streams RAS events matching the request filter to the
+// caller as they are published on the MS leader.
+func (svc *mgmtSvc) SystemEventsFollow(req *mgmtpb.SystemEventsFollowReq, stream mgmtpb.MgmtSvc_SystemEventsFollowServer) error {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return err
+	}
+
+	filter, err := eventFilterFromProto(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	id, evtCh := svc.evtStreamer.subscribe()
+	defer svc.evtStreamer.unsubscribe(id)
+	svc.log.Debugf("RAS event stream %d opened", id)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			svc.log.Debugf("RAS event stream %d closed: %s", id, stream.Context().Err())
+			return nil
+		case evt, ok := <-evtCh:
+			if !ok {
+				// Streams are closed on loss of leadership.
+				if err := svc.sysdb.CheckLeader(); err != nil {
+					return err
+				}
+				return errors.New("RAS event stream closed")
+			}
+			if !filter.Matches(evt) {
+				continue
+			}
+
+			pbEvt, err := evt.ToProto()
+			if err != nil {
+				return errors.Wrapf(err, "converting %s event", evt.ID)
+			}
+			if err := stream.Send(pbEvt); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SystemEventsList returns RAS events retained in the MS event history that
+// match the request filter and time range.
+func (svc *mgmtSvc) SystemEventsList(ctx context.Context, req *mgmtpb.SystemEventsListReq) (*mgmtpb.SystemEventsListResp, error) {
+	if err := svc.checkReplicaRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	filter, err := eventFilterFromProto(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	var since, until time.Time
+	if req.GetSince() != 0 {
+		since = time.Unix(0, req.GetSince())
+	}
+	if req.GetUntil() != 0 {
+		until = time.Unix(0, req.GetUntil())
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return nil, errors.New("until time is before since time")
+	}
+
+	evts, err := svc.sysdb.GetEvents(filter, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemEventsListResp{
+		Events: make([]*sharedpb.RASEvent, 0, len(evts)),
+	}
+	for _, evt := range evts {
+		pbEvt, err := evt.ToProto()
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting %s event", evt.ID)
+		}
+		resp.Events = append(resp.Events, pbEvt)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,326 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+type mockEventsFollowServer struct {
+	grpc.ServerStream
+	sync.Mutex
+	ctx  context.Context
+	sent []*sharedpb.RASEvent
+}
+
+func (m *mockEventsFollowServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockEventsFollowServer) Send(evt *sharedpb.RASEvent) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.sent = append(m.sent, evt)
+	return nil
+}
+
+func (m *mockEventsFollowServer) numSent() int {
+	m.Lock()
+	defer m.Unlock()
+
+	return len(m.sent)
+}
+
+func TestServer_eventFilterFromProto(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pbFilter  *mgmtpb.RASEventFilter
+		expFilter *events.Filter
+		expErr    error
+	}{
+		"nil filter": {
+			expFilter: &events.Filter{},
+		},
+		"all fields": {
+			pbFilter: &mgmtpb.RASEventFilter{
+				Ids:        []uint32{events.RASEngineDied.Uint32()},
+				Types:      []uint32{events.RASTypeStateChange.Uint32()},
+				Severities: []uint32{events.RASSeverityError.Uint32()},
+				Ranks:      "0-2",
+				Hosts:      "foo[1-2]",
+			},
+			expFilter: &events.Filter{
+				IDs:        []events.RASID{events.RASEngineDied},
+				Types:      []events.RASTypeID{events.RASTypeStateChange},
+				Severities: []events.RASSeverityID{events.RASSeverityError},
+				Ranks:      ranklist.MustCreateRankSet("0-2"),
+				Hosts:      hostlist.MustCreateSet("foo[1-2]"),
+			},
+		},
+		"bad ranks": {
+			pbFilter: &mgmtpb.RASEventFilter{Ranks: "a-b"},
+			expErr:   errors.New("invalid rank filter"),
+		},
+		"bad hosts": {
+			pbFilter: &mgmtpb.RASEventFilter{Hosts: "foo[1-"},
+			expErr:   errors.New("invalid host filter"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotFilter, gotErr := eventFilterFromProto(tc.pbFilter)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *ranklist.RankSet) bool {
+					return x.String() == y.String()
+				}),
+				cmp.Comparer(func(x, y *hostlist.HostSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expFilter, gotFilter, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected filter (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemEventsFollow(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemEventsFollowReq
+		evts       []*events.RASEvent
+		expIDs     []uint32
+		expErr     error
+	}{
+		"bad system": {
+			req:    &mgmtpb.SystemEventsFollowReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemEventsFollowReq{},
+			expErr:     errors.New("replica"),
+		},
+		"bad filter": {
+			req: &mgmtpb.SystemEventsFollowReq{
+				Filter: &mgmtpb.RASEventFilter{Ranks: "x"},
+			},
+			expErr: errors.New("invalid rank filter"),
+		},
+		"no filter": {
+			req: &mgmtpb.SystemEventsFollowReq{},
+			evts: []*events.RASEvent{
+				mockEvtEngineDied(t),
+				events.NewGenericEvent(events.RASSwimRankDead, events.RASSeverityError, "dead", ""),
+			},
+			expIDs: []uint32{
+				events.RASEngineDied.Uint32(),
+				events.RASSwimRankDead.Uint32(),
+			},
+		},
+		"filtered by id": {
+			req: &mgmtpb.SystemEventsFollowReq{
+				Filter: &mgmtpb.RASEventFilter{
+					Ids: []uint32{events.RASSwimRankDead.Uint32()},
+				},
+			},
+			evts: []*events.RASEvent{
+				mockEvtEngineDied(t),
+				events.NewGenericEvent(events.RASSwimRankDead, events.RASSeverityError, "dead", ""),
+			},
+			expIDs: []uint32{events.RASSwimRankDead.Uint32()},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+			stream := &mockEventsFollowServer{ctx: ctx}
+
+			errCh := make(chan error)
+			go func() {
+				errCh <- svc.SystemEventsFollow(tc.req, stream)
+			}()
+
+			if tc.expErr == nil {
+				// Wait for the stream to be registered before publishing.
+				for {
+					svc.evtStreamer.RLock()
+					n := len(svc.evtStreamer.streams)
+					svc.evtStreamer.RUnlock()
+					if n > 0 {
+						break
+					}
+					time.Sleep(time.Millisecond)
+				}
+				for _, evt := range tc.evts {
+					svc.evtStreamer.OnEvent(ctx, evt)
+				}
+				for stream.numSent() < len(tc.expIDs) {
+					time.Sleep(time.Millisecond)
+				}
+				cancel()
+			}
+
+			test.CmpErr(t, tc.expErr, <-errCh)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotIDs []uint32
+			for _, evt := range stream.sent {
+				gotIDs = append(gotIDs, evt.Id)
+			}
+			if diff := cmp.Diff(tc.expIDs, gotIDs); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_eventStreamer_closeAll(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	es := newEventStreamer(log)
+	id, ch := es.subscribe()
+	es.closeAll()
+
+	if _, ok := <-ch; ok {
+		t.Fatal("expected closed channel")
+	}
+
+	// Unsubscribing after close should be a no-op.
+	es.unsubscribe(id)
+	test.AssertEqual(t, 0, len(es.streams), "unexpected number of streams")
+}
+
+func TestServer_MgmtSvc_SystemEventsList(t *testing.T) {
+	baseTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockEvt := func(id events.RASID, rank uint32, offset time.Duration) *events.RASEvent {
+		evt := events.NewGenericEvent(id, events.RASSeverityError, "test", "")
+		evt.Rank = rank
+		evt.Timestamp = common.FormatTime(baseTime.Add(offset))
+		return evt
+	}
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemEventsListReq
+		expIDs     []uint32
+		expErr     error
+	}{
+		"bad system": {
+			req:    &mgmtpb.SystemEventsListReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemEventsListReq{},
+			expErr:     errors.New("replica"),
+		},
+		"bad filter": {
+			req: &mgmtpb.SystemEventsListReq{
+				Filter: &mgmtpb.RASEventFilter{Ranks: "x"},
+			},
+			expErr: errors.New("invalid rank filter"),
+		},
+		"bad time range": {
+			req: &mgmtpb.SystemEventsListReq{
+				Since: baseTime.Add(time.Hour).UnixNano(),
+				Until: baseTime.UnixNano(),
+			},
+			expErr: errors.New("before since"),
+		},
+		"all events": {
+			req: &mgmtpb.SystemEventsListReq{},
+			expIDs: []uint32{
+				events.RASEngineDied.Uint32(),
+				events.RASSwimRankDead.Uint32(),
+				events.RASEngineDied.Uint32(),
+			},
+		},
+		"filtered by rank": {
+			req: &mgmtpb.SystemEventsListReq{
+				Filter: &mgmtpb.RASEventFilter{Ranks: "1"},
+			},
+			expIDs: []uint32{
+				events.RASSwimRankDead.Uint32(),
+				events.RASEngineDied.Uint32(),
+			},
+		},
+		"filtered by time range": {
+			req: &mgmtpb.SystemEventsListReq{
+				Since: baseTime.Add(30 * time.Second).UnixNano(),
+				Until: baseTime.Add(90 * time.Second).UnixNano(),
+			},
+			expIDs: []uint32{events.RASSwimRankDead.Uint32()},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, evt := range []*events.RASEvent{
+				mockEvt(events.RASEngineDied, 0, 0),
+				mockEvt(events.RASSwimRankDead, 1, time.Minute),
+				mockEvt(events.RASEngineDied, 1, 2*time.Minute),
+			} {
+				if err := svc.sysdb.AddEvent(evt); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+
+			resp, gotErr := svc.SystemEventsList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotIDs []uint32
+			for _, evt := range resp.Events {
+				gotIDs = append(gotIDs, evt.Id)
+			}
+			if diff := cmp.Diff(tc.expIDs, gotIDs); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// maintenanceRanks resolves the hosts or fault domain in a maintenance request
+// to the set of member ranks.
+func (svc *mgmtSvc) maintenanceRanks(req *mgmtpb.SystemMaintenanceReq) (*ranklist.RankSet, error) {
+	switch {
+	case req.Hosts == "" && req.FaultDomain == "":
+		return nil, errors.New("no hosts or fault domain specified")
+	case req.Hosts != "" && req.FaultDomain != "":
+		return nil, errors.New("hosts and fault domain cannot both be specified")
+	case req.Hosts != "":
+		hitRanks, _, missHosts, err := svc.resolveRanks(req.Hosts, "")
+		if err != nil {
+			return nil, err
+		}
+		if missHosts.Count() > 0 {
+			return nil, errors.Errorf("invalid host(s): %s", missHosts.String())
+		}
+		return hitRanks, nil
+	}
+
+	fd, err := system.NewFaultDomainFromString(req.FaultDomain)
+	if err != nil {
+		return nil, err
+	}
+	if fd.Empty() {
+		return nil, errors.New("fault domain must not be the root domain")
+	}
+
+	members, err := svc.membership.Members(nil)
+	if err != nil {
+		return nil, err
+	}
+	ranks := ranklist.MustCreateRankSet("")
+	for _, m := range members {
+		if fd.IsAncestorOf(m.FaultDomain) {
+			ranks.Add(m.Rank)
+		}
+	}
+	if ranks.Count() == 0 {
+		return nil, errors.Errorf("no members found in fault domain %s", fd)
+	}
+
+	return ranks, nil
+}
+
+// SystemMaintenance places the members on the specified hosts or in the
+// specified fault domain in maintenance, or takes them out of it. Engine exits
+// on members in maintenance are not treated as failures and RAS events raised
+// by them are not delivered to external event sinks.
+func (svc *mgmtSvc) SystemMaintenance(ctx context.Context, req *mgmtpb.SystemMaintenanceReq) (*mgmtpb.SystemMaintenanceResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	ranks, err := svc.maintenanceRanks(req)
+	if err != nil {
+		return nil, err
+	}
+
+	action := "enter maintenance"
+	if req.Exit {
+		action = "exit maintenance"
+	}
+
+	resp := new(mgmtpb.SystemMaintenanceResp)
+	for _, r := range ranks.Ranks() {
+		m, err := svc.sysdb.FindMemberByRank(r)
+		if err != nil {
+			return nil, err
+		}
+		m.Maintenance = !req.Exit
+		if err := svc.sysdb.UpdateMember(m); err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, &sharedpb.RankResult{
+			Rank:   r.Uint32(),
+			Action: action,
+			State:  strings.ToLower(m.State.String()),
+			Addr:   m.Addr.String(),
+		})
+	}
+	svc.log.Noticef("ranks %s: %s", ranks, action)
+
+	return resp, nil
+}
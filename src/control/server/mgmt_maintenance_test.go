@@ -0,0 +1,136 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_SystemMaintenance(t *testing.T) {
+	mockMaintMember := func(r, a int32, s string, fd string, maint bool) *system.Member {
+		m := mockMember(t, r, a, s)
+		m.FaultDomain = system.MustCreateFaultDomainFromString(fd)
+		m.Maintenance = maint
+		return m
+	}
+	mockMaintResult := func(action string, r uint32, a int32, s string) *sharedpb.RankResult {
+		return &sharedpb.RankResult{
+			Rank:   r,
+			Action: action,
+			State:  s,
+			Addr:   test.MockHostAddr(a).String(),
+		}
+	}
+	defMembers := func(maint bool) system.Members {
+		return system.Members{
+			mockMaintMember(0, 1, "joined", "/rack0/host1", maint),
+			mockMaintMember(1, 1, "stopped", "/rack0/host1", maint),
+			mockMaintMember(2, 2, "joined", "/rack1/host2", false),
+			mockMaintMember(3, 2, "joined", "/rack1/host2", false),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.SystemMaintenanceReq
+		members    system.Members
+		expMembers system.Members
+		expResults []*sharedpb.RankResult
+		expErr     error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.SystemMaintenanceReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"not system leader": {
+			req:    &mgmtpb.SystemMaintenanceReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no hosts or fault domain": {
+			req:    &mgmtpb.SystemMaintenanceReq{},
+			expErr: errors.New("no hosts or fault domain"),
+		},
+		"hosts and fault domain": {
+			req: &mgmtpb.SystemMaintenanceReq{
+				Hosts:       test.MockHostAddr(1).String(),
+				FaultDomain: "/rack0",
+			},
+			expErr: errors.New("cannot both be specified"),
+		},
+		"invalid hosts": {
+			req:    &mgmtpb.SystemMaintenanceReq{Hosts: "host-[1-2]"},
+			expErr: errors.New("invalid host(s)"),
+		},
+		"root fault domain": {
+			req:    &mgmtpb.SystemMaintenanceReq{FaultDomain: "/"},
+			expErr: errors.New("root domain"),
+		},
+		"unknown fault domain": {
+			req:    &mgmtpb.SystemMaintenanceReq{FaultDomain: "/rack2"},
+			expErr: errors.New("no members found"),
+		},
+		"enter by host": {
+			req: &mgmtpb.SystemMaintenanceReq{Hosts: test.MockHostAddr(1).String()},
+			expResults: []*sharedpb.RankResult{
+				mockMaintResult("enter maintenance", 0, 1, "joined"),
+				mockMaintResult("enter maintenance", 1, 1, "stopped"),
+			},
+			expMembers: defMembers(true),
+		},
+		"enter by fault domain": {
+			req: &mgmtpb.SystemMaintenanceReq{FaultDomain: "/rack0"},
+			expResults: []*sharedpb.RankResult{
+				mockMaintResult("enter maintenance", 0, 1, "joined"),
+				mockMaintResult("enter maintenance", 1, 1, "stopped"),
+			},
+			expMembers: defMembers(true),
+		},
+		"exit by host": {
+			req: &mgmtpb.SystemMaintenanceReq{
+				Hosts: test.MockHostAddr(1).String(),
+				Exit:  true,
+			},
+			members: defMembers(true),
+			expResults: []*sharedpb.RankResult{
+				mockMaintResult("exit maintenance", 0, 1, "joined"),
+				mockMaintResult("exit maintenance", 1, 1, "stopped"),
+			},
+			expMembers: defMembers(false),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.members == nil {
+				tc.members = defMembers(false)
+			}
+			svc := mgmtSystemTestSetup(t, log, tc.members, nil)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemMaintenance(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			checkRankResults(t, tc.expResults, gotResp.Results)
+			checkMembers(t, tc.expMembers, svc.membership)
+		})
+	}
+}
@@ -8,6 +8,7 @@
 package server
 
 import (
+	"fmt"
 	"math/rand"
 	"sort"
 	"time"
@@ -117,6 +118,9 @@ func (svc *mgmtSvc) getPoolService(id string) (*system.PoolService, error) {
 		return nil, err
 	}
 
+	if ps.State == system.PoolServiceStateDestroyPending {
+		return nil, FaultPoolDestroyPending(id, ps.DestroyAfter)
+	}
 	if ps.State != system.PoolServiceStateReady {
 		return nil, daos.TryAgain
 	}
@@ -162,6 +166,38 @@ func minPoolNvme(tgtCount, rankCount uint64) uint64 {
 	return minRankNvme(tgtCount) * rankCount
 }
 
+// checkPoolTierBytes verifies that the per-rank SCM/NVMe allocations of a pool can be served by
+// the storage of the given engine and are greater than the per-target minimums.
+func checkPoolTierBytes(ei Engine, tierBytes []uint64, ranks uint64) error {
+	if len(tierBytes) != 2 {
+		return errors.Errorf("unexpected number of pool storage tiers: %d", len(tierBytes))
+	}
+
+	// If no NVMe, refuse request as NVMe has been incorrectly requested.
+	if !ei.GetStorage().HasBlockDevices() && tierBytes[1] > 0 {
+		return errors.Errorf("%s NVMe requested for pool but config has zero bdevs",
+			humanize.IBytes(tierBytes[1]))
+	}
+
+	// Sanity check tier bytes are greater than the minimums.
+	tgts := uint64(ei.GetTargetCount())
+	if tgts == 0 {
+		return errors.New("zero target count")
+	}
+	minPoolTotal := minPoolScm(tgts, ranks)
+	if tierBytes[1] > 0 {
+		minPoolTotal += minPoolNvme(tgts, ranks)
+	}
+	if tierBytes[0] < minRankScm(tgts) {
+		return FaultPoolScmTooSmall(minPoolTotal, minPoolScm(tgts, ranks))
+	}
+	if tierBytes[1] != 0 && tierBytes[1] < minRankNvme(tgts) {
+		return FaultPoolNvmeTooSmall(minPoolTotal, minPoolNvme(tgts, ranks))
+	}
+
+	return nil
+}
+
 // calculateCreateStorage determines the amount of SCM/NVMe storage to allocate per engine in order
 // to fulfill the create request, if those values are not already supplied as part of the request.
 func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
@@ -195,14 +231,8 @@ func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
 	// request parameter combinations.
 
 	switch {
-	// Pool tier sizes already specified in request.
+	// Pool tier sizes already specified in request, checked below.
 	case len(req.TierBytes) == 2 && len(req.TierRatio) == 0 && req.TotalBytes == 0:
-		// If no NVMe, refuse request as NVMe has been incorrectly requested.
-		nvmeBytes := req.TierBytes[1]
-		if nvmeMissing && nvmeBytes > 0 {
-			return errors.Errorf("%s NVMe requested for pool but config has zero bdevs",
-				humanize.IBytes(nvmeBytes))
-		}
 
 	// Pool tier sizes to be populated based on total-size and ratio.
 	case len(req.TierBytes) == 0 && len(req.TierRatio) == 2 && req.TotalBytes > 0:
@@ -226,20 +256,8 @@ func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
 		return errors.Errorf("unexpected pool create params in request: %+v", req)
 	}
 
-	// Sanity check tier bytes are greater than the minimums.
-	tgts, ranks := uint64(instances[0].GetTargetCount()), uint64(len(req.GetRanks()))
-	if tgts == 0 {
-		return errors.New("zero target count")
-	}
-	minPoolTotal := minPoolScm(tgts, ranks)
-	if req.TierBytes[1] > 0 {
-		minPoolTotal += minPoolNvme(tgts, ranks)
-	}
-	if req.TierBytes[0] < minRankScm(tgts) {
-		return FaultPoolScmTooSmall(minPoolTotal, minPoolScm(tgts, ranks))
-	}
-	if req.TierBytes[1] != 0 && req.TierBytes[1] < minRankNvme(tgts) {
-		return FaultPoolNvmeTooSmall(minPoolTotal, minPoolNvme(tgts, ranks))
+	if err := checkPoolTierBytes(instances[0], req.TierBytes, uint64(len(req.GetRanks()))); err != nil {
+		return err
 	}
 
 	// Zero no longer required request fields.
@@ -346,6 +364,10 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, err
 	}
 
+	if len(req.GetRanks()) > 0 && hasPlacementConstraints(req) {
+		return nil, errors.New("pool rank list cannot be combined with fault domain constraints")
+	}
+
 	if len(req.GetRanks()) > 0 {
 		// If the request supplies a specific rank list, use it. Note that
 		// the rank list may include downed ranks, in which case the create
@@ -359,6 +381,15 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		}
 
 		req.Ranks = ranklist.RanksToUint32(reqRanks)
+	} else if hasPlacementConstraints(req) {
+		// Select ranks from the available ranks that satisfy the
+		// requested fault domain placement constraints.
+		placeRanks, err := svc.poolPlacementRanks(req, allRanks)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Ranks = ranklist.RanksToUint32(ranklist.RankSetFromRanks(placeRanks).Ranks())
 	} else {
 		// Otherwise, create the pool across the requested number of
 		// available ranks in the system (if the request does not
@@ -420,7 +451,17 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 	ps = system.NewPoolService(poolUUID, req.TierBytes, req.MemRatio,
 		ranklist.RanksFromUint32(req.GetRanks()))
 	ps.PoolLabel = poolLabel
-	if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
+	ps.OwnerUser = req.GetUser()
+	ps.OwnerGroup = req.GetUserGroup()
+	ps.CreationTime = time.Now()
+
+	// The pool service entry reserves the pool's storage against the
+	// owner's quotas until the pool is destroyed.
+	var reqUsage system.TenantUsage
+	reqUsage.Add(ps)
+	if err := svc.reservePoolQuotas(ps.OwnerUser, ps.OwnerGroup, reqUsage, func() error {
+		return svc.sysdb.AddPoolService(ctx, ps)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -570,6 +611,11 @@ func (svc *mgmtSvc) checkPools(parent context.Context, ignCreating bool, psList
 		if ps.State == system.PoolServiceStateReady {
 			continue
 		}
+		// Pools pending destroy are removed by the pool reaper once
+		// their grace period expires.
+		if ps.State == system.PoolServiceStateDestroyPending {
+			continue
+		}
 		if ignCreating && ps.State == system.PoolServiceStateCreating {
 			svc.log.Noticef("pool %s in %s state but cleanup skipped due to ignore", ps.PoolUUID, ps.State)
 			continue
@@ -691,6 +737,19 @@ func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb
 
 	resp := &mgmtpb.PoolDestroyResp{}
 
+	if ps.State == system.PoolServiceStateDestroyPending {
+		if !req.Immediate {
+			return nil, FaultPoolDestroyPending(req.Id, ps.DestroyAfter)
+		}
+
+		// Connections were evicted when the pool entered the pending
+		// state, so skip straight to the destroy.
+		ps.State = system.PoolServiceStateDestroying
+		if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+			return nil, errors.Wrapf(err, "failed to update pool %s", poolUUID)
+		}
+	}
+
 	if ps.State != system.PoolServiceStateDestroying {
 		// If recursive flag is unset, refuse to destroy pool if resident containers exist.
 		if !req.Recursive {
@@ -710,6 +769,14 @@ func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb
 			}
 		}
 
+		grace, err := svc.poolDestroyGracePeriod()
+		if err != nil {
+			return nil, err
+		}
+		if grace > 0 && !req.Immediate && ps.State == system.PoolServiceStateReady {
+			return svc.poolSoftDelete(ctx, req, ps, grace)
+		}
+
 		// Perform separate PoolEvict _before_ possible transition to destroying state.
 		evStatus, err := svc.poolEvictConnections(ctx, req)
 		if !req.Force && err != nil {
@@ -874,30 +941,219 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 	}
 	req.FaultDomains = fdTree
 
-	// Look up the pool service record to find the storage allocations
-	// used at creation.
 	ps, err := svc.getPoolService(req.GetId())
 	if err != nil {
 		return nil, err
 	}
+	lock, err := svc.sysdb.TakePoolLock(ctx, ps.PoolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx = lock.InContext(ctx)
+
+	// Look up the pool service record again now that the pool is locked
+	// to find the storage allocations used at creation.
+	ps, err = svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
 	req.TierBytes = ps.Storage.PerRankTierStorage
 	req.MemRatio = ps.Storage.MemRatio
 
+	// Only ranks not already in the pool add to the tenant's usage. The
+	// additional storage is reserved against the owner's quotas before the
+	// pool is extended and released again if the extend fails.
+	curRanks := ps.Storage.CurrentRanks()
+	newRanks := ranklist.RankSetFromRanks(curRanks)
+	newRanks.Merge(ranklist.RankSetFromRanks(ranklist.RanksFromUint32(req.GetRanks())))
+	nAdded := uint64(newRanks.Count() - len(curRanks))
+	if nAdded > 0 {
+		var extUsage system.TenantUsage
+		for i, tierBytes := range ps.Storage.PerRankTierStorage {
+			if i == 0 {
+				extUsage.ScmBytes += nAdded * tierBytes
+			} else {
+				extUsage.NvmeBytes += nAdded * tierBytes
+			}
+		}
+		if err := svc.reservePoolQuotas(ps.OwnerUser, ps.OwnerGroup, extUsage, func() error {
+			ps.Storage.SetCurrentRanks(newRanks.Ranks())
+			return svc.sysdb.UpdatePoolService(ctx, ps)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	svc.log.Debugf("MgmtSvc.PoolExtend forwarding modified req:%+v\n", req)
 
-	dResp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolExtend, req)
+	resp := &mgmtpb.PoolExtendResp{}
+	dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolExtend, req)
+	if err == nil {
+		err = svc.unmarshalPB(dResp.Body, resp)
+	}
+
+	if nAdded > 0 && (err != nil || resp.GetStatus() != 0) {
+		ps.Storage.SetCurrentRanks(curRanks)
+		if updErr := svc.sysdb.UpdatePoolService(ctx, ps); updErr != nil {
+			svc.log.Errorf("failed to release quota reservation for pool %s: %s", ps.PoolUUID, updErr)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &mgmtpb.PoolExtendResp{}
-	if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
+	return resp, nil
+}
+
+// calculateResizeStorage determines the new per-rank SCM/NVMe allocation of a pool from the resize
+// request and the current allocation. Only growing the NVMe allocation is supported.
+func (svc *mgmtSvc) calculateResizeStorage(req *mgmtpb.PoolResizeReq, ps *system.PoolService) ([]uint64, error) {
+	instances := svc.harness.Instances()
+	if len(instances) < 1 {
+		return nil, errors.New("harness has no managed instances")
+	}
+	ranks := uint64(len(ps.Storage.CurrentRanks()))
+	if ranks == 0 {
+		return nil, errors.New("zero ranks in calculateResizeStorage()")
+	}
+
+	curTiers := make([]uint64, 2)
+	copy(curTiers, ps.Storage.PerRankTierStorage)
+	newTiers := make([]uint64, 2)
+	copy(newTiers, curTiers)
+
+	switch {
+	// Per-rank tier sizes specified in request, zero keeps the current size.
+	case len(req.TierBytes) > 0 && len(req.TierBytes) <= 2 && req.TotalBytes == 0:
+		for tierIdx, tierBytes := range req.TierBytes {
+			if tierBytes != 0 {
+				newTiers[tierIdx] = tierBytes
+			}
+		}
+
+	// Total pool size specified in request, the SCM allocation is retained and the remainder
+	// of each rank's share is allocated to NVMe.
+	case len(req.TierBytes) == 0 && req.TotalBytes > 0:
+		rankBytes := req.TotalBytes / ranks
+		if rankBytes <= curTiers[0] {
+			return nil, FaultPoolResizeUnsupported(fmt.Sprintf("requested size %s is not "+
+				"larger than the current SCM allocation of %s",
+				humanize.IBytes(req.TotalBytes), humanize.IBytes(curTiers[0]*ranks)))
+		}
+		newTiers[1] = rankBytes - curTiers[0]
+		svc.log.Infof("%s = (%s / %d) - %s", humanize.IBytes(newTiers[1]),
+			humanize.IBytes(req.TotalBytes), ranks, humanize.IBytes(curTiers[0]))
+
+	default:
+		return nil, errors.Errorf("unexpected pool resize params in request: %+v", req)
+	}
+
+	switch {
+	case newTiers[0] != curTiers[0]:
+		return nil, FaultPoolResizeUnsupported("the SCM allocation of a pool can't be changed")
+	case curTiers[1] == 0 && newTiers[1] > 0:
+		return nil, FaultPoolResizeUnsupported("NVMe can't be added to a pool created without it")
+	case newTiers[1] < curTiers[1]:
+		return nil, FaultPoolResizeUnsupported(fmt.Sprintf("NVMe allocation can't shrink "+
+			"from %s to %s per rank", humanize.IBytes(curTiers[1]),
+			humanize.IBytes(newTiers[1])))
+	}
+
+	if err := checkPoolTierBytes(instances[0], newTiers, ranks); err != nil {
+		return nil, err
+	}
+
+	return newTiers, nil
+}
+
+// PoolResize implements the method defined for the Management Service.
+func (svc *mgmtSvc) PoolResize(ctx context.Context, req *mgmtpb.PoolResizeReq) (*mgmtpb.PoolResizeResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	ps, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	lock, err := svc.sysdb.TakePoolLock(ctx, ps.PoolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx = lock.InContext(ctx)
+
+	// Look up the pool service record again now that the pool is locked
+	// to find the current storage allocations.
+	ps, err = svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	curTiers := ps.Storage.PerRankTierStorage
+	newTiers, err := svc.calculateResizeStorage(req, ps)
+	if err != nil {
+		return nil, err
+	}
+	curRanks := ps.Storage.CurrentRanks()
+	req.TierBytes = newTiers
+	req.TotalBytes = 0
+	req.Ranks = ranklist.RanksToUint32(curRanks)
+
+	// The additional storage is checked against the owner's quotas and the
+	// quota lock is held until the resize has completed so that concurrent
+	// requests can't claim the same quota. The new allocation is only
+	// recorded once the pool has been resized on all ranks. Resizing is
+	// idempotent on the engines so a partial resize can be completed by
+	// retrying the same request.
+	var curNvme uint64
+	if len(curTiers) > 1 {
+		curNvme = curTiers[1]
+	}
+	var extUsage system.TenantUsage
+	extUsage.NvmeBytes = uint64(len(curRanks)) * (newTiers[1] - curNvme)
+
+	svc.log.Debugf("MgmtSvc.PoolResize forwarding modified req:%+v\n", req)
+
+	resp := &mgmtpb.PoolResizeResp{}
+	if err := svc.reservePoolQuotas(ps.OwnerUser, ps.OwnerGroup, extUsage, func() error {
+		dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolResize, req)
+		if err != nil {
+			return err
+		}
+		if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
+			return err
+		}
+
+		if !poolResized(resp, len(curRanks)) {
+			svc.log.Errorf("pool %s not resized on all ranks, allocation remains %v",
+				ps.PoolUUID, curTiers)
+			return nil
+		}
+		ps.Storage.PerRankTierStorage = newTiers
+		return svc.sysdb.UpdatePoolService(ctx, ps)
+	}); err != nil {
 		return nil, err
 	}
 
 	return resp, nil
 }
 
+// poolResized returns true if the resize response reports success on each of the pool's ranks.
+func poolResized(resp *mgmtpb.PoolResizeResp, nrRanks int) bool {
+	if resp.GetStatus() != 0 || len(resp.GetRankResults()) != nrRanks {
+		return false
+	}
+	for _, rr := range resp.GetRankResults() {
+		if rr.GetStatus() != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // PoolReintegrate implements the method defined for the Management Service.
 func (svc *mgmtSvc) PoolReintegrate(ctx context.Context, req *mgmtpb.PoolReintReq) (*mgmtpb.PoolReintResp, error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
@@ -1237,12 +1493,16 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 
 	resp := new(mgmtpb.ListPoolsResp)
 	for _, ps := range psList {
-		resp.Pools = append(resp.Pools, &mgmtpb.ListPoolsResp_Pool{
+		pool := &mgmtpb.ListPoolsResp_Pool{
 			Uuid:    ps.PoolUUID.String(),
 			Label:   ps.PoolLabel,
 			SvcReps: ranklist.RanksToUint32(ps.Replicas),
 			State:   ps.State.String(),
-		})
+		}
+		if !ps.CreationTime.IsZero() {
+			pool.CreationTime = ps.CreationTime.Unix()
+		}
+		resp.Pools = append(resp.Pools, pool)
 	}
 
 	v, err := svc.sysdb.DataVersion()
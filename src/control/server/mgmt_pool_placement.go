@@ -0,0 +1,172 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// hasPlacementConstraints returns true if the pool create request specifies
+// any fault domain placement constraints.
+func hasPlacementConstraints(req *mgmtpb.PoolCreateReq) bool {
+	return len(req.GetDomains()) > 0 || req.GetSpreadAcross() != "" || req.GetMinDomains() > 0
+}
+
+// spreadLevel resolves the fault domain level to spread pool ranks across. The
+// level may be specified either by label (e.g. "rack") or by its 1-based index
+// below the root (e.g. "1" for the top level).
+func spreadLevel(spread string, members []*system.Member) (int, error) {
+	maxLevels := 0
+	for _, m := range members {
+		if n := m.FaultDomain.NumLevels(); maxLevels == 0 || n < maxLevels {
+			maxLevels = n
+		}
+	}
+
+	if lvl, err := strconv.Atoi(spread); err == nil {
+		if lvl < 1 || lvl > maxLevels {
+			return 0, errors.Errorf("fault domain level %d out of range (1-%d)", lvl, maxLevels)
+		}
+		return lvl, nil
+	}
+
+	for _, m := range members {
+		if !m.FaultDomain.HasLabels() {
+			continue
+		}
+		for i, label := range m.FaultDomain.Labels {
+			if label == spread && i < maxLevels {
+				return i + 1, nil
+			}
+		}
+	}
+
+	return 0, errors.Errorf("unknown fault domain level %q", spread)
+}
+
+// poolPlacementRanks selects the target ranks for a pool create request from
+// the set of available ranks, honoring the fault domain placement constraints
+// in the request. Ranks are first restricted to those in the requested domains,
+// and then, if a spread level is requested, chosen round-robin across the
+// distinct domains at that level.
+func (svc *mgmtSvc) poolPlacementRanks(req *mgmtpb.PoolCreateReq, availRanks []ranklist.Rank) ([]ranklist.Rank, error) {
+	var domains []*system.FaultDomain
+	if len(req.GetDomains()) > 0 {
+		tree := svc.sysdb.FaultDomainTree()
+		for _, dStr := range req.GetDomains() {
+			fd, err := system.NewFaultDomainFromString(dStr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid fault domain %q", dStr)
+			}
+			if _, err := tree.Subtree(fd); err != nil {
+				return nil, errors.Errorf("fault domain %s not found in system", fd)
+			}
+			domains = append(domains, fd)
+		}
+	}
+
+	members := make([]*system.Member, 0, len(availRanks))
+	for _, r := range availRanks {
+		m, err := svc.sysdb.FindMemberByRank(r)
+		if err != nil {
+			return nil, err
+		}
+
+		inDomains := len(domains) == 0
+		for _, fd := range domains {
+			if fd.IsAncestorOf(m.FaultDomain) {
+				inDomains = true
+				break
+			}
+		}
+		if inDomains {
+			members = append(members, m)
+		}
+	}
+	if len(members) == 0 {
+		return nil, errors.Errorf("no available ranks in fault domains %s",
+			strings.Join(req.GetDomains(), ","))
+	}
+
+	nRanks := len(members)
+	if req.GetNumRanks() > 0 {
+		nRanks = int(req.GetNumRanks())
+		if nRanks > len(members) {
+			return nil, FaultPoolInvalidNumRanks(nRanks, len(members))
+		}
+	}
+
+	rand.Shuffle(len(members), func(i, j int) {
+		members[i], members[j] = members[j], members[i]
+	})
+
+	spread := req.GetSpreadAcross()
+	if spread == "" {
+		if req.GetMinDomains() > 0 {
+			return nil, errors.New("minimum number of domains requires a spread level")
+		}
+
+		ranks := make([]ranklist.Rank, nRanks)
+		for i := range ranks {
+			ranks[i] = members[i].Rank
+		}
+		return ranks, nil
+	}
+
+	level, err := spreadLevel(spread, members)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group the candidate members by their domain at the spread level.
+	groups := make(map[string][]*system.Member)
+	for _, m := range members {
+		key := system.MustCreateFaultDomain(m.FaultDomain.Domains[:level]...).String()
+		groups[key] = append(groups[key], m)
+	}
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	rand.Shuffle(len(keys), func(i, j int) {
+		keys[i], keys[j] = keys[j], keys[i]
+	})
+
+	minDomains := int(req.GetMinDomains())
+	if minDomains > len(keys) {
+		return nil, errors.Errorf("pool requires %d distinct %s domains but only %d are available",
+			minDomains, spread, len(keys))
+	}
+	if minDomains > nRanks {
+		return nil, errors.Errorf("pool cannot span %d distinct %s domains with %d ranks",
+			minDomains, spread, nRanks)
+	}
+
+	ranks := make([]ranklist.Rank, 0, nRanks)
+	for len(ranks) < nRanks {
+		for _, key := range keys {
+			if len(ranks) == nRanks {
+				break
+			}
+			if len(groups[key]) == 0 {
+				continue
+			}
+			ranks = append(ranks, groups[key][0].Rank)
+			groups[key] = groups[key][1:]
+		}
+	}
+
+	return ranks, nil
+}
@@ -0,0 +1,152 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_poolPlacementRanks(t *testing.T) {
+	mockFDMember := func(r, a int32, s string, fd string) *system.Member {
+		m := mockMember(t, r, a, s)
+		m.FaultDomain = system.MustCreateFaultDomainFromString(fd)
+		return m
+	}
+	members := system.Members{
+		mockFDMember(0, 1, "joined", "/rack=r0/host=h1"),
+		mockFDMember(1, 1, "joined", "/rack=r0/host=h1"),
+		mockFDMember(2, 2, "joined", "/rack=r1/host=h2"),
+		mockFDMember(3, 2, "joined", "/rack=r1/host=h2"),
+		mockFDMember(4, 3, "joined", "/rack=r2/host=h3"),
+		mockFDMember(5, 3, "stopped", "/rack=r2/host=h3"),
+	}
+
+	for name, tc := range map[string]struct {
+		req           *mgmtpb.PoolCreateReq
+		expRanks      []ranklist.Rank // exact set of ranks, if deterministic
+		expNumRanks   int
+		expNumDomains int // distinct racks spanned
+		expErr        error
+	}{
+		"unknown domain": {
+			req:    &mgmtpb.PoolCreateReq{Domains: []string{"/r3"}},
+			expErr: errors.New("not found"),
+		},
+		"invalid domain": {
+			req:    &mgmtpb.PoolCreateReq{Domains: []string{"r0"}},
+			expErr: errors.New("invalid fault domain"),
+		},
+		"all ranks in domain": {
+			req:      &mgmtpb.PoolCreateReq{Domains: []string{"/r0"}},
+			expRanks: []ranklist.Rank{0, 1},
+		},
+		"available ranks in multiple domains": {
+			req:      &mgmtpb.PoolCreateReq{Domains: []string{"/r1", "/r2/h3"}},
+			expRanks: []ranklist.Rank{2, 3, 4},
+		},
+		"too many ranks for domain": {
+			req:    &mgmtpb.PoolCreateReq{Domains: []string{"/r0"}, NumRanks: 3},
+			expErr: FaultPoolInvalidNumRanks(3, 2),
+		},
+		"spread by label": {
+			req:           &mgmtpb.PoolCreateReq{SpreadAcross: "rack", NumRanks: 3},
+			expNumRanks:   3,
+			expNumDomains: 3,
+		},
+		"spread by level number": {
+			req:           &mgmtpb.PoolCreateReq{SpreadAcross: "1", NumRanks: 2},
+			expNumRanks:   2,
+			expNumDomains: 2,
+		},
+		"spread within domains": {
+			req: &mgmtpb.PoolCreateReq{
+				Domains:      []string{"/r0", "/r1"},
+				SpreadAcross: "rack",
+				NumRanks:     2,
+				MinDomains:   2,
+			},
+			expNumRanks:   2,
+			expNumDomains: 2,
+		},
+		"unknown spread level": {
+			req:    &mgmtpb.PoolCreateReq{SpreadAcross: "row"},
+			expErr: errors.New("unknown fault domain level"),
+		},
+		"spread level out of range": {
+			req:    &mgmtpb.PoolCreateReq{SpreadAcross: "3"},
+			expErr: errors.New("out of range"),
+		},
+		"not enough domains": {
+			req: &mgmtpb.PoolCreateReq{
+				Domains:      []string{"/r0", "/r1"},
+				SpreadAcross: "rack",
+				MinDomains:   3,
+			},
+			expErr: errors.New("only 2 are available"),
+		},
+		"not enough ranks for domains": {
+			req: &mgmtpb.PoolCreateReq{
+				SpreadAcross: "rack",
+				NumRanks:     2,
+				MinDomains:   3,
+			},
+			expErr: errors.New("with 2 ranks"),
+		},
+		"min domains without spread": {
+			req:    &mgmtpb.PoolCreateReq{MinDomains: 2},
+			expErr: errors.New("requires a spread level"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, members, nil)
+			availRanks, err := svc.sysdb.MemberRanks(system.AvailableMemberFilter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotRanks, gotErr := svc.poolPlacementRanks(tc.req, availRanks)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotRanks = ranklist.RankSetFromRanks(gotRanks).Ranks()
+			if tc.expRanks != nil {
+				if diff := cmp.Diff(tc.expRanks, gotRanks); diff != "" {
+					t.Fatalf("unexpected ranks (-want, +got):\n%s\n", diff)
+				}
+				return
+			}
+
+			test.AssertEqual(t, tc.expNumRanks, len(gotRanks), "unexpected number of ranks")
+			racks := make(map[string]struct{})
+			for _, r := range gotRanks {
+				m, err := svc.sysdb.FindMemberByRank(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if m.Rank == 5 {
+					t.Fatal("unavailable rank selected")
+				}
+				racks[m.FaultDomain.TopLevel()] = struct{}{}
+			}
+			test.AssertEqual(t, tc.expNumDomains, len(racks), "unexpected number of domains spanned")
+		})
+	}
+}
@@ -0,0 +1,57 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// unixOrZero returns the Unix time in seconds of the supplied time, or zero
+// if the time is unset.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// PoolRebuildHistory returns the rebuild history of a pool, as recorded from
+// the pool rebuild RAS events received by the MS leader.
+func (svc *mgmtSvc) PoolRebuildHistory(ctx context.Context, req *mgmtpb.PoolRebuildHistoryReq) (*mgmtpb.PoolRebuildHistoryResp, error) {
+	if err := svc.checkReplicaRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := svc.sysdb.PoolRebuildHistory(poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolRebuildHistoryResp{
+		Records: make([]*mgmtpb.PoolRebuildRecord, 0, len(history)),
+	}
+	for _, rec := range history {
+		resp.Records = append(resp.Records, &mgmtpb.PoolRebuildRecord{
+			Operation:  rec.Operation,
+			MapVersion: rec.MapVersion,
+			Started:    unixOrZero(rec.Started),
+			Finished:   unixOrZero(rec.Finished),
+			Failed:     rec.Failed,
+			Message:    rec.Message,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_PoolRebuildHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []*system.PoolRebuildRecord{
+		{
+			Operation:  "Rebuild",
+			MapVersion: 4,
+			Started:    start,
+		},
+		{
+			Operation:  "Rebuild",
+			MapVersion: 4,
+			Finished:   start.Add(time.Hour),
+			Failed:     true,
+			Message:    "Pool rebuild failed",
+		},
+		{
+			Operation:  "Reclaim",
+			MapVersion: 5,
+			Started:    start.Add(2 * time.Hour),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.PoolRebuildHistoryReq
+		expResp *mgmtpb.PoolRebuildHistoryResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.PoolRebuildHistoryReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolRebuildHistoryReq{Id: "0", Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolRebuildHistoryReq{Id: "bad"},
+			expErr: errors.New("unable to find pool service"),
+		},
+		"no history": {
+			req:     &mgmtpb.PoolRebuildHistoryReq{Id: "1"},
+			expResp: &mgmtpb.PoolRebuildHistoryResp{},
+		},
+		"history": {
+			req: &mgmtpb.PoolRebuildHistoryReq{Id: test.MockPoolUUID(1).String()},
+			expResp: &mgmtpb.PoolRebuildHistoryResp{
+				Records: []*mgmtpb.PoolRebuildRecord{
+					{
+						Operation:  "Rebuild",
+						MapVersion: 4,
+						Started:    start.Unix(),
+						Finished:   start.Add(time.Hour).Unix(),
+						Failed:     true,
+						Message:    "Pool rebuild failed",
+					},
+					{
+						Operation:  "Reclaim",
+						MapVersion: 5,
+						Started:    start.Add(2 * time.Hour).Unix(),
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, test.MockPoolUUID(1).String(), test.MockPoolUUID(2).String())
+			for _, rec := range records {
+				if err := svc.sysdb.AddPoolRebuildRecord(test.MockPoolUUID(1), rec); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolRebuildHistory(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -593,6 +593,17 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			},
 			expErr: FaultPoolInvalidRanks([]ranklist.Rank{11, 40}),
 		},
+		"failed creation ranks with fault domains": {
+			targetCount: 1,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:       test.MockUUID(1),
+				TierBytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Ranks:      []uint32{0},
+				Domains:    []string{"/rack0"},
+				Properties: testPoolLabelProp(),
+			},
+			expErr: errors.New("cannot be combined with fault domain"),
+		},
 		"failed creation invalid number of ranks": {
 			targetCount: 1,
 			req: &mgmtpb.PoolCreateReq{
@@ -1468,6 +1479,256 @@ func TestServer_MgmtSvc_PoolExtend(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolResize(t *testing.T) {
+	scmBytes := uint64(1 * humanize.GiByte)
+	nvmeBytes := uint64(10 * humanize.GiByte)
+	mockRanks := []uint32{0, 1}
+	rankResults := func(statuses ...int32) []*mgmtpb.PoolResizeResp_RankResult {
+		var rrs []*mgmtpb.PoolResizeResp_RankResult
+		for i, status := range statuses {
+			rrs = append(rrs, &mgmtpb.PoolResizeResp_RankResult{
+				Rank:   uint32(i),
+				Status: status,
+			})
+		}
+		return rrs
+	}
+
+	for name, tc := range map[string]struct {
+		nilReq       bool
+		reqIn        *mgmtpb.PoolResizeReq
+		quota        *system.TenantQuota
+		drpcResp     *mgmtpb.PoolResizeResp
+		drpcErr      error
+		expDrpcReq   *mgmtpb.PoolResizeReq
+		expResp      *mgmtpb.PoolResizeResp
+		expTierBytes []uint64
+		expErr       error
+	}{
+		"nil request": {
+			nilReq: true,
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			reqIn:  &mgmtpb.PoolResizeReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"missing uuid": {
+			reqIn:  &mgmtpb.PoolResizeReq{TierBytes: []uint64{0, 2 * nvmeBytes}},
+			expErr: errors.New("empty pool id"),
+		},
+		"no sizes": {
+			reqIn:  &mgmtpb.PoolResizeReq{Id: mockUUID},
+			expErr: errors.New("unexpected pool resize params"),
+		},
+		"change SCM": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{2 * scmBytes, 0},
+			},
+			expErr: FaultPoolResizeUnsupported("the SCM allocation of a pool can't be changed"),
+		},
+		"shrink NVMe": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, nvmeBytes / 2},
+			},
+			expErr: errors.New("can't shrink"),
+		},
+		"total size too small": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:         mockUUID,
+				TotalBytes: scmBytes,
+			},
+			expErr: errors.New("not larger than the current SCM allocation"),
+		},
+		"quota exceeded": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, 2 * nvmeBytes},
+			},
+			quota: &system.TenantQuota{
+				Principal:    "u:bob@",
+				MaxNvmeBytes: 3 * nvmeBytes,
+			},
+			expErr: system.FaultQuotaExceeded("u:bob@", "NVMe",
+				humanize.IBytes(3*nvmeBytes), humanize.IBytes(4*nvmeBytes)),
+			expTierBytes: []uint64{scmBytes, nvmeBytes},
+		},
+		"dRPC send fails": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, 2 * nvmeBytes},
+			},
+			drpcErr:      errors.New("send failure"),
+			expErr:       errors.New("send failure"),
+			expTierBytes: []uint64{scmBytes, nvmeBytes},
+		},
+		"resize fails on all ranks": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, 2 * nvmeBytes},
+			},
+			drpcResp: &mgmtpb.PoolResizeResp{
+				Status:      int32(daos.NoSpace),
+				RankResults: rankResults(int32(daos.NoSpace), int32(daos.NoSpace)),
+			},
+			expResp: &mgmtpb.PoolResizeResp{
+				Status:      int32(daos.NoSpace),
+				RankResults: rankResults(int32(daos.NoSpace), int32(daos.NoSpace)),
+			},
+			expTierBytes: []uint64{scmBytes, nvmeBytes},
+		},
+		"resize fails on some ranks": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, 2 * nvmeBytes},
+			},
+			drpcResp: &mgmtpb.PoolResizeResp{
+				Status:      int32(daos.NoSpace),
+				RankResults: rankResults(0, int32(daos.NoSpace)),
+			},
+			expResp: &mgmtpb.PoolResizeResp{
+				Status:      int32(daos.NoSpace),
+				RankResults: rankResults(0, int32(daos.NoSpace)),
+			},
+			expTierBytes: []uint64{scmBytes, nvmeBytes},
+		},
+		"result missing for a rank": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, 2 * nvmeBytes},
+			},
+			drpcResp: &mgmtpb.PoolResizeResp{
+				TierBytes:   []uint64{scmBytes, 2 * nvmeBytes},
+				RankResults: rankResults(0),
+			},
+			expResp: &mgmtpb.PoolResizeResp{
+				TierBytes:   []uint64{scmBytes, 2 * nvmeBytes},
+				RankResults: rankResults(0),
+			},
+			expTierBytes: []uint64{scmBytes, nvmeBytes},
+		},
+		"grow NVMe": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, 2 * nvmeBytes},
+			},
+			drpcResp: &mgmtpb.PoolResizeResp{
+				TierBytes:   []uint64{scmBytes, 2 * nvmeBytes},
+				RankResults: rankResults(0, 0),
+			},
+			expDrpcReq: &mgmtpb.PoolResizeReq{
+				Sys:       build.DefaultSystemName,
+				SvcRanks:  mockSvcRanks,
+				Id:        mockUUID,
+				TierBytes: []uint64{scmBytes, 2 * nvmeBytes},
+				Ranks:     mockRanks,
+			},
+			expResp: &mgmtpb.PoolResizeResp{
+				TierBytes:   []uint64{scmBytes, 2 * nvmeBytes},
+				RankResults: rankResults(0, 0),
+			},
+			expTierBytes: []uint64{scmBytes, 2 * nvmeBytes},
+		},
+		"grow total size": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:         mockUUID,
+				TotalBytes: 2 * (scmBytes + 3*nvmeBytes),
+			},
+			drpcResp: &mgmtpb.PoolResizeResp{
+				TierBytes:   []uint64{scmBytes, 3 * nvmeBytes},
+				RankResults: rankResults(0, 0),
+			},
+			expDrpcReq: &mgmtpb.PoolResizeReq{
+				Sys:       build.DefaultSystemName,
+				SvcRanks:  mockSvcRanks,
+				Id:        mockUUID,
+				TierBytes: []uint64{scmBytes, 3 * nvmeBytes},
+				Ranks:     mockRanks,
+			},
+			expResp: &mgmtpb.PoolResizeResp{
+				TierBytes:   []uint64{scmBytes, 3 * nvmeBytes},
+				RankResults: rankResults(0, 0),
+			},
+			expTierBytes: []uint64{scmBytes, 3 * nvmeBytes},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			engineCfg := engine.MockConfig().
+				WithTargetCount(1).
+				WithStorage(
+					storage.NewTierConfig().
+						WithStorageClass("nvme").
+						WithBdevDeviceList("foo", "bar"),
+				)
+			svc := newTestMgmtSvcWithProvider(t, log,
+				storage.MockProvider(log, 0, &engineCfg.Storage, nil, nil, nil, nil))
+
+			if tc.quota != nil {
+				if err := svc.sysdb.SetTenantQuota(tc.quota); err != nil {
+					t.Fatal(err)
+				}
+			}
+			addTestPoolService(t, svc.sysdb, &system.PoolService{
+				PoolUUID:  uuid.MustParse(mockUUID),
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{0},
+				OwnerUser: "bob@",
+				Storage: &system.PoolServiceStorage{
+					CreationRankStr:    "0-1",
+					CurrentRankStr:     "0-1",
+					PerRankTierStorage: []uint64{scmBytes, nvmeBytes},
+				},
+			})
+
+			mdc := getMockDrpcClient(tc.drpcResp, tc.drpcErr)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			if tc.reqIn != nil && tc.reqIn.Sys == "" {
+				tc.reqIn.Sys = build.DefaultSystemName
+			}
+			if tc.nilReq {
+				tc.reqIn = nil
+			}
+
+			gotResp, gotErr := svc.PoolResize(test.Context(t), tc.reqIn)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if tc.expTierBytes != nil {
+				gotSvc, err := svc.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tc.expTierBytes, gotSvc.Storage.PerRankTierStorage); diff != "" {
+					t.Fatalf("unexpected pool storage (-want, +got)\n%s\n", diff)
+				}
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			if tc.expDrpcReq == nil {
+				return
+			}
+			lastReq := new(mgmtpb.PoolResizeReq)
+			if err := proto.Unmarshal(getLastMockCall(mdc).Body, lastReq); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expDrpcReq, lastReq, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected final dRPC request (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_PoolReintegrate(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	missingSB := newTestMgmtSvc(t, log)
@@ -1900,10 +2161,11 @@ func TestListPools_Success(t *testing.T) {
 			Replicas:  []ranklist.Rank{0, 1, 2},
 		},
 		{
-			PoolUUID:  test.MockPoolUUID(2),
-			PoolLabel: "1",
-			State:     system.PoolServiceStateReady,
-			Replicas:  []ranklist.Rank{0, 1, 2},
+			PoolUUID:     test.MockPoolUUID(2),
+			PoolLabel:    "1",
+			State:        system.PoolServiceStateReady,
+			Replicas:     []ranklist.Rank{0, 1, 2},
+			CreationTime: time.Unix(1735689600, 0),
 		},
 	}
 	expectedResp := &mgmtpb.ListPoolsResp{
@@ -1917,11 +2179,16 @@ func TestListPools_Success(t *testing.T) {
 			t.Fatal(err)
 		}
 		lock.Release()
+		var creationTime int64
+		if !ps.CreationTime.IsZero() {
+			creationTime = ps.CreationTime.Unix()
+		}
 		expectedResp.Pools = append(expectedResp.Pools, &mgmtpb.ListPoolsResp_Pool{
-			Uuid:    ps.PoolUUID.String(),
-			Label:   ps.PoolLabel,
-			SvcReps: []uint32{0, 1, 2},
-			State:   system.PoolServiceStateReady.String(),
+			Uuid:         ps.PoolUUID.String(),
+			Label:        ps.PoolLabel,
+			SvcReps:      []uint32{0, 1, 2},
+			State:        system.PoolServiceStateReady.String(),
+			CreationTime: creationTime,
 		})
 	}
 
@@ -0,0 +1,186 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// poolReaperInterval is the interval between checks for pools whose destroy
+// grace period has expired.
+const poolReaperInterval = time.Minute
+
+// poolDestroyGracePeriod returns the period for which destroyed pools are
+// retained before being permanently removed. A zero period disables
+// soft-delete.
+func (svc *mgmtSvc) poolDestroyGracePeriod() (time.Duration, error) {
+	val, err := system.GetUserProperty(svc.sysdb, svc.systemProps,
+		daos.SystemPropertyPoolDestroyGrace.String())
+	if err != nil {
+		return 0, err
+	}
+
+	grace, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s value %q",
+			daos.SystemPropertyPoolDestroyGrace, val)
+	}
+
+	return grace, nil
+}
+
+// poolSoftDelete closes the pool to new connections and transitions it into
+// the pending destroy state. As with a regular destroy, the request fails with
+// DER_BUSY if the pool has open handles unless it is forced, in which case the
+// handles are evicted. The pool will be destroyed by the reaper once the grace
+// period has expired unless it is restored with PoolUndelete first. The caller
+// must hold the pool lock.
+func (svc *mgmtSvc) poolSoftDelete(ctx context.Context, req *mgmtpb.PoolDestroyReq, ps *system.PoolService, grace time.Duration) (*mgmtpb.PoolDestroyResp, error) {
+	resp := &mgmtpb.PoolDestroyResp{}
+
+	evStatus, err := svc.poolEvictConnections(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if evStatus != daos.Success {
+		svc.log.Errorf("PoolEvict during pool soft-delete failed: %s", evStatus)
+		resp.Status = int32(evStatus)
+		return resp, nil
+	}
+
+	ps.State = system.PoolServiceStateDestroyPending
+	ps.DestroyAfter = time.Now().Add(grace)
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		return nil, errors.Wrapf(err, "failed to update pool %s", ps.PoolUUID)
+	}
+	svc.log.Noticef("pool %s pending destroy after %s", ps.PoolUUID, ps.DestroyAfter)
+
+	resp.DestroyAfter = ps.DestroyAfter.Unix()
+	return resp, nil
+}
+
+// reapPools destroys any pools pending destroy whose grace period has
+// expired.
+func (svc *mgmtSvc) reapPools(ctx context.Context) error {
+	psList, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, ps := range psList {
+		if ps.State != system.PoolServiceStateDestroyPending || now.Before(ps.DestroyAfter) {
+			continue
+		}
+
+		svc.log.Noticef("pool %s destroy grace period expired", ps.PoolUUID)
+		resp, err := svc.poolDestroyNoLeaderCheck(ctx, &mgmtpb.PoolDestroyReq{
+			Sys:       svc.sysdb.SystemName(),
+			Id:        ps.PoolUUID.String(),
+			Force:     true,
+			Recursive: true,
+			Immediate: true,
+		})
+		if err == nil && resp.Status != 0 {
+			err = daos.Status(resp.Status)
+		}
+		if err != nil {
+			// Pools left in the destroying state will be cleaned up
+			// on the next leadership step-up.
+			svc.log.Errorf("pool %s not destroyed: %s", ps.PoolUUID, err)
+		}
+	}
+
+	return nil
+}
+
+// poolReaperLoop periodically destroys expired pools while this replica is
+// the leader.
+func (svc *mgmtSvc) poolReaperLoop(parent context.Context) {
+	ticker := time.NewTicker(poolReaperInterval)
+	defer ticker.Stop()
+
+	svc.log.Debugf("starting poolReaperLoop (interval %s)", poolReaperInterval)
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped poolReaperLoop")
+			return
+		case <-ticker.C:
+			if err := svc.reapPools(parent); err != nil {
+				svc.log.Errorf("pool reaper failed: %s", err)
+			}
+		}
+	}
+}
+
+// PoolUndelete restores a pool that is pending destroy to the ready state.
+func (svc *mgmtSvc) PoolUndelete(parent context.Context, req *mgmtpb.PoolUndeleteReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx := lock.InContext(parent)
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ps.State != system.PoolServiceStateDestroyPending {
+		return nil, errors.Errorf("pool %s is not pending destroy (state: %s)", req.Id, ps.State)
+	}
+
+	// The pool was closed to new connections by the soft-delete, reopen it
+	// before reporting it as ready again.
+	svcRanks, err := svc.getPoolServiceRanks(ps)
+	if err != nil {
+		return nil, err
+	}
+	req.Id = poolUUID.String()
+	req.SvcRanks = svcRanks
+
+	dResp, err := svc.harness.CallDrpc(ctx, drpc.MethodPoolUndelete, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := new(mgmtpb.DaosResp)
+	if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		svc.log.Errorf("failed to reopen pool %s: %s", poolUUID, daos.Status(resp.Status))
+		return resp, nil
+	}
+
+	ps.State = system.PoolServiceStateReady
+	ps.DestroyAfter = time.Time{}
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		return nil, errors.Wrapf(err, "failed to update pool %s", poolUUID)
+	}
+	svc.log.Noticef("pool %s restored from pending destroy", poolUUID)
+
+	return resp, nil
+}
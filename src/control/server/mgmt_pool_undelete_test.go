@@ -0,0 +1,321 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func addTestPendingPool(t *testing.T, svc *mgmtSvc, poolUUID uuid.UUID, after time.Time) {
+	t.Helper()
+
+	addTestPoolService(t, svc.sysdb, &system.PoolService{
+		PoolUUID:     poolUUID,
+		PoolLabel:    "pending-" + poolUUID.String(),
+		State:        system.PoolServiceStateDestroyPending,
+		DestroyAfter: after,
+		Replicas:     []ranklist.Rank{0},
+	})
+}
+
+func TestServer_MgmtSvc_PoolDestroy_SoftDelete(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+
+	for name, tc := range map[string]struct {
+		grace          string
+		pending        bool
+		drpcResp       proto.Message
+		req            *mgmtpb.PoolDestroyReq
+		expErr         error
+		expStatus      daos.Status
+		expEvictReq    *mgmtpb.PoolEvictReq
+		expPending     bool
+		expDestroyed   bool
+		expDestroyTime bool
+	}{
+		"grace period disabled": {
+			grace:        "0s",
+			req:          &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true},
+			expDestroyed: true,
+		},
+		"grace period set": {
+			grace: "1h",
+			req:   &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true},
+			expEvictReq: &mgmtpb.PoolEvictReq{
+				Sys:      build.DefaultSystemName,
+				Id:       mockUUID,
+				SvcRanks: []uint32{0},
+				Destroy:  true,
+			},
+			expPending:     true,
+			expDestroyTime: true,
+		},
+		"grace period set; open handles": {
+			grace:     "1h",
+			drpcResp:  &mgmtpb.PoolEvictResp{Status: int32(daos.Busy)},
+			req:       &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true},
+			expStatus: daos.Busy,
+		},
+		"grace period set; force": {
+			grace: "1h",
+			req:   &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true, Force: true},
+			expEvictReq: &mgmtpb.PoolEvictReq{
+				Sys:          build.DefaultSystemName,
+				Id:           mockUUID,
+				SvcRanks:     []uint32{0},
+				Destroy:      true,
+				ForceDestroy: true,
+			},
+			expPending:     true,
+			expDestroyTime: true,
+		},
+		"grace period set; immediate": {
+			grace:        "1h",
+			req:          &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true, Immediate: true},
+			expDestroyed: true,
+		},
+		"already pending": {
+			grace:      "1h",
+			pending:    true,
+			req:        &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true},
+			expErr:     errors.New("pending destroy"),
+			expPending: true,
+		},
+		"already pending; immediate": {
+			grace:        "1h",
+			pending:      true,
+			req:          &mgmtpb.PoolDestroyReq{Id: mockUUID, Immediate: true},
+			expDestroyed: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.pending {
+				addTestPendingPool(t, svc, uuid.MustParse(mockUUID), future)
+			} else {
+				addTestPools(t, svc.sysdb, mockUUID)
+			}
+			if err := system.SetUserProperty(svc.sysdb, svc.systemProps,
+				daos.SystemPropertyPoolDestroyGrace.String(), tc.grace); err != nil {
+				t.Fatal(err)
+			}
+			if tc.drpcResp == nil {
+				tc.drpcResp = &mgmtpb.PoolDestroyResp{}
+			}
+			mdc := getMockDrpcClient(tc.drpcResp, nil)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			tc.req.Sys = build.DefaultSystemName
+			gotResp, gotErr := svc.PoolDestroy(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr == nil {
+				test.AssertEqual(t, tc.expStatus, daos.Status(gotResp.Status), "unexpected status")
+				if (gotResp.DestroyAfter > 0) != tc.expDestroyTime {
+					t.Fatalf("unexpected destroy after time %d", gotResp.DestroyAfter)
+				}
+			}
+
+			if tc.expEvictReq != nil {
+				gotReq := new(mgmtpb.PoolEvictReq)
+				if err := proto.Unmarshal(getLastMockCall(mdc).Body, gotReq); err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tc.expEvictReq, gotReq, protocmp.Transform()); diff != "" {
+					t.Fatalf("unexpected evict dRPC call (-want, +got):\n%s\n", diff)
+				}
+			}
+
+			ps, err := svc.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+			if tc.expDestroyed {
+				if !system.IsPoolNotFound(err) {
+					t.Fatalf("expected pool to be destroyed, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, tc.expPending, ps.State == system.PoolServiceStateDestroyPending,
+				"unexpected pool state "+ps.State.String())
+			if tc.expDestroyTime {
+				test.AssertEqual(t, gotResp.DestroyAfter, ps.DestroyAfter.Unix(),
+					"unexpected destroy after time")
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolDestroy_PendingBlocksAccess(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	addTestPendingPool(t, svc, uuid.MustParse(mockUUID), time.Now().Add(time.Hour))
+
+	_, err := svc.getPoolService(mockUUID)
+	if !fault.IsFaultCode(err, code.ServerPoolDestroyPending) {
+		t.Fatalf("expected pending destroy fault, got %v", err)
+	}
+}
+
+func TestServer_MgmtSvc_PoolUndelete(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pending    bool
+		req        *mgmtpb.PoolUndeleteReq
+		drpcResp   *mgmtpb.DaosResp
+		drpcErr    error
+		expResp    *mgmtpb.DaosResp
+		expDrpcReq *mgmtpb.PoolUndeleteReq
+		expState   system.PoolServiceState
+		expErr     error
+	}{
+		"nil request": {
+			req:    (*mgmtpb.PoolUndeleteReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolUndeleteReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolUndeleteReq{Id: "bad"},
+			expErr: errors.New("unable to find pool service"),
+		},
+		"pool not pending destroy": {
+			req:    &mgmtpb.PoolUndeleteReq{Id: mockUUID},
+			expErr: errors.New("not pending destroy"),
+		},
+		"dRPC send fails": {
+			pending:  true,
+			req:      &mgmtpb.PoolUndeleteReq{Id: mockUUID},
+			drpcErr:  errors.New("send failure"),
+			expErr:   errors.New("send failure"),
+			expState: system.PoolServiceStateDestroyPending,
+		},
+		"reopen fails": {
+			pending:  true,
+			req:      &mgmtpb.PoolUndeleteReq{Id: mockUUID},
+			drpcResp: &mgmtpb.DaosResp{Status: int32(daos.TimedOut)},
+			expResp:  &mgmtpb.DaosResp{Status: int32(daos.TimedOut)},
+			expDrpcReq: &mgmtpb.PoolUndeleteReq{
+				Sys:      build.DefaultSystemName,
+				Id:       mockUUID,
+				SvcRanks: []uint32{0},
+			},
+			expState: system.PoolServiceStateDestroyPending,
+		},
+		"success": {
+			pending:  true,
+			req:      &mgmtpb.PoolUndeleteReq{Id: mockUUID},
+			drpcResp: &mgmtpb.DaosResp{},
+			expResp:  &mgmtpb.DaosResp{},
+			expDrpcReq: &mgmtpb.PoolUndeleteReq{
+				Sys:      build.DefaultSystemName,
+				Id:       mockUUID,
+				SvcRanks: []uint32{0},
+			},
+			expState: system.PoolServiceStateReady,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.pending {
+				addTestPendingPool(t, svc, uuid.MustParse(mockUUID), time.Now().Add(time.Hour))
+			} else {
+				addTestPools(t, svc.sysdb, mockUUID)
+			}
+			mdc := getMockDrpcClient(tc.drpcResp, tc.drpcErr)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolUndelete(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if tc.pending {
+				ps, err := svc.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, tc.expState, ps.State, "unexpected pool state")
+				test.AssertEqual(t, tc.expState == system.PoolServiceStateReady,
+					ps.DestroyAfter.IsZero(), "unexpected destroy after time")
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			// The engine must be asked to reopen the pool to connections.
+			lastCall := getLastMockCall(mdc)
+			test.AssertEqual(t, drpc.MethodPoolUndelete.ID(), lastCall.Method,
+				"unexpected dRPC method")
+			gotDrpcReq := new(mgmtpb.PoolUndeleteReq)
+			if err := proto.Unmarshal(lastCall.Body, gotDrpcReq); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expDrpcReq, gotDrpcReq, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected dRPC request (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_reapPools(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, test.MockPoolUUID(1).String())
+	addTestPendingPool(t, svc, test.MockPoolUUID(2), time.Now().Add(-time.Minute))
+	addTestPendingPool(t, svc, test.MockPoolUUID(3), time.Now().Add(time.Hour))
+	setupSvcDrpcClient(svc, 0, getMockDrpcClient(&mgmtpb.PoolDestroyResp{}, nil))
+
+	if err := svc.reapPools(test.Context(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expExists := range map[int32]bool{1: true, 2: false, 3: true} {
+		_, err := svc.sysdb.FindPoolServiceByUUID(test.MockPoolUUID(i))
+		if expExists && err != nil {
+			t.Fatalf("pool %d: unexpected error: %s", i, err)
+		}
+		if !expExists && !system.IsPoolNotFound(err) {
+			t.Fatalf("pool %d: expected pool to be destroyed, got %v", i, err)
+		}
+	}
+}
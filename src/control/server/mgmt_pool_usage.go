@@ -0,0 +1,142 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// poolUsageSampleInterval is the interval between pool usage samples taken by
+// the MS leader. Together with raft.MaxPoolUsageSamples, this determines the
+// period covered by pool capacity forecasts.
+const poolUsageSampleInterval = time.Hour
+
+// samplePoolUsage queries the space usage of each ready pool and appends the
+// results to the pool usage time series in the system database. Pools that
+// cannot be queried are skipped.
+func (svc *mgmtSvc) samplePoolUsage(ctx context.Context) error {
+	pools, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	samples := make(map[uuid.UUID]*system.PoolUsageSample)
+	for _, ps := range pools {
+		resp, err := svc.PoolQuery(ctx, &mgmtpb.PoolQueryReq{
+			Sys:       svc.sysdb.SystemName(),
+			Id:        ps.PoolUUID.String(),
+			QueryMask: uint64(daos.MustNewPoolQueryMask(daos.PoolQueryOptionSpace)),
+		})
+		if err == nil && resp.Status != 0 {
+			err = daos.Status(resp.Status)
+		}
+		if err != nil {
+			svc.log.Debugf("skipping usage sample for pool %s: %s", ps.PoolUUID, err)
+			continue
+		}
+
+		sample := &system.PoolUsageSample{Time: now}
+		for _, ts := range resp.TierStats {
+			sample.Tiers = append(sample.Tiers, &system.PoolTierUsage{
+				Total: ts.Total,
+				Free:  ts.Free,
+			})
+		}
+		samples[ps.PoolUUID] = sample
+	}
+
+	return svc.sysdb.AddPoolUsageSamples(samples)
+}
+
+// poolUsageLoop periodically samples pool usage while this replica is the
+// leader.
+func (svc *mgmtSvc) poolUsageLoop(parent context.Context) {
+	ticker := time.NewTicker(poolUsageSampleInterval)
+	defer ticker.Stop()
+
+	svc.log.Debugf("starting poolUsageLoop (interval %s)", poolUsageSampleInterval)
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped poolUsageLoop")
+			return
+		case <-ticker.C:
+			if err := svc.samplePoolUsage(parent); err != nil {
+				svc.log.Errorf("pool usage sampling failed: %s", err)
+			}
+		}
+	}
+}
+
+// PoolForecast reports the capacity growth rate and projected time until
+// full of each storage tier for the requested pools, or all pools if none
+// are specified.
+func (svc *mgmtSvc) PoolForecast(ctx context.Context, req *mgmtpb.PoolForecastReq) (*mgmtpb.PoolForecastResp, error) {
+	if err := svc.checkReplicaRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	var pools []*system.PoolService
+	if len(req.GetIds()) == 0 {
+		var err error
+		if pools, err = svc.sysdb.PoolServiceList(false); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range req.GetIds() {
+		poolUUID, err := svc.resolvePoolID(id)
+		if err != nil {
+			return nil, err
+		}
+		ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, ps)
+	}
+
+	resp := new(mgmtpb.PoolForecastResp)
+	for _, ps := range pools {
+		samples, err := svc.sysdb.PoolUsageSamples(ps.PoolUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		pf := &mgmtpb.PoolForecast{
+			Uuid:       ps.PoolUUID.String(),
+			Label:      ps.PoolLabel,
+			NumSamples: uint32(len(samples)),
+		}
+		if len(samples) > 1 {
+			pf.Period = int64(samples[len(samples)-1].Time.Sub(samples[0].Time).Seconds())
+		}
+		for i, ptf := range system.ForecastPoolUsage(samples) {
+			mediaType := mgmtpb.StorageMediaType_NVME
+			if i == 0 {
+				mediaType = mgmtpb.StorageMediaType_SCM
+			}
+			pf.Tiers = append(pf.Tiers, &mgmtpb.PoolTierForecast{
+				MediaType:     mediaType,
+				Total:         ptf.Total,
+				Free:          ptf.Free,
+				GrowthRate:    ptf.GrowthRate,
+				DaysUntilFull: ptf.DaysUntilFull,
+			})
+		}
+		resp.Forecasts = append(resp.Forecasts, pf)
+	}
+
+	return resp, nil
+}
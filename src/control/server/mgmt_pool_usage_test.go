@@ -0,0 +1,194 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_samplePoolUsage(t *testing.T) {
+	for name, tc := range map[string]struct {
+		drpcResp   *mgmtpb.PoolQueryResp
+		drpcErr    error
+		expSamples []*system.PoolUsageSample
+	}{
+		"query fails": {
+			drpcErr:    errors.New("query failed"),
+			expSamples: []*system.PoolUsageSample{},
+		},
+		"query returns error status": {
+			drpcResp:   &mgmtpb.PoolQueryResp{Status: -1012},
+			expSamples: []*system.PoolUsageSample{},
+		},
+		"query succeeds": {
+			drpcResp: &mgmtpb.PoolQueryResp{
+				TierStats: []*mgmtpb.StorageUsageStats{
+					{Total: humanize.GiByte, Free: humanize.MiByte},
+					{Total: humanize.TiByte, Free: humanize.GiByte},
+				},
+			},
+			expSamples: []*system.PoolUsageSample{
+				{
+					Tiers: []*system.PoolTierUsage{
+						{Total: humanize.GiByte, Free: humanize.MiByte},
+						{Total: humanize.TiByte, Free: humanize.GiByte},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+			setupSvcDrpcClient(svc, 0, getMockDrpcClient(tc.drpcResp, tc.drpcErr))
+
+			if err := svc.samplePoolUsage(test.Context(t)); err != nil {
+				t.Fatal(err)
+			}
+
+			gotSamples, err := svc.sysdb.PoolUsageSamples(uuid.MustParse(mockUUID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpOpts := []cmp.Option{
+				cmp.FilterPath(func(p cmp.Path) bool {
+					return p.Last().String() == ".Time"
+				}, cmp.Ignore()),
+			}
+			if diff := cmp.Diff(tc.expSamples, gotSamples, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected samples (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolForecast(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockSample := func(days int, scmFree, nvmeFree uint64) *system.PoolUsageSample {
+		return &system.PoolUsageSample{
+			Time: start.Add(time.Duration(days) * 24 * time.Hour),
+			Tiers: []*system.PoolTierUsage{
+				{Total: 100 * humanize.GiByte, Free: scmFree},
+				{Total: 1000 * humanize.GiByte, Free: nvmeFree},
+			},
+		}
+	}
+	samples := []*system.PoolUsageSample{
+		mockSample(0, 90*humanize.GiByte, 1000*humanize.GiByte),
+		mockSample(1, 80*humanize.GiByte, 1000*humanize.GiByte),
+		mockSample(2, 70*humanize.GiByte, 1000*humanize.GiByte),
+	}
+	growingForecast := &mgmtpb.PoolForecast{
+		Uuid:       test.MockPoolUUID(1).String(),
+		Label:      "0",
+		NumSamples: 3,
+		Period:     int64((48 * time.Hour).Seconds()),
+		Tiers: []*mgmtpb.PoolTierForecast{
+			{
+				MediaType:     mgmtpb.StorageMediaType_SCM,
+				Total:         100 * humanize.GiByte,
+				Free:          70 * humanize.GiByte,
+				GrowthRate:    10 * humanize.GiByte,
+				DaysUntilFull: 7,
+			},
+			{
+				MediaType:     mgmtpb.StorageMediaType_NVME,
+				Total:         1000 * humanize.GiByte,
+				Free:          1000 * humanize.GiByte,
+				DaysUntilFull: -1,
+			},
+		},
+	}
+	emptyForecast := &mgmtpb.PoolForecast{
+		Uuid:  test.MockPoolUUID(2).String(),
+		Label: "1",
+	}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.PoolForecastReq
+		expResp *mgmtpb.PoolForecastResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.PoolForecastReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolForecastReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolForecastReq{Ids: []string{"bad"}},
+			expErr: errors.New("unable to find pool service"),
+		},
+		"all pools": {
+			req: &mgmtpb.PoolForecastReq{},
+			expResp: &mgmtpb.PoolForecastResp{
+				Forecasts: []*mgmtpb.PoolForecast{growingForecast, emptyForecast},
+			},
+		},
+		"selected pool by label": {
+			req: &mgmtpb.PoolForecastReq{Ids: []string{"1"}},
+			expResp: &mgmtpb.PoolForecastResp{
+				Forecasts: []*mgmtpb.PoolForecast{emptyForecast},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, test.MockPoolUUID(1).String(), test.MockPoolUUID(2).String())
+			for _, s := range samples {
+				if err := svc.sysdb.AddPoolUsageSamples(map[uuid.UUID]*system.PoolUsageSample{
+					test.MockPoolUUID(1): s,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolForecast(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				protocmp.Transform(),
+				protocmp.SortRepeated(func(a, b *mgmtpb.PoolForecast) bool {
+					return a.Uuid < b.Uuid
+				}),
+				cmpopts.EquateApprox(0, 1e-6),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
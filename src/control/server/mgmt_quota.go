@@ -0,0 +1,172 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// tenantUsage returns the storage usage of the pools owned by each of the
+// given quota principals.
+func (svc *mgmtSvc) tenantUsage(principals ...string) (map[string]*system.TenantUsage, error) {
+	pools, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[string]*system.TenantUsage)
+	for _, p := range principals {
+		usage[p] = new(system.TenantUsage)
+	}
+	for _, ps := range pools {
+		for _, p := range ps.QuotaPrincipals() {
+			if tu, found := usage[p]; found {
+				tu.Add(ps)
+			}
+		}
+	}
+
+	return usage, nil
+}
+
+// checkPoolQuotas verifies that allocating the requested storage and pools to
+// a pool owned by the given user and group does not exceed any tenant quota.
+func (svc *mgmtSvc) checkPoolQuotas(user, group string, req system.TenantUsage) error {
+	var principals []string
+	if user != "" {
+		principals = append(principals, system.UserQuotaPrincipal(user))
+	}
+	if group != "" {
+		principals = append(principals, system.GroupQuotaPrincipal(group))
+	}
+	if len(principals) == 0 {
+		return nil
+	}
+
+	quotas, err := svc.sysdb.TenantQuotas(principals...)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+
+	usage, err := svc.tenantUsage(principals...)
+	if err != nil {
+		return err
+	}
+	for _, tq := range quotas {
+		if err := tq.Check(*usage[tq.Principal], req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reservePoolQuotas verifies that the requested usage does not exceed any
+// tenant quota of the given user and group and then calls reserveFn to record
+// the usage in the system database. Both steps are carried out under the quota
+// lock so that concurrent requests cannot exceed a quota between the check and
+// the update.
+func (svc *mgmtSvc) reservePoolQuotas(user, group string, req system.TenantUsage, reserveFn func() error) error {
+	svc.quotaLock.Lock()
+	defer svc.quotaLock.Unlock()
+
+	if err := svc.checkPoolQuotas(user, group, req); err != nil {
+		return err
+	}
+
+	return reserveFn()
+}
+
+// SystemSetQuota sets or removes the storage quota for a tenant.
+func (svc *mgmtSvc) SystemSetQuota(ctx context.Context, req *mgmtpb.SystemSetQuotaReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	tq := &system.TenantQuota{
+		Principal:    req.GetPrincipal(),
+		MaxScmBytes:  req.GetMaxScmBytes(),
+		MaxNvmeBytes: req.GetMaxNvmeBytes(),
+		MaxPools:     req.GetMaxPools(),
+	}
+	if err := system.ValidateQuotaPrincipal(tq.Principal); err != nil {
+		return nil, err
+	}
+
+	if req.GetRemove() {
+		if !tq.IsUnlimited() {
+			return nil, errors.New("quota limits cannot be set when removing a quota")
+		}
+		if err := svc.sysdb.RemoveTenantQuota(tq.Principal); err != nil {
+			return nil, err
+		}
+		svc.log.Noticef("removed quota for %s", tq.Principal)
+
+		return new(mgmtpb.DaosResp), nil
+	}
+
+	if tq.IsUnlimited() {
+		return nil, errors.New("no quota limits specified")
+	}
+	if err := svc.sysdb.SetTenantQuota(tq); err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("set quota for %s: %+v", tq.Principal, tq)
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+// SystemGetQuota returns the storage quotas and current usage for tenants.
+func (svc *mgmtSvc) SystemGetQuota(ctx context.Context, req *mgmtpb.SystemGetQuotaReq) (*mgmtpb.SystemGetQuotaResp, error) {
+	if err := svc.checkReplicaRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	for _, p := range req.GetPrincipals() {
+		if err := system.ValidateQuotaPrincipal(p); err != nil {
+			return nil, err
+		}
+	}
+
+	quotas, err := svc.sysdb.TenantQuotas(req.GetPrincipals()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(quotas) == 0 && len(req.GetPrincipals()) > 0 {
+		return nil, errors.Errorf("no quotas found for %v", req.GetPrincipals())
+	}
+
+	principals := make([]string, 0, len(quotas))
+	for _, tq := range quotas {
+		principals = append(principals, tq.Principal)
+	}
+	usage, err := svc.tenantUsage(principals...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.SystemGetQuotaResp)
+	for _, tq := range quotas {
+		tu := usage[tq.Principal]
+		resp.Quotas = append(resp.Quotas, &mgmtpb.TenantQuota{
+			Principal:     tq.Principal,
+			MaxScmBytes:   tq.MaxScmBytes,
+			MaxNvmeBytes:  tq.MaxNvmeBytes,
+			MaxPools:      tq.MaxPools,
+			UsedScmBytes:  tu.ScmBytes,
+			UsedNvmeBytes: tu.NvmeBytes,
+			UsedPools:     tu.Pools,
+		})
+	}
+
+	return resp, nil
+}
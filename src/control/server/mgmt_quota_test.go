@@ -0,0 +1,340 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockQuotaPool(t *testing.T, idx int32, user, group string) *system.PoolService {
+	t.Helper()
+
+	ps := system.NewPoolService(test.MockPoolUUID(idx),
+		[]uint64{humanize.GiByte, 10 * humanize.GiByte}, 0, []ranklist.Rank{0, 1})
+	ps.State = system.PoolServiceStateReady
+	ps.Replicas = []ranklist.Rank{0}
+	ps.OwnerUser = user
+	ps.OwnerGroup = group
+	return ps
+}
+
+func TestServer_MgmtSvc_SystemSetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		existing  []*system.TenantQuota
+		req       *mgmtpb.SystemSetQuotaReq
+		expQuotas []*system.TenantQuota
+		expErr    error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.SystemSetQuotaReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"not system leader": {
+			req:    &mgmtpb.SystemSetQuotaReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"invalid principal": {
+			req:    &mgmtpb.SystemSetQuotaReq{Principal: "bob", MaxPools: 1},
+			expErr: errors.New("invalid quota principal"),
+		},
+		"no limits": {
+			req:    &mgmtpb.SystemSetQuotaReq{Principal: "u:bob@"},
+			expErr: errors.New("no quota limits"),
+		},
+		"set quota": {
+			req: &mgmtpb.SystemSetQuotaReq{
+				Principal:   "u:bob@",
+				MaxScmBytes: humanize.GiByte,
+				MaxPools:    2,
+			},
+			expQuotas: []*system.TenantQuota{
+				{Principal: "u:bob@", MaxScmBytes: humanize.GiByte, MaxPools: 2},
+			},
+		},
+		"replace quota": {
+			existing: []*system.TenantQuota{
+				{Principal: "u:bob@", MaxScmBytes: humanize.GiByte, MaxPools: 2},
+			},
+			req: &mgmtpb.SystemSetQuotaReq{Principal: "u:bob@", MaxNvmeBytes: humanize.TiByte},
+			expQuotas: []*system.TenantQuota{
+				{Principal: "u:bob@", MaxNvmeBytes: humanize.TiByte},
+			},
+		},
+		"remove quota with limits": {
+			req:    &mgmtpb.SystemSetQuotaReq{Principal: "u:bob@", MaxPools: 1, Remove: true},
+			expErr: errors.New("cannot be set when removing"),
+		},
+		"remove quota": {
+			existing: []*system.TenantQuota{
+				{Principal: "u:bob@", MaxPools: 2},
+				{Principal: "g:builders@", MaxPools: 4},
+			},
+			req: &mgmtpb.SystemSetQuotaReq{Principal: "u:bob@", Remove: true},
+			expQuotas: []*system.TenantQuota{
+				{Principal: "g:builders@", MaxPools: 4},
+			},
+		},
+		"remove unknown quota": {
+			req:    &mgmtpb.SystemSetQuotaReq{Principal: "u:bob@", Remove: true},
+			expErr: errors.New("no quota found"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, tq := range tc.existing {
+				if err := svc.sysdb.SetTenantQuota(tq); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			_, gotErr := svc.SystemSetQuota(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotQuotas, err := svc.sysdb.TenantQuotas()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expQuotas, gotQuotas); diff != "" {
+				t.Fatalf("unexpected quotas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemGetQuota(t *testing.T) {
+	quotas := []*system.TenantQuota{
+		{Principal: "u:bob@", MaxScmBytes: 8 * humanize.GiByte, MaxPools: 4},
+		{Principal: "g:builders@", MaxNvmeBytes: humanize.TiByte},
+	}
+	pools := []*system.PoolService{
+		mockQuotaPool(t, 1, "bob@", "builders@"),
+		mockQuotaPool(t, 2, "bob@", "staff@"),
+		mockQuotaPool(t, 3, "alice@", "builders@"),
+		mockQuotaPool(t, 4, "carol@", "staff@"),
+	}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemGetQuotaReq
+		expResp *mgmtpb.SystemGetQuotaResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.SystemGetQuotaReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"invalid principal": {
+			req:    &mgmtpb.SystemGetQuotaReq{Principals: []string{"bob@"}},
+			expErr: errors.New("invalid quota principal"),
+		},
+		"unknown principal": {
+			req:    &mgmtpb.SystemGetQuotaReq{Principals: []string{"u:alice@"}},
+			expErr: errors.New("no quotas found"),
+		},
+		"all quotas": {
+			req: &mgmtpb.SystemGetQuotaReq{},
+			expResp: &mgmtpb.SystemGetQuotaResp{
+				Quotas: []*mgmtpb.TenantQuota{
+					{
+						Principal:     "g:builders@",
+						MaxNvmeBytes:  humanize.TiByte,
+						UsedScmBytes:  4 * humanize.GiByte,
+						UsedNvmeBytes: 40 * humanize.GiByte,
+						UsedPools:     2,
+					},
+					{
+						Principal:     "u:bob@",
+						MaxScmBytes:   8 * humanize.GiByte,
+						MaxPools:      4,
+						UsedScmBytes:  4 * humanize.GiByte,
+						UsedNvmeBytes: 40 * humanize.GiByte,
+						UsedPools:     2,
+					},
+				},
+			},
+		},
+		"selected quota": {
+			req: &mgmtpb.SystemGetQuotaReq{Principals: []string{"u:bob@"}},
+			expResp: &mgmtpb.SystemGetQuotaResp{
+				Quotas: []*mgmtpb.TenantQuota{
+					{
+						Principal:     "u:bob@",
+						MaxScmBytes:   8 * humanize.GiByte,
+						MaxPools:      4,
+						UsedScmBytes:  4 * humanize.GiByte,
+						UsedNvmeBytes: 40 * humanize.GiByte,
+						UsedPools:     2,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, tq := range quotas {
+				if err := svc.sysdb.SetTenantQuota(tq); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, ps := range pools {
+				addTestPoolService(t, svc.sysdb, ps)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemGetQuota(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_checkPoolQuotas(t *testing.T) {
+	quotas := []*system.TenantQuota{
+		{Principal: "u:bob@", MaxScmBytes: 5 * humanize.GiByte, MaxPools: 3},
+		{Principal: "g:builders@", MaxNvmeBytes: 50 * humanize.GiByte},
+	}
+
+	for name, tc := range map[string]struct {
+		user   string
+		group  string
+		req    system.TenantUsage
+		expErr error
+	}{
+		"no quota": {
+			user:  "alice@",
+			group: "staff@",
+			req:   system.TenantUsage{ScmBytes: humanize.TiByte, Pools: 1},
+		},
+		"within quota": {
+			user:  "bob@",
+			group: "builders@",
+			req:   system.TenantUsage{ScmBytes: humanize.GiByte, NvmeBytes: 10 * humanize.GiByte, Pools: 1},
+		},
+		"user without domain": {
+			user: "bob",
+			req:  system.TenantUsage{ScmBytes: 2 * humanize.GiByte},
+			expErr: system.FaultQuotaExceeded("u:bob@", "SCM",
+				humanize.IBytes(5*humanize.GiByte), humanize.IBytes(6*humanize.GiByte)),
+		},
+		"pool count exceeded": {
+			user:   "bob@",
+			req:    system.TenantUsage{Pools: 2},
+			expErr: system.FaultQuotaExceeded("u:bob@", "pool count", "3", "4"),
+		},
+		"group nvme exceeded": {
+			user:  "alice@",
+			group: "builders@",
+			req:   system.TenantUsage{NvmeBytes: 20 * humanize.GiByte},
+			expErr: system.FaultQuotaExceeded("g:builders@", "NVMe",
+				humanize.IBytes(50*humanize.GiByte), humanize.IBytes(60*humanize.GiByte)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, tq := range quotas {
+				if err := svc.sysdb.SetTenantQuota(tq); err != nil {
+					t.Fatal(err)
+				}
+			}
+			addTestPoolService(t, svc.sysdb, mockQuotaPool(t, 1, "bob@", "builders@"))
+			addTestPoolService(t, svc.sysdb, mockQuotaPool(t, 2, "bob@", "builders@"))
+
+			gotErr := svc.checkPoolQuotas(tc.user, tc.group, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_reservePoolQuotas(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	if err := svc.sysdb.SetTenantQuota(&system.TenantQuota{Principal: "u:bob@", MaxPools: 3}); err != nil {
+		t.Fatal(err)
+	}
+	addTestPoolService(t, svc.sysdb, mockQuotaPool(t, 1, "bob@", ""))
+	addTestPoolService(t, svc.sysdb, mockQuotaPool(t, 2, "bob@", ""))
+
+	// Only one of the concurrent requests may reserve the last pool
+	// allowed by the quota.
+	const nReqs = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, nReqs)
+	for i := 0; i < nReqs; i++ {
+		wg.Add(1)
+		go func(idx int32) {
+			defer wg.Done()
+			ps := mockQuotaPool(t, idx, "bob@", "")
+			lock, err := svc.sysdb.TakePoolLock(test.Context(t), ps.PoolUUID)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer lock.Release()
+
+			errs <- svc.reservePoolQuotas(ps.OwnerUser, ps.OwnerGroup, system.TenantUsage{Pools: 1}, func() error {
+				return svc.sysdb.AddPoolService(lock.InContext(test.Context(t)), ps)
+			})
+		}(int32(i + 3))
+	}
+	wg.Wait()
+	close(errs)
+
+	var nReserved int
+	for err := range errs {
+		if err == nil {
+			nReserved++
+			continue
+		}
+		test.CmpErr(t, system.FaultQuotaExceeded("u:bob@", "pool count", "3", "4"), err)
+	}
+	test.AssertEqual(t, 1, nReserved, "unexpected number of reservations")
+
+	pools, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 3, len(pools), "unexpected number of pools")
+}
@@ -0,0 +1,227 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+// SystemReplicasList lists the MS replicas and their raft membership state.
+func (svc *mgmtSvc) SystemReplicasList(ctx context.Context, req *mgmtpb.SystemReplicasListReq) (*mgmtpb.SystemReplicasListResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	infos, err := svc.sysdb.ReplicaStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemReplicasListResp{
+		Replicas: make([]*mgmtpb.SystemReplica, 0, len(infos)),
+	}
+	for _, info := range infos {
+		resp.Replicas = append(resp.Replicas, &mgmtpb.SystemReplica{
+			Addr:   info.Addr.String(),
+			State:  info.State,
+			Leader: info.Leader,
+		})
+	}
+
+	return resp, nil
+}
+
+func indexOfAddr(addrs []*net.TCPAddr, addr *net.TCPAddr) int {
+	for i, a := range addrs {
+		if common.CmpTCPAddr(a, addr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// joinedHostAddrs returns the set of control plane addresses with at least
+// one joined member, along with the set of all member control addresses.
+func (svc *mgmtSvc) joinedHostAddrs() (joined, all map[string]*net.TCPAddr, err error) {
+	members, err := svc.membership.Members(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	joined = make(map[string]*net.TCPAddr)
+	all = make(map[string]*net.TCPAddr)
+	for _, m := range members {
+		all[m.Addr.String()] = m.Addr
+		if m.State == system.MemberStateJoined {
+			joined[m.Addr.String()] = m.Addr
+		}
+	}
+
+	return joined, all, nil
+}
+
+// newReplicaSet validates the requested replica changes and returns the
+// resulting replica set. Replicas are only added for hosts with joined
+// members, and the change is rejected if the resulting set would not have a
+// healthy majority.
+func (svc *mgmtSvc) newReplicaSet(req *mgmtpb.SystemReplicasUpdateReq, joined map[string]*net.TCPAddr) ([]*net.TCPAddr, []*net.TCPAddr, error) {
+	toAdd, err := raft.ParseReplicas(req.GetAdd())
+	if err != nil {
+		return nil, nil, err
+	}
+	toRemove, err := raft.ParseReplicas(req.GetRemove())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaderAddr, err := svc.sysdb.ReplicaAddr()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replicas := svc.sysdb.Replicas()
+	var removed []*net.TCPAddr
+	for _, addr := range toRemove {
+		idx := indexOfAddr(replicas, addr)
+		if idx < 0 {
+			return nil, nil, errors.Errorf("%s is not a MS replica", addr)
+		}
+		if common.CmpTCPAddr(addr, leaderAddr) {
+			return nil, nil, errors.Errorf("cannot remove the current MS leader (%s); "+
+				"stop its control plane to move leadership first", addr)
+		}
+		replicas = append(replicas[:idx], replicas[idx+1:]...)
+		removed = append(removed, addr)
+	}
+
+	for _, addr := range toAdd {
+		if indexOfAddr(replicas, addr) >= 0 {
+			return nil, nil, errors.Errorf("%s is already a MS replica", addr)
+		}
+		if _, found := joined[addr.String()]; !found {
+			return nil, nil, errors.Errorf("%s is not the address of a joined system member", addr)
+		}
+		replicas = append(replicas, addr)
+	}
+
+	if len(replicas) == 0 {
+		return nil, nil, errors.New("MS replica set cannot be empty")
+	}
+	if len(replicas)%2 == 0 && !req.GetForce() {
+		return nil, nil, errors.Errorf("change would result in an even number (%d) of MS replicas; "+
+			"use force to override", len(replicas))
+	}
+
+	healthy := 0
+	for _, addr := range replicas {
+		if _, found := joined[addr.String()]; found || common.CmpTCPAddr(addr, leaderAddr) {
+			healthy++
+		}
+	}
+	if majority := len(replicas)/2 + 1; healthy < majority {
+		return nil, nil, errors.Errorf("change would leave %d of %d MS replicas available, "+
+			"fewer than the %d required for quorum", healthy, len(replicas), majority)
+	}
+
+	return replicas, removed, nil
+}
+
+// SystemReplicasUpdate adds or removes MS replicas at runtime. The updated
+// replica set is committed to the system database and then sent to each
+// control plane server so that removed replicas stop their local raft service
+// and added replicas can start it after a restart.
+func (svc *mgmtSvc) SystemReplicasUpdate(ctx context.Context, req *mgmtpb.SystemReplicasUpdateReq) (*mgmtpb.SystemReplicasUpdateResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+	if len(req.GetAdd()) == 0 && len(req.GetRemove()) == 0 {
+		return nil, errors.New("no replicas to add or remove")
+	}
+
+	joined, all, err := svc.joinedHostAddrs()
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, removed, err := svc.newReplicaSet(req, joined)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.log.Noticef("updating MS replica set: %v", replicas)
+	if err := svc.sysdb.UpdateReplicas(replicas); err != nil {
+		return nil, errors.Wrap(err, "failed to update MS replica set")
+	}
+
+	for _, addr := range removed {
+		all[addr.String()] = addr
+	}
+	hosts := make([]string, 0, len(all))
+	for host := range all {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	resp := &mgmtpb.SystemReplicasUpdateResp{
+		HostErrors: make(map[string]string),
+	}
+	for _, addr := range replicas {
+		resp.Replicas = append(resp.Replicas, addr.String())
+	}
+
+	if len(hosts) == 0 {
+		return resp, nil
+	}
+
+	setReq := &control.SetMgmtSvcReplicasReq{
+		Replicas: resp.Replicas,
+	}
+	setReq.SetHostList(hosts)
+	setResp, err := control.SetMgmtSvcReplicas(ctx, svc.rpcClient, setReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send MS replica set to servers")
+	}
+
+	resp.RestartHosts = setResp.RestartHosts
+	for errStr, hes := range setResp.HostErrors {
+		for _, host := range hes.HostSet.Slice() {
+			resp.HostErrors[host] = errStr
+		}
+	}
+
+	return resp, nil
+}
+
+// SetMgmtSvcReplicas updates the MS replica set on this server in response to
+// a change made on the MS leader.
+func (svc *mgmtSvc) SetMgmtSvcReplicas(ctx context.Context, req *mgmtpb.SetMgmtSvcReplicasReq) (*mgmtpb.SetMgmtSvcReplicasResp, error) {
+	if err := svc.checkSystemRequest(req); err != nil {
+		return nil, err
+	}
+
+	replicas, err := raft.ParseReplicas(req.GetReplicas())
+	if err != nil {
+		return nil, err
+	}
+
+	restart, err := svc.sysdb.SetReplicas(replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SetMgmtSvcReplicasResp{RestartRequired: restart}, nil
+}
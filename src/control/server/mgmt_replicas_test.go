@@ -0,0 +1,363 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+func TestServer_MgmtSvc_SystemReplicasList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemReplicasListReq
+		expResp    *mgmtpb.SystemReplicasListResp
+		expErr     error
+	}{
+		"bad system": {
+			req:    &mgmtpb.SystemReplicasListReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemReplicasListReq{},
+			expErr:     errors.New("replica"),
+		},
+		"success": {
+			req: &mgmtpb.SystemReplicasListReq{},
+			expResp: &mgmtpb.SystemReplicasListResp{
+				Replicas: []*mgmtpb.SystemReplica{
+					{
+						Addr:  common.LocalhostCtrlAddr().String(),
+						State: raft.ReplicaStatePending,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+
+			gotResp, gotErr := svc.SystemReplicasList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemReplicasUpdate(t *testing.T) {
+	addr := func(idx uint32) string {
+		return system.MockControlAddr(t, idx).String()
+	}
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		replicas   []string
+		members    []*system.Member
+		req        *mgmtpb.SystemReplicasUpdateReq
+		uResp      *control.UnaryResponse
+		expResp    *mgmtpb.SystemReplicasUpdateResp
+		expErr     error
+	}{
+		"bad system": {
+			req:    &mgmtpb.SystemReplicasUpdateReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemReplicasUpdateReq{Add: []string{addr(2)}},
+			expErr:     errors.New("replica"),
+		},
+		"no changes": {
+			req:    &mgmtpb.SystemReplicasUpdateReq{},
+			expErr: errors.New("no replicas"),
+		},
+		"invalid address": {
+			req:    &mgmtpb.SystemReplicasUpdateReq{Add: []string{"bad:address:1"}},
+			expErr: errors.New("invalid replica address"),
+		},
+		"add unknown host": {
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+			},
+			req:    &mgmtpb.SystemReplicasUpdateReq{Add: []string{addr(9)}},
+			expErr: errors.New("not the address of a joined system member"),
+		},
+		"add stopped host": {
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+				system.MockMember(t, 3, system.MemberStateStopped),
+			},
+			req:    &mgmtpb.SystemReplicasUpdateReq{Add: []string{addr(2), addr(3)}},
+			expErr: errors.New("not the address of a joined system member"),
+		},
+		"add existing replica": {
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+			},
+			req:    &mgmtpb.SystemReplicasUpdateReq{Add: []string{addr(1)}},
+			expErr: errors.New("already a MS replica"),
+		},
+		"even number of replicas": {
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+			},
+			req:    &mgmtpb.SystemReplicasUpdateReq{Add: []string{addr(2)}},
+			expErr: errors.New("even number"),
+		},
+		"remove leader": {
+			replicas: []string{addr(1), addr(2), addr(3)},
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+				system.MockMember(t, 3, system.MemberStateJoined),
+			},
+			req:    &mgmtpb.SystemReplicasUpdateReq{Remove: []string{addr(1)}},
+			expErr: errors.New("cannot remove the current MS leader"),
+		},
+		"remove non-replica": {
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+			},
+			req:    &mgmtpb.SystemReplicasUpdateReq{Remove: []string{addr(2)}},
+			expErr: errors.New("not a MS replica"),
+		},
+		"removal would lose quorum": {
+			replicas: []string{addr(1), addr(2), addr(3)},
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateStopped),
+				system.MockMember(t, 3, system.MemberStateStopped),
+			},
+			req: &mgmtpb.SystemReplicasUpdateReq{
+				Remove: []string{addr(3)},
+				Force:  true,
+			},
+			expErr: errors.New("required for quorum"),
+		},
+		"add replicas": {
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+				system.MockMember(t, 3, system.MemberStateJoined),
+			},
+			req: &mgmtpb.SystemReplicasUpdateReq{Add: []string{addr(2), addr(3)}},
+			uResp: &control.UnaryResponse{
+				Responses: []*control.HostResponse{
+					{
+						Addr:    addr(1),
+						Message: &mgmtpb.SetMgmtSvcReplicasResp{},
+					},
+					{
+						Addr:    addr(2),
+						Message: &mgmtpb.SetMgmtSvcReplicasResp{RestartRequired: true},
+					},
+					{
+						Addr:  addr(3),
+						Error: errors.New("unreachable"),
+					},
+				},
+			},
+			expResp: &mgmtpb.SystemReplicasUpdateResp{
+				Replicas:     []string{addr(1), addr(2), addr(3)},
+				RestartHosts: []string{addr(2)},
+				HostErrors: map[string]string{
+					addr(3): "unreachable",
+				},
+			},
+		},
+		"add replica with force": {
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+			},
+			req: &mgmtpb.SystemReplicasUpdateReq{
+				Add:   []string{addr(2)},
+				Force: true,
+			},
+			uResp: &control.UnaryResponse{
+				Responses: []*control.HostResponse{
+					{
+						Addr:    addr(1),
+						Message: &mgmtpb.SetMgmtSvcReplicasResp{},
+					},
+					{
+						Addr:    addr(2),
+						Message: &mgmtpb.SetMgmtSvcReplicasResp{RestartRequired: true},
+					},
+				},
+			},
+			expResp: &mgmtpb.SystemReplicasUpdateResp{
+				Replicas:     []string{addr(1), addr(2)},
+				RestartHosts: []string{addr(2)},
+				HostErrors:   map[string]string{},
+			},
+		},
+		"remove replicas": {
+			replicas: []string{addr(1), addr(2), addr(3)},
+			members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateJoined),
+				system.MockMember(t, 3, system.MemberStateStopped),
+			},
+			req: &mgmtpb.SystemReplicasUpdateReq{Remove: []string{addr(2), addr(3)}},
+			uResp: &control.UnaryResponse{
+				Responses: []*control.HostResponse{
+					{
+						Addr:    addr(1),
+						Message: &mgmtpb.SetMgmtSvcReplicasResp{},
+					},
+					{
+						Addr:    addr(2),
+						Message: &mgmtpb.SetMgmtSvcReplicasResp{},
+					},
+					{
+						Addr:    addr(3),
+						Message: &mgmtpb.SetMgmtSvcReplicasResp{},
+					},
+				},
+			},
+			expResp: &mgmtpb.SystemReplicasUpdateResp{
+				Replicas:   []string{addr(1)},
+				HostErrors: map[string]string{},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+			for _, m := range tc.members {
+				if err := svc.sysdb.AddMember(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.replicas != nil {
+				replicas, err := raft.ParseReplicas(tc.replicas)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := svc.sysdb.UpdateReplicas(replicas); err != nil {
+					t.Fatal(err)
+				}
+			}
+			svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := svc.SystemReplicasUpdate(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			gotReplicas := []string{}
+			for _, r := range svc.sysdb.Replicas() {
+				gotReplicas = append(gotReplicas, r.String())
+			}
+			if diff := cmp.Diff(tc.expResp.Replicas, gotReplicas); diff != "" {
+				t.Fatalf("unexpected replica set (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SetMgmtSvcReplicas(t *testing.T) {
+	localAddr := common.LocalhostCtrlAddr().String()
+	remoteAddr := "192.0.2.1:10001"
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SetMgmtSvcReplicasReq
+		expResp    *mgmtpb.SetMgmtSvcReplicasResp
+		expReplica bool
+		expErr     error
+	}{
+		"bad system": {
+			req:    &mgmtpb.SetMgmtSvcReplicasReq{Sys: "bad", Replicas: []string{localAddr}},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"invalid address": {
+			req:    &mgmtpb.SetMgmtSvcReplicasReq{Replicas: []string{"bad:address:1"}},
+			expErr: errors.New("invalid replica address"),
+		},
+		"empty replica set": {
+			req:    &mgmtpb.SetMgmtSvcReplicasReq{},
+			expErr: errors.New("empty"),
+		},
+		"replica unchanged": {
+			req:        &mgmtpb.SetMgmtSvcReplicasReq{Replicas: []string{localAddr, remoteAddr}},
+			expResp:    &mgmtpb.SetMgmtSvcReplicasResp{},
+			expReplica: true,
+		},
+		"non-replica promoted": {
+			nonReplica: true,
+			req:        &mgmtpb.SetMgmtSvcReplicasReq{Replicas: []string{remoteAddr, localAddr}},
+			expResp:    &mgmtpb.SetMgmtSvcReplicasResp{RestartRequired: true},
+		},
+		"non-replica unchanged": {
+			nonReplica: true,
+			req:        &mgmtpb.SetMgmtSvcReplicasReq{Replicas: []string{remoteAddr}},
+			expResp:    &mgmtpb.SetMgmtSvcReplicasResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+
+			gotResp, gotErr := svc.SetMgmtSvcReplicas(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expReplica, svc.sysdb.IsReplica(), "unexpected replica state")
+		})
+	}
+}
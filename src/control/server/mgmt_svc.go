@@ -11,6 +11,7 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/system"
 	"github.com/daos-stack/daos/src/control/system/raft"
 )
@@ -76,6 +78,7 @@ type mgmtSvc struct {
 	sysdb             *raft.Database
 	rpcClient         control.UnaryInvoker
 	events            *events.PubSub
+	evtStreamer       *eventStreamer
 	systemProps       daos.SystemPropertyMap
 	clientNetworkHint []*mgmtpb.ClientNetHint
 	batchInterval     time.Duration
@@ -83,6 +86,8 @@ type mgmtSvc struct {
 	serialReqs        batchReqChan
 	groupUpdateReqs   chan bool
 	lastMapVer        uint32
+	backupCfg         *config.MgmtSvcBackupConfig
+	quotaLock         sync.Mutex // serializes tenant quota checks and reservations
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
@@ -93,6 +98,7 @@ func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c cont
 		sysdb:             s,
 		rpcClient:         c,
 		events:            p,
+		evtStreamer:       newEventStreamer(h.log),
 		systemProps:       daos.SystemProperties(),
 		clientNetworkHint: []*mgmtpb.ClientNetHint{new(mgmtpb.ClientNetHint)},
 		batchInterval:     batchLoopInterval,
@@ -164,6 +170,11 @@ func (svc *mgmtSvc) checkReplicaRequest(req proto.Message) error {
 // that will be canceled on leadership loss.
 func (svc *mgmtSvc) startLeaderLoops(ctx context.Context) {
 	go svc.leaderTaskLoop(ctx)
+	go svc.poolUsageLoop(ctx)
+	go svc.poolReaperLoop(ctx)
+	if svc.backupCfg != nil && svc.backupCfg.Interval > 0 {
+		go svc.backupLoop(ctx)
+	}
 }
 
 // startAsyncLoops kicks off the asynchronous processing loops.
@@ -902,6 +902,18 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 		return nil, err
 	}
 
+	if req.DryRun {
+		impacts, err := svc.getPoolImpacts(ctx, req.Sys, fReq.Ranks)
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtpb.SystemStopResp{
+			Absentranks: fResp.AbsentRanks.String(),
+			Absenthosts: fResp.AbsentHosts.String(),
+			PoolImpacts: impacts,
+		}, nil
+	}
+
 	// First phase: Prepare the ranks for shutdown, but only if the request
 	// is for an unforced full system stop.
 	if fReq.FullSystem && !fReq.Force {
@@ -1050,6 +1062,17 @@ func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExclude
 		return nil, errors.Errorf("invalid rank(s): %s", fResp.AbsentRanks.String())
 	}
 
+	if req.DryRun {
+		if req.Clear {
+			return nil, errors.New("dry-run is not supported when clearing excluded state")
+		}
+		impacts, err := svc.getPoolImpacts(ctx, req.Sys, fReq.Ranks)
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtpb.SystemExcludeResp{PoolImpacts: impacts}, nil
+	}
+
 	resp := new(mgmtpb.SystemExcludeResp)
 	for _, r := range fReq.Ranks.Ranks() {
 		m, err := svc.sysdb.FindMemberByRank(r)
@@ -1287,6 +1310,17 @@ func (svc *mgmtSvc) SystemDrain(ctx context.Context, req *mgmtpb.SystemDrainReq)
 		return nil, err
 	}
 
+	if req.DryRun {
+		if req.Reint {
+			return nil, errors.New("dry-run is not supported for reintegration")
+		}
+		impacts, err := svc.getPoolImpacts(ctx, req.Sys, hitRanks)
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtpb.SystemDrainResp{PoolImpacts: impacts}, nil
+	}
+
 	// Retrieve rank-to-pool mappings.
 	poolIDs, poolRanks, err := svc.getPoolsRanks(hitRanks)
 	if err != nil {
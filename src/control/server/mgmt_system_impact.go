@@ -0,0 +1,160 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// getPoolRedunFac fetches the redundancy factor property of a pool.
+func (svc *mgmtSvc) getPoolRedunFac(ctx context.Context, sys string, ps *system.PoolService) (uint32, error) {
+	resp, err := svc.PoolGetProp(ctx, &mgmtpb.PoolGetPropReq{
+		Sys:      sys,
+		Id:       ps.PoolUUID.String(),
+		SvcRanks: ranklist.RanksToUint32(ps.Replicas),
+		Properties: []*mgmtpb.PoolProperty{
+			{Number: uint32(daos.PoolPropertyRedunFac)},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.GetStatus() != 0 {
+		return 0, daos.Status(resp.GetStatus())
+	}
+
+	for _, prop := range resp.GetProperties() {
+		if prop.GetNumber() == daos.PoolPropertyRedunFac {
+			return uint32(prop.GetNumval()), nil
+		}
+	}
+
+	return 0, errors.New("redundancy factor property not returned")
+}
+
+// rankFaultDomains maps each member rank to the fault domain of its node, the
+// default redundancy level used for object placement. Members without a fault
+// domain are treated as a domain of their own.
+func rankFaultDomains(members system.Members) map[ranklist.Rank]string {
+	domains := make(map[ranklist.Rank]string)
+	for _, m := range members {
+		if m.FaultDomain == nil || m.FaultDomain.Empty() {
+			domains[m.Rank] = "rank:" + m.Rank.String()
+			continue
+		}
+		domains[m.Rank] = m.FaultDomain.String()
+	}
+	return domains
+}
+
+// getPoolImpacts reports, for each pool with storage on the supplied ranks,
+// the predicted impact of those ranks becoming unavailable. Pool ranks whose
+// members are not joined are counted as already unavailable. Unavailable ranks
+// are grouped by node fault domain before being compared with the pool
+// redundancy factor, as losing any number of ranks in one domain costs a single
+// degree of redundancy.
+func (svc *mgmtSvc) getPoolImpacts(ctx context.Context, sys string, ranks *ranklist.RankSet) ([]*mgmtpb.PoolImpact, error) {
+	poolIDs, poolRanks, err := svc.getPoolsRanks(ranks)
+	if err != nil {
+		return nil, err
+	}
+
+	psList, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return nil, err
+	}
+	poolSvcs := make(map[string]*system.PoolService)
+	for _, ps := range psList {
+		poolID := ps.PoolLabel
+		if poolID == "" {
+			poolID = ps.PoolUUID.String()
+		}
+		poolSvcs[poolID] = ps
+	}
+
+	members, err := svc.membership.Members(nil)
+	if err != nil {
+		return nil, err
+	}
+	selected := make(map[ranklist.Rank]struct{})
+	for _, r := range ranks.Ranks() {
+		selected[r] = struct{}{}
+	}
+	rankDomains := rankFaultDomains(members)
+	downRanks := make(map[ranklist.Rank]struct{})
+	for _, m := range members {
+		if _, exists := selected[m.Rank]; !exists && m.State != system.MemberStateJoined {
+			downRanks[m.Rank] = struct{}{}
+		}
+	}
+
+	impacts := []*mgmtpb.PoolImpact{}
+	for _, id := range poolIDs {
+		ps, found := poolSvcs[id]
+		if !found {
+			return nil, errors.Errorf("pool %s not found", id)
+		}
+
+		down := ranklist.MustCreateRankSet("")
+		failedDomains := make(map[string]struct{})
+		for _, r := range ps.Storage.CurrentRanks() {
+			_, isSelected := selected[r]
+			_, isDown := downRanks[r]
+			if isDown {
+				down.Add(r)
+			}
+			if isSelected || isDown {
+				domain, found := rankDomains[r]
+				if !found {
+					domain = "rank:" + r.String()
+				}
+				failedDomains[domain] = struct{}{}
+			}
+		}
+
+		svcLost := ranklist.MustCreateRankSet("")
+		svcDown := 0
+		for _, r := range ps.Replicas {
+			if _, exists := selected[r]; exists {
+				svcLost.Add(r)
+			} else if _, exists := downRanks[r]; exists {
+				svcDown++
+			}
+		}
+		svcAvail := len(ps.Replicas) - svcLost.Count() - svcDown
+
+		impact := &mgmtpb.PoolImpact{
+			PoolId:          id,
+			Ranks:           poolRanks[id].String(),
+			DownRanks:       down.String(),
+			SvcRanks:        svcLost.String(),
+			SvcReplicas:     uint32(len(ps.Replicas)),
+			SvcMajorityLost: svcLost.Count() > 0 && svcAvail < len(ps.Replicas)/2+1,
+		}
+
+		rf, err := svc.getPoolRedunFac(ctx, sys, ps)
+		if err != nil {
+			svc.log.Errorf("pool %s: failed to get redundancy factor: %s", id, err)
+			impact.Msg = errors.Wrap(err, "failed to get redundancy factor").Error()
+		} else {
+			impact.RedundancyFactor = rf
+			impact.RfBroken = uint32(len(failedDomains)) > rf
+			impact.Degraded = !impact.RfBroken && len(failedDomains) > 0
+		}
+
+		impacts = append(impacts, impact)
+	}
+
+	return impacts, nil
+}
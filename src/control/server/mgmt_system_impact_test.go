@@ -0,0 +1,353 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	uuid "github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockRedunFacResp(rf uint64) *mgmtpb.PoolGetPropResp {
+	return &mgmtpb.PoolGetPropResp{
+		Properties: []*mgmtpb.PoolProperty{
+			{
+				Number: uint32(daos.PoolPropertyRedunFac),
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: rf},
+			},
+		},
+	}
+}
+
+// mockNodeMember returns a member whose fault domain is its node, as reported
+// by a real engine.
+func mockNodeMember(t *testing.T, r, a int32, s string) *system.Member {
+	t.Helper()
+
+	m := mockMember(t, r, a, s)
+	m.FaultDomain = system.MustCreateFaultDomain(test.MockHostAddr(a).String())
+
+	return m
+}
+
+func TestServer_MgmtSvc_getPoolImpacts(t *testing.T) {
+	type testPool struct {
+		ranks    string
+		replicas []ranklist.Rank
+	}
+
+	for name, tc := range map[string]struct {
+		members    system.Members
+		ranks      string
+		pools      map[string]testPool
+		drpcResp   proto.Message
+		expImpacts []*mgmtpb.PoolImpact
+	}{
+		"no matching pools": {
+			ranks: "0-1",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "2-5", replicas: []ranklist.Rank{2, 3, 4}},
+			},
+			drpcResp:   mockRedunFacResp(1),
+			expImpacts: []*mgmtpb.PoolImpact{},
+		},
+		"degraded within rf": {
+			ranks: "0",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "0-4", replicas: []ranklist.Rank{0, 1, 2}},
+			},
+			drpcResp: mockRedunFacResp(1),
+			expImpacts: []*mgmtpb.PoolImpact{
+				{
+					PoolId:           test.MockUUID(1),
+					Ranks:            "0",
+					RedundancyFactor: 1,
+					SvcRanks:         "0",
+					SvcReplicas:      3,
+					Degraded:         true,
+				},
+			},
+		},
+		"rf broken; svc majority lost": {
+			ranks: "0-1",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "0-4", replicas: []ranklist.Rank{0, 1, 2}},
+				test.MockUUID(2): {ranks: "1-7", replicas: []ranklist.Rank{5, 6, 7}},
+			},
+			drpcResp: mockRedunFacResp(1),
+			expImpacts: []*mgmtpb.PoolImpact{
+				{
+					PoolId:           test.MockUUID(1),
+					Ranks:            "0-1",
+					RedundancyFactor: 1,
+					SvcRanks:         "0-1",
+					SvcReplicas:      3,
+					RfBroken:         true,
+					SvcMajorityLost:  true,
+				},
+				{
+					PoolId:           test.MockUUID(2),
+					Ranks:            "1",
+					RedundancyFactor: 1,
+					SvcReplicas:      3,
+					Degraded:         true,
+				},
+			},
+		},
+		"ranks in one fault domain within rf": {
+			members: system.Members{
+				mockNodeMember(t, 0, 1, "joined"),
+				mockNodeMember(t, 1, 1, "joined"),
+				mockNodeMember(t, 2, 2, "joined"),
+				mockNodeMember(t, 3, 2, "joined"),
+				mockNodeMember(t, 4, 3, "joined"),
+				mockNodeMember(t, 5, 3, "joined"),
+			},
+			ranks: "0-1",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "0-5", replicas: []ranklist.Rank{1, 2, 4}},
+			},
+			drpcResp: mockRedunFacResp(1),
+			expImpacts: []*mgmtpb.PoolImpact{
+				{
+					PoolId:           test.MockUUID(1),
+					Ranks:            "0-1",
+					RedundancyFactor: 1,
+					SvcRanks:         "1",
+					SvcReplicas:      3,
+					Degraded:         true,
+				},
+			},
+		},
+		"ranks in two fault domains exceed rf": {
+			members: system.Members{
+				mockNodeMember(t, 0, 1, "joined"),
+				mockNodeMember(t, 1, 1, "joined"),
+				mockNodeMember(t, 2, 2, "stopped"),
+				mockNodeMember(t, 3, 2, "joined"),
+			},
+			ranks: "1",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "0-3", replicas: []ranklist.Rank{0}},
+			},
+			drpcResp: mockRedunFacResp(1),
+			expImpacts: []*mgmtpb.PoolImpact{
+				{
+					PoolId:           test.MockUUID(1),
+					Ranks:            "1",
+					DownRanks:        "2",
+					RedundancyFactor: 1,
+					SvcReplicas:      1,
+					RfBroken:         true,
+				},
+			},
+		},
+		"no redundancy": {
+			ranks: "3",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "0-4", replicas: []ranklist.Rank{0}},
+			},
+			drpcResp: mockRedunFacResp(0),
+			expImpacts: []*mgmtpb.PoolImpact{
+				{
+					PoolId:      test.MockUUID(1),
+					Ranks:       "3",
+					SvcReplicas: 1,
+					RfBroken:    true,
+				},
+			},
+		},
+		"already unavailable ranks count towards rf": {
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "stopped"),
+				mockMember(t, 3, 2, "joined"),
+			},
+			ranks: "0",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "0-3", replicas: []ranklist.Rank{0, 1, 2}},
+			},
+			drpcResp: mockRedunFacResp(1),
+			expImpacts: []*mgmtpb.PoolImpact{
+				{
+					PoolId:           test.MockUUID(1),
+					Ranks:            "0",
+					DownRanks:        "2",
+					RedundancyFactor: 1,
+					SvcRanks:         "0",
+					SvcReplicas:      3,
+					RfBroken:         true,
+					SvcMajorityLost:  true,
+				},
+			},
+		},
+		"redundancy factor unavailable": {
+			ranks: "0",
+			pools: map[string]testPool{
+				test.MockUUID(1): {ranks: "0-4", replicas: []ranklist.Rank{2}},
+			},
+			drpcResp: &mgmtpb.PoolGetPropResp{Status: int32(daos.TryAgain)},
+			expImpacts: []*mgmtpb.PoolImpact{
+				{
+					PoolId:      test.MockUUID(1),
+					Ranks:       "0",
+					SvcReplicas: 1,
+					Msg:         "failed to get redundancy factor: " + daos.TryAgain.Error(),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.members == nil {
+				tc.members = system.Members{
+					mockMember(t, 0, 1, "joined"),
+					mockMember(t, 1, 2, "joined"),
+					mockMember(t, 2, 2, "joined"),
+					mockMember(t, 3, 1, "joined"),
+					mockMember(t, 4, 3, "joined"),
+					mockMember(t, 5, 3, "joined"),
+					mockMember(t, 6, 4, "joined"),
+					mockMember(t, 7, 4, "joined"),
+				}
+			}
+			svc := mgmtSystemTestSetup(t, log, tc.members, nil)
+
+			for uuidStr, tp := range tc.pools {
+				addTestPoolService(t, svc.sysdb, &system.PoolService{
+					PoolUUID: uuid.MustParse(uuidStr),
+					State:    system.PoolServiceStateReady,
+					Storage: &system.PoolServiceStorage{
+						CurrentRankStr: tp.ranks,
+					},
+					Replicas: tp.replicas,
+				})
+			}
+			setupMockDrpcClient(svc, tc.drpcResp, nil)
+
+			gotImpacts, err := svc.getPoolImpacts(test.Context(t), build.DefaultSystemName,
+				ranklist.MustCreateRankSet(tc.ranks))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expImpacts, gotImpacts, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected impacts (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemDryRun(t *testing.T) {
+	expImpacts := []*mgmtpb.PoolImpact{
+		{
+			PoolId:           test.MockUUID(1),
+			Ranks:            "1",
+			RedundancyFactor: 2,
+			SvcReplicas:      1,
+			Degraded:         true,
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		stopReq    *mgmtpb.SystemStopReq
+		excludeReq *mgmtpb.SystemExcludeReq
+		drainReq   *mgmtpb.SystemDrainReq
+		expErr     error
+	}{
+		"stop": {
+			stopReq: &mgmtpb.SystemStopReq{Ranks: "1", DryRun: true},
+		},
+		"exclude": {
+			excludeReq: &mgmtpb.SystemExcludeReq{Ranks: "1", DryRun: true},
+		},
+		"exclude clear": {
+			excludeReq: &mgmtpb.SystemExcludeReq{Ranks: "1", Clear: true, DryRun: true},
+			expErr:     errors.New("not supported when clearing"),
+		},
+		"drain": {
+			drainReq: &mgmtpb.SystemDrainReq{Ranks: "1", DryRun: true},
+		},
+		"reintegrate": {
+			drainReq: &mgmtpb.SystemDrainReq{Ranks: "1", Reint: true, DryRun: true},
+			expErr:   errors.New("not supported for reintegration"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 2, "joined"),
+			}, nil)
+			addTestPoolService(t, svc.sysdb, &system.PoolService{
+				PoolUUID: uuid.MustParse(test.MockUUID(1)),
+				State:    system.PoolServiceStateReady,
+				Storage: &system.PoolServiceStorage{
+					CurrentRankStr: "0-1",
+				},
+				Replicas: []ranklist.Rank{0},
+			})
+			setupMockDrpcClient(svc, mockRedunFacResp(2), nil)
+
+			ctx := test.MustLogContext(t, log)
+			var gotImpacts []*mgmtpb.PoolImpact
+			var gotErr error
+			switch {
+			case tc.stopReq != nil:
+				tc.stopReq.Sys = build.DefaultSystemName
+				var resp *mgmtpb.SystemStopResp
+				resp, gotErr = svc.SystemStop(ctx, tc.stopReq)
+				gotImpacts = resp.GetPoolImpacts()
+				test.AssertEqual(t, 0, len(resp.GetResults()), "unexpected stop results")
+			case tc.excludeReq != nil:
+				tc.excludeReq.Sys = build.DefaultSystemName
+				var resp *mgmtpb.SystemExcludeResp
+				resp, gotErr = svc.SystemExclude(ctx, tc.excludeReq)
+				gotImpacts = resp.GetPoolImpacts()
+				test.AssertEqual(t, 0, len(resp.GetResults()), "unexpected exclude results")
+			case tc.drainReq != nil:
+				tc.drainReq.Sys = build.DefaultSystemName
+				var resp *mgmtpb.SystemDrainResp
+				resp, gotErr = svc.SystemDrain(ctx, tc.drainReq)
+				gotImpacts = resp.GetPoolImpacts()
+				test.AssertEqual(t, 0, len(resp.GetResults()), "unexpected drain results")
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(expImpacts, gotImpacts, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected impacts (-want, +got):\n%s\n", diff)
+			}
+
+			// Member states must be unchanged by a dry run.
+			m, err := svc.sysdb.FindMemberByRank(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, system.MemberStateJoined, m.State, "unexpected member state")
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -150,6 +151,9 @@ type server struct {
 	pubSub       *events.PubSub
 	evtForwarder *control.EventForwarder
 	evtLogger    *control.EventLogger
+	evtSinks     []*events.Sink
+	evtHistory   *eventHistoryRecorder
+	ctlCollector *promexp.ControlCollector
 	ctlSvc       *ControlService
 	mgmtSvc      *mgmtSvc
 	grpcServer   *grpc.Server
@@ -202,6 +206,15 @@ func CreateDatabaseConfig(cfg *config.Server) (*raft.DatabaseConfig, error) {
 		return nil, errors.New("raft directory not available (missing SCM or control metadata in config?)")
 	}
 
+	// A replica set changed at runtime takes precedence over the config.
+	persisted, err := raft.ReadPersistedReplicas(raftDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(persisted) > 0 {
+		dbReplicas = persisted
+	}
+
 	return &raft.DatabaseConfig{
 		Replicas:   dbReplicas,
 		RaftDir:    raftDir,
@@ -242,10 +255,27 @@ func (srv *server) createServices(ctx context.Context) (err error) {
 	srv.OnShutdown(srv.pubSub.Close)
 	srv.evtForwarder = control.NewEventForwarder(rpcClient, srv.cfg.MgmtSvcReplicas)
 	srv.evtLogger = control.NewEventLogger(srv.log)
+	srv.evtHistory = newEventHistoryRecorder(ctx, srv.log, srv.sysdb)
+
+	for _, sinkCfg := range srv.cfg.EventSinks {
+		sink, err := events.NewSink(ctx, srv.log, sinkCfg)
+		if err != nil {
+			return errors.Wrapf(err, "creating event sink %q", sinkCfg.Name)
+		}
+		srv.evtSinks = append(srv.evtSinks, sink)
+	}
+
+	if srv.cfg.TelemetryPort != 0 {
+		srv.ctlCollector, err = promexp.NewControlCollector(srv.log, srv.sysdb)
+		if err != nil {
+			return errors.Wrap(err, "creating control plane metrics collector")
+		}
+	}
 
 	srv.ctlSvc = NewControlService(srv.log, srv.harness, srv.cfg, srv.pubSub,
 		network.DefaultFabricScanner(srv.log))
 	srv.mgmtSvc = newMgmtSvc(srv.harness, srv.membership, srv.sysdb, rpcClient, srv.pubSub)
+	srv.mgmtSvc.backupCfg = srv.cfg.MgmtSvcBackup
 
 	if err := srv.mgmtSvc.systemProps.UpdateCompPropVal(daos.SystemPropertyDaosSystem, func() string {
 		return srv.cfg.SystemName
@@ -346,6 +376,8 @@ func (srv *server) createEngine(ctx context.Context, idx int, cfg *engine.Config
 func (srv *server) addEngines(ctx context.Context) error {
 	var allStarted sync.WaitGroup
 	registerTelemetryCallbacks(ctx, srv)
+	registerNvmeHealthCallbacks(srv)
+	registerNvmeFaultCallbacks(srv)
 
 	iommuEnabled, err := topology.DefaultIOMMUDetector(srv.log).IsIOMMUEnabled()
 	if err != nil {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -662,7 +663,8 @@ func registerTelemetryCallbacks(ctx context.Context, srv *server) {
 
 	srv.OnEnginesStarted(func(ctxIn context.Context) error {
 		srv.log.Debug("starting Prometheus exporter")
-		cleanup, err := startPrometheusExporter(ctxIn, srv.log, telemPort, srv.harness.Instances())
+		cleanup, err := startPrometheusExporter(ctxIn, srv.log, telemPort, srv.harness.Instances(),
+			srv.ctlCollector)
 		if err != nil {
 			return err
 		}
@@ -671,14 +673,90 @@ func registerTelemetryCallbacks(ctx context.Context, srv *server) {
 	})
 }
 
+// cfgHaveBdevs returns true if any engine is configured with NVMe.
+func cfgHaveBdevs(cfg *config.Server) bool {
+	for _, ec := range cfg.Engines {
+		if ec.Storage.Tiers.HaveBdevs() {
+			return true
+		}
+	}
+	return false
+}
+
+// registerNvmeHealthCallbacks starts periodic sampling of NVMe device health
+// when all engines have been started, if any engine is configured with NVMe.
+func registerNvmeHealthCallbacks(srv *server) {
+	if !cfgHaveBdevs(srv.cfg) {
+		return
+	}
+
+	srv.OnEnginesStarted(func(ctxIn context.Context) error {
+		go srv.ctlSvc.nvmeHealthLoop(ctxIn)
+		return nil
+	})
+}
+
+// registerNvmeFaultCallbacks starts periodic checks of NVMe device health
+// against the NVMe fault policy when all engines have been started, if any
+// engine is configured with NVMe. The policy may be enabled at runtime with
+// system properties so the checks are started even if the policy is disabled
+// in the server configuration.
+func registerNvmeFaultCallbacks(srv *server) {
+	if !cfgHaveBdevs(srv.cfg) {
+		return
+	}
+
+	getProps := func(ctx context.Context) (map[string]string, error) {
+		resp, err := control.SystemGetProp(ctx, srv.mgmtSvc.rpcClient, &control.SystemGetPropReq{
+			Keys: nvmeFaultPolicyProps,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		props := make(map[string]string)
+		for _, prop := range resp.Properties {
+			props[prop.Key.String()] = prop.Value.String()
+		}
+		return props, nil
+	}
+
+	srv.OnEnginesStarted(func(ctxIn context.Context) error {
+		go srv.ctlSvc.nvmeFaultLoop(ctxIn, getProps)
+		return nil
+	})
+}
+
 // registerFollowerSubscriptions stops handling received forwarded (in addition
 // to local) events and starts forwarding events to the new MS leader.
 // Log events on the host that they were raised (and first published) on.
 // This is the initial behavior before leadership has been determined.
 func registerFollowerSubscriptions(srv *server) {
 	srv.pubSub.Reset()
+	srv.mgmtSvc.evtStreamer.closeAll()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
-	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.evtForwarder)
+	// Forward all event types so that they can be streamed from the MS leader.
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtForwarder)
+	subscribeControlCollector(srv)
+}
+
+// subscribeControlCollector counts locally raised events in the control plane
+// metrics, if enabled.
+func subscribeControlCollector(srv *server) {
+	if srv.ctlCollector != nil {
+		srv.pubSub.Subscribe(events.RASTypeAny, srv.ctlCollector)
+	}
+}
+
+// muteMaintenanceEvents returns a handler that passes events to the supplied
+// handler unless they were raised by a rank that is in maintenance.
+func muteMaintenanceEvents(membership *system.Membership, hdlr events.Handler) events.Handler {
+	return events.HandlerFunc(func(ctx context.Context, evt *events.RASEvent) {
+		if evt.Rank != uint32(ranklist.NilRank) && membership.InMaintenance(ranklist.Rank(evt.Rank)) {
+			return
+		}
+		hdlr.OnEvent(ctx, evt)
+	})
 }
 
 // registerLeaderSubscriptions stops forwarding events to MS and instead starts
@@ -686,8 +764,18 @@ func registerFollowerSubscriptions(srv *server) {
 func registerLeaderSubscriptions(srv *server) {
 	srv.pubSub.Reset()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.mgmtSvc.evtStreamer)
+	// Retain events in the MS event history so that they may be queried
+	// after the fact, e.g. following a leader failover.
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtHistory)
+	for _, sink := range srv.evtSinks {
+		srv.pubSub.Subscribe(events.RASTypeAny, muteMaintenanceEvents(srv.membership, sink))
+	}
+	subscribeControlCollector(srv)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.membership)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.sysdb)
+	// Record pool rebuild start/finish events in the pool rebuild history.
+	srv.pubSub.Subscribe(events.RASTypeInfoOnly, srv.sysdb)
 	srv.pubSub.Subscribe(events.RASTypeStateChange,
 		events.HandlerFunc(func(ctx context.Context, evt *events.RASEvent) {
 			switch evt.ID {
@@ -698,6 +786,12 @@ func registerLeaderSubscriptions(srv *server) {
 					return
 				}
 				srv.log.Debugf("%s marked rank %d:%x dead @ %s", evt.Hostname, evt.Rank, evt.Incarnation, ts)
+				// Ranks in maintenance are expected to go away; leave their
+				// state to the operator rather than marking them dead.
+				if srv.membership.InMaintenance(ranklist.Rank(evt.Rank)) {
+					srv.log.Debugf("ignoring rank dead event for rank %d in maintenance", evt.Rank)
+					return
+				}
 				// Mark the rank as unavailable for membership in
 				// new pools, etc. Do group update on success.
 				if err := srv.membership.MarkRankDead(ranklist.Rank(evt.Rank), evt.Incarnation); err != nil {
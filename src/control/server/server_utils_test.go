@@ -7,6 +7,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
@@ -20,7 +21,9 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 	sysprov "github.com/daos-stack/daos/src/control/provider/system"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -29,6 +32,7 @@ import (
 	"github.com/daos-stack/daos/src/control/server/storage/bdev"
 	"github.com/daos-stack/daos/src/control/server/storage/scm"
 	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
 )
 
 // basic engine configs populated enough to complete validation
@@ -1204,3 +1208,41 @@ f0: 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00
 		t.Fatalf("unexpected output format (-want, +got):\n%s\n", diff)
 	}
 }
+
+func TestServer_muteMaintenanceEvents(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := raft.MockDatabase(t, log)
+	membership := system.MockMembership(t, log, db, mockTCPResolver)
+	for _, m := range []*system.Member{
+		system.MockMember(t, 0, system.MemberStateJoined),
+		system.MockMember(t, 1, system.MemberStateJoined),
+	} {
+		if _, err := membership.Add(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := db.FindMemberByRank(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Maintenance = true
+	if err := db.UpdateMember(m); err != nil {
+		t.Fatal(err)
+	}
+
+	var gotRanks []uint32
+	hdlr := muteMaintenanceEvents(membership,
+		events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			gotRanks = append(gotRanks, evt.Rank)
+		}))
+
+	for _, rank := range []uint32{0, 1, uint32(ranklist.NilRank)} {
+		hdlr.OnEvent(test.Context(t), events.NewEngineDiedEvent("foo", 0, rank, common.NormalExit, 1234))
+	}
+
+	if diff := cmp.Diff([]uint32{0, uint32(ranklist.NilRank)}, gotRanks); diff != "" {
+		t.Fatalf("unexpected delivered events (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,230 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// mediaWearUnitsPerPct is the number of MediaWearRaw units in one percent of
+// rated device life.
+const mediaWearUnitsPerPct = 1024
+
+// maxEndOfLifeDays is the horizon beyond which no end of life date is
+// projected for a device. Very low wear rates would otherwise project dates
+// too far in the future to be meaningful, or to be represented as a duration.
+const maxEndOfLifeDays = 100 * 365
+
+type (
+	// NvmeHealthSample is a point-in-time record of the NVMe health statistics
+	// that are tracked over time to detect device degradation.
+	NvmeHealthSample struct {
+		// Timestamp is the time of the sample in seconds since the epoch.
+		Timestamp           uint64 `json:"timestamp"`
+		MediaErrors         uint64 `json:"media_errs"`
+		ReadErrors          uint32 `json:"bio_read_errs"`
+		WriteErrors         uint32 `json:"bio_write_errs"`
+		ChecksumErrors      uint32 `json:"checksum_errs"`
+		Temperature         uint32 `json:"temperature"`
+		AvailSpareWarn      bool   `json:"avail_spare_warn"`
+		WearLevelingCntNorm uint8  `json:"wear_leveling_cnt_norm"`
+		WearLevelingCntAvg  uint16 `json:"wear_leveling_cnt_avg"`
+		MediaWearRaw        uint64 `json:"media_wear_raw"`
+	}
+
+	// NvmeHealthHistory contains the health samples retained for an NVMe
+	// device, oldest first.
+	NvmeHealthHistory struct {
+		UUID    string              `json:"uuid"`
+		PciAddr string              `json:"pci_addr"`
+		Model   string              `json:"model"`
+		Serial  string              `json:"serial"`
+		Rank    ranklist.Rank       `json:"rank"`
+		Samples []*NvmeHealthSample `json:"samples,omitempty"`
+	}
+
+	// NvmeHealthTrend summarizes the change in the health of an NVMe device
+	// over the period covered by its health samples.
+	NvmeHealthTrend struct {
+		NumSamples int `json:"num_samples"`
+		// Period is the number of seconds covered by the health samples.
+		Period int64 `json:"period"`
+		// Error counter increases over the period.
+		MediaErrors    uint64 `json:"media_errs"`
+		ReadErrors     uint64 `json:"bio_read_errs"`
+		WriteErrors    uint64 `json:"bio_write_errs"`
+		ChecksumErrors uint64 `json:"checksum_errs"`
+		// MaxTemperature is the highest temperature sampled, in Kelvin.
+		MaxTemperature uint32 `json:"max_temperature"`
+		// AvailSpareWarn is set if the most recent sample reported that the
+		// available spare capacity is below threshold.
+		AvailSpareWarn bool `json:"avail_spare_warn"`
+		// LifeUsed is the estimated percentage of rated device life consumed.
+		LifeUsed float64 `json:"life_used"`
+		// WearRate is the percentage of rated device life consumed per day.
+		WearRate float64 `json:"wear_rate"`
+		// DaysUntilWornOut is negative if device wear is not increasing.
+		DaysUntilWornOut float64 `json:"days_until_worn_out"`
+		// EndOfLife is the projected time at which the device will have
+		// consumed its rated life, if known and within maxEndOfLifeDays.
+		EndOfLife *time.Time `json:"end_of_life,omitempty"`
+	}
+)
+
+// NewNvmeHealthSample returns a sample of the given health statistics taken at
+// the given time.
+func NewNvmeHealthSample(t time.Time, health *NvmeHealth) *NvmeHealthSample {
+	return &NvmeHealthSample{
+		Timestamp:           uint64(t.Unix()),
+		MediaErrors:         health.MediaErrors,
+		ReadErrors:          health.ReadErrors,
+		WriteErrors:         health.WriteErrors,
+		ChecksumErrors:      health.ChecksumErrors,
+		Temperature:         health.Temperature,
+		AvailSpareWarn:      health.AvailSpareWarn,
+		WearLevelingCntNorm: health.WearLevelingCntNorm,
+		WearLevelingCntAvg:  health.WearLevelingCntAvg,
+		MediaWearRaw:        health.MediaWearRaw,
+	}
+}
+
+// Time returns the time at which the sample was taken.
+func (nhs *NvmeHealthSample) Time() time.Time {
+	return time.Unix(int64(nhs.Timestamp), 0)
+}
+
+// lifeUsed returns the estimated percentage of rated device life consumed.
+// The normalized wear leveling count is preferred as it covers the lifetime
+// of the device, whereas the media wear counter is reset with the workload
+// timer.
+func (nhs *NvmeHealthSample) lifeUsed() float64 {
+	if nhs.WearLevelingCntNorm > 0 || nhs.WearLevelingCntAvg > 0 {
+		if nhs.WearLevelingCntNorm > 100 {
+			return 0
+		}
+		return float64(100 - nhs.WearLevelingCntNorm)
+	}
+	return float64(nhs.MediaWearRaw) / mediaWearUnitsPerPct
+}
+
+// ratedCycles returns the number of erase cycles the device is rated for, as
+// implied by the average erase count and the normalized wear leveling count.
+func (nhs *NvmeHealthSample) ratedCycles() float64 {
+	if nhs.WearLevelingCntAvg == 0 || nhs.WearLevelingCntNorm >= 100 {
+		return 0
+	}
+	return float64(nhs.WearLevelingCntAvg) * 100 / float64(100-nhs.WearLevelingCntNorm)
+}
+
+// counterIncrease returns the total increase of a counter over the samples.
+// Counters that are reset, e.g. on engine restart, are assumed to have
+// restarted from zero.
+func counterIncrease(samples []*NvmeHealthSample, val func(*NvmeHealthSample) uint64) uint64 {
+	var total uint64
+	for i := 1; i < len(samples); i++ {
+		prev, cur := val(samples[i-1]), val(samples[i])
+		if cur < prev {
+			total += cur
+			continue
+		}
+		total += cur - prev
+	}
+	return total
+}
+
+// slopePerDay returns the least-squares fit of the value over time in units
+// per day.
+func slopePerDay(samples []*NvmeHealthSample, val func(*NvmeHealthSample) float64) float64 {
+	start := samples[0].Time()
+
+	var n, sumX, sumY, sumXY, sumXX float64
+	for _, s := range samples {
+		x := s.Time().Sub(start).Hours() / 24
+		y := val(s)
+		n++
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denom := n*sumXX - sumX*sumX
+	if n < 2 || denom == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denom
+}
+
+// CalcNvmeHealthTrend summarizes the supplied time-ordered health samples of
+// a device. The rate of wear is calculated from a least-squares fit of the
+// average erase count over time, relative to the rated number of erase
+// cycles implied by the normalized wear leveling count. If the device does
+// not report wear leveling counts then the fit of the media wear counter is
+// used instead. The wear rate is used to project when the device will have
+// consumed its rated life. At least two samples spanning a non-zero period
+// are required to calculate a wear rate.
+func CalcNvmeHealthTrend(samples []*NvmeHealthSample) *NvmeHealthTrend {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	latest := samples[len(samples)-1]
+	trend := &NvmeHealthTrend{
+		NumSamples:       len(samples),
+		Period:           int64(latest.Time().Sub(samples[0].Time()).Seconds()),
+		AvailSpareWarn:   latest.AvailSpareWarn,
+		LifeUsed:         latest.lifeUsed(),
+		DaysUntilWornOut: -1,
+	}
+
+	trend.MediaErrors = counterIncrease(samples, func(s *NvmeHealthSample) uint64 {
+		return s.MediaErrors
+	})
+	trend.ReadErrors = counterIncrease(samples, func(s *NvmeHealthSample) uint64 {
+		return uint64(s.ReadErrors)
+	})
+	trend.WriteErrors = counterIncrease(samples, func(s *NvmeHealthSample) uint64 {
+		return uint64(s.WriteErrors)
+	})
+	trend.ChecksumErrors = counterIncrease(samples, func(s *NvmeHealthSample) uint64 {
+		return uint64(s.ChecksumErrors)
+	})
+	for _, s := range samples {
+		if s.Temperature > trend.MaxTemperature {
+			trend.MaxTemperature = s.Temperature
+		}
+	}
+
+	if trend.Period <= 0 {
+		return trend
+	}
+
+	if rated := latest.ratedCycles(); rated > 0 {
+		trend.WearRate = slopePerDay(samples, func(s *NvmeHealthSample) float64 {
+			return float64(s.WearLevelingCntAvg)
+		}) * 100 / rated
+	} else {
+		trend.WearRate = slopePerDay(samples, func(s *NvmeHealthSample) float64 {
+			return float64(s.MediaWearRaw)
+		}) / mediaWearUnitsPerPct
+	}
+
+	if trend.WearRate > 0 {
+		trend.DaysUntilWornOut = 0
+		if trend.LifeUsed < 100 {
+			trend.DaysUntilWornOut = (100 - trend.LifeUsed) / trend.WearRate
+		}
+		if trend.DaysUntilWornOut <= maxEndOfLifeDays {
+			eol := latest.Time().Add(time.Duration(trend.DaysUntilWornOut * 24 * float64(time.Hour)))
+			trend.EndOfLife = &eol
+		}
+	}
+
+	return trend
+}
@@ -0,0 +1,168 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestStorage_CalcNvmeHealthTrend(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) uint64 {
+		return uint64(start.Add(time.Duration(d) * 24 * time.Hour).Unix())
+	}
+	eol := func(d int) *time.Time {
+		t := start.Add(time.Duration(d) * 24 * time.Hour)
+		return &t
+	}
+
+	for name, tc := range map[string]struct {
+		samples  []*NvmeHealthSample
+		expTrend *NvmeHealthTrend
+	}{
+		"no samples": {},
+		"single sample": {
+			samples: []*NvmeHealthSample{
+				{
+					Timestamp:           day(0),
+					Temperature:         300,
+					WearLevelingCntNorm: 95,
+					WearLevelingCntAvg:  50,
+				},
+			},
+			expTrend: &NvmeHealthTrend{
+				NumSamples:       1,
+				MaxTemperature:   300,
+				LifeUsed:         5,
+				DaysUntilWornOut: -1,
+			},
+		},
+		"wear leveling": {
+			samples: []*NvmeHealthSample{
+				{Timestamp: day(0), WearLevelingCntNorm: 92, WearLevelingCntAvg: 80},
+				{Timestamp: day(10), WearLevelingCntNorm: 91, WearLevelingCntAvg: 90},
+				{Timestamp: day(20), WearLevelingCntNorm: 90, WearLevelingCntAvg: 100},
+			},
+			// 1000 rated cycles, 1 cycle per day.
+			expTrend: &NvmeHealthTrend{
+				NumSamples:       3,
+				Period:           20 * 24 * 60 * 60,
+				LifeUsed:         10,
+				WearRate:         0.1,
+				DaysUntilWornOut: 900,
+				EndOfLife:        eol(920),
+			},
+		},
+		"media wear": {
+			samples: []*NvmeHealthSample{
+				{Timestamp: day(0), MediaWearRaw: 10 * 1024},
+				{Timestamp: day(10), MediaWearRaw: 12 * 1024},
+			},
+			expTrend: &NvmeHealthTrend{
+				NumSamples:       2,
+				Period:           10 * 24 * 60 * 60,
+				LifeUsed:         12,
+				WearRate:         0.2,
+				DaysUntilWornOut: 440,
+				EndOfLife:        eol(450),
+			},
+		},
+		"worn out": {
+			samples: []*NvmeHealthSample{
+				{Timestamp: day(0), MediaWearRaw: 98 * 1024},
+				{Timestamp: day(10), MediaWearRaw: 101 * 1024},
+			},
+			expTrend: &NvmeHealthTrend{
+				NumSamples:       2,
+				Period:           10 * 24 * 60 * 60,
+				LifeUsed:         101,
+				WearRate:         0.3,
+				DaysUntilWornOut: 0,
+				EndOfLife:        eol(10),
+			},
+		},
+		"wear too slow to project end of life": {
+			samples: []*NvmeHealthSample{
+				{Timestamp: day(0), MediaWearRaw: 0},
+				{Timestamp: day(1024), MediaWearRaw: 1},
+			},
+			// One unit in 1024 days, too far out to be held in a
+			// time.Duration.
+			expTrend: &NvmeHealthTrend{
+				NumSamples:       2,
+				Period:           1024 * 24 * 60 * 60,
+				LifeUsed:         1.0 / 1024,
+				WearRate:         1.0 / (1024 * 1024),
+				DaysUntilWornOut: 100*1024*1024 - 1024,
+			},
+		},
+		"no wear": {
+			samples: []*NvmeHealthSample{
+				{Timestamp: day(0), WearLevelingCntNorm: 90, WearLevelingCntAvg: 100},
+				{Timestamp: day(10), WearLevelingCntNorm: 90, WearLevelingCntAvg: 100},
+			},
+			expTrend: &NvmeHealthTrend{
+				NumSamples:       2,
+				Period:           10 * 24 * 60 * 60,
+				LifeUsed:         10,
+				DaysUntilWornOut: -1,
+			},
+		},
+		"error counters with reset": {
+			samples: []*NvmeHealthSample{
+				{
+					Timestamp:   day(0),
+					MediaErrors: 1,
+					ReadErrors:  5,
+					Temperature: 310,
+				},
+				{
+					Timestamp:      day(1),
+					MediaErrors:    1,
+					ReadErrors:     8,
+					WriteErrors:    1,
+					Temperature:    330,
+					AvailSpareWarn: true,
+				},
+				{
+					Timestamp:      day(2),
+					MediaErrors:    4,
+					ReadErrors:     2,
+					WriteErrors:    1,
+					ChecksumErrors: 3,
+					Temperature:    320,
+				},
+			},
+			expTrend: &NvmeHealthTrend{
+				NumSamples:       3,
+				Period:           2 * 24 * 60 * 60,
+				MediaErrors:      3,
+				ReadErrors:       5,
+				WriteErrors:      1,
+				ChecksumErrors:   3,
+				MaxTemperature:   330,
+				DaysUntilWornOut: -1,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTrend := CalcNvmeHealthTrend(tc.samples)
+
+			cmpOpts := []cmp.Option{
+				cmpopts.EquateApprox(0, 1e-9),
+				cmpopts.EquateApproxTime(time.Second),
+			}
+			if diff := cmp.Diff(tc.expTrend, gotTrend, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected trend (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -406,6 +407,14 @@ func (p *Provider) GetBdevConfigs() []*TierConfig {
 	return p.engineStorage.Tiers.BdevConfigs()
 }
 
+// GetNvmeConfigPath returns the path of the NVMe config file written for the engine.
+func (p *Provider) GetNvmeConfigPath() string {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.engineStorage.ConfigOutputPath
+}
+
 // QueryScmFirmware queries PMem SSD firmware.
 func (p *Provider) QueryScmFirmware(req ScmFirmwareQueryRequest) (*ScmFirmwareQueryResponse, error) {
 	return p.scm.QueryFirmware(req)
@@ -68,11 +68,16 @@ func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Eng
 	return nil
 }
 
-func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine) (func(), error) {
+func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine, ctlCollector *promexp.ControlCollector) (func(), error) {
 	expCfg := &promexp.ExporterConfig{
 		Port:  port,
 		Title: "DAOS Engine Telemetry",
 		Register: func(ctx context.Context, log logging.Logger) error {
+			if ctlCollector != nil {
+				if err := prometheus.Register(ctlCollector); err != nil {
+					return errors.Wrap(err, "registering control plane metrics")
+				}
+			}
 			return regPromEngineSources(ctx, log, engines)
 		},
 	}
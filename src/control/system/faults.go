@@ -33,6 +33,14 @@ func FaultPoolLocked(poolUUID, lockID uuid.UUID, lockTime time.Time) *fault.Faul
 		"retry the pool operation")
 }
 
+// FaultQuotaExceeded generates a fault indicating that a pool operation would
+// exceed a tenant quota.
+func FaultQuotaExceeded(principal, resource, limit, requested string) *fault.Fault {
+	return systemFault(code.SystemQuotaExceeded,
+		fmt.Sprintf("%s quota for %s exceeded (limit: %s, requested: %s)", resource, principal, limit, requested),
+		"reduce the size or number of pools owned by the tenant, or raise its quota with dmg system quota set")
+}
+
 func systemFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "system",
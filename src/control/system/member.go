@@ -201,6 +201,9 @@ type Member struct {
 	Info                    string        `json:"info"`
 	FaultDomain             *FaultDomain  `json:"fault_domain"`
 	LastUpdate              time.Time     `json:"last_update"`
+	// Maintenance indicates that the member's host has been placed in
+	// maintenance by an administrator and that failures are expected.
+	Maintenance bool `json:"maintenance"`
 }
 
 // MarshalJSON marshals system.Member to JSON.
@@ -580,7 +580,12 @@ func (m *Membership) handleEngineFailure(evt *events.RASEvent) {
 	//
 	// e.g. if member.Addr.IP.Equal(net.ResolveIPAddr(evt.Hostname))
 
+	// An engine exit is expected on a host in maintenance so mark the
+	// member as stopped rather than errored.
 	newState := MemberStateErrored
+	if member.Maintenance {
+		newState = MemberStateStopped
+	}
 	if member.State.isTransitionIllegal(newState) {
 		m.log.Debugf("skipping %s", msgBadStateTransition(member, newState))
 		return
@@ -598,9 +603,27 @@ func (m *Membership) handleEngineFailure(evt *events.RASEvent) {
 	if evt.Msg != "" {
 		msg = fmt.Sprintf(" (%s)", evt.Msg)
 	}
+	if member.Maintenance {
+		m.log.Noticef("rank %d (maintenance): %s->%s%s", member.Rank, oldState, newState, msg)
+		return
+	}
 	m.log.Errorf("rank %d: %s->%s%s", member.Rank, oldState, newState, msg)
 }
 
+// InMaintenance returns true if the member with the supplied rank has been
+// placed in maintenance.
+func (m *Membership) InMaintenance(rank Rank) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	member, err := m.db.FindMemberByRank(rank)
+	if err != nil {
+		return false
+	}
+
+	return member.Maintenance
+}
+
 // OnEvent handles events on channel and updates member states accordingly.
 func (m *Membership) OnEvent(_ context.Context, evt *events.RASEvent) {
 	switch evt.ID {
@@ -940,6 +940,11 @@ func TestSystem_Membership_OnEvent(t *testing.T) {
 		MockMember(t, 2, MemberStateStopped),
 		MockMember(t, 3, MemberStateExcluded),
 	}
+	maintMember := func(state MemberState) *Member {
+		m := MockMember(t, 1, state)
+		m.Maintenance = true
+		return m
+	}
 
 	for name, tc := range map[string]struct {
 		members    Members
@@ -968,6 +973,19 @@ func TestSystem_Membership_OnEvent(t *testing.T) {
 				MockMember(t, 3, MemberStateExcluded),
 			},
 		},
+		"member in maintenance stopped on unscheduled exit": {
+			members: Members{
+				MockMember(t, 0, MemberStateJoined),
+				maintMember(MemberStateJoined),
+			},
+			event: mockEvtEngineDied(t, 1),
+			expMembers: Members{
+				MockMember(t, 0, MemberStateJoined),
+				maintMember(MemberStateStopped).WithInfo(
+					errors.Wrap(common.NormalExit,
+						"DAOS engine 0 exited unexpectedly").Error()),
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1316,3 +1334,16 @@ func TestSystem_Membership_CompressedFaultDomainTree(t *testing.T) {
 		})
 	}
 }
+
+func TestSystem_Membership_InMaintenance(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)
+
+	maint := MockMember(t, 1, MemberStateJoined)
+	maint.Maintenance = true
+	ms := populateMembership(t, log, MockMember(t, 0, MemberStateJoined), maint)
+
+	test.AssertFalse(t, ms.InMaintenance(0), "rank 0 should not be in maintenance")
+	test.AssertTrue(t, ms.InMaintenance(1), "rank 1 should be in maintenance")
+	test.AssertFalse(t, ms.InMaintenance(2), "unknown rank should not be in maintenance")
+}
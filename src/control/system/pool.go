@@ -43,14 +43,21 @@ type (
 		State      PoolServiceState
 		Replicas   []ranklist.Rank
 		Storage    *PoolServiceStorage
-		LastUpdate time.Time
+		OwnerUser  string // owner user principal, e.g. "bob@"
+		OwnerGroup string // owner group principal, e.g. "builders@"
+		// DestroyAfter is the time after which a pool that is pending
+		// destroy will be destroyed.
+		DestroyAfter time.Time
+		CreationTime time.Time
+		LastUpdate   time.Time
 	}
 )
 
 const (
-	PoolServiceStateCreating   = PoolServiceState(daos.PoolServiceStateCreating)
-	PoolServiceStateReady      = PoolServiceState(daos.PoolServiceStateReady)
-	PoolServiceStateDestroying = PoolServiceState(daos.PoolServiceStateDestroying)
+	PoolServiceStateCreating       = PoolServiceState(daos.PoolServiceStateCreating)
+	PoolServiceStateReady          = PoolServiceState(daos.PoolServiceStateReady)
+	PoolServiceStateDestroying     = PoolServiceState(daos.PoolServiceStateDestroying)
+	PoolServiceStateDestroyPending = PoolServiceState(daos.PoolServiceStateDestroyPending)
 )
 
 func (pss PoolServiceState) String() string {
@@ -104,6 +111,16 @@ func (pss *PoolServiceStorage) CurrentRanks() []ranklist.Rank {
 	return pss.currentRanks.Ranks()
 }
 
+// SetCurrentRanks updates the set of target ranks associated
+// with the pool.
+func (pss *PoolServiceStorage) SetCurrentRanks(ranks []ranklist.Rank) {
+	pss.Lock()
+	defer pss.Unlock()
+
+	pss.currentRanks = ranklist.RankSetFromRanks(ranks)
+	pss.CurrentRankStr = pss.currentRanks.RangedString()
+}
+
 // TotalSCM returns the total amount of SCM storage allocated to
 // the pool, calculated from the current set of ranks multiplied
 // by the per-rank SCM allocation made at creation time.
@@ -0,0 +1,62 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"time"
+)
+
+// PoolRebuildRecord describes a single rebuild of a pool, as reported by the
+// pool rebuild RAS events.
+type PoolRebuildRecord struct {
+	Operation  string    `json:"operation"`
+	MapVersion uint32    `json:"map_version"`
+	Started    time.Time `json:"started"`
+	Finished   time.Time `json:"finished"`
+	Failed     bool      `json:"failed"`
+	Message    string    `json:"message,omitempty"`
+}
+
+// IsFinished returns true if the rebuild has finished or failed.
+func (prr *PoolRebuildRecord) IsFinished() bool {
+	return !prr.Finished.IsZero()
+}
+
+// Duration returns the duration of a finished rebuild, or zero if either
+// the start or finish time is unknown.
+func (prr *PoolRebuildRecord) Duration() time.Duration {
+	if prr.Started.IsZero() || prr.Finished.IsZero() {
+		return 0
+	}
+	return prr.Finished.Sub(prr.Started)
+}
+
+// UpdatePoolRebuildHistory applies the supplied record to the time-ordered
+// rebuild history of a pool. A finished record completes the most recent
+// unfinished record for the same operation and map version; otherwise the
+// record is appended, e.g. if the start of the rebuild was not recorded.
+func UpdatePoolRebuildHistory(history []*PoolRebuildRecord, update *PoolRebuildRecord) []*PoolRebuildRecord {
+	if update == nil {
+		return history
+	}
+
+	if update.IsFinished() {
+		for i := len(history) - 1; i >= 0; i-- {
+			cur := history[i]
+			if cur.IsFinished() || cur.Operation != update.Operation ||
+				cur.MapVersion != update.MapVersion {
+				continue
+			}
+			cur.Finished = update.Finished
+			cur.Failed = update.Failed
+			cur.Message = update.Message
+			return history
+		}
+	}
+
+	return append(history, update)
+}
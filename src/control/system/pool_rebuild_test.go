@@ -0,0 +1,118 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSystem_UpdatePoolRebuildHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	started := func(op string, ver uint32) *PoolRebuildRecord {
+		return &PoolRebuildRecord{Operation: op, MapVersion: ver, Started: start}
+	}
+	finished := func(op string, ver uint32, failed bool) *PoolRebuildRecord {
+		return &PoolRebuildRecord{Operation: op, MapVersion: ver, Finished: end, Failed: failed}
+	}
+
+	for name, tc := range map[string]struct {
+		history    []*PoolRebuildRecord
+		update     *PoolRebuildRecord
+		expHistory []*PoolRebuildRecord
+	}{
+		"nil update": {
+			history:    []*PoolRebuildRecord{started("Rebuild", 2)},
+			expHistory: []*PoolRebuildRecord{started("Rebuild", 2)},
+		},
+		"start added": {
+			history: []*PoolRebuildRecord{started("Rebuild", 2)},
+			update:  started("Reclaim", 3),
+			expHistory: []*PoolRebuildRecord{
+				started("Rebuild", 2),
+				started("Reclaim", 3),
+			},
+		},
+		"finish completes matching start": {
+			history: []*PoolRebuildRecord{
+				started("Rebuild", 2),
+				started("Rebuild", 3),
+			},
+			update: finished("Rebuild", 2, false),
+			expHistory: []*PoolRebuildRecord{
+				{Operation: "Rebuild", MapVersion: 2, Started: start, Finished: end},
+				started("Rebuild", 3),
+			},
+		},
+		"failure completes matching start": {
+			history: []*PoolRebuildRecord{started("Rebuild", 2)},
+			update: &PoolRebuildRecord{
+				Operation:  "Rebuild",
+				MapVersion: 2,
+				Finished:   end,
+				Failed:     true,
+				Message:    "Pool rebuild failed",
+			},
+			expHistory: []*PoolRebuildRecord{
+				{
+					Operation:  "Rebuild",
+					MapVersion: 2,
+					Started:    start,
+					Finished:   end,
+					Failed:     true,
+					Message:    "Pool rebuild failed",
+				},
+			},
+		},
+		"finish without start": {
+			history: []*PoolRebuildRecord{finished("Rebuild", 2, false)},
+			update:  finished("Rebuild", 2, true),
+			expHistory: []*PoolRebuildRecord{
+				finished("Rebuild", 2, false),
+				finished("Rebuild", 2, true),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotHistory := UpdatePoolRebuildHistory(tc.history, tc.update)
+
+			if diff := cmp.Diff(tc.expHistory, gotHistory); diff != "" {
+				t.Fatalf("unexpected history (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_PoolRebuildRecord_Duration(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		rec    *PoolRebuildRecord
+		expDur time.Duration
+	}{
+		"in progress": {
+			rec: &PoolRebuildRecord{Started: start},
+		},
+		"start unknown": {
+			rec: &PoolRebuildRecord{Finished: start},
+		},
+		"finished": {
+			rec:    &PoolRebuildRecord{Started: start, Finished: start.Add(90 * time.Second)},
+			expDur: 90 * time.Second,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expDur, tc.rec.Duration(), "unexpected duration")
+		})
+	}
+}
@@ -0,0 +1,100 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"time"
+)
+
+type (
+	// PoolTierUsage describes the space usage of a single storage tier.
+	PoolTierUsage struct {
+		Total uint64 `json:"total"`
+		Free  uint64 `json:"free"`
+	}
+
+	// PoolUsageSample records the space usage of each storage tier in a
+	// pool at a point in time.
+	PoolUsageSample struct {
+		Time  time.Time        `json:"time"`
+		Tiers []*PoolTierUsage `json:"tiers"`
+	}
+
+	// PoolTierForecast describes the growth of a storage tier and the
+	// projected time until the tier is full.
+	PoolTierForecast struct {
+		Total uint64 `json:"total"`
+		Free  uint64 `json:"free"`
+		// GrowthRate is the rate of change in used bytes per day.
+		GrowthRate float64 `json:"growth_rate"`
+		// DaysUntilFull is negative if the tier usage is not growing.
+		DaysUntilFull float64 `json:"days_until_full"`
+	}
+)
+
+// Used returns the number of used bytes in the tier.
+func (ptu *PoolTierUsage) Used() uint64 {
+	if ptu.Free > ptu.Total {
+		return 0
+	}
+	return ptu.Total - ptu.Free
+}
+
+// ForecastPoolUsage estimates the growth rate of each storage tier from the
+// supplied time-ordered samples using a least-squares fit of used bytes over
+// time. The free space in the most recent sample is used to project the
+// number of days until the tier is full. At least two samples spanning a
+// non-zero period are required to calculate a growth rate.
+func ForecastPoolUsage(samples []*PoolUsageSample) []*PoolTierForecast {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	latest := samples[len(samples)-1]
+	start := samples[0].Time
+	period := latest.Time.Sub(start)
+
+	forecasts := make([]*PoolTierForecast, 0, len(latest.Tiers))
+	for tierIdx, tier := range latest.Tiers {
+		ptf := &PoolTierForecast{
+			Total:         tier.Total,
+			Free:          tier.Free,
+			DaysUntilFull: -1,
+		}
+		forecasts = append(forecasts, ptf)
+
+		if len(samples) < 2 || period <= 0 {
+			continue
+		}
+
+		var n, sumX, sumY, sumXY, sumXX float64
+		for _, s := range samples {
+			if tierIdx >= len(s.Tiers) {
+				continue
+			}
+			x := s.Time.Sub(start).Hours() / 24
+			y := float64(s.Tiers[tierIdx].Used())
+			n++
+			sumX += x
+			sumY += y
+			sumXY += x * y
+			sumXX += x * x
+		}
+
+		denom := n*sumXX - sumX*sumX
+		if n < 2 || denom == 0 {
+			continue
+		}
+		ptf.GrowthRate = (n*sumXY - sumX*sumY) / denom
+
+		if ptf.GrowthRate > 0 {
+			ptf.DaysUntilFull = float64(tier.Free) / ptf.GrowthRate
+		}
+	}
+
+	return forecasts
+}
@@ -0,0 +1,90 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestSystem_ForecastPoolUsage(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	mockSample := func(offset time.Duration, tiers ...PoolTierUsage) *PoolUsageSample {
+		s := &PoolUsageSample{Time: start.Add(offset)}
+		for i := range tiers {
+			s.Tiers = append(s.Tiers, &tiers[i])
+		}
+		return s
+	}
+
+	for name, tc := range map[string]struct {
+		samples      []*PoolUsageSample
+		expForecasts []*PoolTierForecast
+	}{
+		"no samples": {},
+		"single sample": {
+			samples: []*PoolUsageSample{
+				mockSample(0, PoolTierUsage{Total: 100, Free: 50}),
+			},
+			expForecasts: []*PoolTierForecast{
+				{Total: 100, Free: 50, DaysUntilFull: -1},
+			},
+		},
+		"zero period": {
+			samples: []*PoolUsageSample{
+				mockSample(0, PoolTierUsage{Total: 100, Free: 60}),
+				mockSample(0, PoolTierUsage{Total: 100, Free: 50}),
+			},
+			expForecasts: []*PoolTierForecast{
+				{Total: 100, Free: 50, DaysUntilFull: -1},
+			},
+		},
+		"linear growth": {
+			samples: []*PoolUsageSample{
+				mockSample(0, PoolTierUsage{Total: 100, Free: 90}, PoolTierUsage{Total: 1000, Free: 1000}),
+				mockSample(day, PoolTierUsage{Total: 100, Free: 80}, PoolTierUsage{Total: 1000, Free: 900}),
+				mockSample(2*day, PoolTierUsage{Total: 100, Free: 70}, PoolTierUsage{Total: 1000, Free: 800}),
+			},
+			expForecasts: []*PoolTierForecast{
+				{Total: 100, Free: 70, GrowthRate: 10, DaysUntilFull: 7},
+				{Total: 1000, Free: 800, GrowthRate: 100, DaysUntilFull: 8},
+			},
+		},
+		"shrinking usage": {
+			samples: []*PoolUsageSample{
+				mockSample(0, PoolTierUsage{Total: 100, Free: 50}),
+				mockSample(2*day, PoolTierUsage{Total: 100, Free: 70}),
+			},
+			expForecasts: []*PoolTierForecast{
+				{Total: 100, Free: 70, GrowthRate: -10, DaysUntilFull: -1},
+			},
+		},
+		"tier added after first sample": {
+			samples: []*PoolUsageSample{
+				mockSample(0, PoolTierUsage{Total: 100, Free: 100}),
+				mockSample(day, PoolTierUsage{Total: 100, Free: 80}, PoolTierUsage{Total: 1000, Free: 500}),
+			},
+			expForecasts: []*PoolTierForecast{
+				{Total: 100, Free: 80, GrowthRate: 20, DaysUntilFull: 4},
+				{Total: 1000, Free: 500, DaysUntilFull: -1},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotForecasts := ForecastPoolUsage(tc.samples)
+
+			if diff := cmp.Diff(tc.expForecasts, gotForecasts, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Fatalf("unexpected forecasts (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
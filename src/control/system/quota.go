@@ -0,0 +1,116 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+)
+
+const (
+	quotaUserPrefix  = "u:"
+	quotaGroupPrefix = "g:"
+)
+
+type (
+	// TenantQuota defines limits on the pool storage that may be owned by
+	// a tenant, identified by a user or group principal. Zero limits are
+	// unlimited.
+	TenantQuota struct {
+		Principal    string
+		MaxScmBytes  uint64
+		MaxNvmeBytes uint64
+		MaxPools     uint32
+	}
+
+	// TenantUsage describes the pool storage owned by a tenant.
+	TenantUsage struct {
+		ScmBytes  uint64
+		NvmeBytes uint64
+		Pools     uint32
+	}
+)
+
+func withDomain(name string) string {
+	if name != "" && !strings.Contains(name, "@") {
+		return name + "@"
+	}
+	return name
+}
+
+// UserQuotaPrincipal returns the quota principal for the given owner user.
+func UserQuotaPrincipal(user string) string {
+	return quotaUserPrefix + withDomain(user)
+}
+
+// GroupQuotaPrincipal returns the quota principal for the given owner group.
+func GroupQuotaPrincipal(group string) string {
+	return quotaGroupPrefix + withDomain(group)
+}
+
+// ValidateQuotaPrincipal checks that the principal identifies a user or group,
+// in the format "u:name@domain" or "g:name@domain".
+func ValidateQuotaPrincipal(principal string) error {
+	name := strings.TrimPrefix(strings.TrimPrefix(principal, quotaUserPrefix), quotaGroupPrefix)
+	if name == principal {
+		return errors.Errorf("invalid quota principal %q: must start with %q or %q",
+			principal, quotaUserPrefix, quotaGroupPrefix)
+	}
+	if strings.HasPrefix(name, "@") || strings.Count(name, "@") != 1 {
+		return errors.Errorf("invalid quota principal %q: name must be in the format name@domain", principal)
+	}
+
+	return nil
+}
+
+// QuotaPrincipals returns the quota principals of the pool's owner user and
+// group.
+func (ps *PoolService) QuotaPrincipals() []string {
+	var principals []string
+	if ps.OwnerUser != "" {
+		principals = append(principals, UserQuotaPrincipal(ps.OwnerUser))
+	}
+	if ps.OwnerGroup != "" {
+		principals = append(principals, GroupQuotaPrincipal(ps.OwnerGroup))
+	}
+	return principals
+}
+
+// Add adds the storage of the given pool to the usage.
+func (tu *TenantUsage) Add(ps *PoolService) {
+	tu.Pools++
+	if ps.Storage != nil {
+		tu.ScmBytes += ps.Storage.TotalSCM()
+		tu.NvmeBytes += ps.Storage.TotalNVMe()
+	}
+}
+
+// IsUnlimited returns true if the quota sets no limits.
+func (tq *TenantQuota) IsUnlimited() bool {
+	return tq.MaxScmBytes == 0 && tq.MaxNvmeBytes == 0 && tq.MaxPools == 0
+}
+
+// Check returns a fault if adding the requested usage to the current usage
+// would exceed any limit of the quota.
+func (tq *TenantQuota) Check(cur, req TenantUsage) error {
+	switch {
+	case tq.MaxPools > 0 && cur.Pools+req.Pools > tq.MaxPools:
+		return FaultQuotaExceeded(tq.Principal, "pool count",
+			fmt.Sprint(tq.MaxPools), fmt.Sprint(cur.Pools+req.Pools))
+	case tq.MaxScmBytes > 0 && cur.ScmBytes+req.ScmBytes > tq.MaxScmBytes:
+		return FaultQuotaExceeded(tq.Principal, "SCM",
+			humanize.IBytes(tq.MaxScmBytes), humanize.IBytes(cur.ScmBytes+req.ScmBytes))
+	case tq.MaxNvmeBytes > 0 && cur.NvmeBytes+req.NvmeBytes > tq.MaxNvmeBytes:
+		return FaultQuotaExceeded(tq.Principal, "NVMe",
+			humanize.IBytes(tq.MaxNvmeBytes), humanize.IBytes(cur.NvmeBytes+req.NvmeBytes))
+	}
+
+	return nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -46,6 +48,7 @@ type (
 		Apply([]byte, time.Duration) raft.ApplyFuture
 		AddVoter(raft.ServerID, raft.ServerAddress, uint64, time.Duration) raft.IndexFuture
 		RemoveServer(raft.ServerID, uint64, time.Duration) raft.IndexFuture
+		GetConfiguration() raft.ConfigurationFuture
 		BootstrapCluster(raft.Configuration) raft.Future
 		Leader() raft.ServerAddress
 		LeaderCh() <-chan bool
@@ -53,6 +56,7 @@ type (
 		Barrier(time.Duration) raft.Future
 		Shutdown() raft.Future
 		State() raft.RaftState
+		Stats() map[string]string
 	}
 
 	// syncRaft provides a wrapper for synchronized access to the
@@ -77,6 +81,11 @@ type (
 		Pools         *PoolDatabase
 		Checker       *CheckerDatabase
 		System        *SystemDatabase
+		Events        *EventDatabase
+		Quotas        *QuotaDatabase
+		PoolUsage     *PoolUsageDatabase
+		PoolRebuilds  *PoolRebuildDatabase
+		Replicas      []string
 		SchemaVersion uint
 	}
 
@@ -89,6 +98,7 @@ type (
 		cfg                *DatabaseConfig
 		initialized        atm.Bool
 		steppingUp         atm.Bool
+		replicaLock        sync.RWMutex // protects replicaAddr and cfg.Replicas
 		replicaAddr        *net.TCPAddr
 		raftTransport      raft.Transport
 		raft               syncRaft
@@ -270,6 +280,16 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 			System: &SystemDatabase{
 				Attributes: make(map[string]string),
 			},
+			Events: &EventDatabase{},
+			Quotas: &QuotaDatabase{
+				Quotas: make(map[string]*system.TenantQuota),
+			},
+			PoolUsage: &PoolUsageDatabase{
+				Samples: make(PoolUsageMap),
+			},
+			PoolRebuilds: &PoolRebuildDatabase{
+				History: make(PoolRebuildMap),
+			},
 			SchemaVersion: CurrentSchemaVersion,
 		},
 	}
@@ -282,6 +302,9 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 // isReplica returns true if the supplied address matches
 // a known replica address.
 func (db *Database) isReplica(ctrlAddr *net.TCPAddr) bool {
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
 	for _, candidate := range db.cfg.Replicas {
 		if common.CmpTCPAddr(ctrlAddr, candidate) {
 			return true
@@ -299,16 +322,19 @@ func (db *Database) SystemName() string {
 // LeaderQuery returns the system leader, if known.
 func (db *Database) LeaderQuery() (leader string, replicas []string, err error) {
 	if !db.IsReplica() {
-		return "", nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return "", nil, &system.ErrNotReplica{db.stringReplicas()}
 	}
 
-	return db.leaderHint(), db.cfg.stringReplicas(), nil
+	return db.leaderHint(), db.stringReplicas(), nil
 }
 
 // ReplicaAddr returns the system's replica address if
 // the system is configured as a MS replica.
 func (db *Database) ReplicaAddr() (*net.TCPAddr, error) {
-	if !db.IsReplica() {
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
+	if db.replicaAddr == nil {
 		return nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
 	}
 	return db.replicaAddr, nil
@@ -321,6 +347,9 @@ func (db *Database) PeerAddrs() ([]*net.TCPAddr, error) {
 		return nil, err
 	}
 
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
 	var peers []*net.TCPAddr
 	for _, rep := range db.cfg.Replicas {
 		if !common.CmpTCPAddr(myAddr, rep) {
@@ -332,7 +361,14 @@ func (db *Database) PeerAddrs() ([]*net.TCPAddr, error) {
 
 // IsReplica returns true if the system is configured as a replica.
 func (db *Database) IsReplica() bool {
-	return db != nil && db.replicaAddr != nil
+	if db == nil {
+		return false
+	}
+
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
+	return db.replicaAddr != nil
 }
 
 // IsBootstrap returns true if the system is a replica and meets the
@@ -342,6 +378,9 @@ func (db *Database) IsBootstrap() bool {
 	if !db.IsReplica() {
 		return false
 	}
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
 	// Only the first replica should bootstrap. All the others
 	// should be added as voters.
 	return common.CmpTCPAddr(db.cfg.Replicas[0], db.replicaAddr)
@@ -351,7 +390,7 @@ func (db *Database) IsBootstrap() bool {
 // replica or the service is not running.
 func (db *Database) CheckReplica() error {
 	if !db.IsReplica() {
-		return &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return &system.ErrNotReplica{db.stringReplicas()}
 	}
 
 	if db.initialized.IsFalse() {
@@ -364,9 +403,10 @@ func (db *Database) CheckReplica() error {
 // errNotSysLeader returns an error indicating that the node is not
 // the current system leader.
 func errNotSysLeader(svc raftService, db *Database) error {
+	repAddr, _ := db.ReplicaAddr()
 	return &system.ErrNotLeader{
 		LeaderHint: string(svc.Leader()),
-		Replicas:   db.cfg.stringReplicas(db.replicaAddr),
+		Replicas:   db.stringReplicas(repAddr),
 	}
 }
 
@@ -717,6 +757,21 @@ func (db *Database) CurMapVersion() (uint32, error) {
 	return db.data.MapVersion, nil
 }
 
+// CurrentTerm returns the current raft term as seen by this replica.
+func (db *Database) CurrentTerm() (uint64, error) {
+	if err := db.CheckReplica(); err != nil {
+		return 0, err
+	}
+
+	var term uint64
+	err := db.raft.withReadLock(func(svc raftService) (err error) {
+		term, err = strconv.ParseUint(svc.Stats()["term"], 10, 64)
+		return errors.Wrap(err, "invalid raft term")
+	})
+
+	return term, err
+}
+
 // RemoveMember removes a member from the system.
 func (db *Database) RemoveMember(m *system.Member) error {
 	if err := db.CheckLeader(); err != nil {
@@ -735,7 +790,7 @@ func (db *Database) RemoveMember(m *system.Member) error {
 
 func (db *Database) manageVoter(vc *system.Member, op raftOp) error {
 	// Ignore self as a voter candidate.
-	if common.CmpTCPAddr(db.replicaAddr, vc.Addr) {
+	if repAddr, err := db.ReplicaAddr(); err == nil && common.CmpTCPAddr(repAddr, vc.Addr) {
 		return nil
 	}
 
@@ -819,7 +874,17 @@ func (db *Database) UpdateMember(m *system.Member) error {
 		return err
 	}
 
-	return db.submitMemberUpdate(raftOpUpdateMember, &memberUpdate{Member: m})
+	if err := db.submitMemberUpdate(raftOpUpdateMember, &memberUpdate{Member: m}); err != nil {
+		return err
+	}
+
+	// A replica added to the replica set at runtime becomes a raft voter
+	// once it has been restarted and has rejoined the system.
+	if err := db.addPendingVoter(m); err != nil {
+		db.log.Errorf("failed to add %s as a raft voter: %s", m.Addr, err)
+	}
+
+	return nil
 }
 
 // FindMemberByRank searches the member database by rank. If no
@@ -1126,6 +1191,8 @@ func (db *Database) OnEvent(_ context.Context, evt *events.RASEvent) {
 	switch evt.ID {
 	case events.RASPoolRepsUpdate:
 		db.handlePoolRepsUpdate(evt)
+	case events.RASPoolRebuildStart, events.RASPoolRebuildEnd, events.RASPoolRebuildFailed:
+		db.handlePoolRebuildEvent(evt)
 	}
 }
 
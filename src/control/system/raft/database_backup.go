@@ -0,0 +1,237 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+const (
+	backupPrefix     = "backup-"
+	backupTimeFormat = "20060102T150405.000Z"
+	backupTmpSuffix  = ".tmp"
+)
+
+// BackupDetails contains details of a database backup. Backups are written in
+// the same layout as raft snapshots, so they may be used directly with
+// RestoreLocalReplica.
+type BackupDetails struct {
+	SnapshotDetails
+	Name       string
+	Created    time.Time
+	Size       int64
+	Compressed bool
+}
+
+func backupName(t time.Time) string {
+	return backupPrefix + t.UTC().Format(backupTimeFormat)
+}
+
+func parseBackupName(name string) (time.Time, error) {
+	if !strings.HasPrefix(name, backupPrefix) {
+		return time.Time{}, errors.Errorf("%q is not a backup name", name)
+	}
+	return time.Parse(backupTimeFormat, strings.TrimPrefix(name, backupPrefix))
+}
+
+// ReadBackupInfo reads the details of the backup at the given path.
+func ReadBackupInfo(path string) (*BackupDetails, error) {
+	name := filepath.Base(path)
+	created, err := parseBackupName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	sInfo, err := ReadSnapshotInfo(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bd := &BackupDetails{
+		SnapshotDetails: *sInfo,
+		Name:            name,
+		Created:         created,
+	}
+	for _, file := range []string{snapshotDataFile, snapshotGzDataFile} {
+		st, err := os.Stat(filepath.Join(path, file))
+		if err != nil {
+			continue
+		}
+		bd.Size = st.Size()
+		bd.Compressed = file == snapshotGzDataFile
+		break
+	}
+
+	return bd, nil
+}
+
+// ListBackups returns details of the backups found in the given directory,
+// ordered from oldest to newest.
+func ListBackups(dir string) ([]*BackupDetails, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read backup directory %q", dir)
+	}
+
+	var backups []*BackupDetails
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), backupPrefix) ||
+			strings.HasSuffix(entry.Name(), backupTmpSuffix) {
+			continue
+		}
+
+		bd, err := ReadBackupInfo(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read backup %q", entry.Name())
+		}
+		backups = append(backups, bd)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Created.Before(backups[j].Created)
+	})
+
+	return backups, nil
+}
+
+// PruneBackups removes the oldest backups in the given directory so that no
+// more than keep backups remain. The names of the removed backups are returned.
+func PruneBackups(dir string, keep int) ([]string, error) {
+	if keep <= 0 {
+		return nil, nil
+	}
+
+	backups, err := ListBackups(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for len(backups) > keep {
+		if err := os.RemoveAll(backups[0].Path); err != nil {
+			return removed, errors.Wrapf(err, "failed to remove backup %q", backups[0].Path)
+		}
+		removed = append(removed, backups[0].Name)
+		backups = backups[1:]
+	}
+
+	return removed, nil
+}
+
+func writeFileSync(path string, write func(*os.File) error) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := write(f); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// raftStat returns the named numeric raft statistic.
+func raftStat(svc raftService, name string) (uint64, error) {
+	val, err := strconv.ParseUint(svc.Stats()[name], 10, 64)
+	return val, errors.Wrapf(err, "invalid raft %s", name)
+}
+
+// Backup writes a consistent point-in-time copy of the database to a new
+// subdirectory of the given directory. The backup is written in raft snapshot
+// format so that it may be restored with RestoreLocalReplica.
+func (db *Database) Backup(dir string, compress bool) (*BackupDetails, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup directory %q", dir)
+	}
+
+	meta := &raft.SnapshotMeta{
+		Version: raft.SnapshotVersionMax,
+	}
+	var data []byte
+	if err := db.raft.withReadLock(func(svc raftService) (err error) {
+		db.data.RLock()
+		defer db.data.RUnlock()
+
+		if meta.Term, err = raftStat(svc, "term"); err != nil {
+			return err
+		}
+		if meta.Index, err = raftStat(svc, "applied_index"); err != nil {
+			return err
+		}
+		data, err = json.Marshal(db.data)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to create database snapshot")
+	}
+	meta.Size = int64(len(data))
+
+	name := backupName(time.Now())
+	meta.ID = name
+	finalPath := filepath.Join(dir, name)
+	tmpPath := finalPath + backupTmpSuffix
+	if err := os.Mkdir(tmpPath, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup %q", tmpPath)
+	}
+	cleanup := func() {
+		if err := os.RemoveAll(tmpPath); err != nil {
+			db.log.Errorf("failed to remove incomplete backup %q: %s", tmpPath, err)
+		}
+	}
+
+	if err := writeFileSync(filepath.Join(tmpPath, snapshotMetaFile), func(f *os.File) error {
+		return json.NewEncoder(f).Encode(meta)
+	}); err != nil {
+		cleanup()
+		return nil, errors.Wrap(err, "failed to write backup metadata")
+	}
+
+	dataFile := snapshotDataFile
+	if compress {
+		dataFile = snapshotGzDataFile
+	}
+	if err := writeFileSync(filepath.Join(tmpPath, dataFile), func(f *os.File) error {
+		if !compress {
+			_, err := f.Write(data)
+			return err
+		}
+		zw := gzip.NewWriter(f)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		return zw.Close()
+	}); err != nil {
+		cleanup()
+		return nil, errors.Wrap(err, "failed to write backup data")
+	}
+
+	if err := os.Rename(tmpPath, finalPath); err != nil {
+		cleanup()
+		return nil, errors.Wrapf(err, "failed to finalize backup %q", finalPath)
+	}
+	db.log.Debugf("wrote %d byte database backup (index %d; term %d) to %s", meta.Size, meta.Index, meta.Term, finalPath)
+
+	return ReadBackupInfo(finalPath)
+}
@@ -0,0 +1,137 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestRaft_Database_Backup(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notLeader bool
+		compress  bool
+		expErr    error
+	}{
+		"not leader": {
+			notLeader: true,
+			expErr:    errors.New("leader"),
+		},
+		"uncompressed": {},
+		"compressed": {
+			compress: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for i := 0; i < 3; i++ {
+				if err := db.AddMember(system.MockMember(t, uint32(i), system.MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.notLeader {
+				db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+					State: raft.Follower,
+				}, (*fsm)(db)))
+			}
+
+			dir := filepath.Join(t.TempDir(), "backups")
+			bd, err := db.Backup(dir, tc.compress)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.compress, bd.Compressed, "unexpected compression")
+			test.AssertEqual(t, "0-2", bd.MemberRanks.String(), "unexpected backup ranks")
+
+			// The backup should be readable as a raft snapshot.
+			data, err := readSnapshotData(bd.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, bd.Metadata.Size, int64(len(data)), "unexpected snapshot size")
+
+			backups, err := ListBackups(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, 1, len(backups), "unexpected number of backups")
+			test.AssertEqual(t, bd.Name, backups[0].Name, "unexpected backup name")
+		})
+	}
+}
+
+func TestRaft_PruneBackups(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	dir := t.TempDir()
+
+	var names []string
+	for i := 0; i < 4; i++ {
+		bd, err := db.Backup(dir, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, bd.Name)
+		// Backup names have millisecond resolution.
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	// Unrelated entries should be ignored.
+	if err := os.Mkdir(filepath.Join(dir, "unrelated"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := PruneBackups(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(removed), "expected no backups removed with zero retention")
+
+	removed, err = PruneBackups(dir, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.CmpAny(t, "removed backups", names[:2], removed)
+
+	backups, err := ListBackups(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var remaining []string
+	for _, bd := range backups {
+		remaining = append(remaining, bd.Name)
+	}
+	test.CmpAny(t, "remaining backups", names[2:], remaining)
+
+	if _, err := os.Stat(filepath.Join(dir, "unrelated")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRaft_ListBackups_NoDir(t *testing.T) {
+	backups, err := ListBackups(filepath.Join(t.TempDir(), "missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(backups), "expected no backups")
+}
@@ -0,0 +1,150 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/events"
+)
+
+// MaxEventRecords is the maximum number of RAS events retained in the
+// event history. Once reached, the oldest events are discarded.
+const MaxEventRecords = 1024
+
+type (
+	// EventRecord is a RAS event retained in the event history. The event
+	// is stored in protobuf wire format as the native type's extended info
+	// can't be decoded from JSON.
+	EventRecord struct {
+		Seq   uint64
+		Event []byte
+	}
+
+	// EventDatabase contains a bounded history of RAS events published
+	// on the MS leader, ordered from oldest to newest.
+	EventDatabase struct {
+		NextSeq uint64
+		Records []*EventRecord
+	}
+)
+
+func (edb *EventDatabase) addRecord(rec *EventRecord) {
+	edb.NextSeq++
+	rec.Seq = edb.NextSeq
+	edb.Records = append(edb.Records, rec)
+	edb.trim()
+}
+
+// loadRecords appends records that have already been assigned sequence
+// numbers, e.g. from a database export, to the event history.
+func (edb *EventDatabase) loadRecords(recs []*EventRecord) {
+	for _, rec := range recs {
+		if rec.Seq > edb.NextSeq {
+			edb.NextSeq = rec.Seq
+		}
+		edb.Records = append(edb.Records, rec)
+	}
+	edb.trim()
+}
+
+func (edb *EventDatabase) trim() {
+	if excess := len(edb.Records) - MaxEventRecords; excess > 0 {
+		edb.Records = append([]*EventRecord(nil), edb.Records[excess:]...)
+	}
+}
+
+func (rec *EventRecord) toEvent() (*events.RASEvent, error) {
+	pbEvt := new(sharedpb.RASEvent)
+	if err := proto.Unmarshal(rec.Event, pbEvt); err != nil {
+		return nil, errors.Wrapf(err, "decoding event record %d", rec.Seq)
+	}
+
+	return events.NewFromProto(pbEvt)
+}
+
+// AddEvent adds a RAS event to the event history.
+func (db *Database) AddEvent(evt *events.RASEvent) error {
+	return db.AddEvents([]*events.RASEvent{evt})
+}
+
+// AddEvents adds a batch of RAS events to the event history in a single
+// update.
+func (db *Database) AddEvents(evts []*events.RASEvent) error {
+	if len(evts) == 0 {
+		return nil
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	recs := make([]*EventRecord, 0, len(evts))
+	for _, evt := range evts {
+		if evt == nil {
+			return errors.New("nil event")
+		}
+		pbEvt, err := evt.ToProto()
+		if err != nil {
+			return errors.Wrapf(err, "converting %s event", evt.ID)
+		}
+		data, err := proto.Marshal(pbEvt)
+		if err != nil {
+			return errors.Wrapf(err, "encoding %s event", evt.ID)
+		}
+		recs = append(recs, &EventRecord{Event: data})
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitEventUpdate(raftOpAddEvent, recs)
+}
+
+// GetEvents returns the events in the event history that match the supplied
+// filter and were published within the given time range, ordered from oldest
+// to newest. A zero since or until time leaves that end of the range open.
+func (db *Database) GetEvents(filter *events.Filter, since, until time.Time) ([]*events.RASEvent, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := make([]*events.RASEvent, 0, len(db.data.Events.Records))
+	for _, rec := range db.data.Events.Records {
+		evt, err := rec.toEvent()
+		if err != nil {
+			db.log.Errorf("skipping bad event record: %s", err)
+			continue
+		}
+		if !filter.Matches(evt) {
+			continue
+		}
+
+		if !since.IsZero() || !until.IsZero() {
+			ts, err := evt.GetTimestamp()
+			if err != nil {
+				db.log.Errorf("skipping %s event with bad timestamp %q: %s", evt.ID, evt.Timestamp, err)
+				continue
+			}
+			if !since.IsZero() && ts.Before(since) {
+				continue
+			}
+			if !until.IsZero() && ts.After(until) {
+				continue
+			}
+		}
+
+		out = append(out, evt)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,151 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// MaxPoolRebuildRecords is the maximum number of rebuild records retained
+// for each pool. Older records are discarded as new records are added.
+const MaxPoolRebuildRecords = 32
+
+// rebuildInfoRegexp matches the extended info string of the pool rebuild
+// RAS events, e.g. "map_ver: [5] op: [Rebuild]".
+var rebuildInfoRegexp = regexp.MustCompile(`map_ver: \[(\d+)\] op: \[([^\]]*)\]`)
+
+type (
+	// PoolRebuildMap provides a map of pool UUID->rebuild records.
+	PoolRebuildMap map[uuid.UUID][]*system.PoolRebuildRecord
+
+	// PoolRebuildDatabase contains the rebuild history of each pool.
+	PoolRebuildDatabase struct {
+		History PoolRebuildMap
+	}
+)
+
+// addRecords applies the given records to the rebuild history of each pool,
+// discarding the oldest records once the history is full. Records for pools
+// without a pool service entry are ignored.
+func (prd *PoolRebuildDatabase) addRecords(pools PoolUuidMap, records PoolRebuildMap) {
+	for poolUUID, recs := range records {
+		if _, found := pools[poolUUID]; !found {
+			continue
+		}
+		cur := prd.History[poolUUID]
+		for _, rec := range recs {
+			cur = system.UpdatePoolRebuildHistory(cur, rec)
+		}
+		if len(cur) > MaxPoolRebuildRecords {
+			cur = cur[len(cur)-MaxPoolRebuildRecords:]
+		}
+		prd.History[poolUUID] = cur
+	}
+}
+
+// AddPoolRebuildRecord adds a rebuild record to the rebuild history of the
+// given pool.
+func (db *Database) AddPoolRebuildRecord(poolUUID uuid.UUID, rec *system.PoolRebuildRecord) error {
+	if rec == nil {
+		return errors.Errorf("nil rebuild record for pool %s", poolUUID)
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	data, err := createRaftUpdate(raftOpAddPoolRebuildRecord, PoolRebuildMap{
+		poolUUID: []*system.PoolRebuildRecord{rec},
+	})
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// PoolRebuildHistory returns the time-ordered rebuild records for the given
+// pool.
+func (db *Database) PoolRebuildHistory(poolUUID uuid.UUID) ([]*system.PoolRebuildRecord, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	history := db.data.PoolRebuilds.History[poolUUID]
+	out := make([]*system.PoolRebuildRecord, 0, len(history))
+	for _, rec := range history {
+		recCopy := *rec
+		out = append(out, &recCopy)
+	}
+
+	return out, nil
+}
+
+// poolRebuildRecordFromEvent creates a rebuild record from a pool rebuild
+// RAS event.
+func poolRebuildRecordFromEvent(evt *events.RASEvent) (*system.PoolRebuildRecord, error) {
+	ts, err := evt.GetTimestamp()
+	if err != nil {
+		return nil, errors.Wrapf(err, "bad event timestamp %q", evt.Timestamp)
+	}
+
+	rec := new(system.PoolRebuildRecord)
+	if si := evt.GetStrInfo(); si != nil {
+		if m := rebuildInfoRegexp.FindStringSubmatch(string(*si)); m != nil {
+			ver, err := strconv.ParseUint(m[1], 10, 32)
+			if err != nil {
+				return nil, errors.Wrapf(err, "bad map version in %q", *si)
+			}
+			rec.MapVersion = uint32(ver)
+			rec.Operation = m[2]
+		}
+	}
+
+	switch evt.ID {
+	case events.RASPoolRebuildStart:
+		rec.Started = ts
+	case events.RASPoolRebuildEnd:
+		rec.Finished = ts
+	case events.RASPoolRebuildFailed:
+		rec.Finished = ts
+		rec.Failed = true
+		rec.Message = evt.Msg
+	default:
+		return nil, errors.Errorf("unexpected %s event", evt.ID)
+	}
+
+	return rec, nil
+}
+
+func (db *Database) handlePoolRebuildEvent(evt *events.RASEvent) {
+	poolUUID, err := uuid.Parse(evt.PoolUUID)
+	if err != nil {
+		db.log.Errorf("failed to parse pool UUID %q: %s", evt.PoolUUID, err)
+		return
+	}
+
+	rec, err := poolRebuildRecordFromEvent(evt)
+	if err != nil {
+		db.log.Errorf("failed to process %s event for pool %s: %s", evt.ID,
+			dbgUuidStr(poolUUID), err)
+		return
+	}
+
+	db.log.Debugf("recording %s event for pool %s: %+v", evt.ID, dbgUuidStr(poolUUID), rec)
+	if err := db.AddPoolRebuildRecord(poolUUID, rec); err != nil {
+		db.log.Errorf("failed to add rebuild record for pool %s: %s", dbgUuidStr(poolUUID), err)
+	}
+}
@@ -0,0 +1,93 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// MaxPoolUsageSamples is the maximum number of usage samples retained for
+// each pool. Older samples are discarded as new samples are added.
+const MaxPoolUsageSamples = 168
+
+type (
+	// PoolUsageMap provides a map of pool UUID->usage samples.
+	PoolUsageMap map[uuid.UUID][]*system.PoolUsageSample
+
+	// PoolUsageDatabase contains the pool space usage time series.
+	PoolUsageDatabase struct {
+		Samples PoolUsageMap
+	}
+)
+
+// addSamples appends the given samples to the time series of each pool,
+// discarding the oldest samples once the series is full. Samples for pools
+// without a pool service entry are ignored.
+func (pud *PoolUsageDatabase) addSamples(pools PoolUuidMap, samples PoolUsageMap) {
+	for poolUUID, series := range samples {
+		if _, found := pools[poolUUID]; !found {
+			continue
+		}
+		cur := append(pud.Samples[poolUUID], series...)
+		if len(cur) > MaxPoolUsageSamples {
+			cur = cur[len(cur)-MaxPoolUsageSamples:]
+		}
+		pud.Samples[poolUUID] = cur
+	}
+}
+
+// AddPoolUsageSamples appends a usage sample to the time series of each of
+// the given pools.
+func (db *Database) AddPoolUsageSamples(samples map[uuid.UUID]*system.PoolUsageSample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	update := make(PoolUsageMap, len(samples))
+	for poolUUID, s := range samples {
+		if s == nil {
+			return errors.Errorf("nil usage sample for pool %s", poolUUID)
+		}
+		update[poolUUID] = []*system.PoolUsageSample{s}
+	}
+
+	data, err := createRaftUpdate(raftOpAddPoolUsageSamples, update)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// PoolUsageSamples returns the time-ordered usage samples for the given pool.
+func (db *Database) PoolUsageSamples(poolUUID uuid.UUID) ([]*system.PoolUsageSample, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	series := db.data.PoolUsage.Samples[poolUUID]
+	out := make([]*system.PoolUsageSample, 0, len(series))
+	for _, s := range series {
+		sCopy := &system.PoolUsageSample{Time: s.Time}
+		for _, tier := range s.Tiers {
+			tierCopy := *tier
+			sCopy.Tiers = append(sCopy.Tiers, &tierCopy)
+		}
+		out = append(out, sCopy)
+	}
+
+	return out, nil
+}
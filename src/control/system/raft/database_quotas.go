@@ -0,0 +1,86 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// QuotaDatabase contains the tenant quotas, keyed by principal.
+	QuotaDatabase struct {
+		Quotas map[string]*system.TenantQuota
+	}
+)
+
+// SetTenantQuota adds or replaces the quota for the quota's principal.
+func (db *Database) SetTenantQuota(tq *system.TenantQuota) error {
+	if tq == nil {
+		return errors.New("nil tenant quota")
+	}
+	if err := system.ValidateQuotaPrincipal(tq.Principal); err != nil {
+		return err
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitQuotaUpdate(raftOpUpdateTenantQuota, tq)
+}
+
+// RemoveTenantQuota removes the quota for the given principal.
+func (db *Database) RemoveTenantQuota(principal string) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	db.data.RLock()
+	_, found := db.data.Quotas.Quotas[principal]
+	db.data.RUnlock()
+	if !found {
+		return errors.Errorf("no quota found for %s", principal)
+	}
+
+	return db.submitQuotaUpdate(raftOpRemoveTenantQuota, &system.TenantQuota{Principal: principal})
+}
+
+// TenantQuotas returns the tenant quotas for the given principals, or all
+// quotas if none are supplied, ordered by principal. Principals without a
+// quota are ignored.
+func (db *Database) TenantQuotas(principals ...string) ([]*system.TenantQuota, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := make([]*system.TenantQuota, 0, len(db.data.Quotas.Quotas))
+	if len(principals) == 0 {
+		for _, tq := range db.data.Quotas.Quotas {
+			tqCopy := *tq
+			out = append(out, &tqCopy)
+		}
+	} else {
+		for _, p := range principals {
+			if tq, found := db.data.Quotas.Quotas[p]; found {
+				tqCopy := *tq
+				out = append(out, &tqCopy)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Principal < out[j].Principal })
+
+	return out, nil
+}
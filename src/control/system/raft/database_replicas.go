@@ -0,0 +1,352 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// replicasFile is the name of the file in the raft directory used to
+	// persist the MS replica set after it has been changed at runtime.
+	replicasFile = "mgmt_svc_replicas.json"
+
+	// voterChangeTimeout is the maximum amount of time to wait for a raft
+	// voter configuration change to be committed.
+	voterChangeTimeout = 30 * time.Second
+)
+
+// Replica states reported by ReplicaStatus.
+const (
+	// ReplicaStateVoter indicates that the replica is a raft voter.
+	ReplicaStateVoter = "voter"
+	// ReplicaStatePending indicates that the replica has been added to the
+	// replica set but has not yet joined the raft cluster.
+	ReplicaStatePending = "pending"
+	// ReplicaStateRemoving indicates that the replica has been removed from
+	// the replica set but is still a member of the raft cluster.
+	ReplicaStateRemoving = "removing"
+)
+
+// ReplicaInfo describes the state of a MS replica.
+type ReplicaInfo struct {
+	Addr   *net.TCPAddr
+	State  string
+	Leader bool
+}
+
+// ReplicasFilePath returns the path to the file used to persist the MS
+// replica set.
+func (cfg *DatabaseConfig) ReplicasFilePath() string {
+	return filepath.Join(cfg.RaftDir, replicasFile)
+}
+
+// ReadPersistedReplicas returns the MS replica set persisted in the given
+// raft directory, or nil if the replica set has not been changed at runtime.
+func ReadPersistedReplicas(raftDir string) ([]*net.TCPAddr, error) {
+	cfg := &DatabaseConfig{RaftDir: raftDir}
+	data, err := os.ReadFile(cfg.ReplicasFilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to read persisted MS replicas")
+	}
+
+	var strReplicas []string
+	if err := json.Unmarshal(data, &strReplicas); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", cfg.ReplicasFilePath())
+	}
+
+	return ParseReplicas(strReplicas)
+}
+
+// ParseReplicas converts a list of replica address strings into TCP addresses.
+func ParseReplicas(strReplicas []string) ([]*net.TCPAddr, error) {
+	replicas := make([]*net.TCPAddr, 0, len(strReplicas))
+	for _, str := range strReplicas {
+		addr, err := net.ResolveTCPAddr("tcp", str)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid replica address %q", str)
+		}
+		replicas = append(replicas, addr)
+	}
+
+	return replicas, nil
+}
+
+func replicaStrings(replicas []*net.TCPAddr) []string {
+	strReplicas := make([]string, 0, len(replicas))
+	for _, r := range replicas {
+		strReplicas = append(strReplicas, r.String())
+	}
+	return strReplicas
+}
+
+func containsAddr(addrs []*net.TCPAddr, addr *net.TCPAddr) bool {
+	for _, a := range addrs {
+		if common.CmpTCPAddr(a, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// stringReplicas returns the current replica set as a list of strings,
+// omitting any of the excluded addresses.
+func (db *Database) stringReplicas(excludeAddrs ...*net.TCPAddr) []string {
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
+	return db.cfg.stringReplicas(excludeAddrs...)
+}
+
+// Replicas returns the current MS replica set.
+func (db *Database) Replicas() []*net.TCPAddr {
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
+	return append([]*net.TCPAddr(nil), db.cfg.Replicas...)
+}
+
+// persistReplicas writes the replica set to the raft directory so that it
+// takes precedence over the configured replicas on restart.
+func (db *Database) persistReplicas(replicas []*net.TCPAddr) error {
+	if db.cfg.RaftDir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(db.cfg.RaftDir, 0700); err != nil {
+		return errors.Wrap(err, "failed to create raft directory")
+	}
+
+	data, err := json.Marshal(replicaStrings(replicas))
+	if err != nil {
+		return err
+	}
+
+	tmpPath := db.cfg.ReplicasFilePath() + ".tmp"
+	if err := writeFileSync(tmpPath, func(f *os.File) error {
+		_, err := f.Write(data)
+		return err
+	}); err != nil {
+		_ = os.Remove(tmpPath)
+		return errors.Wrap(err, "failed to write MS replicas")
+	}
+
+	return errors.Wrap(os.Rename(tmpPath, db.cfg.ReplicasFilePath()),
+		"failed to write MS replicas")
+}
+
+// updateReplicaList replaces the in-memory replica set and persists it if
+// the raft service is running.
+func (db *Database) updateReplicaList(strReplicas []string) {
+	replicas, err := ParseReplicas(strReplicas)
+	if err != nil {
+		db.log.Errorf("ignoring invalid MS replica update: %s", err)
+		return
+	}
+
+	db.replicaLock.Lock()
+	db.cfg.Replicas = replicas
+	db.replicaLock.Unlock()
+
+	// Don't write to the raft directory when replaying the log offline.
+	if db.initialized.IsFalse() {
+		return
+	}
+
+	if err := db.persistReplicas(replicas); err != nil {
+		db.log.Errorf("failed to persist MS replicas: %s", err)
+	}
+}
+
+// SetReplicas updates the local MS replica set in response to a change made
+// via the MS leader, persisting it so that it is used on restart. If this
+// node has been removed from the replica set, the local raft service is
+// stopped. If this node has been added, the returned value indicates that
+// the control plane must be restarted in order to start the raft service.
+func (db *Database) SetReplicas(replicas []*net.TCPAddr) (restartRequired bool, err error) {
+	if len(replicas) == 0 {
+		return false, errors.New("empty MS replica set")
+	}
+
+	if err := db.persistReplicas(replicas); err != nil {
+		return false, err
+	}
+
+	db.replicaLock.Lock()
+	db.cfg.Replicas = replicas
+	wasReplica := db.replicaAddr != nil
+	demoted := wasReplica && !containsAddr(replicas, db.replicaAddr)
+	if demoted {
+		db.replicaAddr = nil
+	}
+	db.replicaLock.Unlock()
+
+	if demoted {
+		db.log.Noticef("removed from MS replica set; stopping local MS replica")
+		if db.shutdownCb != nil {
+			if err := db.Stop(); err != nil {
+				db.log.Errorf("failed to stop system database: %s", err)
+			}
+		}
+		return false, nil
+	}
+
+	if !wasReplica {
+		if _, err := db.cfg.LocalReplicaAddr(); err == nil {
+			db.log.Noticef("added to MS replica set; restart required to start local MS replica")
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// UpdateReplicas replaces the MS replica set with the supplied list of
+// replicas. Any replicas removed from the set are removed from the raft
+// cluster. Replicas added to the set are added to the raft cluster once
+// their control plane has been restarted and rejoined the system.
+func (db *Database) UpdateReplicas(replicas []*net.TCPAddr) error {
+	if len(replicas) == 0 {
+		return errors.New("empty MS replica set")
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	cur := db.Replicas()
+	if repAddr, err := db.ReplicaAddr(); err == nil && !containsAddr(replicas, repAddr) {
+		return errors.Errorf("cannot remove the current MS leader (%s) from the replica set", repAddr)
+	}
+
+	data, err := createRaftUpdate(raftOpUpdateReplicas, replicaStrings(replicas))
+	if err != nil {
+		return err
+	}
+	if err := db.submitRaftUpdate(data); err != nil {
+		return err
+	}
+
+	for _, addr := range cur {
+		if containsAddr(replicas, addr) {
+			continue
+		}
+
+		db.log.Debugf("removing %s as a raft voter", addr)
+		if err := db.raft.withReadLock(func(svc raftService) error {
+			return svc.RemoveServer(raft.ServerID(addr.String()), 0, voterChangeTimeout).Error()
+		}); err != nil {
+			return errors.Wrapf(err, "failed to remove %q as a raft replica", addr)
+		}
+	}
+
+	return nil
+}
+
+// addPendingVoter adds a rejoining member as a raft voter if it is a replica
+// that was added to the replica set at runtime and is not yet a voter.
+func (db *Database) addPendingVoter(m *system.Member) error {
+	if m.State != system.MemberStateJoined || !db.isReplica(m.Addr) {
+		return nil
+	}
+	if repAddr, err := db.ReplicaAddr(); err == nil && common.CmpTCPAddr(repAddr, m.Addr) {
+		return nil
+	}
+
+	servers, err := db.raftServers()
+	if err != nil {
+		return err
+	}
+	for _, srv := range servers {
+		if srv.ID == raft.ServerID(m.Addr.String()) {
+			return nil
+		}
+	}
+
+	db.log.Debugf("adding pending replica %s as a raft voter", m.Addr)
+	return db.manageVoter(m, raftOpAddMember)
+}
+
+// raftServers returns the servers in the current raft configuration.
+func (db *Database) raftServers() (servers []raft.Server, err error) {
+	err = db.raft.withReadLock(func(svc raftService) error {
+		f := svc.GetConfiguration()
+		if err := f.Error(); err != nil {
+			return err
+		}
+		servers = f.Configuration().Servers
+		return nil
+	})
+	return
+}
+
+// ReplicaStatus returns the state of each replica in the MS replica set, along
+// with any raft cluster members that are no longer in the set.
+func (db *Database) ReplicaStatus() ([]*ReplicaInfo, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	servers, err := db.raftServers()
+	if err != nil {
+		return nil, err
+	}
+
+	var leader string
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		leader = string(svc.Leader())
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	voters := make(map[string]bool)
+	for _, srv := range servers {
+		voters[string(srv.ID)] = srv.Suffrage == raft.Voter
+	}
+
+	var infos []*ReplicaInfo
+	replicas := db.Replicas()
+	for _, addr := range replicas {
+		info := &ReplicaInfo{
+			Addr:   addr,
+			State:  ReplicaStatePending,
+			Leader: addr.String() == leader,
+		}
+		if voters[addr.String()] {
+			info.State = ReplicaStateVoter
+		}
+		infos = append(infos, info)
+	}
+	for _, srv := range servers {
+		addr, err := net.ResolveTCPAddr("tcp", string(srv.Address))
+		if err != nil || containsAddr(replicas, addr) {
+			continue
+		}
+		infos = append(infos, &ReplicaInfo{
+			Addr:   addr,
+			State:  ReplicaStateRemoving,
+			Leader: string(srv.Address) == leader,
+		})
+	}
+
+	return infos, nil
+}
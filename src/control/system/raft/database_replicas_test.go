@@ -0,0 +1,324 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"net"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockReplicaAddr(t *testing.T, str string) *net.TCPAddr {
+	t.Helper()
+
+	addr, err := net.ResolveTCPAddr("tcp", str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+func mockRaftServers(addrs ...*net.TCPAddr) []raft.Server {
+	servers := make([]raft.Server, 0, len(addrs))
+	for _, addr := range addrs {
+		servers = append(servers, raft.Server{
+			Suffrage: raft.Voter,
+			ID:       raft.ServerID(addr.String()),
+			Address:  raft.ServerAddress(addr.String()),
+		})
+	}
+	return servers
+}
+
+func TestRaft_ReadPersistedReplicas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		contents    string
+		expReplicas []string
+		expErr      error
+	}{
+		"no file": {},
+		"invalid json": {
+			contents: "{",
+			expErr:   errors.New("failed to decode"),
+		},
+		"invalid address": {
+			contents: `["bad:address:1"]`,
+			expErr:   errors.New("invalid replica address"),
+		},
+		"success": {
+			contents:    `["127.0.0.1:10001","127.0.0.2:10001"]`,
+			expReplicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			raftDir := t.TempDir()
+			cfg := &DatabaseConfig{RaftDir: raftDir}
+			if tc.contents != "" {
+				if err := os.WriteFile(cfg.ReplicasFilePath(), []byte(tc.contents), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotReplicas, gotErr := ReadPersistedReplicas(raftDir)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if tc.expReplicas == nil {
+				if gotReplicas != nil {
+					t.Fatalf("expected nil replicas, got %v", gotReplicas)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.expReplicas, replicaStrings(gotReplicas)); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRaft_Database_UpdateReplicas(t *testing.T) {
+	localAddr := common.LocalhostCtrlAddr()
+	peerAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: localAddr.Port}
+	newAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 3), Port: localAddr.Port}
+
+	for name, tc := range map[string]struct {
+		notLeader   bool
+		replicas    []*net.TCPAddr
+		expReplicas []string
+		expServers  []string
+		expErr      error
+	}{
+		"not leader": {
+			notLeader: true,
+			replicas:  []*net.TCPAddr{localAddr, peerAddr, newAddr},
+			expErr:    errors.New("leader"),
+		},
+		"empty replica set": {
+			expErr: errors.New("empty"),
+		},
+		"leader removed": {
+			replicas: []*net.TCPAddr{peerAddr},
+			expErr:   errors.New("cannot remove the current MS leader"),
+		},
+		"replica added": {
+			replicas:    []*net.TCPAddr{localAddr, peerAddr, newAddr},
+			expReplicas: []string{localAddr.String(), peerAddr.String(), newAddr.String()},
+			expServers:  []string{localAddr.String(), peerAddr.String()},
+		},
+		"replica removed": {
+			replicas:    []*net.TCPAddr{localAddr},
+			expReplicas: []string{localAddr.String()},
+			expServers:  []string{localAddr.String()},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabaseWithCfg(t, log, &DatabaseConfig{
+				SystemName: "test",
+				RaftDir:    t.TempDir(),
+				Replicas:   []*net.TCPAddr{localAddr, peerAddr},
+			})
+			db.replicaAddr = localAddr
+			state := raft.Leader
+			if tc.notLeader {
+				state = raft.Follower
+			}
+			db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+				State:   state,
+				Servers: mockRaftServers(localAddr, peerAddr),
+			}, (*fsm)(db)))
+
+			gotErr := db.UpdateReplicas(tc.replicas)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, replicaStrings(db.Replicas())); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expReplicas, db.data.Replicas); diff != "" {
+				t.Fatalf("unexpected replicas in db data (-want, +got):\n%s\n", diff)
+			}
+
+			persisted, err := ReadPersistedReplicas(db.cfg.RaftDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expReplicas, replicaStrings(persisted)); diff != "" {
+				t.Fatalf("unexpected persisted replicas (-want, +got):\n%s\n", diff)
+			}
+
+			servers, err := db.raftServers()
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotServers := []string{}
+			for _, srv := range servers {
+				gotServers = append(gotServers, string(srv.ID))
+			}
+			if diff := cmp.Diff(tc.expServers, gotServers); diff != "" {
+				t.Fatalf("unexpected raft servers (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRaft_Database_SetReplicas(t *testing.T) {
+	localAddr := common.LocalhostCtrlAddr()
+	remoteAddr := mockReplicaAddr(t, "192.0.2.1:10001")
+
+	for name, tc := range map[string]struct {
+		isReplica  bool
+		replicas   []*net.TCPAddr
+		expRestart bool
+		expReplica bool
+		expErr     error
+	}{
+		"empty replica set": {
+			expErr: errors.New("empty"),
+		},
+		"non-replica unchanged": {
+			replicas: []*net.TCPAddr{remoteAddr},
+		},
+		"non-replica promoted": {
+			replicas:   []*net.TCPAddr{remoteAddr, localAddr},
+			expRestart: true,
+		},
+		"replica unchanged": {
+			isReplica:  true,
+			replicas:   []*net.TCPAddr{localAddr, remoteAddr},
+			expReplica: true,
+		},
+		"replica demoted": {
+			isReplica: true,
+			replicas:  []*net.TCPAddr{remoteAddr},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var db *Database
+			if tc.isReplica {
+				db = MockDatabase(t, log)
+			} else {
+				db = MockDatabaseWithAddr(t, log, nil)
+			}
+			db.cfg.RaftDir = t.TempDir()
+
+			gotRestart, gotErr := db.SetReplicas(tc.replicas)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expRestart, gotRestart, "unexpected restart required")
+			test.AssertEqual(t, tc.expReplica, db.IsReplica(), "unexpected replica state")
+
+			persisted, err := ReadPersistedReplicas(db.cfg.RaftDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(replicaStrings(tc.replicas), replicaStrings(persisted)); diff != "" {
+				t.Fatalf("unexpected persisted replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRaft_Database_ReplicaStatus(t *testing.T) {
+	localAddr := common.LocalhostCtrlAddr()
+	pendingAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: localAddr.Port}
+	removingAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 3), Port: localAddr.Port}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabaseWithCfg(t, log, &DatabaseConfig{
+		SystemName: "test",
+		Replicas:   []*net.TCPAddr{localAddr, pendingAddr},
+	})
+	db.replicaAddr = localAddr
+	db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+		State:         raft.Leader,
+		ServerAddress: raft.ServerAddress(localAddr.String()),
+		Servers:       mockRaftServers(localAddr, removingAddr),
+	}, (*fsm)(db)))
+
+	gotInfos, err := db.ReplicaStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expInfos := []*ReplicaInfo{
+		{Addr: localAddr, State: ReplicaStateVoter, Leader: true},
+		{Addr: pendingAddr, State: ReplicaStatePending},
+		{Addr: removingAddr, State: ReplicaStateRemoving},
+	}
+	cmpOpts := []cmp.Option{
+		cmp.Comparer(common.CmpTCPAddr),
+	}
+	if diff := cmp.Diff(expInfos, gotInfos, cmpOpts...); diff != "" {
+		t.Fatalf("unexpected replica status (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestRaft_Database_AddPendingVoter(t *testing.T) {
+	localAddr := common.LocalhostCtrlAddr()
+	pendingAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: localAddr.Port}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabaseWithCfg(t, log, &DatabaseConfig{
+		SystemName: "test",
+		Replicas:   []*net.TCPAddr{localAddr, pendingAddr},
+	})
+	db.replicaAddr = localAddr
+	db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+		State:   raft.Leader,
+		Servers: mockRaftServers(localAddr),
+	}, (*fsm)(db)))
+
+	m := system.MockMember(t, 1, system.MemberStateJoined)
+	m.Addr = pendingAddr
+	if err := db.AddMember(m); err != nil {
+		t.Fatal(err)
+	}
+	// Simulate the replica having been added at runtime after the
+	// member joined.
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.RemoveServer(raft.ServerID(pendingAddr.String()), 0, 0).Error()
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.UpdateMember(m); err != nil {
+		t.Fatal(err)
+	}
+
+	servers, err := db.raftServers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 2, len(servers), "expected pending replica to be added as a voter")
+	test.AssertEqual(t, raft.ServerID(pendingAddr.String()), servers[1].ID, "unexpected voter")
+}
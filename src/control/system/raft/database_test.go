@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -235,6 +236,9 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 	maxPools := 1024
 	maxAttrs := 4096
 	maxFindings := 512
+	maxEvents := 128
+	maxQuotas := 64
+	maxUsageSamples := 4
 
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -267,9 +271,11 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 		(*fsm)(db0).Apply(rl)
 	}
 
+	poolUUIDs := make([]uuid.UUID, 0, maxPools)
 	for i := 0; i < maxPools; i++ {
+		poolUUIDs = append(poolUUIDs, uuid.New())
 		ps := &PoolService{
-			PoolUUID:  uuid.New(),
+			PoolUUID:  poolUUIDs[i],
 			PoolLabel: fmt.Sprintf("pool%04d", i),
 			State:     system.PoolServiceStateReady,
 			Replicas:  <-replicas,
@@ -301,6 +307,79 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 		(*fsm)(db0).Apply(rl)
 	}
 
+	for i := 0; i < maxEvents; i++ {
+		rec := &EventRecord{Event: []byte(fmt.Sprintf("event%04d", i))}
+		data, err := createRaftUpdate(raftOpAddEvent, []*EventRecord{rec})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
+	for i := 0; i < maxQuotas; i++ {
+		tq := &TenantQuota{
+			Principal:   fmt.Sprintf("u:user%04d@", i),
+			MaxScmBytes: uint64(i) * 1024,
+			MaxPools:    uint32(i),
+		}
+		data, err := createRaftUpdate(raftOpUpdateTenantQuota, tq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
+	sampleTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < maxUsageSamples; i++ {
+		samples := make(PoolUsageMap)
+		for _, poolUUID := range poolUUIDs {
+			samples[poolUUID] = []*PoolUsageSample{
+				{
+					Time: sampleTime.Add(time.Duration(i) * time.Hour),
+					Tiers: []*PoolTierUsage{
+						{Total: 1024, Free: uint64(1024 - i)},
+						{Total: 4096, Free: uint64(4096 - i)},
+					},
+				},
+			}
+		}
+		data, err := createRaftUpdate(raftOpAddPoolUsageSamples, samples)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
+	for i := 0; i < maxUsageSamples; i++ {
+		records := make(PoolRebuildMap)
+		for _, poolUUID := range poolUUIDs {
+			records[poolUUID] = []*PoolRebuildRecord{
+				{
+					Operation:  "Rebuild",
+					MapVersion: uint32(i),
+					Started:    sampleTime.Add(time.Duration(i) * time.Hour),
+				},
+			}
+		}
+		data, err := createRaftUpdate(raftOpAddPoolRebuildRecord, records)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
 	attrs := make(map[string]string)
 	for i := 0; i < maxAttrs; i++ {
 		attrs[fmt.Sprintf("prop%04d", i)] = fmt.Sprintf("value%04d", i)
@@ -716,6 +795,368 @@ func TestSystem_Database_SystemAttrs(t *testing.T) {
 	}
 }
 
+func TestSystem_Database_Events(t *testing.T) {
+	baseTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockEvt := func(id events.RASID, rank uint32, offset time.Duration) *events.RASEvent {
+		evt := events.NewGenericEvent(id, events.RASSeverityError, "test", "")
+		evt.Rank = rank
+		evt.Timestamp = common.FormatTime(baseTime.Add(offset))
+		return evt
+	}
+	evts := []*events.RASEvent{
+		mockEvt(events.RASEngineDied, 0, 0),
+		mockEvt(events.RASSwimRankDead, 1, time.Minute),
+		mockEvt(events.RASEngineDied, 1, 2*time.Minute),
+		mockEvt(events.RASSystemStartFailed, 2, 3*time.Minute),
+	}
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		filter     *events.Filter
+		since      time.Time
+		until      time.Time
+		expIdx     []int
+		expErr     error
+	}{
+		"not replica": {
+			nonReplica: true,
+			expErr:     errors.New("replica"),
+		},
+		"all events": {
+			expIdx: []int{0, 1, 2, 3},
+		},
+		"filtered by id": {
+			filter: &events.Filter{IDs: []events.RASID{events.RASEngineDied}},
+			expIdx: []int{0, 2},
+		},
+		"filtered by rank": {
+			filter: &events.Filter{Ranks: MustCreateRankSet("1")},
+			expIdx: []int{1, 2},
+		},
+		"since": {
+			since:  baseTime.Add(time.Minute),
+			expIdx: []int{1, 2, 3},
+		},
+		"until": {
+			until:  baseTime.Add(time.Minute),
+			expIdx: []int{0, 1},
+		},
+		"filtered by id within range": {
+			filter: &events.Filter{IDs: []events.RASID{events.RASEngineDied}},
+			since:  baseTime.Add(time.Second),
+			until:  baseTime.Add(time.Hour),
+			expIdx: []int{2},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, evt := range evts {
+				if err := db.AddEvent(evt); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.nonReplica {
+				db.replicaAddr = nil
+			}
+
+			gotEvts, gotErr := db.GetEvents(tc.filter, tc.since, tc.until)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var expTimes, gotTimes []string
+			for _, idx := range tc.expIdx {
+				expTimes = append(expTimes, evts[idx].Timestamp)
+			}
+			for _, evt := range gotEvts {
+				gotTimes = append(gotTimes, evt.Timestamp)
+			}
+			if diff := cmp.Diff(expTimes, gotTimes); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_EventHistoryBounded(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	for i := 0; i < MaxEventRecords+10; i++ {
+		evt := events.NewGenericEvent(events.RASEngineDied, events.RASSeverityError, fmt.Sprintf("evt%d", i), "")
+		if err := db.AddEvent(evt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gotEvts, err := db.GetEvents(nil, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, MaxEventRecords, len(gotEvts), "unexpected number of events")
+	test.AssertEqual(t, "evt10", gotEvts[0].Msg, "unexpected oldest event")
+	test.AssertEqual(t, uint64(MaxEventRecords+10), db.data.Events.NextSeq, "unexpected next sequence")
+}
+
+func TestSystem_Database_AddEvents(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	var evts []*events.RASEvent
+	for i := 0; i < 3; i++ {
+		evts = append(evts, events.NewGenericEvent(events.RASEngineDied, events.RASSeverityError,
+			fmt.Sprintf("evt%d", i), ""))
+	}
+
+	if err := db.AddEvents(nil); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, errors.New("nil event"), db.AddEvents([]*events.RASEvent{evts[0], nil}))
+	if err := db.AddEvents(evts); err != nil {
+		t.Fatal(err)
+	}
+
+	gotEvts, err := db.GetEvents(nil, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotMsgs []string
+	for _, evt := range gotEvts {
+		gotMsgs = append(gotMsgs, evt.Msg)
+	}
+	if diff := cmp.Diff([]string{"evt0", "evt1", "evt2"}, gotMsgs); diff != "" {
+		t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+	}
+	test.AssertEqual(t, uint64(3), db.data.Events.NextSeq, "unexpected next sequence")
+}
+
+func TestSystem_Database_TenantQuotas(t *testing.T) {
+	userQuota := &TenantQuota{Principal: "u:bob@", MaxScmBytes: 1024, MaxPools: 2}
+	groupQuota := &TenantQuota{Principal: "g:builders@", MaxNvmeBytes: 4096}
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		set        []*TenantQuota
+		remove     string
+		principals []string
+		expQuotas  []*TenantQuota
+		expSetErr  error
+		expErr     error
+	}{
+		"not replica": {
+			nonReplica: true,
+			expErr:     errors.New("replica"),
+		},
+		"no quotas": {
+			expQuotas: []*TenantQuota{},
+		},
+		"invalid principal": {
+			set:       []*TenantQuota{{Principal: "bob@"}},
+			expSetErr: errors.New("invalid quota principal"),
+		},
+		"all quotas": {
+			set:       []*TenantQuota{userQuota, groupQuota},
+			expQuotas: []*TenantQuota{groupQuota, userQuota},
+		},
+		"selected quotas": {
+			set:        []*TenantQuota{userQuota, groupQuota},
+			principals: []string{"u:bob@", "u:alice@"},
+			expQuotas:  []*TenantQuota{userQuota},
+		},
+		"replaced quota": {
+			set: []*TenantQuota{
+				userQuota,
+				{Principal: "u:bob@", MaxPools: 5},
+			},
+			expQuotas: []*TenantQuota{{Principal: "u:bob@", MaxPools: 5}},
+		},
+		"removed quota": {
+			set:       []*TenantQuota{userQuota, groupQuota},
+			remove:    "u:bob@",
+			expQuotas: []*TenantQuota{groupQuota},
+		},
+		"remove unknown quota": {
+			remove:    "u:bob@",
+			expSetErr: errors.New("no quota found"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			var setErr error
+			for _, tq := range tc.set {
+				if setErr = db.SetTenantQuota(tq); setErr != nil {
+					break
+				}
+			}
+			if setErr == nil && tc.remove != "" {
+				setErr = db.RemoveTenantQuota(tc.remove)
+			}
+			test.CmpErr(t, tc.expSetErr, setErr)
+			if tc.expSetErr != nil {
+				return
+			}
+			if tc.nonReplica {
+				db.replicaAddr = nil
+			}
+
+			gotQuotas, gotErr := db.TenantQuotas(tc.principals...)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expQuotas, gotQuotas); diff != "" {
+				t.Fatalf("unexpected quotas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_PoolUsageSamples(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx := test.Context(t)
+	db := MockDatabase(t, log)
+	ps := &PoolService{
+		PoolUUID: uuid.New(),
+		State:    system.PoolServiceStateReady,
+		Replicas: []Rank{0},
+		Storage:  &PoolServiceStorage{},
+	}
+	lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+	if err := db.AddPoolService(lock.InContext(ctx), ps); err != nil {
+		t.Fatal(err)
+	}
+	unknownUUID := uuid.New()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < MaxPoolUsageSamples+10; i++ {
+		sample := func() *PoolUsageSample {
+			return &PoolUsageSample{
+				Time:  start.Add(time.Duration(i) * time.Hour),
+				Tiers: []*PoolTierUsage{{Total: 1024, Free: uint64(1024 - i)}},
+			}
+		}
+		if err := db.AddPoolUsageSamples(map[uuid.UUID]*PoolUsageSample{
+			ps.PoolUUID: sample(),
+			unknownUUID: sample(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gotSamples, err := db.PoolUsageSamples(ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, MaxPoolUsageSamples, len(gotSamples), "unexpected number of samples")
+	test.AssertTrue(t, gotSamples[0].Time.Equal(start.Add(10*time.Hour)), "unexpected oldest sample")
+
+	unknownSamples, err := db.PoolUsageSamples(unknownUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(unknownSamples), "samples recorded for unknown pool")
+
+	if err := db.RemovePoolService(lock.InContext(ctx), ps.PoolUUID); err != nil {
+		t.Fatal(err)
+	}
+	gotSamples, err = db.PoolUsageSamples(ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(gotSamples), "samples retained after pool removal")
+
+	db.replicaAddr = nil
+	_, err = db.PoolUsageSamples(ps.PoolUUID)
+	test.CmpErr(t, errors.New("replica"), err)
+}
+
+func TestSystem_Database_PoolRebuildHistory(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx := test.Context(t)
+	db := MockDatabase(t, log)
+	ps := &PoolService{
+		PoolUUID: uuid.New(),
+		State:    system.PoolServiceStateReady,
+		Replicas: []Rank{0},
+		Storage:  &PoolServiceStorage{},
+	}
+	lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+	if err := db.AddPoolService(lock.InContext(ctx), ps); err != nil {
+		t.Fatal(err)
+	}
+	unknownUUID := uuid.New()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockEvent := func(poolUUID uuid.UUID, id events.RASID, ts time.Time, ver int) *events.RASEvent {
+		evt := events.NewGenericEvent(id, events.RASSeverityNotice, "Pool rebuild.",
+			fmt.Sprintf("map_ver: [%d] op: [Rebuild]", ver))
+		evt.PoolUUID = poolUUID.String()
+		evt.Timestamp = common.FormatTime(ts)
+		return evt
+	}
+
+	for i := 0; i < MaxPoolRebuildRecords+10; i++ {
+		for _, poolUUID := range []uuid.UUID{ps.PoolUUID, unknownUUID} {
+			db.OnEvent(ctx, mockEvent(poolUUID, events.RASPoolRebuildStart,
+				start.Add(time.Duration(i)*time.Hour), i))
+		}
+	}
+	lastVer := MaxPoolRebuildRecords + 9
+	db.OnEvent(ctx, mockEvent(ps.PoolUUID, events.RASPoolRebuildFailed,
+		start.Add(time.Duration(lastVer+1)*time.Hour), lastVer))
+
+	gotHistory, err := db.PoolRebuildHistory(ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, MaxPoolRebuildRecords, len(gotHistory), "unexpected number of records")
+	test.AssertEqual(t, uint32(10), gotHistory[0].MapVersion, "unexpected oldest record")
+	latest := gotHistory[len(gotHistory)-1]
+	test.AssertTrue(t, latest.Failed, "latest rebuild not marked failed")
+	test.AssertEqual(t, time.Hour, latest.Duration(), "unexpected duration of latest rebuild")
+
+	unknownHistory, err := db.PoolRebuildHistory(unknownUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(unknownHistory), "records added for unknown pool")
+
+	if err := db.RemovePoolService(lock.InContext(ctx), ps.PoolUUID); err != nil {
+		t.Fatal(err)
+	}
+	gotHistory, err = db.PoolRebuildHistory(ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(gotHistory), "records retained after pool removal")
+
+	db.replicaAddr = nil
+	_, err = db.PoolRebuildHistory(ps.PoolUUID)
+	test.CmpErr(t, errors.New("replica"), err)
+}
+
 func TestSystem_Database_OnEvent(t *testing.T) {
 	puuid := uuid.New()
 	puuidAnother := uuid.New()
@@ -1116,6 +1557,46 @@ func Test_Database_ResignLeadership(t *testing.T) {
 	}
 }
 
+func TestSystem_Database_CurrentTerm(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		term       uint64
+		expTerm    uint64
+		expErr     error
+	}{
+		"not a replica": {
+			nonReplica: true,
+			expErr:     errors.New("not a"),
+		},
+		"success": {
+			term:    7,
+			expTerm: 7,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			if tc.nonReplica {
+				db = MockDatabaseWithAddr(t, log, nil)
+			}
+			db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+				State: raft.Leader,
+				Term:  tc.term,
+			}, (*fsm)(db)))
+
+			gotTerm, gotErr := db.CurrentTerm()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expTerm, gotTerm, "unexpected term")
+		})
+	}
+}
+
 func TestDatabase_TakePoolLock(t *testing.T) {
 	mockUUID := uuid.MustParse(test.MockUUID(1))
 	parentLock := makeLock(1, 1, 1)
@@ -8,6 +8,7 @@ package raft
 
 import (
 	"net"
+	"strconv"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ type (
 		err      error
 		index    uint64
 		response interface{}
+		config   raft.Configuration
 	}
 	mockRaftServiceConfig struct {
 		LeaderCh              <-chan bool
@@ -32,6 +34,8 @@ type (
 		State                 raft.RaftState
 		LeadershipTransferErr error
 		BarrierReturn         raft.Future
+		Term                  uint64
+		Servers               []raft.Server
 	}
 	mockRaftService struct {
 		cfg mockRaftServiceConfig
@@ -39,24 +43,48 @@ type (
 	}
 )
 
-// mockRaftFuture implements raft.Future, raft.IndexFuture, and raft.ApplyFuture
-func (mrf *mockRaftFuture) Error() error          { return mrf.err }
-func (mrf *mockRaftFuture) Index() uint64         { return mrf.index }
-func (mrf *mockRaftFuture) Response() interface{} { return mrf.response }
+// mockRaftFuture implements raft.Future, raft.IndexFuture, raft.ApplyFuture
+// and raft.ConfigurationFuture
+func (mrf *mockRaftFuture) Error() error                      { return mrf.err }
+func (mrf *mockRaftFuture) Index() uint64                     { return mrf.index }
+func (mrf *mockRaftFuture) Response() interface{}             { return mrf.response }
+func (mrf *mockRaftFuture) Configuration() raft.Configuration { return mrf.config }
 
 func (mrs *mockRaftService) Apply(cmd []byte, timeout time.Duration) raft.ApplyFuture {
 	mrs.fsm.Apply(&raft.Log{Data: cmd})
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) AddVoter(_ raft.ServerID, _ raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+func (mr *mockRaftService) AddVoter(id raft.ServerID, addr raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+	for _, srv := range mr.cfg.Servers {
+		if srv.ID == id {
+			return &mockRaftFuture{}
+		}
+	}
+	mr.cfg.Servers = append(mr.cfg.Servers, raft.Server{
+		Suffrage: raft.Voter,
+		ID:       id,
+		Address:  addr,
+	})
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) RemoveServer(_ raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+func (mr *mockRaftService) RemoveServer(id raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+	for i, srv := range mr.cfg.Servers {
+		if srv.ID == id {
+			mr.cfg.Servers = append(mr.cfg.Servers[:i], mr.cfg.Servers[i+1:]...)
+			break
+		}
+	}
 	return &mockRaftFuture{}
 }
 
+func (mrs *mockRaftService) GetConfiguration() raft.ConfigurationFuture {
+	return &mockRaftFuture{
+		config: raft.Configuration{Servers: mrs.cfg.Servers},
+	}
+}
+
 func (mrs *mockRaftService) BootstrapCluster(cfg raft.Configuration) raft.Future {
 	return &mockRaftFuture{}
 }
@@ -85,6 +113,14 @@ func (mrs *mockRaftService) State() raft.RaftState {
 	return mrs.cfg.State
 }
 
+func (mrs *mockRaftService) Stats() map[string]string {
+	return map[string]string{
+		"state":         mrs.cfg.State.String(),
+		"term":          strconv.FormatUint(mrs.cfg.Term, 10),
+		"applied_index": "0",
+	}
+}
+
 func (mrs *mockRaftService) Barrier(time.Duration) raft.Future {
 	if mrs.cfg.BarrierReturn == nil {
 		return &mockRaftFuture{}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -51,6 +52,12 @@ const (
 	raftOpUpdateCheckerFinding
 	raftOpRemoveCheckerFinding
 	raftOpClearCheckerFindings
+	raftOpAddEvent
+	raftOpUpdateReplicas
+	raftOpUpdateTenantQuota
+	raftOpRemoveTenantQuota
+	raftOpAddPoolUsageSamples
+	raftOpAddPoolRebuildRecord
 
 	sysDBFile = "daos_system.db"
 )
@@ -90,6 +97,12 @@ func (ro raftOp) String() string {
 		"updateCheckerFinding",
 		"removeCheckerFinding",
 		"clearCheckerFindings",
+		"addEvent",
+		"updateReplicas",
+		"updateTenantQuota",
+		"removeTenantQuota",
+		"addPoolUsageSamples",
+		"addPoolRebuildRecord",
 	}[ro]
 }
 
@@ -489,6 +502,24 @@ func (db *Database) submitCheckerUpdate(op raftOp, f *checker.Finding) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitEventUpdate submits the given event history update.
+func (db *Database) submitEventUpdate(op raftOp, recs []*EventRecord) error {
+	data, err := createRaftUpdate(op, recs)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// submitQuotaUpdate submits the given tenant quota update.
+func (db *Database) submitQuotaUpdate(op raftOp, tq *system.TenantQuota) error {
+	data, err := createRaftUpdate(op, tq)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
@@ -531,33 +562,49 @@ func (f *fsm) EmergencyShutdown(err error) {
 // Apply is called after the log entry has been committed. This is the
 // only place that direct modification of the data should occur.
 func (f *fsm) Apply(l *raft.Log) interface{} {
+	f.applyLog(l, f.EmergencyShutdown)
+	return nil
+}
+
+// applyLog applies the log entry to the data, calling panicFn on failure.
+func (f *fsm) applyLog(l *raft.Log, panicFn func(error)) {
 	c := new(raftUpdate)
 	if err := json.Unmarshal(l.Data, c); err != nil {
-		f.EmergencyShutdown(errors.Wrapf(err, "failed to unmarshal %+v", l.Data))
-		return nil
+		panicFn(errors.Wrapf(err, "failed to unmarshal %+v", l.Data))
+		return
 	}
 
 	switch c.Op {
 	case raftOpIncMapVer:
 		f.data.applyMapVersionIncrement()
 	case raftOpAddMember, raftOpUpdateMember, raftOpRemoveMember:
-		f.data.applyMemberUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		f.data.applyMemberUpdate(c.Op, c.Data, panicFn)
 	case raftOpAddPoolService, raftOpUpdatePoolService, raftOpRemovePoolService:
-		f.data.applyPoolUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		f.data.applyPoolUpdate(c.Op, c.Data, panicFn)
 	case raftOpUpdateSystemAttrs:
-		f.data.applySystemUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		f.data.applySystemUpdate(c.Op, c.Data, panicFn)
 	case raftOpAddCheckerFinding, raftOpUpdateCheckerFinding, raftOpRemoveCheckerFinding, raftOpClearCheckerFindings:
-		f.data.applyCheckerUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		f.data.applyCheckerUpdate(c.Op, c.Data, panicFn)
+	case raftOpAddEvent:
+		f.data.applyEventUpdate(c.Op, c.Data, panicFn)
+	case raftOpUpdateReplicas:
+		if replicas := f.data.applyReplicasUpdate(c.Op, c.Data, panicFn); replicas != nil {
+			(*Database)(f).updateReplicaList(replicas)
+		}
+	case raftOpUpdateTenantQuota, raftOpRemoveTenantQuota:
+		f.data.applyQuotaUpdate(c.Op, c.Data, panicFn)
+	case raftOpAddPoolUsageSamples:
+		f.data.applyPoolUsageUpdate(c.Op, c.Data, panicFn)
+	case raftOpAddPoolRebuildRecord:
+		f.data.applyPoolRebuildUpdate(c.Op, c.Data, panicFn)
 	default:
-		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
-		return nil
+		panicFn(errors.Errorf("unhandled Apply operation: %d", c.Op))
+		return
 	}
 
 	f.data.Lock()
 	f.data.Version++ // Successful updates should increment this value.
 	f.data.Unlock()
-
-	return nil
 }
 
 // applyMapVersionIncrement is responsible for incrementing the group map version.
@@ -621,6 +668,8 @@ func (d *dbData) applyPoolUpdate(op raftOp, data []byte, panicFn func(error)) {
 		d.Pools.updateService(cur, ps)
 	case raftOpRemovePoolService:
 		d.Pools.removeService(ps)
+		delete(d.PoolUsage.Samples, ps.PoolUUID)
+		delete(d.PoolRebuilds.History, ps.PoolUUID)
 	default:
 		panicFn(errors.Errorf("unhandled Pool Service Apply operation: %d", op))
 		return
@@ -696,6 +745,117 @@ func (d *dbData) applyCheckerUpdate(op raftOp, data []byte, panicFn func(error))
 	}
 }
 
+// applyEventUpdate is responsible for applying the event history update
+// operation to the database.
+func (d *dbData) applyEventUpdate(op raftOp, data []byte, panicFn func(error)) {
+	var recs []*EventRecord
+	if err := json.Unmarshal(data, &recs); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode event update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddEvent:
+		for _, rec := range recs {
+			d.Events.addRecord(rec)
+		}
+	default:
+		panicFn(errors.Errorf("unhandled Event Apply operation: %d", op))
+		return
+	}
+}
+
+// applyReplicasUpdate is responsible for applying the MS replica set update
+// operation to the database. The new replica set is returned on success.
+func (d *dbData) applyReplicasUpdate(op raftOp, data []byte, panicFn func(error)) []string {
+	var replicas []string
+	if err := json.Unmarshal(data, &replicas); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode replicas update"))
+		return nil
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpUpdateReplicas:
+		d.Replicas = replicas
+	default:
+		panicFn(errors.Errorf("unhandled Replicas Apply operation: %d", op))
+		return nil
+	}
+
+	return replicas
+}
+
+// applyQuotaUpdate is responsible for applying the tenant quota update
+// operation to the database.
+func (d *dbData) applyQuotaUpdate(op raftOp, data []byte, panicFn func(error)) {
+	tq := new(system.TenantQuota)
+	if err := json.Unmarshal(data, tq); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode tenant quota update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpUpdateTenantQuota:
+		d.Quotas.Quotas[tq.Principal] = tq
+	case raftOpRemoveTenantQuota:
+		delete(d.Quotas.Quotas, tq.Principal)
+	default:
+		panicFn(errors.Errorf("unhandled Quota Apply operation: %d", op))
+		return
+	}
+}
+
+// applyPoolUsageUpdate is responsible for applying the pool usage sample
+// update operation to the database.
+func (d *dbData) applyPoolUsageUpdate(op raftOp, data []byte, panicFn func(error)) {
+	samples := make(PoolUsageMap)
+	if err := json.Unmarshal(data, &samples); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode pool usage update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddPoolUsageSamples:
+		d.PoolUsage.addSamples(d.Pools.Uuids, samples)
+	default:
+		panicFn(errors.Errorf("unhandled Pool Usage Apply operation: %d", op))
+		return
+	}
+}
+
+// applyPoolRebuildUpdate is responsible for applying the pool rebuild
+// history update operation to the database.
+func (d *dbData) applyPoolRebuildUpdate(op raftOp, data []byte, panicFn func(error)) {
+	records := make(PoolRebuildMap)
+	if err := json.Unmarshal(data, &records); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode pool rebuild update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddPoolRebuildRecord:
+		d.PoolRebuilds.addRecords(d.Pools.Uuids, records)
+	default:
+		panicFn(errors.Errorf("unhandled Pool Rebuild Apply operation: %d", op))
+		return
+	}
+}
+
 // Snapshot is called to support log compaction, so that we don't have to keep
 // every log entry from the start of the system. Instead, the raft service periodically
 // creates a point-in-time snapshot which can be used to restore the current state, or
@@ -732,8 +892,16 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 	f.data.MapVersion = db.data.MapVersion
 	f.data.System = db.data.System
 	f.data.Checker = db.data.Checker
+	f.data.Events = db.data.Events
+	f.data.Quotas = db.data.Quotas
+	f.data.PoolUsage = db.data.PoolUsage
+	f.data.PoolRebuilds = db.data.PoolRebuilds
+	f.data.Replicas = db.data.Replicas
 	f.data.Version = db.data.Version
 	f.data.Unlock()
+	if len(db.data.Replicas) > 0 {
+		(*Database)(f).updateReplicaList(db.data.Replicas)
+	}
 	f.log.Debugf("db snapshot loaded (map version %d; data version %d)", db.data.MapVersion, db.data.Version)
 	return nil
 }
@@ -0,0 +1,451 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	boltdb "github.com/hashicorp/raft-boltdb/v2"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/checker"
+)
+
+// DatabaseExportVersion is the version of the DatabaseExport document format.
+// It must be incremented whenever the format changes incompatibly.
+const DatabaseExportVersion = 6
+
+// DatabaseExport is a human-readable representation of the contents of the
+// system database, suitable for auditing and for hand-repair prior to import.
+type DatabaseExport struct {
+	Version         uint                  `json:"version"`
+	SchemaVersion   uint                  `json:"schema_version"`
+	DataVersion     uint64                `json:"data_version"`
+	MapVersion      uint32                `json:"map_version"`
+	NextRank        ranklist.Rank         `json:"next_rank"`
+	Members         []*system.Member      `json:"members"`
+	PoolServices    []*system.PoolService `json:"pool_services"`
+	SystemAttrs     map[string]string     `json:"system_attributes"`
+	CheckerFindings []*checker.Finding    `json:"checker_findings"`
+	TenantQuotas    []*system.TenantQuota `json:"tenant_quotas"`
+	Replicas        []string              `json:"replicas,omitempty"`
+	PoolUsage       PoolUsageMap          `json:"pool_usage,omitempty"`
+	PoolRebuilds    PoolRebuildMap        `json:"pool_rebuilds,omitempty"`
+	Events          []*EventExport        `json:"events,omitempty"`
+}
+
+// EventExport is a RAS event from the event history. The event is encoded in
+// protobuf JSON format so that it remains readable.
+type EventExport struct {
+	Seq   uint64          `json:"seq"`
+	Event json.RawMessage `json:"event"`
+}
+
+func newEventExport(rec *EventRecord) (*EventExport, error) {
+	pbEvt := new(sharedpb.RASEvent)
+	if err := proto.Unmarshal(rec.Event, pbEvt); err != nil {
+		return nil, errors.Wrapf(err, "decoding event record %d", rec.Seq)
+	}
+	data, err := protojson.Marshal(pbEvt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "exporting event record %d", rec.Seq)
+	}
+
+	return &EventExport{Seq: rec.Seq, Event: data}, nil
+}
+
+func (ee *EventExport) toRecord() (*EventRecord, error) {
+	pbEvt := new(sharedpb.RASEvent)
+	if err := protojson.Unmarshal(ee.Event, pbEvt); err != nil {
+		return nil, errors.Wrapf(err, "decoding event %d", ee.Seq)
+	}
+	data, err := proto.Marshal(pbEvt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "encoding event %d", ee.Seq)
+	}
+
+	return &EventRecord{Seq: ee.Seq, Event: data}, nil
+}
+
+// Validate checks that the exported database is internally consistent and
+// compatible with this version of the software.
+func (de *DatabaseExport) Validate() error {
+	if de == nil {
+		return errors.Errorf("nil %T", de)
+	}
+	if de.Version != DatabaseExportVersion {
+		return errors.Errorf("unsupported export version %d (expected %d)", de.Version, DatabaseExportVersion)
+	}
+	if de.SchemaVersion != CurrentSchemaVersion {
+		return errors.Errorf("unsupported schema version %d (expected %d)", de.SchemaVersion, CurrentSchemaVersion)
+	}
+
+	ranks := make(map[ranklist.Rank]bool)
+	uuids := make(map[uuid.UUID]bool)
+	for i, m := range de.Members {
+		switch {
+		case m == nil:
+			return errors.Errorf("member %d: nil entry", i)
+		case m.Rank.Equals(ranklist.NilRank):
+			return errors.Errorf("member %d: invalid rank", i)
+		case m.UUID == uuid.Nil:
+			return errors.Errorf("rank %d: invalid UUID", m.Rank)
+		case m.Addr == nil:
+			return errors.Errorf("rank %d: invalid address", m.Rank)
+		case m.State == system.MemberStateUnknown:
+			return errors.Errorf("rank %d: invalid state", m.Rank)
+		case ranks[m.Rank]:
+			return errors.Errorf("rank %d: duplicate rank", m.Rank)
+		case uuids[m.UUID]:
+			return errors.Errorf("rank %d: duplicate UUID %s", m.Rank, m.UUID)
+		}
+		ranks[m.Rank] = true
+		uuids[m.UUID] = true
+	}
+
+	poolUUIDs := make(map[uuid.UUID]bool)
+	labels := make(map[string]bool)
+	for i, ps := range de.PoolServices {
+		switch {
+		case ps == nil:
+			return errors.Errorf("pool service %d: nil entry", i)
+		case ps.PoolUUID == uuid.Nil:
+			return errors.Errorf("pool service %d: invalid UUID", i)
+		case poolUUIDs[ps.PoolUUID]:
+			return errors.Errorf("pool %s: duplicate UUID", ps.PoolUUID)
+		case ps.PoolLabel != "" && labels[ps.PoolLabel]:
+			return errors.Errorf("pool %s: duplicate label %q", ps.PoolUUID, ps.PoolLabel)
+		}
+		for _, r := range ps.Replicas {
+			if !ranks[r] {
+				return errors.Errorf("pool %s: service replica rank %d is not a system member", ps.PoolUUID, r)
+			}
+		}
+		poolUUIDs[ps.PoolUUID] = true
+		labels[ps.PoolLabel] = true
+	}
+
+	seqs := make(map[uint64]bool)
+	for i, f := range de.CheckerFindings {
+		switch {
+		case f == nil:
+			return errors.Errorf("checker finding %d: nil entry", i)
+		case seqs[f.Seq]:
+			return errors.Errorf("checker finding 0x%x: duplicate sequence number", f.Seq)
+		}
+		seqs[f.Seq] = true
+	}
+
+	principals := make(map[string]bool)
+	for i, tq := range de.TenantQuotas {
+		if tq == nil {
+			return errors.Errorf("tenant quota %d: nil entry", i)
+		}
+		if err := system.ValidateQuotaPrincipal(tq.Principal); err != nil {
+			return errors.Wrapf(err, "tenant quota %d", i)
+		}
+		if principals[tq.Principal] {
+			return errors.Errorf("tenant quota %s: duplicate principal", tq.Principal)
+		}
+		principals[tq.Principal] = true
+	}
+
+	for poolUUID, samples := range de.PoolUsage {
+		if !poolUUIDs[poolUUID] {
+			return errors.Errorf("pool %s: usage samples for unknown pool", poolUUID)
+		}
+		for i, s := range samples {
+			if s == nil {
+				return errors.Errorf("pool %s: usage sample %d: nil entry", poolUUID, i)
+			}
+		}
+	}
+
+	for poolUUID, recs := range de.PoolRebuilds {
+		if !poolUUIDs[poolUUID] {
+			return errors.Errorf("pool %s: rebuild history for unknown pool", poolUUID)
+		}
+		for i, rec := range recs {
+			if rec == nil {
+				return errors.Errorf("pool %s: rebuild record %d: nil entry", poolUUID, i)
+			}
+		}
+	}
+
+	var lastSeq uint64
+	for i, ee := range de.Events {
+		switch {
+		case ee == nil:
+			return errors.Errorf("event %d: nil entry", i)
+		case ee.Seq <= lastSeq:
+			return errors.Errorf("event %d: sequence number %d out of order", i, ee.Seq)
+		}
+		if _, err := ee.toRecord(); err != nil {
+			return err
+		}
+		lastSeq = ee.Seq
+	}
+
+	replicas, err := ParseReplicas(de.Replicas)
+	if err != nil {
+		return err
+	}
+	for i, r := range replicas {
+		if containsAddr(replicas[:i], r) {
+			return errors.Errorf("replica %s: duplicate address", r)
+		}
+	}
+
+	return nil
+}
+
+// export returns the current contents of the database in export format.
+func (db *Database) export() *DatabaseExport {
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	de := &DatabaseExport{
+		Version:         DatabaseExportVersion,
+		SchemaVersion:   db.data.SchemaVersion,
+		DataVersion:     db.data.Version,
+		MapVersion:      db.data.MapVersion,
+		NextRank:        db.data.NextRank,
+		Members:         make([]*system.Member, 0, len(db.data.Members.Ranks)),
+		PoolServices:    make([]*system.PoolService, 0, len(db.data.Pools.Uuids)),
+		SystemAttrs:     make(map[string]string),
+		CheckerFindings: make([]*checker.Finding, 0, len(db.data.Checker.Findings)),
+		TenantQuotas:    make([]*system.TenantQuota, 0, len(db.data.Quotas.Quotas)),
+		Replicas:        append([]string(nil), db.data.Replicas...),
+		PoolUsage:       make(PoolUsageMap, len(db.data.PoolUsage.Samples)),
+		PoolRebuilds:    make(PoolRebuildMap, len(db.data.PoolRebuilds.History)),
+		Events:          make([]*EventExport, 0, len(db.data.Events.Records)),
+	}
+
+	for _, m := range db.data.Members.Ranks {
+		de.Members = append(de.Members, m)
+	}
+	sort.Slice(de.Members, func(i, j int) bool {
+		return de.Members[i].Rank < de.Members[j].Rank
+	})
+
+	for _, ps := range db.data.Pools.Uuids {
+		de.PoolServices = append(de.PoolServices, ps)
+	}
+	sort.Slice(de.PoolServices, func(i, j int) bool {
+		if de.PoolServices[i].PoolLabel != de.PoolServices[j].PoolLabel {
+			return de.PoolServices[i].PoolLabel < de.PoolServices[j].PoolLabel
+		}
+		return de.PoolServices[i].PoolUUID.String() < de.PoolServices[j].PoolUUID.String()
+	})
+
+	for k, v := range db.data.System.Attributes {
+		de.SystemAttrs[k] = v
+	}
+
+	for _, f := range db.data.Checker.Findings {
+		de.CheckerFindings = append(de.CheckerFindings, copyFinding(f))
+	}
+	sort.Slice(de.CheckerFindings, func(i, j int) bool {
+		return de.CheckerFindings[i].Seq < de.CheckerFindings[j].Seq
+	})
+
+	for _, tq := range db.data.Quotas.Quotas {
+		tqCopy := *tq
+		de.TenantQuotas = append(de.TenantQuotas, &tqCopy)
+	}
+	sort.Slice(de.TenantQuotas, func(i, j int) bool {
+		return de.TenantQuotas[i].Principal < de.TenantQuotas[j].Principal
+	})
+
+	for poolUUID, samples := range db.data.PoolUsage.Samples {
+		de.PoolUsage[poolUUID] = append([]*system.PoolUsageSample(nil), samples...)
+	}
+
+	for poolUUID, recs := range db.data.PoolRebuilds.History {
+		de.PoolRebuilds[poolUUID] = append([]*system.PoolRebuildRecord(nil), recs...)
+	}
+
+	for _, rec := range db.data.Events.Records {
+		ee, err := newEventExport(rec)
+		if err != nil {
+			db.log.Errorf("skipping bad event record: %s", err)
+			continue
+		}
+		de.Events = append(de.Events, ee)
+	}
+
+	return de
+}
+
+// load replaces the contents of the database with the exported contents.
+func (db *Database) load(de *DatabaseExport) {
+	db.data.Lock()
+	defer db.data.Unlock()
+
+	db.data.Version = de.DataVersion
+	db.data.MapVersion = de.MapVersion
+	db.data.NextRank = de.NextRank
+	db.data.SchemaVersion = de.SchemaVersion
+	for _, m := range de.Members {
+		db.data.Members.addMember(m)
+	}
+	for _, ps := range de.PoolServices {
+		db.data.Pools.addService(ps)
+	}
+	for k, v := range de.SystemAttrs {
+		db.data.System.Attributes[k] = v
+	}
+	for _, f := range de.CheckerFindings {
+		db.data.Checker.Findings[f.Seq] = copyFinding(f)
+	}
+	for _, tq := range de.TenantQuotas {
+		tqCopy := *tq
+		db.data.Quotas.Quotas[tq.Principal] = &tqCopy
+	}
+	db.data.Replicas = append([]string(nil), de.Replicas...)
+	db.data.PoolUsage.addSamples(db.data.Pools.Uuids, de.PoolUsage)
+	db.data.PoolRebuilds.addRecords(db.data.Pools.Uuids, de.PoolRebuilds)
+
+	recs := make([]*EventRecord, 0, len(de.Events))
+	for _, ee := range de.Events {
+		rec, err := ee.toRecord()
+		if err != nil {
+			db.log.Errorf("skipping bad event: %s", err)
+			continue
+		}
+		recs = append(recs, rec)
+	}
+	db.data.Events.loadRecords(recs)
+}
+
+// replayLogEntries applies any log entries found in the local raft log
+// following the supplied index to the database.
+func (db *Database) replayLogEntries(afterIdx uint64) error {
+	boltDB, err := boltdb.New(boltdb.Options{
+		Path: db.cfg.DBFilePath(),
+		BoltOptions: &bbolt.Options{
+			ReadOnly: true,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to open boltdb at %s", db.cfg.DBFilePath())
+	}
+	defer boltDB.Close()
+
+	first, err := boltDB.FirstIndex()
+	if err != nil {
+		return errors.Wrap(err, "failed to get first log index")
+	}
+	last, err := boltDB.LastIndex()
+	if err != nil {
+		return errors.Wrap(err, "failed to get last log index")
+	}
+	if first <= afterIdx {
+		first = afterIdx + 1
+	}
+
+	for idx := first; idx <= last && idx != 0; idx++ {
+		entry := new(raft.Log)
+		if err := boltDB.GetLog(idx, entry); err != nil {
+			return errors.Wrapf(err, "failed to get log entry %d", idx)
+		}
+		if entry.Type != raft.LogCommand {
+			continue
+		}
+
+		var applyErr error
+		(*fsm)(db).applyLog(entry, func(err error) {
+			applyErr = err
+		})
+		if applyErr != nil {
+			return errors.Wrapf(applyErr, "failed to apply log entry %d", idx)
+		}
+	}
+
+	return nil
+}
+
+// ExportLocalReplica reads the contents of the local on-disk replica without
+// starting the raft service. The latest snapshot is loaded and any subsequent
+// log entries are applied to it. Note that any log entries that were not
+// committed by a quorum at the time of shutdown will also be applied.
+func ExportLocalReplica(log logging.Logger, cfg *DatabaseConfig) (*DatabaseExport, error) {
+	exists, err := DatabaseExists(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("no system database found at %s", cfg.DBFilePath())
+	}
+
+	db, err := NewDatabase(log, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create database")
+	}
+
+	var snapIdx uint64
+	sInfo, err := GetLatestSnapshot(log, cfg)
+	switch {
+	case err == nil:
+		data, err := readSnapshotData(sInfo.Path)
+		if err != nil {
+			return nil, err
+		}
+		if err := (*fsm)(db).Restore(io.NopCloser(bytes.NewReader(data))); err != nil {
+			return nil, errors.Wrapf(err, "failed to load snapshot from %q", sInfo.Path)
+		}
+		snapIdx = sInfo.Metadata.Index
+	case errors.Is(err, ErrNoRaftSnapshots):
+		log.Debug("no snapshots found; replaying complete raft log")
+	default:
+		return nil, errors.Wrap(err, "failed to get latest snapshot")
+	}
+
+	if err := db.replayLogEntries(snapIdx); err != nil {
+		return nil, err
+	}
+
+	return db.export(), nil
+}
+
+// ImportLocalReplica validates the exported database contents and replaces the
+// local replica state with them. As with RestoreLocalReplica, the local replica
+// is then forced to re-bootstrap into single-node mode.
+func ImportLocalReplica(log logging.Logger, cfg *DatabaseConfig, de *DatabaseExport) error {
+	if err := de.Validate(); err != nil {
+		return errors.Wrap(err, "invalid database export")
+	}
+
+	db, err := NewDatabase(log, cfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to create database")
+	}
+	db.load(de)
+
+	data, err := json.Marshal(db.data)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode database")
+	}
+	meta := &raft.SnapshotMeta{
+		Version: raft.SnapshotVersionMax,
+		Size:    int64(len(data)),
+	}
+
+	return restoreLocalReplica(log, cfg, meta, data)
+}
@@ -0,0 +1,318 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	. "github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockEventExport(t *testing.T, seq uint64, msg string) *EventExport {
+	t.Helper()
+
+	pbEvt, err := events.NewGenericEvent(events.RASEngineDied, events.RASSeverityError, msg, "").ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := protojson.Marshal(pbEvt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &EventExport{Seq: seq, Event: data}
+}
+
+func TestRaft_DatabaseExport_Validate(t *testing.T) {
+	validExport := func() *DatabaseExport {
+		return &DatabaseExport{
+			Version:  DatabaseExportVersion,
+			NextRank: 3,
+			Members: []*system.Member{
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateExcluded),
+			},
+			PoolServices: []*system.PoolService{
+				{
+					PoolUUID:  uuid.New(),
+					PoolLabel: "pool1",
+					Replicas:  []Rank{1, 2},
+				},
+			},
+			TenantQuotas: []*system.TenantQuota{
+				{
+					Principal:   "u:alice@",
+					MaxScmBytes: 1 << 30,
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		modify func(*DatabaseExport)
+		expErr error
+	}{
+		"valid": {},
+		"bad version": {
+			modify: func(de *DatabaseExport) {
+				de.Version = DatabaseExportVersion + 1
+			},
+			expErr: errors.New("unsupported export version"),
+		},
+		"bad schema version": {
+			modify: func(de *DatabaseExport) {
+				de.SchemaVersion = CurrentSchemaVersion + 1
+			},
+			expErr: errors.New("unsupported schema version"),
+		},
+		"duplicate rank": {
+			modify: func(de *DatabaseExport) {
+				de.Members = append(de.Members, system.MockMember(t, 1, system.MemberStateJoined))
+				de.Members[2].UUID = uuid.New()
+			},
+			expErr: errors.New("duplicate rank"),
+		},
+		"duplicate member uuid": {
+			modify: func(de *DatabaseExport) {
+				de.Members[1].UUID = de.Members[0].UUID
+			},
+			expErr: errors.New("duplicate UUID"),
+		},
+		"unknown member state": {
+			modify: func(de *DatabaseExport) {
+				de.Members[0].State = system.MemberStateUnknown
+			},
+			expErr: errors.New("invalid state"),
+		},
+		"duplicate pool label": {
+			modify: func(de *DatabaseExport) {
+				de.PoolServices = append(de.PoolServices, &system.PoolService{
+					PoolUUID:  uuid.New(),
+					PoolLabel: "pool1",
+				})
+			},
+			expErr: errors.New("duplicate label"),
+		},
+		"pool replica not a member": {
+			modify: func(de *DatabaseExport) {
+				de.PoolServices[0].Replicas = []Rank{1, 5}
+			},
+			expErr: errors.New("rank 5 is not a system member"),
+		},
+		"invalid quota principal": {
+			modify: func(de *DatabaseExport) {
+				de.TenantQuotas[0].Principal = "alice"
+			},
+			expErr: errors.New("invalid quota principal"),
+		},
+		"duplicate quota principal": {
+			modify: func(de *DatabaseExport) {
+				de.TenantQuotas = append(de.TenantQuotas, &system.TenantQuota{
+					Principal: "u:alice@",
+					MaxPools:  1,
+				})
+			},
+			expErr: errors.New("duplicate principal"),
+		},
+		"invalid replica address": {
+			modify: func(de *DatabaseExport) {
+				de.Replicas = []string{"127.0.0.1:10001", "bad:addr:port"}
+			},
+			expErr: errors.New("invalid replica address"),
+		},
+		"duplicate replica address": {
+			modify: func(de *DatabaseExport) {
+				de.Replicas = []string{"127.0.0.1:10001", "127.0.0.1:10001"}
+			},
+			expErr: errors.New("duplicate address"),
+		},
+		"usage samples for unknown pool": {
+			modify: func(de *DatabaseExport) {
+				de.PoolUsage = PoolUsageMap{
+					uuid.New(): {{Time: time.Now()}},
+				}
+			},
+			expErr: errors.New("usage samples for unknown pool"),
+		},
+		"nil usage sample": {
+			modify: func(de *DatabaseExport) {
+				de.PoolUsage = PoolUsageMap{
+					de.PoolServices[0].PoolUUID: {nil},
+				}
+			},
+			expErr: errors.New("nil entry"),
+		},
+		"rebuild history for unknown pool": {
+			modify: func(de *DatabaseExport) {
+				de.PoolRebuilds = PoolRebuildMap{
+					uuid.New(): {{Operation: "Rebuild", MapVersion: 2}},
+				}
+			},
+			expErr: errors.New("rebuild history for unknown pool"),
+		},
+		"valid events": {
+			modify: func(de *DatabaseExport) {
+				de.Events = []*EventExport{
+					mockEventExport(t, 1, "evt1"),
+					mockEventExport(t, 3, "evt3"),
+				}
+			},
+		},
+		"nil event": {
+			modify: func(de *DatabaseExport) {
+				de.Events = []*EventExport{nil}
+			},
+			expErr: errors.New("nil entry"),
+		},
+		"events out of order": {
+			modify: func(de *DatabaseExport) {
+				de.Events = []*EventExport{
+					mockEventExport(t, 2, "evt2"),
+					mockEventExport(t, 1, "evt1"),
+				}
+			},
+			expErr: errors.New("sequence number 1 out of order"),
+		},
+		"undecodable event": {
+			modify: func(de *DatabaseExport) {
+				de.Events = []*EventExport{
+					{Seq: 1, Event: json.RawMessage(`{"moo":"cow"}`)},
+				}
+			},
+			expErr: errors.New("decoding event 1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			de := validExport()
+			if tc.modify != nil {
+				tc.modify(de)
+			}
+
+			test.CmpErr(t, tc.expErr, de.Validate())
+		})
+	}
+}
+
+func Test_Raft_ExportImportLocalReplica(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	dbCfg := testDbCfg()
+	srcDir := dbCfg.RaftDir
+	dbCfg.RaftDir = filepath.Join(t.TempDir(), filepath.Base(srcDir))
+	test.CopyDir(t, srcDir, dbCfg.RaftDir)
+
+	exported, err := ExportLocalReplica(log, dbCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := exported.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	sInfo, err := GetLatestSnapshot(log, dbCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotRanks := NewRankSet()
+	for _, m := range exported.Members {
+		gotRanks.Add(m.Rank)
+	}
+	test.AssertEqual(t, sInfo.MemberRanks.String(), gotRanks.String(), "unexpected exported ranks")
+	test.AssertEqual(t, len(sInfo.Pools), len(exported.PoolServices), "unexpected number of exported pools")
+
+	// Hand-edit the export before importing it into a fresh replica.
+	exported.SystemAttrs["imported"] = "true"
+	exported.TenantQuotas = append(exported.TenantQuotas,
+		&system.TenantQuota{
+			Principal:    "g:admins@",
+			MaxNvmeBytes: 1 << 40,
+		},
+		&system.TenantQuota{
+			Principal:   "u:alice@",
+			MaxScmBytes: 1 << 30,
+			MaxPools:    2,
+		},
+	)
+	exported.Replicas = []string{"127.0.0.1:10001", "127.0.0.2:10001"}
+	if len(exported.PoolServices) == 0 {
+		t.Fatal("expected at least one exported pool")
+	}
+	usagePool := exported.PoolServices[0].PoolUUID
+	exported.PoolUsage[usagePool] = append(exported.PoolUsage[usagePool], &system.PoolUsageSample{
+		Time: time.Unix(1700000000, 0).UTC(),
+		Tiers: []*system.PoolTierUsage{
+			{Total: 1 << 30, Free: 1 << 29},
+		},
+	})
+	exported.PoolRebuilds[usagePool] = append(exported.PoolRebuilds[usagePool], &system.PoolRebuildRecord{
+		Operation:  "Rebuild",
+		MapVersion: 7,
+		Started:    time.Unix(1700000000, 0).UTC(),
+		Finished:   time.Unix(1700000600, 0).UTC(),
+	})
+	nextSeq := uint64(1)
+	if len(exported.Events) > 0 {
+		nextSeq = exported.Events[len(exported.Events)-1].Seq + 1
+	}
+	exported.Events = append(exported.Events,
+		mockEventExport(t, nextSeq, "imported event 1"),
+		mockEventExport(t, nextSeq+1, "imported event 2"),
+	)
+
+	dbCfg.RaftDir = filepath.Join(t.TempDir(), filepath.Base(srcDir))
+	if err := ImportLocalReplica(log, dbCfg, exported); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := ExportLocalReplica(log, dbCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	toJSON := func(de *DatabaseExport) string {
+		data, err := json.Marshal(de)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	if diff := cmp.Diff(toJSON(exported), toJSON(imported)); diff != "" {
+		t.Fatalf("unexpected imported database (-want +got):\n%s", diff)
+	}
+	test.AssertEqual(t, 2, len(imported.TenantQuotas), "unexpected number of imported tenant quotas")
+	test.AssertEqual(t, 2, len(imported.Replicas), "unexpected number of imported replicas")
+	test.AssertEqual(t, len(exported.PoolUsage[usagePool]), len(imported.PoolUsage[usagePool]),
+		"unexpected number of imported pool usage samples")
+	test.AssertEqual(t, len(exported.PoolRebuilds[usagePool]), len(imported.PoolRebuilds[usagePool]),
+		"unexpected number of imported pool rebuild records")
+	test.AssertEqual(t, len(exported.Events), len(imported.Events),
+		"unexpected number of imported events")
+}
+
+func Test_Raft_ExportLocalReplica_NoDatabase(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	dbCfg := testDbCfg()
+	dbCfg.RaftDir = t.TempDir()
+
+	_, err := ExportLocalReplica(log, dbCfg)
+	test.CmpErr(t, errors.New("no system database found"), err)
+}
@@ -8,6 +8,7 @@ package raft
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"io"
 	"os"
@@ -27,8 +28,9 @@ import (
 )
 
 const (
-	snapshotMetaFile = "meta.json"
-	snapshotDataFile = "state.bin"
+	snapshotMetaFile   = "meta.json"
+	snapshotDataFile   = "state.bin"
+	snapshotGzDataFile = snapshotDataFile + ".gz"
 )
 
 // GetRaftConfiguration returns the current raft configuration.
@@ -158,6 +160,21 @@ func RestoreLocalReplica(log logging.Logger, cfg *DatabaseConfig, snapPath strin
 		snapPath = tmpDir
 	}
 
+	data, err := readSnapshotData(snapPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read snapshot data from %q", snapPath)
+	}
+
+	if err := restoreLocalReplica(log, cfg, sInfo.Metadata, data); err != nil {
+		return errors.Wrapf(err, "failed to restore snapshot from %q", snapPath)
+	}
+
+	return nil
+}
+
+// restoreLocalReplica replaces the local replica state with the supplied
+// snapshot data and forces the local replica to re-bootstrap.
+func restoreLocalReplica(log logging.Logger, cfg *DatabaseConfig, meta *raft.SnapshotMeta, data []byte) error {
 	// Nuke the existing raft directory to ensure we're restarting from a clean slate.
 	log.Infof("Removing existing raft directory %s", cfg.RaftDir)
 	if err := os.RemoveAll(cfg.RaftDir); err != nil {
@@ -200,10 +217,6 @@ func RestoreLocalReplica(log logging.Logger, cfg *DatabaseConfig, snapPath strin
 		}
 	}()
 
-	data, err := readSnapshotData(snapPath)
-	if err != nil {
-		return errors.Wrapf(err, "failed to read snapshot data from %q", snapPath)
-	}
 	log.Info("Bootstrapping new raft service; waiting for completion")
 	if f := svc.BootstrapCluster(genBootstrapCfg(db.replicaAddr)); f.Error() != nil {
 		return errors.Wrap(f.Error(), "failed to bootstrap cluster")
@@ -219,8 +232,8 @@ func RestoreLocalReplica(log logging.Logger, cfg *DatabaseConfig, snapPath strin
 	}
 
 	log.Info("Leader ready; restoring snapshot")
-	if err := svc.Restore(sInfo.Metadata, bytes.NewReader(data), 0); err != nil {
-		return errors.Wrapf(err, "failed to restore snapshot from %q", snapPath)
+	if err := svc.Restore(meta, bytes.NewReader(data), 0); err != nil {
+		return errors.Wrap(err, "failed to restore snapshot")
 	}
 
 	log.Info("Shutting down raft service")
@@ -465,9 +478,31 @@ func readSnapshotMeta(path string, meta *raft.SnapshotMeta) error {
 func readSnapshotData(path string) ([]byte, error) {
 	dataPath := filepath.Join(path, snapshotDataFile)
 	data, err := os.ReadFile(dataPath)
-	if err != nil {
+	if err == nil {
+		return data, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to read snapshot data from %q", dataPath)
+	}
+
+	// Backups may have been written with compressed snapshot data.
+	gzPath := filepath.Join(path, snapshotGzDataFile)
+	f, gzErr := os.Open(gzPath)
+	if gzErr != nil {
 		return nil, errors.Wrapf(err, "failed to read snapshot data from %q", dataPath)
 	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress snapshot data from %q", gzPath)
+	}
+	defer zr.Close()
+
+	data, err = io.ReadAll(zr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress snapshot data from %q", gzPath)
+	}
 
 	return data, nil
 }
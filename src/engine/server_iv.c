@@ -865,6 +865,13 @@ ds_iv_ns_leader_stop(struct ds_iv_ns *ns)
 	ns->iv_stop = 1;
 }
 
+void
+ds_iv_ns_leader_resume(struct ds_iv_ns *ns)
+{
+	/* Undo ds_iv_ns_leader_stop, e.g. when a pool destroy is cancelled. */
+	ns->iv_stop = 0;
+}
+
 void
 ds_iv_ns_cleanup(struct ds_iv_ns *ns)
 {
@@ -75,6 +75,8 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_CHK_PROP               = 245,
 	DRPC_METHOD_MGMT_CHK_ACT                = 246,
 	DRPC_METHOD_MGMT_SETUP_CLIENT_TELEM     = 247,
+	DRPC_METHOD_MGMT_POOL_RESIZE            = 248,
+	DRPC_METHOD_MGMT_POOL_UNDELETE          = 249,
 
 	NUM_DRPC_MGMT_METHODS /* Must be last */
 };
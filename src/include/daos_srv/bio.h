@@ -621,6 +621,16 @@ int bio_blob_unmap(struct bio_io_context *ctxt, uint64_t off, uint64_t len);
  */
 int bio_blob_unmap_sgl(struct bio_io_context *ctxt, d_sg_list_t *unmap_sgl, uint32_t blk_sz);
 
+/*
+ * Grow the blob of an I/O context, shrinking isn't supported.
+ *
+ * \param[IN] ctxt	I/O context
+ * \param[IN] blob_sz	New blob size in bytes
+ *
+ * \returns		Zero on success, negative value on error
+ */
+int bio_blob_resize(struct bio_io_context *ctxt, uint64_t blob_sz);
+
 /**
  * Write to per VOS instance blob.
  *
@@ -315,6 +315,7 @@ void ds_iv_ns_update(struct ds_iv_ns *ns, unsigned int master_rank, uint64_t ter
 void ds_iv_ns_cleanup(struct ds_iv_ns *ns);
 void ds_iv_ns_stop(struct ds_iv_ns *ns);
 void ds_iv_ns_leader_stop(struct ds_iv_ns *ns);
+void ds_iv_ns_leader_resume(struct ds_iv_ns *ns);
 void ds_iv_ns_start(struct ds_iv_ns *ns);
 void ds_iv_ns_put(struct ds_iv_ns *ns);
 void ds_iv_ns_get(struct ds_iv_ns *ns);
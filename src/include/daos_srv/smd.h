@@ -263,6 +263,17 @@ char *smd_dev_stat2str(enum smd_dev_state state);
  */
 int smd_pool_mark_ready(uuid_t pool_id);
 
+/**
+ * Update the per-target blob size of a pool in smd.
+ *
+ * \param [IN]	pool_id		Pool UUID
+ * \param [IN]	st		SMD device type
+ * \param [IN]	blob_sz		New blob size
+ *
+ * \return			Zero on success, negative value on error
+ */
+int smd_pool_set_blob_sz(uuid_t pool_id, enum smd_dev_type st, uint64_t blob_sz);
+
 static inline void
 smd_pool_free_info(struct smd_pool_info *pool_info)
 {
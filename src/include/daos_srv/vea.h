@@ -151,6 +151,19 @@ int vea_format(struct umem_instance *umem, struct umem_tx_stage_data *txd,
 	       struct vea_space_df *md, uint32_t blk_sz, uint32_t hdr_blks,
 	       uint64_t capacity, vea_format_callback_t cb, void *cb_data,
 	       bool force, uint32_t compat);
+/**
+ * Grow the space tracked by VEA, the added blocks at the end of the block
+ * device are made available for allocation. Shrinking isn't supported.
+ *
+ * \param vsi	   [IN]	In-memory compound free extent index
+ * \param capacity [IN]	New block device capacity in bytes
+ *
+ * \return		Zero on success (or when the capacity is unchanged);
+ *			-DER_NOTSUPPORTED when the new capacity is smaller;
+ *			Appropriated negative value for other errors
+ */
+int vea_extend(struct vea_space_info *vsi, uint64_t capacity);
+
 /**
  * Upgrade VEA to support latest disk format
  *
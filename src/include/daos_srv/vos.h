@@ -444,6 +444,16 @@ vos_pool_open(const char *path, uuid_t uuid, unsigned int flags,
 int
 vos_pool_upgrade(daos_handle_t poh, uint32_t version);
 
+/** Grow the NVMe space of the vos pool
+ *
+ * \param poh		[IN]	Pool open handle
+ * \param nvme_sz	[IN]	New NVMe size in bytes
+ *
+ * \return	0 on success, error otherwise
+ */
+int
+vos_pool_resize(daos_handle_t poh, daos_size_t nvme_sz);
+
 /**
  * Extended vos_pool_open() with an additional 'metrics' parameter to VOS telemetry.
  */
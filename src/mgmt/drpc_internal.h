@@ -44,6 +44,9 @@ ds_mgmt_drpc_pool_drain(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 void
 ds_mgmt_drpc_pool_extend(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
+void
+ds_mgmt_drpc_pool_resize(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
 void
 ds_mgmt_drpc_pool_reintegrate(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
@@ -62,6 +65,9 @@ ds_mgmt_drpc_pool_overwrite_acl(Drpc__Call *drpc_req,
 void
 ds_mgmt_drpc_pool_upgrade(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
+void
+ds_mgmt_drpc_pool_undelete(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
 void
 ds_mgmt_drpc_pool_update_acl(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
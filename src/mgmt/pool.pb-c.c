@@ -187,6 +187,51 @@ void   mgmt__pool_destroy_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_destroy_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__pool_undelete_req__init
+                     (Mgmt__PoolUndeleteReq         *message)
+{
+  static const Mgmt__PoolUndeleteReq init_value = MGMT__POOL_UNDELETE_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_undelete_req__get_packed_size
+                     (const Mgmt__PoolUndeleteReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_undelete_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_undelete_req__pack
+                     (const Mgmt__PoolUndeleteReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_undelete_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_undelete_req__pack_to_buffer
+                     (const Mgmt__PoolUndeleteReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_undelete_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolUndeleteReq *
+       mgmt__pool_undelete_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolUndeleteReq *)
+     protobuf_c_message_unpack (&mgmt__pool_undelete_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_undelete_req__free_unpacked
+                     (Mgmt__PoolUndeleteReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_undelete_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   mgmt__pool_evict_req__init
                      (Mgmt__PoolEvictReq         *message)
 {
@@ -547,6 +592,102 @@ void   mgmt__pool_extend_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_extend_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__pool_resize_req__init
+                     (Mgmt__PoolResizeReq         *message)
+{
+  static const Mgmt__PoolResizeReq init_value = MGMT__POOL_RESIZE_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_resize_req__get_packed_size
+                     (const Mgmt__PoolResizeReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_resize_req__pack
+                     (const Mgmt__PoolResizeReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_resize_req__pack_to_buffer
+                     (const Mgmt__PoolResizeReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolResizeReq *
+       mgmt__pool_resize_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolResizeReq *)
+     protobuf_c_message_unpack (&mgmt__pool_resize_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_resize_req__free_unpacked
+                     (Mgmt__PoolResizeReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_resize_resp__rank_result__init
+                     (Mgmt__PoolResizeResp__RankResult         *message)
+{
+  static const Mgmt__PoolResizeResp__RankResult init_value = MGMT__POOL_RESIZE_RESP__RANK_RESULT__INIT;
+  *message = init_value;
+}
+void   mgmt__pool_resize_resp__init
+                     (Mgmt__PoolResizeResp         *message)
+{
+  static const Mgmt__PoolResizeResp init_value = MGMT__POOL_RESIZE_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_resize_resp__get_packed_size
+                     (const Mgmt__PoolResizeResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_resize_resp__pack
+                     (const Mgmt__PoolResizeResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_resize_resp__pack_to_buffer
+                     (const Mgmt__PoolResizeResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolResizeResp *
+       mgmt__pool_resize_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolResizeResp *)
+     protobuf_c_message_unpack (&mgmt__pool_resize_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_resize_resp__free_unpacked
+                     (Mgmt__PoolResizeResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   mgmt__pool_reint_req__init
                      (Mgmt__PoolReintReq         *message)
 {
@@ -1955,6 +2096,70 @@ const ProtobufCMessageDescriptor mgmt__pool_destroy_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_destroy_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__pool_undelete_req__field_descriptors[3] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolUndeleteReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolUndeleteReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolUndeleteReq, n_svc_ranks),
+    offsetof(Mgmt__PoolUndeleteReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_undelete_req__field_indices_by_name[] = {
+  1,   /* field[1] = id */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__pool_undelete_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_undelete_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolUndeleteReq",
+  "PoolUndeleteReq",
+  "Mgmt__PoolUndeleteReq",
+  "mgmt",
+  sizeof(Mgmt__PoolUndeleteReq),
+  3,
+  mgmt__pool_undelete_req__field_descriptors,
+  mgmt__pool_undelete_req__field_indices_by_name,
+  1,  mgmt__pool_undelete_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_undelete_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor mgmt__pool_evict_req__field_descriptors[7] =
 {
   {
@@ -2558,6 +2763,224 @@ const ProtobufCMessageDescriptor mgmt__pool_extend_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_extend_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__pool_resize_req__field_descriptors[6] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolResizeReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolResizeReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolResizeReq, n_svc_ranks),
+    offsetof(Mgmt__PoolResizeReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "tier_bytes",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(Mgmt__PoolResizeReq, n_tier_bytes),
+    offsetof(Mgmt__PoolResizeReq, tier_bytes),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "total_bytes",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolResizeReq, total_bytes),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "ranks",
+    6,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolResizeReq, n_ranks),
+    offsetof(Mgmt__PoolResizeReq, ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_resize_req__field_indices_by_name[] = {
+  1,   /* field[1] = id */
+  5,   /* field[5] = ranks */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+  3,   /* field[3] = tier_bytes */
+  4,   /* field[4] = total_bytes */
+};
+static const ProtobufCIntRange mgmt__pool_resize_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 6 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_resize_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolResizeReq",
+  "PoolResizeReq",
+  "Mgmt__PoolResizeReq",
+  "mgmt",
+  sizeof(Mgmt__PoolResizeReq),
+  6,
+  mgmt__pool_resize_req__field_descriptors,
+  mgmt__pool_resize_req__field_indices_by_name,
+  1,  mgmt__pool_resize_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_resize_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_resize_resp__rank_result__field_descriptors[2] =
+{
+  {
+    "rank",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolResizeResp__RankResult, rank),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "status",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolResizeResp__RankResult, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_resize_resp__rank_result__field_indices_by_name[] = {
+  0,   /* field[0] = rank */
+  1,   /* field[1] = status */
+};
+static const ProtobufCIntRange mgmt__pool_resize_resp__rank_result__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_resize_resp__rank_result__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolResizeResp.RankResult",
+  "RankResult",
+  "Mgmt__PoolResizeResp__RankResult",
+  "mgmt",
+  sizeof(Mgmt__PoolResizeResp__RankResult),
+  2,
+  mgmt__pool_resize_resp__rank_result__field_descriptors,
+  mgmt__pool_resize_resp__rank_result__field_indices_by_name,
+  1,  mgmt__pool_resize_resp__rank_result__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_resize_resp__rank_result__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_resize_resp__field_descriptors[3] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolResizeResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "tier_bytes",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(Mgmt__PoolResizeResp, n_tier_bytes),
+    offsetof(Mgmt__PoolResizeResp, tier_bytes),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "rank_results",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__PoolResizeResp, n_rank_results),
+    offsetof(Mgmt__PoolResizeResp, rank_results),
+    &mgmt__pool_resize_resp__rank_result__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_resize_resp__field_indices_by_name[] = {
+  2,   /* field[2] = rank_results */
+  0,   /* field[0] = status */
+  1,   /* field[1] = tier_bytes */
+};
+static const ProtobufCIntRange mgmt__pool_resize_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_resize_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolResizeResp",
+  "PoolResizeResp",
+  "Mgmt__PoolResizeResp",
+  "mgmt",
+  sizeof(Mgmt__PoolResizeResp),
+  3,
+  mgmt__pool_resize_resp__field_descriptors,
+  mgmt__pool_resize_resp__field_indices_by_name,
+  1,  mgmt__pool_resize_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_resize_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor mgmt__pool_reint_req__field_descriptors[7] =
 {
   {
@@ -2904,7 +3327,7 @@ const ProtobufCMessageDescriptor mgmt__list_pools_resp__descriptor =
   (ProtobufCMessageInit) mgmt__list_pools_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__list_cont_req__field_descriptors[3] =
+static const ProtobufCFieldDescriptor mgmt__list_cont_req__field_descriptors[4] =
 {
   {
     "sys",
@@ -2942,8 +3365,21 @@ static const ProtobufCFieldDescriptor mgmt__list_cont_req__field_descriptors[3]
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "details",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContReq, details),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__list_cont_req__field_indices_by_name[] = {
+  3,   /* field[3] = details */
   1,   /* field[1] = id */
   2,   /* field[2] = svc_ranks */
   0,   /* field[0] = sys */
@@ -2951,7 +3387,7 @@ static const unsigned mgmt__list_cont_req__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__list_cont_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 4 }
 };
 const ProtobufCMessageDescriptor mgmt__list_cont_req__descriptor =
 {
@@ -2961,14 +3397,14 @@ const ProtobufCMessageDescriptor mgmt__list_cont_req__descriptor =
   "Mgmt__ListContReq",
   "mgmt",
   sizeof(Mgmt__ListContReq),
-  3,
+  4,
   mgmt__list_cont_req__field_descriptors,
   mgmt__list_cont_req__field_indices_by_name,
   1,  mgmt__list_cont_req__number_ranges,
   (ProtobufCMessageInit) mgmt__list_cont_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descriptors[1] =
+static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descriptors[7] =
 {
   {
     "uuid",
@@ -2982,14 +3418,92 @@ static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descript
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "label",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContResp__Cont, label),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "owner_user",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContResp__Cont, owner_user),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "owner_group",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContResp__Cont, owner_group),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "redun_fac",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContResp__Cont, redun_fac),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "redun_lvl",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContResp__Cont, redun_lvl),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "status",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContResp__Cont, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__list_cont_resp__cont__field_indices_by_name[] = {
+  1,   /* field[1] = label */
+  3,   /* field[3] = owner_group */
+  2,   /* field[2] = owner_user */
+  4,   /* field[4] = redun_fac */
+  5,   /* field[5] = redun_lvl */
+  6,   /* field[6] = status */
   0,   /* field[0] = uuid */
 };
 static const ProtobufCIntRange mgmt__list_cont_resp__cont__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 1 }
+  { 0, 7 }
 };
 const ProtobufCMessageDescriptor mgmt__list_cont_resp__cont__descriptor =
 {
@@ -2999,7 +3513,7 @@ const ProtobufCMessageDescriptor mgmt__list_cont_resp__cont__descriptor =
   "Mgmt__ListContResp__Cont",
   "mgmt",
   sizeof(Mgmt__ListContResp__Cont),
-  1,
+  7,
   mgmt__list_cont_resp__cont__field_descriptors,
   mgmt__list_cont_resp__cont__field_indices_by_name,
   1,  mgmt__list_cont_resp__cont__number_ranges,
@@ -3267,7 +3781,7 @@ const ProtobufCEnumDescriptor mgmt__pool_rebuild_status__state__descriptor =
   mgmt__pool_rebuild_status__state__value_ranges,
   NULL,NULL,NULL,NULL   /* reserved[1234] */
 };
-static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[4] =
+static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[5] =
 {
   {
     "status",
@@ -3317,17 +3831,30 @@ static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descripto
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "total_objects",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, total_objects),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__pool_rebuild_status__field_indices_by_name[] = {
   2,   /* field[2] = objects */
   3,   /* field[3] = records */
   1,   /* field[1] = state */
   0,   /* field[0] = status */
+  4,   /* field[4] = total_objects */
 };
 static const ProtobufCIntRange mgmt__pool_rebuild_status__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 4 }
+  { 0, 5 }
 };
 const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
 {
@@ -3337,7 +3864,7 @@ const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
   "Mgmt__PoolRebuildStatus",
   "mgmt",
   sizeof(Mgmt__PoolRebuildStatus),
-  4,
+  5,
   mgmt__pool_rebuild_status__field_descriptors,
   mgmt__pool_rebuild_status__field_indices_by_name,
   1,  mgmt__pool_rebuild_status__number_ranges,
@@ -19,6 +19,7 @@ typedef struct _Mgmt__PoolCreateReq Mgmt__PoolCreateReq;
 typedef struct _Mgmt__PoolCreateResp Mgmt__PoolCreateResp;
 typedef struct _Mgmt__PoolDestroyReq Mgmt__PoolDestroyReq;
 typedef struct _Mgmt__PoolDestroyResp Mgmt__PoolDestroyResp;
+typedef struct _Mgmt__PoolUndeleteReq Mgmt__PoolUndeleteReq;
 typedef struct _Mgmt__PoolEvictReq Mgmt__PoolEvictReq;
 typedef struct _Mgmt__PoolEvictResp Mgmt__PoolEvictResp;
 typedef struct _Mgmt__PoolExcludeReq Mgmt__PoolExcludeReq;
@@ -27,6 +28,9 @@ typedef struct _Mgmt__PoolDrainReq Mgmt__PoolDrainReq;
 typedef struct _Mgmt__PoolDrainResp Mgmt__PoolDrainResp;
 typedef struct _Mgmt__PoolExtendReq Mgmt__PoolExtendReq;
 typedef struct _Mgmt__PoolExtendResp Mgmt__PoolExtendResp;
+typedef struct _Mgmt__PoolResizeReq Mgmt__PoolResizeReq;
+typedef struct _Mgmt__PoolResizeResp Mgmt__PoolResizeResp;
+typedef struct _Mgmt__PoolResizeResp__RankResult Mgmt__PoolResizeResp__RankResult;
 typedef struct _Mgmt__PoolReintReq Mgmt__PoolReintReq;
 typedef struct _Mgmt__PoolReintResp Mgmt__PoolReintResp;
 typedef struct _Mgmt__ListPoolsReq Mgmt__ListPoolsReq;
@@ -311,6 +315,31 @@ struct  _Mgmt__PoolDestroyResp
     , 0 }
 
 
+/*
+ * PoolUndeleteReq supplies pool identifier.
+ */
+struct  _Mgmt__PoolUndeleteReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid or label of pool to restore
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__POOL_UNDELETE_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_undelete_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+
+
 /*
  * PoolEvictReq supplies pool identifier.
  */
@@ -542,6 +571,88 @@ struct  _Mgmt__PoolExtendResp
     , 0, 0,NULL }
 
 
+/*
+ * PoolResizeReq supplies pool identifier and new per-rank storage allocation.
+ */
+struct  _Mgmt__PoolResizeReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid or label of pool to resize
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+  /*
+   * Per-rank size in bytes of storage tiers, 0 keeps current
+   */
+  size_t n_tier_bytes;
+  uint64_t *tier_bytes;
+  /*
+   * Total pool size in bytes (auto config)
+   */
+  uint64_t total_bytes;
+  /*
+   * Ranks of the pool to be resized (set by the MS)
+   */
+  size_t n_ranks;
+  uint32_t *ranks;
+};
+#define MGMT__POOL_RESIZE_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_resize_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0, 0,NULL }
+
+
+struct  _Mgmt__PoolResizeResp__RankResult
+{
+  ProtobufCMessage base;
+  /*
+   * rank resized
+   */
+  uint32_t rank;
+  /*
+   * DAOS error code of resize on rank
+   */
+  int32_t status;
+};
+#define MGMT__POOL_RESIZE_RESP__RANK_RESULT__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_resize_resp__rank_result__descriptor) \
+    , 0, 0 }
+
+
+/*
+ * PoolResizeResp returns resultant state of Resize operation.
+ */
+struct  _Mgmt__PoolResizeResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * per-rank storage tiers allocated to pool
+   */
+  size_t n_tier_bytes;
+  uint64_t *tier_bytes;
+  /*
+   * result of resize on each rank
+   */
+  size_t n_rank_results;
+  Mgmt__PoolResizeResp__RankResult **rank_results;
+};
+#define MGMT__POOL_RESIZE_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_resize_resp__descriptor) \
+    , 0, 0,NULL, 0,NULL }
+
+
 /*
  * PoolReintReq supplies pool identifier, rank, and target_idxs.
  */
@@ -693,10 +804,14 @@ struct  _Mgmt__ListContReq
    */
   size_t n_svc_ranks;
   uint32_t *svc_ranks;
+  /*
+   * Include container ownership and redundancy details
+   */
+  protobuf_c_boolean details;
 };
 #define MGMT__LIST_CONT_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_cont_req__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0 }
 
 
 struct  _Mgmt__ListContResp__Cont
@@ -706,10 +821,34 @@ struct  _Mgmt__ListContResp__Cont
    * uuid of container
    */
   char *uuid;
+  /*
+   * label of container
+   */
+  char *label;
+  /*
+   * container owner user (details only)
+   */
+  char *owner_user;
+  /*
+   * container owner group (details only)
+   */
+  char *owner_group;
+  /*
+   * container redundancy factor (details only)
+   */
+  uint64_t redun_fac;
+  /*
+   * container redundancy level (details only)
+   */
+  uint64_t redun_lvl;
+  /*
+   * DAOS error code if details could not be retrieved
+   */
+  int32_t status;
 };
 #define MGMT__LIST_CONT_RESP__CONT__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_cont_resp__cont__descriptor) \
-    , (char *)protobuf_c_empty_string }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0 }
 
 
 struct  _Mgmt__ListContResp
@@ -787,10 +926,14 @@ struct  _Mgmt__PoolRebuildStatus
   Mgmt__PoolRebuildStatus__State state;
   uint64_t objects;
   uint64_t records;
+  /*
+   * objects to be rebuilt
+   */
+  uint64_t total_objects;
 };
 #define MGMT__POOL_REBUILD_STATUS__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebuild_status__descriptor) \
-    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0 }
+    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0, 0 }
 
 
 /*
@@ -1245,6 +1388,25 @@ Mgmt__PoolDestroyResp *
 void   mgmt__pool_destroy_resp__free_unpacked
                      (Mgmt__PoolDestroyResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolUndeleteReq methods */
+void   mgmt__pool_undelete_req__init
+                     (Mgmt__PoolUndeleteReq         *message);
+size_t mgmt__pool_undelete_req__get_packed_size
+                     (const Mgmt__PoolUndeleteReq   *message);
+size_t mgmt__pool_undelete_req__pack
+                     (const Mgmt__PoolUndeleteReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_undelete_req__pack_to_buffer
+                     (const Mgmt__PoolUndeleteReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolUndeleteReq *
+       mgmt__pool_undelete_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_undelete_req__free_unpacked
+                     (Mgmt__PoolUndeleteReq *message,
+                      ProtobufCAllocator *allocator);
 /* Mgmt__PoolEvictReq methods */
 void   mgmt__pool_evict_req__init
                      (Mgmt__PoolEvictReq         *message);
@@ -1397,6 +1559,47 @@ Mgmt__PoolExtendResp *
 void   mgmt__pool_extend_resp__free_unpacked
                      (Mgmt__PoolExtendResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolResizeReq methods */
+void   mgmt__pool_resize_req__init
+                     (Mgmt__PoolResizeReq         *message);
+size_t mgmt__pool_resize_req__get_packed_size
+                     (const Mgmt__PoolResizeReq   *message);
+size_t mgmt__pool_resize_req__pack
+                     (const Mgmt__PoolResizeReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_resize_req__pack_to_buffer
+                     (const Mgmt__PoolResizeReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolResizeReq *
+       mgmt__pool_resize_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_resize_req__free_unpacked
+                     (Mgmt__PoolResizeReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolResizeResp__RankResult methods */
+void   mgmt__pool_resize_resp__rank_result__init
+                     (Mgmt__PoolResizeResp__RankResult         *message);
+/* Mgmt__PoolResizeResp methods */
+void   mgmt__pool_resize_resp__init
+                     (Mgmt__PoolResizeResp         *message);
+size_t mgmt__pool_resize_resp__get_packed_size
+                     (const Mgmt__PoolResizeResp   *message);
+size_t mgmt__pool_resize_resp__pack
+                     (const Mgmt__PoolResizeResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_resize_resp__pack_to_buffer
+                     (const Mgmt__PoolResizeResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolResizeResp *
+       mgmt__pool_resize_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_resize_resp__free_unpacked
+                     (Mgmt__PoolResizeResp *message,
+                      ProtobufCAllocator *allocator);
 /* Mgmt__PoolReintReq methods */
 void   mgmt__pool_reint_req__init
                      (Mgmt__PoolReintReq         *message);
@@ -1816,6 +2019,9 @@ typedef void (*Mgmt__PoolDestroyReq_Closure)
 typedef void (*Mgmt__PoolDestroyResp_Closure)
                  (const Mgmt__PoolDestroyResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolUndeleteReq_Closure)
+                 (const Mgmt__PoolUndeleteReq *message,
+                  void *closure_data);
 typedef void (*Mgmt__PoolEvictReq_Closure)
                  (const Mgmt__PoolEvictReq *message,
                   void *closure_data);
@@ -1840,6 +2046,15 @@ typedef void (*Mgmt__PoolExtendReq_Closure)
 typedef void (*Mgmt__PoolExtendResp_Closure)
                  (const Mgmt__PoolExtendResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolResizeReq_Closure)
+                 (const Mgmt__PoolResizeReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolResizeResp__RankResult_Closure)
+                 (const Mgmt__PoolResizeResp__RankResult *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolResizeResp_Closure)
+                 (const Mgmt__PoolResizeResp *message,
+                  void *closure_data);
 typedef void (*Mgmt__PoolReintReq_Closure)
                  (const Mgmt__PoolReintReq *message,
                   void *closure_data);
@@ -1921,6 +2136,7 @@ extern const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_create_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_destroy_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_destroy_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_undelete_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_evict_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_evict_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_exclude_req__descriptor;
@@ -1929,6 +2145,9 @@ extern const ProtobufCMessageDescriptor mgmt__pool_drain_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_drain_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_extend_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_extend_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_resize_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_resize_resp__rank_result__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_resize_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_reint_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_reint_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__list_pools_req__descriptor;
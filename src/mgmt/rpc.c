@@ -86,6 +86,9 @@ CRT_RPC_DEFINE(mgmt_get_bs_state, DAOS_ISEQ_MGMT_GET_BS_STATE,
 CRT_RPC_DEFINE(mgmt_tgt_shard_destroy, DAOS_ISEQ_MGMT_TGT_SHARD_DESTROY,
 	       DAOS_OSEQ_MGMT_TGT_SHARD_DESTROY)
 
+CRT_RPC_DEFINE(mgmt_tgt_resize, DAOS_ISEQ_MGMT_TGT_RESIZE,
+	       DAOS_OSEQ_MGMT_TGT_RESIZE)
+
 /* Define for cont_rpcs[] array population below.
  * See MGMT_PROTO_*_RPC_LIST macro definition
  */
@@ -44,7 +44,9 @@
 	  &ds_mgmt_hdlr_tgt_map_update_co_ops)                                                     \
 	X(MGMT_TGT_MARK, 0, &CQF_mgmt_mark, ds_mgmt_tgt_mark_hdlr, NULL)                           \
 	X(MGMT_TGT_SHARD_DESTROY, 0, &CQF_mgmt_tgt_shard_destroy, ds_mgmt_hdlr_tgt_shard_destroy,  \
-	  NULL)
+	  NULL)                                                                                    \
+	X(MGMT_TGT_RESIZE, 0, &CQF_mgmt_tgt_resize, ds_mgmt_hdlr_tgt_resize,                       \
+	  &ds_mgmt_hdlr_tgt_resize_co_ops)
 
 /* Define for RPC enum population below */
 #define X(a, ...) a,
@@ -236,6 +238,18 @@ CRT_RPC_DECLARE(mgmt_get_bs_state, DAOS_ISEQ_MGMT_GET_BS_STATE,
 CRT_RPC_DECLARE(mgmt_tgt_shard_destroy, DAOS_ISEQ_MGMT_TGT_SHARD_DESTROY,
 		DAOS_OSEQ_MGMT_TGT_SHARD_DESTROY)
 
+#define DAOS_ISEQ_MGMT_TGT_RESIZE /* input fields */		 \
+	((uuid_t)		(tr_pool_uuid)		CRT_VAR) \
+	((daos_size_t)		(tr_nvme_size)		CRT_VAR)
+
+#define DAOS_OSEQ_MGMT_TGT_RESIZE /* output fields */		   \
+	((d_rank_t)		(tr_ranks)		CRT_ARRAY) \
+	((int32_t)		(tr_rcs)		CRT_ARRAY) \
+	((int32_t)		(tr_rc)			CRT_VAR)
+
+CRT_RPC_DECLARE(mgmt_tgt_resize, DAOS_ISEQ_MGMT_TGT_RESIZE,
+		DAOS_OSEQ_MGMT_TGT_RESIZE)
+
 /* clang-format on */
 
 #endif /* __MGMT_RPC_H__ */
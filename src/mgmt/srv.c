@@ -45,6 +45,12 @@ static struct crt_corpc_ops ds_mgmt_hdlr_tgt_map_update_co_ops = {
 	.co_pre_forward	= ds_mgmt_tgt_map_update_pre_forward,
 };
 
+static struct crt_corpc_ops ds_mgmt_hdlr_tgt_resize_co_ops = {
+	.co_aggregate	= ds_mgmt_tgt_resize_aggregator,
+	.co_pre_forward	= NULL,
+	.co_post_reply	= ds_mgmt_tgt_resize_post_reply,
+};
+
 /* Define for cont_rpcs[] array population below.
  * See MGMT_PROTO_*_RPC_LIST macro definition
  */
@@ -93,6 +99,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_POOL_UPGRADE:
 		ds_mgmt_drpc_pool_upgrade(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_POOL_UNDELETE:
+		ds_mgmt_drpc_pool_undelete(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_POOL_EVICT:
 		ds_mgmt_drpc_pool_evict(drpc_req, drpc_resp);
 		break;
@@ -108,6 +117,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_POOL_EXTEND:
 		ds_mgmt_drpc_pool_extend(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_POOL_RESIZE:
+		ds_mgmt_drpc_pool_resize(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_BIO_HEALTH_QUERY:
 		ds_mgmt_drpc_bio_health_query(drpc_req, drpc_resp);
 		break;
@@ -66,3 +66,13 @@ out_prop:
 	daos_prop_free(prop);
 	return rc;
 }
+
+int
+ds_mgmt_cont_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, uuid_t cont_uuid,
+		      daos_prop_t **prop)
+{
+	D_DEBUG(DB_MGMT, DF_CONT ": Getting container properties\n",
+		DP_CONT(pool_uuid, cont_uuid));
+
+	return ds_cont_svc_get_prop(pool_uuid, cont_uuid, svc_ranks, prop);
+}
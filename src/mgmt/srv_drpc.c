@@ -935,6 +935,96 @@ out:
 	mgmt__pool_extend_req__free_unpacked(req, &alloc.alloc);
 }
 
+void
+ds_mgmt_drpc_pool_resize(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc		 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolResizeReq		*req = NULL;
+	Mgmt__PoolResizeResp		 resp;
+	Mgmt__PoolResizeResp__RankResult *results = NULL;
+	d_rank_list_t			*rank_list = NULL;
+	d_rank_t			*ranks = NULL;
+	int32_t				*rcs = NULL;
+	size_t				 nr = 0;
+	uuid_t				 uuid;
+	uint8_t				*body;
+	size_t				 len;
+	size_t				 i;
+	int				 rc;
+
+	mgmt__pool_resize_resp__init(&resp);
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_resize_req__unpack(&alloc.alloc, drpc_req->body.len,
+					    drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (Resize pool)\n");
+		return;
+	}
+
+	if (req->n_tier_bytes <= DAOS_MEDIA_NVME || req->n_tier_bytes > DAOS_MEDIA_MAX) {
+		D_ERROR("Invalid number of storage tiers: " DF_U64 "\n", req->n_tier_bytes);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (uuid_parse(req->id, uuid) != 0) {
+		rc = -DER_INVAL;
+		DL_ERROR(rc, "Pool UUID is invalid");
+		D_GOTO(out, rc);
+	}
+
+	rank_list = uint32_array_to_rank_list(req->ranks, req->n_ranks);
+	if (rank_list == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	/* Only the NVMe allocation can be changed on an existing pool */
+	rc = ds_mgmt_pool_resize(uuid, rank_list, req->tier_bytes[DAOS_MEDIA_NVME], &ranks, &rcs,
+				 &nr);
+	if (rc != 0)
+		D_ERROR("Failed to resize pool %s: "DF_RC"\n", req->id, DP_RC(rc));
+
+	if (nr > 0) {
+		D_ALLOC_ARRAY(resp.rank_results, nr);
+		D_ALLOC_ARRAY(results, nr);
+		if (resp.rank_results == NULL || results == NULL)
+			D_GOTO(out_list, rc = -DER_NOMEM);
+		resp.n_rank_results = nr;
+
+		for (i = 0; i < nr; i++) {
+			resp.rank_results[i] = &results[i];
+			mgmt__pool_resize_resp__rank_result__init(resp.rank_results[i]);
+			resp.rank_results[i]->rank = ranks[i];
+			resp.rank_results[i]->status = rcs[i];
+		}
+	}
+
+	/* Echo back the allocations from the request */
+	resp.n_tier_bytes = req->n_tier_bytes;
+	resp.tier_bytes   = req->tier_bytes;
+
+out_list:
+	d_rank_list_free(rank_list);
+out:
+	resp.status = rc;
+	len = mgmt__pool_resize_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__pool_resize_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	D_FREE(results);
+	D_FREE(resp.rank_results);
+	D_FREE(ranks);
+	D_FREE(rcs);
+	mgmt__pool_resize_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 ds_mgmt_drpc_pool_reintegrate(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -1116,6 +1206,51 @@ out:
 	mgmt__pool_upgrade_req__free_unpacked(req, &alloc.alloc);
 }
 
+void
+ds_mgmt_drpc_pool_undelete(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolUndeleteReq	*req = NULL;
+	Mgmt__DaosResp		 resp = MGMT__DAOS_RESP__INIT;
+	uuid_t			 uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	int			 rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_undelete_req__unpack(&alloc.alloc,
+					      drpc_req->body.len,
+					      drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (undelete pool)\n");
+		return;
+	}
+
+	D_INFO("Received request to undelete pool %s\n", req->id);
+
+	if (uuid_parse(req->id, uuid) != 0) {
+		rc = -DER_INVAL;
+		DL_ERROR(rc, "Pool UUID is invalid");
+		goto out;
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	/* Reopen the pool to the connections that were refused on soft-delete. */
+	rc = ds_mgmt_pool_reopen(uuid, svc_ranks);
+
+	d_rank_list_free(svc_ranks);
+
+out:
+	resp.status = rc;
+	pack_daos_response(&resp, drpc_resp);
+
+	mgmt__pool_undelete_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 free_response_props(Mgmt__PoolProperty **props, size_t n_props)
 {
@@ -1607,6 +1742,76 @@ out:
 	mgmt__delete_aclreq__free_unpacked(req, &alloc.alloc);
 }
 
+static int
+add_cont_details(uuid_t pool_uuid, d_rank_list_t *svc_ranks, uuid_t cont_uuid,
+		 Mgmt__ListContResp__Cont *cont)
+{
+	daos_prop_t		*prop = NULL;
+	struct daos_prop_entry	*entry;
+	int			 rc;
+
+	rc = ds_mgmt_cont_get_prop(pool_uuid, svc_ranks, cont_uuid, &prop);
+	if (rc != 0) {
+		/* Report the failure for this container only */
+		DL_ERROR(rc, DF_CONT ": failed to get container details",
+			 DP_CONT(pool_uuid, cont_uuid));
+		cont->status = rc;
+		return 0;
+	}
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_OWNER);
+	if (entry != NULL && entry->dpe_str != NULL && entry->dpe_str[0] != '\0') {
+		D_STRNDUP(cont->owner_user, entry->dpe_str, DAOS_ACL_MAX_PRINCIPAL_LEN);
+		if (cont->owner_user == NULL)
+			D_GOTO(out, rc = -DER_NOMEM);
+	}
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_OWNER_GROUP);
+	if (entry != NULL && entry->dpe_str != NULL && entry->dpe_str[0] != '\0') {
+		D_STRNDUP(cont->owner_group, entry->dpe_str, DAOS_ACL_MAX_PRINCIPAL_LEN);
+		if (cont->owner_group == NULL)
+			D_GOTO(out, rc = -DER_NOMEM);
+	}
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_REDUN_FAC);
+	if (entry != NULL)
+		cont->redun_fac = entry->dpe_val;
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_REDUN_LVL);
+	if (entry != NULL)
+		cont->redun_lvl = entry->dpe_val;
+
+out:
+	daos_prop_free(prop);
+	return rc;
+}
+
+static void
+free_cont_list_resp(Mgmt__ListContResp *resp)
+{
+	Mgmt__ListContResp__Cont *cont;
+	int                       i;
+
+	if (resp->containers == NULL)
+		return;
+
+	for (i = 0; i < resp->n_containers; i++) {
+		cont = resp->containers[i];
+		if (cont == NULL)
+			continue;
+		if (cont->uuid)
+			D_FREE(cont->uuid);
+		if (cont->label && cont->label[0] != '\0')
+			D_FREE(cont->label);
+		if (cont->owner_user && cont->owner_user[0] != '\0')
+			D_FREE(cont->owner_user);
+		if (cont->owner_group && cont->owner_group[0] != '\0')
+			D_FREE(cont->owner_group);
+		D_FREE(cont);
+	}
+	D_FREE(resp->containers);
+}
+
 void
 ds_mgmt_drpc_pool_list_cont(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -1676,6 +1881,20 @@ ds_mgmt_drpc_pool_list_cont(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 		if (resp.containers[i]->uuid == NULL)
 			D_GOTO(out_ranks, rc = -DER_NOMEM);
 		uuid_unparse(containers[i].pci_uuid, resp.containers[i]->uuid);
+
+		if (containers[i].pci_label[0] != '\0') {
+			D_STRNDUP(resp.containers[i]->label, containers[i].pci_label,
+				  DAOS_PROP_LABEL_MAX_LEN);
+			if (resp.containers[i]->label == NULL)
+				D_GOTO(out_ranks, rc = -DER_NOMEM);
+		}
+
+		if (req->details) {
+			rc = add_cont_details(req_uuid, svc_ranks, containers[i].pci_uuid,
+					      resp.containers[i]);
+			if (rc != 0)
+				D_GOTO(out_ranks, rc);
+		}
 	}
 
 out_ranks:
@@ -1694,16 +1913,7 @@ out:
 
 	mgmt__list_cont_req__free_unpacked(req, &alloc.alloc);
 
-	if (resp.containers) {
-		for (i = 0; i < resp.n_containers; i++) {
-			if (resp.containers[i]) {
-				if (resp.containers[i]->uuid)
-					D_FREE(resp.containers[i]->uuid);
-				D_FREE(resp.containers[i]);
-			}
-		}
-		D_FREE(resp.containers);
-	}
+	free_cont_list_resp(&resp);
 
 	D_FREE(containers);
 }
@@ -1731,6 +1941,7 @@ pool_rebuild_status_from_info(Mgmt__PoolRebuildStatus *rebuild,
 	if (rebuild->status == 0) {
 		rebuild->objects = info->rs_obj_nr;
 		rebuild->records = info->rs_rec_nr;
+		rebuild->total_objects = info->rs_toberb_obj_nr;
 
 		if (info->rs_version == 0)
 			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__IDLE;
@@ -102,10 +102,14 @@ ds_mgmt_pool_extend(uuid_t pool_uuid, d_rank_list_t *svc_ranks, d_rank_list_t *r
 		    size_t scm_size, size_t nvme_size, size_t meta_size, size_t domains_nr,
 		    uint32_t *domains);
 int
+ds_mgmt_pool_resize(uuid_t pool_uuid, d_rank_list_t *rank_list, size_t nvme_size,
+		    d_rank_t **ranks, int32_t **rcs, size_t *nr);
+int
 ds_mgmt_pool_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t *prop);
 int
     ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t *prop);
 int ds_mgmt_pool_upgrade(uuid_t pool_uuid, d_rank_list_t *svc_ranks);
+int ds_mgmt_pool_reopen(uuid_t pool_uuid, d_rank_list_t *svc_ranks);
 int
 ds_mgmt_pool_get_acl(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t **access_prop);
 int
@@ -133,6 +137,9 @@ int
 int
      ds_mgmt_cont_set_owner(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id,
 			    const char *user, const char *group);
+int
+     ds_mgmt_cont_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, uuid_t cont_uuid,
+			   daos_prop_t **prop);
 
 /** srv_chk.c */
 int ds_mgmt_check_start(uint32_t rank_nr, d_rank_t *ranks, uint32_t policy_nr,
@@ -183,6 +190,9 @@ void ds_mgmt_hdlr_tgt_map_update(crt_rpc_t *rpc);
 int ds_mgmt_tgt_map_update_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 				      void *priv);
 void ds_mgmt_tgt_mark_hdlr(crt_rpc_t *rpc);
+void ds_mgmt_hdlr_tgt_resize(crt_rpc_t *rpc_req);
+int ds_mgmt_tgt_resize_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv);
+int ds_mgmt_tgt_resize_post_reply(crt_rpc_t *rpc, void *priv);
 
 /** srv_util.c */
 int ds_mgmt_group_update(struct server_entry *servers, int nservers, uint32_t version);
@@ -312,6 +312,80 @@ out:
 	return rc;
 }
 
+int
+ds_mgmt_pool_resize(uuid_t pool_uuid, d_rank_list_t *rank_list, size_t nvme_size,
+		    d_rank_t **ranks, int32_t **rcs, size_t *nr)
+{
+	crt_rpc_t			*tr_req;
+	crt_opcode_t			 opc;
+	struct mgmt_tgt_resize_in	*tr_in;
+	struct mgmt_tgt_resize_out	*tr_out;
+	d_rank_t			*out_ranks = NULL;
+	int32_t				*out_rcs = NULL;
+	size_t				 out_nr;
+	int				 topo;
+	int				 rc;
+
+	D_DEBUG(DB_MGMT, "resize pool "DF_UUID"\n", DP_UUID(pool_uuid));
+
+	/* Collective RPC to all of targets of the pool */
+	topo = crt_tree_topo(CRT_TREE_KNOMIAL, 4);
+	opc = DAOS_RPC_OPCODE(MGMT_TGT_RESIZE, DAOS_MGMT_MODULE, DAOS_MGMT_VERSION);
+	rc = crt_corpc_req_create(dss_get_module_info()->dmi_ctx, NULL, rank_list, opc, NULL,
+				  NULL, CRT_RPC_FLAG_FILTER_INVERT, topo, &tr_req);
+	if (rc) {
+		D_ERROR(DF_UUID": corpc_req_create failed: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	tr_in = crt_req_get(tr_req);
+	D_ASSERT(tr_in != NULL);
+	uuid_copy(tr_in->tr_pool_uuid, pool_uuid);
+	tr_in->tr_nvme_size = nvme_size;
+	rc = dss_rpc_send(tr_req);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": dss_rpc_send MGMT_TGT_RESIZE: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_GOTO(decref, rc);
+	}
+
+	tr_out = crt_reply_get(tr_req);
+	out_nr = tr_out->tr_ranks.ca_count;
+	D_ASSERT(tr_out->tr_rcs.ca_count == out_nr);
+
+	if (out_nr > 0) {
+		D_ALLOC_ARRAY(out_ranks, out_nr);
+		D_ALLOC_ARRAY(out_rcs, out_nr);
+		if (out_ranks == NULL || out_rcs == NULL) {
+			D_FREE(out_ranks);
+			D_FREE(out_rcs);
+			D_GOTO(free, rc = -DER_NOMEM);
+		}
+		memcpy(out_ranks, tr_out->tr_ranks.ca_arrays, out_nr * sizeof(*out_ranks));
+		memcpy(out_rcs, tr_out->tr_rcs.ca_arrays, out_nr * sizeof(*out_rcs));
+	}
+
+	*ranks = out_ranks;
+	*rcs = out_rcs;
+	*nr = out_nr;
+
+	/* The per-rank results are returned even if some of the ranks failed */
+	rc = tr_out->tr_rc;
+	if (rc != 0)
+		D_ERROR(DF_UUID": failed to resize targets: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+	else
+		D_DEBUG(DB_MGMT, DF_UUID" resized pool tgts on %zu ranks\n",
+			DP_UUID(pool_uuid), out_nr);
+free:
+	D_FREE(tr_out->tr_ranks.ca_arrays);
+	D_FREE(tr_out->tr_rcs.ca_arrays);
+decref:
+	crt_req_decref(tr_req);
+	return rc;
+}
+
 int
 ds_mgmt_evict_pool(uuid_t pool_uuid, d_rank_list_t *svc_ranks, uuid_t *handles, size_t n_handles,
 		   uint32_t destroy, uint32_t force_destroy, char *machine, uint32_t *count)
@@ -625,6 +699,14 @@ int ds_mgmt_pool_upgrade(uuid_t pool_uuid, d_rank_list_t *svc_ranks)
 	return dsc_pool_svc_upgrade(pool_uuid, svc_ranks, mgmt_ps_call_deadline());
 }
 
+int
+ds_mgmt_pool_reopen(uuid_t pool_uuid, d_rank_list_t *svc_ranks)
+{
+	D_DEBUG(DB_MGMT, "Reopening pool " DF_UUID "\n", DP_UUID(pool_uuid));
+
+	return dsc_pool_svc_reopen(pool_uuid, svc_ranks, mgmt_ps_call_deadline());
+}
+
 int
 ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 		      daos_prop_t *prop)
@@ -1267,6 +1267,150 @@ out_reply:
 		D_FREE(rank);
 }
 
+int
+ds_mgmt_tgt_resize_post_reply(crt_rpc_t *rpc, void *priv)
+{
+	struct mgmt_tgt_resize_out	*tr_out;
+
+	tr_out = crt_reply_get(rpc);
+	D_FREE(tr_out->tr_ranks.ca_arrays);
+	D_FREE(tr_out->tr_rcs.ca_arrays);
+
+	return 0;
+}
+
+int
+ds_mgmt_tgt_resize_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv)
+{
+	struct mgmt_tgt_resize_out	*tr_out;
+	struct mgmt_tgt_resize_out	*ret_out;
+	d_rank_t			*new_ranks;
+	int32_t				*new_rcs;
+	unsigned int			 tr_nr;
+	unsigned int			 ret_nr;
+	int				 i;
+
+	tr_out = crt_reply_get(source);
+	tr_nr = tr_out->tr_ranks.ca_count;
+	D_ASSERT(tr_out->tr_rcs.ca_count == tr_nr);
+
+	ret_out = crt_reply_get(result);
+	ret_nr = ret_out->tr_ranks.ca_count;
+
+	if (tr_out->tr_rc != 0 && ret_out->tr_rc == 0)
+		ret_out->tr_rc = tr_out->tr_rc;
+	if (tr_nr == 0)
+		return 0;
+
+	D_ALLOC_ARRAY(new_ranks, ret_nr + tr_nr);
+	if (new_ranks == NULL)
+		return -DER_NOMEM;
+	D_ALLOC_ARRAY(new_rcs, ret_nr + tr_nr);
+	if (new_rcs == NULL) {
+		D_FREE(new_ranks);
+		return -DER_NOMEM;
+	}
+
+	for (i = 0; i < ret_nr; i++) {
+		new_ranks[i] = ((d_rank_t *)ret_out->tr_ranks.ca_arrays)[i];
+		new_rcs[i] = ((int32_t *)ret_out->tr_rcs.ca_arrays)[i];
+	}
+	for (i = 0; i < tr_nr; i++) {
+		new_ranks[ret_nr + i] = ((d_rank_t *)tr_out->tr_ranks.ca_arrays)[i];
+		new_rcs[ret_nr + i] = ((int32_t *)tr_out->tr_rcs.ca_arrays)[i];
+	}
+
+	D_FREE(ret_out->tr_ranks.ca_arrays);
+	D_FREE(ret_out->tr_rcs.ca_arrays);
+
+	ret_out->tr_ranks.ca_arrays = new_ranks;
+	ret_out->tr_ranks.ca_count = ret_nr + tr_nr;
+	ret_out->tr_rcs.ca_arrays = new_rcs;
+	ret_out->tr_rcs.ca_count = ret_nr + tr_nr;
+	return 0;
+}
+
+static int
+tgt_vos_resize_one(void *varg)
+{
+	struct vos_pool_arg	*vpa = varg;
+	struct ds_pool_child	*child;
+	int			 rc;
+
+	child = ds_pool_child_lookup(vpa->vpa_uuid);
+	if (child == NULL)
+		return -DER_NONEXIST;
+
+	if (*child->spc_state != POOL_CHILD_STARTED) {
+		D_ERROR(DF_UUID": pool child isn't started (%u)\n",
+			DP_UUID(vpa->vpa_uuid), *child->spc_state);
+		D_GOTO(out, rc = -DER_AGAIN);
+	}
+
+	if (child->spc_no_storage)
+		D_GOTO(out, rc = 0);
+
+	rc = vos_pool_resize(child->spc_hdl, vpa->vpa_nvme_size);
+	if (rc)
+		DL_ERROR(rc, DF_UUID": failed to resize vos pool to "DF_U64" bytes of NVMe",
+			 DP_UUID(vpa->vpa_uuid), vpa->vpa_nvme_size);
+out:
+	ds_pool_child_put(child);
+	return rc;
+}
+
+void
+ds_mgmt_hdlr_tgt_resize(crt_rpc_t *tr_req)
+{
+	struct mgmt_tgt_resize_in	*tr_in;
+	struct mgmt_tgt_resize_out	*tr_out;
+	struct vos_pool_arg		 vpa = {0};
+	d_rank_t			*rank = NULL;
+	int32_t				*rank_rc = NULL;
+	int				 rc;
+
+	tr_in = crt_req_get(tr_req);
+	tr_out = crt_reply_get(tr_req);
+	D_ASSERT(tr_in != NULL && tr_out != NULL);
+
+	D_DEBUG(DB_MGMT, DF_UUID": processing rpc %p\n",
+		DP_UUID(tr_in->tr_pool_uuid), tr_req);
+
+	D_ALLOC_PTR(rank);
+	D_ALLOC_PTR(rank_rc);
+	if (rank == NULL || rank_rc == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = crt_group_rank(NULL, rank);
+	if (rc)
+		D_GOTO(out, rc);
+
+	D_ASSERT(dss_tgt_nr > 0);
+	uuid_copy(vpa.vpa_uuid, tr_in->tr_pool_uuid);
+	vpa.vpa_nvme_size = tr_in->tr_nvme_size / dss_tgt_nr;
+	rc = dss_thread_collective(tgt_vos_resize_one, &vpa, DSS_ULT_DEEP_STACK);
+	if (rc)
+		DL_ERROR(rc, DF_UUID": thread collective tgt_vos_resize_one failed",
+			 DP_UUID(tr_in->tr_pool_uuid));
+	else
+		D_INFO(DF_UUID": resized pool to "DF_U64" bytes of NVMe\n",
+		       DP_UUID(tr_in->tr_pool_uuid), tr_in->tr_nvme_size);
+
+	/* Report the result of this rank, the error is also aggregated in tr_rc */
+	*rank_rc = rc;
+	tr_out->tr_ranks.ca_arrays = rank;
+	tr_out->tr_ranks.ca_count = 1;
+	tr_out->tr_rcs.ca_arrays = rank_rc;
+	tr_out->tr_rcs.ca_count = 1;
+out:
+	tr_out->tr_rc = rc;
+	rc = crt_reply_send(tr_req);
+	if (rc || tr_out->tr_ranks.ca_arrays == NULL) {
+		D_FREE(rank);
+		D_FREE(rank_rc);
+	}
+}
+
 static void *
 tgt_destroy_cleanup(void *arg)
 {
@@ -441,6 +441,32 @@ void mock_ds_mgmt_cont_set_owner_teardown(void)
 	D_FREE(ds_mgmt_cont_set_owner_group);
 }
 
+int          ds_mgmt_cont_get_prop_return;
+daos_prop_t *ds_mgmt_cont_get_prop_out;
+int
+ds_mgmt_cont_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, uuid_t cont_uuid,
+		      daos_prop_t **prop)
+{
+	if (prop != NULL && ds_mgmt_cont_get_prop_out != NULL)
+		*prop = daos_prop_dup(ds_mgmt_cont_get_prop_out, false, false);
+
+	return ds_mgmt_cont_get_prop_return;
+}
+
+void
+mock_ds_mgmt_cont_get_prop_setup(void)
+{
+	ds_mgmt_cont_get_prop_return = 0;
+	ds_mgmt_cont_get_prop_out    = NULL;
+}
+
+void
+mock_ds_mgmt_cont_get_prop_teardown(void)
+{
+	daos_prop_free(ds_mgmt_cont_get_prop_out);
+	ds_mgmt_cont_get_prop_out = NULL;
+}
+
 int     ds_mgmt_target_update_return;
 uuid_t  ds_mgmt_target_update_uuid;
 int
@@ -478,6 +504,40 @@ mock_ds_mgmt_pool_extend_setup(void)
 	uuid_clear(ds_mgmt_pool_extend_uuid);
 }
 
+int     ds_mgmt_pool_resize_return;
+uuid_t  ds_mgmt_pool_resize_uuid;
+size_t  ds_mgmt_pool_resize_nvme_size;
+int32_t ds_mgmt_pool_resize_rank_rc;
+int
+ds_mgmt_pool_resize(uuid_t pool_uuid, d_rank_list_t *rank_list, size_t nvme_size,
+		    d_rank_t **ranks, int32_t **rcs, size_t *nr)
+{
+	uuid_copy(ds_mgmt_pool_resize_uuid, pool_uuid);
+	ds_mgmt_pool_resize_nvme_size = nvme_size;
+
+	/* Report a result for the first rank of the list */
+	if (rank_list != NULL && rank_list->rl_nr > 0) {
+		D_ALLOC_PTR(*ranks);
+		D_ALLOC_PTR(*rcs);
+		if (*ranks == NULL || *rcs == NULL)
+			return -DER_NOMEM;
+		(*ranks)[0] = rank_list->rl_ranks[0];
+		(*rcs)[0] = ds_mgmt_pool_resize_rank_rc;
+		*nr = 1;
+	}
+
+	return ds_mgmt_pool_resize_return;
+}
+
+void
+mock_ds_mgmt_pool_resize_setup(void)
+{
+	ds_mgmt_pool_resize_return = 0;
+	uuid_clear(ds_mgmt_pool_resize_uuid);
+	ds_mgmt_pool_resize_nvme_size = 0;
+	ds_mgmt_pool_resize_rank_rc = 0;
+}
+
 int     ds_mgmt_pool_evict_return;
 uuid_t  ds_mgmt_pool_evict_uuid;
 
@@ -590,6 +650,23 @@ mock_ds_mgmt_pool_upgrade_setup(void)
 	uuid_clear(ds_mgmt_pool_upgrade_uuid);
 }
 
+int	ds_mgmt_pool_reopen_return;
+uuid_t  ds_mgmt_pool_reopen_uuid;
+
+int
+ds_mgmt_pool_reopen(uuid_t pool_uuid, d_rank_list_t *svc_ranks)
+{
+	uuid_copy(ds_mgmt_pool_reopen_uuid, pool_uuid);
+	return ds_mgmt_pool_reopen_return;
+}
+
+void
+mock_ds_mgmt_pool_reopen_setup(void)
+{
+	ds_mgmt_pool_reopen_return = 0;
+	uuid_clear(ds_mgmt_pool_reopen_uuid);
+}
+
 int	ds_mgmt_dev_manage_led_return;
 uuid_t  ds_mgmt_dev_manage_led_uuid;
 
@@ -100,6 +100,15 @@ extern int		ds_mgmt_pool_extend_return;
 extern uuid_t		ds_mgmt_pool_extend_uuid;
 void mock_ds_mgmt_pool_extend_setup(void);
 
+/*
+ * Mock ds_mgmt_pool_resize
+ */
+extern int		ds_mgmt_pool_resize_return;
+extern uuid_t		ds_mgmt_pool_resize_uuid;
+extern size_t		ds_mgmt_pool_resize_nvme_size;
+extern int32_t		ds_mgmt_pool_resize_rank_rc;
+void mock_ds_mgmt_pool_resize_setup(void);
+
 /*
  * Mock ds_mgmt_pool_query
  */
@@ -149,6 +158,14 @@ extern char                             *ds_mgmt_cont_set_owner_user;
 extern char                             *ds_mgmt_cont_set_owner_group;
 void mock_ds_mgmt_cont_set_owner_setup(void);
 void mock_ds_mgmt_cont_set_owner_teardown(void);
+
+/*
+ * Mock ds_mgmt_cont_get_prop
+ */
+extern int                               ds_mgmt_cont_get_prop_return;
+extern daos_prop_t                      *ds_mgmt_cont_get_prop_out;
+void mock_ds_mgmt_cont_get_prop_setup(void);
+void mock_ds_mgmt_cont_get_prop_teardown(void);
 void mock_ds_mgmt_pool_query_targets_gen_infos(uint32_t n_infos);
 
 /*
@@ -158,6 +175,13 @@ extern int	ds_mgmt_pool_upgrade_return;
 extern uuid_t	ds_mgmt_pool_upgrade_uuid;
 void mock_ds_mgmt_pool_upgrade_setup(void);
 
+/*
+ * Mock ds_mgmt_pool_reopen
+ */
+extern int	ds_mgmt_pool_reopen_return;
+extern uuid_t	ds_mgmt_pool_reopen_uuid;
+void mock_ds_mgmt_pool_reopen_setup(void);
+
 /*
  * Mock ds_mgmt_dev_manage_led
  */
@@ -103,12 +103,14 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_exclude);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_drain);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_extend);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_resize);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_reintegrate);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_set_prop);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_get_prop);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_get_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_overwrite_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_upgrade);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_undelete);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_update_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_delete_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_query);
@@ -646,6 +648,7 @@ static int
 drpc_list_cont_setup(void **state)
 {
 	mock_ds_mgmt_pool_list_cont_setup();
+	mock_ds_mgmt_cont_get_prop_setup();
 
 	return 0;
 }
@@ -654,6 +657,7 @@ static int
 drpc_list_cont_teardown(void **state)
 {
 	mock_ds_mgmt_pool_list_cont_teardown();
+	mock_ds_mgmt_cont_get_prop_teardown();
 
 	return 0;
 }
@@ -802,6 +806,92 @@ test_drpc_pool_list_cont_with_containers(void **state)
 	D_FREE(resp.body.data);
 }
 
+static void
+setup_list_cont_details_drpc_call(Drpc__Call *call, char *uuid)
+{
+	Mgmt__ListContReq lc_req = MGMT__LIST_CONT_REQ__INIT;
+
+	lc_req.id      = uuid;
+	lc_req.details = true;
+	pack_list_cont_req(call, &lc_req);
+}
+
+static void
+test_drpc_pool_list_cont_with_details(void **state)
+{
+	Drpc__Call          call = DRPC__CALL__INIT;
+	Drpc__Response      resp = DRPC__RESPONSE__INIT;
+	Mgmt__ListContResp *lc_resp;
+	const size_t        ncont = 4;
+	daos_prop_t        *prop;
+	size_t              i;
+
+	setup_list_cont_details_drpc_call(&call, TEST_UUID);
+	mock_ds_mgmt_list_cont_gen_cont(ncont);
+	strncpy(ds_mgmt_pool_list_cont_out[0].pci_label, "cont0", DAOS_PROP_LABEL_MAX_LEN);
+
+	prop = daos_prop_alloc(4);
+	assert_non_null(prop);
+	prop->dpp_entries[0].dpe_type = DAOS_PROP_CO_OWNER;
+	D_STRNDUP_S(prop->dpp_entries[0].dpe_str, "user@");
+	prop->dpp_entries[1].dpe_type = DAOS_PROP_CO_OWNER_GROUP;
+	D_STRNDUP_S(prop->dpp_entries[1].dpe_str, "group@");
+	prop->dpp_entries[2].dpe_type = DAOS_PROP_CO_REDUN_FAC;
+	prop->dpp_entries[2].dpe_val  = DAOS_PROP_CO_REDUN_RF2;
+	prop->dpp_entries[3].dpe_type = DAOS_PROP_CO_REDUN_LVL;
+	prop->dpp_entries[3].dpe_val  = DAOS_PROP_CO_REDUN_NODE;
+	ds_mgmt_cont_get_prop_out     = prop;
+
+	ds_mgmt_drpc_pool_list_cont(&call, &resp);
+
+	expect_drpc_list_cont_resp_with_containers(&resp, ds_mgmt_pool_list_cont_out, ncont);
+
+	lc_resp = mgmt__list_cont_resp__unpack(NULL, resp.body.len, resp.body.data);
+	assert_non_null(lc_resp);
+	assert_string_equal(lc_resp->containers[0]->label, "cont0");
+	for (i = 0; i < ncont; i++) {
+		assert_int_equal(lc_resp->containers[i]->status, 0);
+		assert_string_equal(lc_resp->containers[i]->owner_user, "user@");
+		assert_string_equal(lc_resp->containers[i]->owner_group, "group@");
+		assert_int_equal(lc_resp->containers[i]->redun_fac, DAOS_PROP_CO_REDUN_RF2);
+		assert_int_equal(lc_resp->containers[i]->redun_lvl, DAOS_PROP_CO_REDUN_NODE);
+	}
+	mgmt__list_cont_resp__free_unpacked(lc_resp, NULL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_list_cont_details_fail(void **state)
+{
+	Drpc__Call          call = DRPC__CALL__INIT;
+	Drpc__Response      resp = DRPC__RESPONSE__INIT;
+	Mgmt__ListContResp *lc_resp;
+	const size_t        ncont = 2;
+	size_t              i;
+
+	setup_list_cont_details_drpc_call(&call, TEST_UUID);
+	mock_ds_mgmt_list_cont_gen_cont(ncont);
+	ds_mgmt_cont_get_prop_return = -DER_NONEXIST;
+
+	ds_mgmt_drpc_pool_list_cont(&call, &resp);
+
+	/* the list itself succeeds, with the error reported per container */
+	expect_drpc_list_cont_resp_with_containers(&resp, ds_mgmt_pool_list_cont_out, ncont);
+
+	lc_resp = mgmt__list_cont_resp__unpack(NULL, resp.body.len, resp.body.data);
+	assert_non_null(lc_resp);
+	for (i = 0; i < ncont; i++) {
+		assert_int_equal(lc_resp->containers[i]->status, -DER_NONEXIST);
+		assert_string_equal(lc_resp->containers[i]->owner_user, "");
+	}
+	mgmt__list_cont_resp__free_unpacked(lc_resp, NULL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * dRPC Pool SetProp setup/teardown
  */
@@ -2069,6 +2159,150 @@ test_drpc_extend_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * dRPC pool resize test setup
+ */
+static int
+drpc_pool_resize_setup(void **state)
+{
+	mock_ds_mgmt_pool_resize_setup();
+	return 0;
+}
+
+/*
+ * dRPC pool resize tests
+ */
+static void
+pack_pool_resize_req(Drpc__Call *call, Mgmt__PoolResizeReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__pool_resize_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_resize_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+setup_resize_drpc_call(Drpc__Call *call, char *uuid, size_t n_tier_bytes)
+{
+	Mgmt__PoolResizeReq req = MGMT__POOL_RESIZE_REQ__INIT;
+	uint64_t            tier_bytes[2] = {1000000000, 2000000000};
+
+	req.id = uuid;
+	req.n_ranks = 3;
+	req.ranks = TEST_RANKS;
+	req.n_tier_bytes = n_tier_bytes;
+	req.tier_bytes   = tier_bytes;
+	pack_pool_resize_req(call, &req);
+}
+
+static Mgmt__PoolResizeResp *
+expect_drpc_resize_resp_with_error(Drpc__Response *resp, int exp_error)
+{
+	Mgmt__PoolResizeResp	*pr_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	pr_resp = mgmt__pool_resize_resp__unpack(NULL, resp->body.len,
+						 resp->body.data);
+	assert_non_null(pr_resp);
+	assert_int_equal(pr_resp->status, exp_error);
+
+	return pr_resp;
+}
+
+static void
+test_drpc_resize_bad_uuid(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolResizeResp	*pr_resp;
+
+	setup_resize_drpc_call(&call, "BAD", 2);
+
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+
+	pr_resp = expect_drpc_resize_resp_with_error(&resp, -DER_INVAL);
+	mgmt__pool_resize_resp__free_unpacked(pr_resp, NULL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_resize_no_nvme_tier(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolResizeResp	*pr_resp;
+
+	setup_resize_drpc_call(&call, TEST_UUID, 1);
+
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+
+	pr_resp = expect_drpc_resize_resp_with_error(&resp, -DER_INVAL);
+	mgmt__pool_resize_resp__free_unpacked(pr_resp, NULL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_resize_mgmt_svc_fails(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolResizeResp	*pr_resp;
+
+	setup_resize_drpc_call(&call, TEST_UUID, 2);
+	ds_mgmt_pool_resize_return = -DER_NOSPACE;
+	ds_mgmt_pool_resize_rank_rc = -DER_NOSPACE;
+
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+
+	pr_resp = expect_drpc_resize_resp_with_error(&resp, -DER_NOSPACE);
+	assert_int_equal(pr_resp->n_rank_results, 1);
+	assert_int_equal(pr_resp->rank_results[0]->rank, TEST_RANKS[0]);
+	assert_int_equal(pr_resp->rank_results[0]->status, -DER_NOSPACE);
+	mgmt__pool_resize_resp__free_unpacked(pr_resp, NULL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_resize_success(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolResizeResp	*pr_resp;
+	uuid_t			 exp_uuid;
+
+	setup_resize_drpc_call(&call, TEST_UUID, 2);
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+
+	pr_resp = expect_drpc_resize_resp_with_error(&resp, 0);
+	assert_int_equal(pr_resp->n_tier_bytes, 2);
+	assert_int_equal(pr_resp->n_rank_results, 1);
+	assert_int_equal(pr_resp->rank_results[0]->rank, TEST_RANKS[0]);
+	assert_int_equal(pr_resp->rank_results[0]->status, 0);
+	mgmt__pool_resize_resp__free_unpacked(pr_resp, NULL);
+
+	assert_int_equal(uuid_parse(TEST_UUID, exp_uuid), 0);
+	assert_int_equal(uuid_compare(exp_uuid, ds_mgmt_pool_resize_uuid), 0);
+	assert_int_equal(ds_mgmt_pool_resize_nvme_size, 2000000000);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * dRPC pool reintegrate tests
  */
@@ -2648,6 +2882,90 @@ test_drpc_pool_upgrade_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * Pool undelete test setup
+ */
+static int
+drpc_undelete_setup(void **state)
+{
+	mock_ds_mgmt_pool_reopen_setup();
+	return 0;
+}
+
+/*
+ * dRPC pool undelete tests
+ */
+static void
+setup_undelete_drpc_call(Drpc__Call *call, char *uuid, char *sys_name)
+{
+	Mgmt__PoolUndeleteReq	req = MGMT__POOL_UNDELETE_REQ__INIT;
+	size_t			len;
+	uint8_t			*body;
+
+	req.id = uuid;
+	req.sys = sys_name;
+
+	len = mgmt__pool_undelete_req__get_packed_size(&req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_undelete_req__pack(&req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+test_drpc_pool_undelete_bad_uuid(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_undelete_drpc_call(&call, "BAD", "DaosSys");
+
+	ds_mgmt_drpc_pool_undelete(&call, &resp);
+
+	expect_daos_resp_with_der(&resp, -DER_INVAL);
+	assert_true(uuid_is_null(ds_mgmt_pool_reopen_uuid));
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_undelete_mgmt_svc_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_undelete_drpc_call(&call, TEST_UUID, "DaosSys");
+	ds_mgmt_pool_reopen_return = -DER_MISC;
+
+	ds_mgmt_drpc_pool_undelete(&call, &resp);
+	expect_daos_resp_with_der(&resp, ds_mgmt_pool_reopen_return);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_undelete_success(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	uuid_t		exp_uuid;
+
+	setup_undelete_drpc_call(&call, TEST_UUID, "DaosSys");
+	ds_mgmt_drpc_pool_undelete(&call, &resp);
+
+	expect_daos_resp_with_der(&resp, 0);
+	assert_int_equal(uuid_parse(TEST_UUID, exp_uuid), 0);
+	assert_int_equal(uuid_compare(exp_uuid, ds_mgmt_pool_reopen_uuid), 0);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*/
  * LED manage test setup
  */
@@ -3031,6 +3349,9 @@ test_drpc_check_act_success(void **state)
 #define POOL_EXTEND_TEST(x)	cmocka_unit_test_setup(x, \
 						drpc_pool_extend_setup)
 
+#define POOL_RESIZE_TEST(x)	cmocka_unit_test_setup(x, \
+						drpc_pool_resize_setup)
+
 #define REINT_TEST(x)           cmocka_unit_test(x)
 
 #define POOL_CREATE_TEST(x)	cmocka_unit_test(x)
@@ -3041,6 +3362,9 @@ test_drpc_check_act_success(void **state)
 #define POOL_UPGRADE_TEST(x)	cmocka_unit_test_setup(x, \
 						drpc_upgrade_setup)
 
+#define POOL_UNDELETE_TEST(x)	cmocka_unit_test_setup(x, \
+						drpc_undelete_setup)
+
 #define PING_RANK_TEST(x)	cmocka_unit_test(x)
 
 #define PREP_SHUTDOWN_TEST(x)	cmocka_unit_test(x)
@@ -3092,6 +3416,8 @@ main(void)
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_mgmt_svc_fails),
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_no_containers),
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_with_containers),
+	    LIST_CONT_TEST(test_drpc_pool_list_cont_with_details),
+	    LIST_CONT_TEST(test_drpc_pool_list_cont_details_fail),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_invalid_value_type),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_bad_uuid),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_success),
@@ -3109,6 +3435,10 @@ main(void)
 	    POOL_EXTEND_TEST(test_drpc_extend_bad_uuid),
 	    POOL_EXTEND_TEST(test_drpc_extend_mgmt_svc_fails),
 	    POOL_EXTEND_TEST(test_drpc_extend_success),
+	    POOL_RESIZE_TEST(test_drpc_resize_bad_uuid),
+	    POOL_RESIZE_TEST(test_drpc_resize_no_nvme_tier),
+	    POOL_RESIZE_TEST(test_drpc_resize_mgmt_svc_fails),
+	    POOL_RESIZE_TEST(test_drpc_resize_success),
 	    REINT_TEST(test_drpc_reint_bad_uuid),
 	    QUERY_TEST(test_drpc_pool_query_bad_uuid),
 	    QUERY_TEST(test_drpc_pool_query_mgmt_svc_fails),
@@ -3133,6 +3463,9 @@ main(void)
 	    POOL_UPGRADE_TEST(test_drpc_pool_upgrade_bad_uuid),
 	    POOL_UPGRADE_TEST(test_drpc_pool_upgrade_mgmt_svc_fails),
 	    POOL_UPGRADE_TEST(test_drpc_pool_upgrade_success),
+	    POOL_UNDELETE_TEST(test_drpc_pool_undelete_bad_uuid),
+	    POOL_UNDELETE_TEST(test_drpc_pool_undelete_mgmt_svc_fails),
+	    POOL_UNDELETE_TEST(test_drpc_pool_undelete_success),
 	    LED_MANAGE_TEST(test_drpc_dev_manage_led_bad_tr_addr),
 	    LED_MANAGE_TEST(test_drpc_dev_manage_led_fails),
 	    LED_MANAGE_TEST(test_drpc_dev_manage_led_success),
@@ -77,7 +77,8 @@
 	X(POOL_ACL_DELETE, 0, &CQF_pool_acl_delete, ds_pool_acl_delete_handler, NULL)              \
 	X(POOL_RANKS_GET, 0, &CQF_pool_ranks_get, ds_pool_ranks_get_handler, NULL)                 \
 	X(POOL_UPGRADE, 0, &CQF_pool_upgrade, ds_pool_upgrade_handler, NULL)                       \
-	X(POOL_TGT_DISCARD, 0, &CQF_pool_tgt_discard, ds_pool_tgt_discard_handler, NULL)
+	X(POOL_TGT_DISCARD, 0, &CQF_pool_tgt_discard, ds_pool_tgt_discard_handler, NULL)           \
+	X(POOL_REOPEN, 0, &CQF_pool_reopen, ds_pool_reopen_handler, NULL)
 
 #define POOL_PROTO_RPC_LIST                                                                        \
 	POOL_PROTO_CLI_RPC_LIST(DAOS_POOL_VERSION)                                                 \
@@ -625,6 +626,14 @@ CRT_RPC_DECLARE(pool_evict, DAOS_ISEQ_POOL_EVICT, DAOS_OSEQ_POOL_EVICT)
 
 CRT_RPC_DECLARE(pool_svc_stop, DAOS_ISEQ_POOL_SVC_STOP, DAOS_OSEQ_POOL_SVC_STOP)
 
+#define DAOS_ISEQ_POOL_REOPEN		/* input fields */		 \
+	((struct pool_op_in)		(proi_op)		CRT_VAR)
+
+#define DAOS_OSEQ_POOL_REOPEN		/* output fields */		 \
+	((struct pool_op_out)		(proo_op)		CRT_VAR)
+
+CRT_RPC_DECLARE(pool_reopen, DAOS_ISEQ_POOL_REOPEN, DAOS_OSEQ_POOL_REOPEN)
+
 #define DAOS_ISEQ_POOL_TGT_DISCONNECT	/* input fields */			\
 	((uuid_t)			(tdi_uuid)		CRT_VAR)	\
 	((uuid_t)			(tdi_hdls)		CRT_ARRAY)
@@ -1229,3 +1229,36 @@ dsc_pool_svc_upgrade(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline)
 	D_DEBUG(DB_MGMT, DF_UUID ": Upgrading pool prop\n", DP_UUID(pool_uuid));
 	return dsc_pool_svc_call(pool_uuid, ranks, &pool_upgrade_cbs, NULL /* arg */, deadline);
 }
+
+static int
+pool_reopen_consume(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
+{
+	struct pool_reopen_out *out = crt_reply_get(rpc);
+	int                     rc  = out->proo_op.po_rc;
+
+	if (rc != 0)
+		DL_ERROR(rc, DF_UUID ": failed to reopen pool", DP_UUID(pool_uuid));
+	return rc;
+}
+
+static struct dsc_pool_svc_call_cbs pool_reopen_cbs = {
+	.pscc_op	= POOL_REOPEN,
+	.pscc_init	= NULL,
+	.pscc_consume	= pool_reopen_consume,
+	.pscc_fini	= NULL
+};
+
+/**
+ * Reopen a pool to new connections after a destroy evict, i.e. when the
+ * destroy of the pool has been cancelled.
+ *
+ * \param[in]	pool_uuid	UUID of the pool
+ * \param[in]	ranks		Pool service replicas
+ * \param[in]	deadline	Unix time deadline in milliseconds
+ */
+int
+dsc_pool_svc_reopen(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline)
+{
+	D_DEBUG(DB_MGMT, DF_UUID ": Reopening pool\n", DP_UUID(pool_uuid));
+	return dsc_pool_svc_call(pool_uuid, ranks, &pool_reopen_cbs, NULL /* arg */, deadline);
+}
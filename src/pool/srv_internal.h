@@ -180,6 +180,7 @@ void
      ds_pool_update_handler(crt_rpc_t *rpc);
 void ds_pool_extend_handler(crt_rpc_t *rpc);
 void ds_pool_evict_handler(crt_rpc_t *rpc);
+void ds_pool_reopen_handler(crt_rpc_t *rpc);
 void
 ds_pool_svc_stop_handler(crt_rpc_t *rpc);
 void
@@ -7920,6 +7920,70 @@ out:
 	crt_reply_send(rpc);
 }
 
+/*
+ * Undo the closing of the pool to new connections by a destroy evict (see
+ * ds_pool_evict_handler) when the destroy of the pool has been cancelled.
+ */
+void
+ds_pool_reopen_handler(crt_rpc_t *rpc)
+{
+	struct pool_reopen_in  *in  = crt_req_get(rpc);
+	struct pool_reopen_out *out = crt_reply_get(rpc);
+	struct pool_svc        *svc;
+	struct rdb_tx           tx;
+	uuid_t                  pool_hdl_uuid;
+	uuid_t                  cont_hdl_uuid;
+	int                     rc;
+
+	D_DEBUG(DB_MD, DF_UUID ": processing rpc %p\n", DP_UUID(in->proi_op.pi_uuid), rpc);
+
+	rc = pool_svc_lookup_leader(in->proi_op.pi_uuid, &svc, &out->proo_op.po_hint);
+	if (rc != 0)
+		goto out;
+
+	rc = rdb_tx_begin(svc->ps_rsvc.s_db, svc->ps_rsvc.s_term, &tx);
+	if (rc != 0)
+		goto out_svc;
+
+	ABT_rwlock_wrlock(svc->ps_lock);
+	rc = ds_pool_mark_connectable_internal(&tx, svc);
+	if (rc > 0)
+		rc = rdb_tx_commit(&tx);
+	ABT_rwlock_unlock(svc->ps_lock);
+	rdb_tx_end(&tx);
+	if (rc != 0)
+		goto out_svc;
+
+	/*
+	 * The destroy evict stopped the IV leader and invalidated the server
+	 * handles, restore them as done when stepping up.
+	 */
+	ds_iv_ns_leader_resume(svc->ps_pool->sp_iv_ns);
+	if (!uuid_is_null(svc->ps_pool->sp_srv_cont_hdl)) {
+		uuid_copy(pool_hdl_uuid, svc->ps_pool->sp_srv_pool_hdl);
+		uuid_copy(cont_hdl_uuid, svc->ps_pool->sp_srv_cont_hdl);
+	} else {
+		uuid_generate(pool_hdl_uuid);
+		uuid_generate(cont_hdl_uuid);
+		uuid_copy(svc->ps_pool->sp_srv_pool_hdl, pool_hdl_uuid);
+	}
+	rc = ds_pool_iv_srv_hdl_update(svc->ps_pool, pool_hdl_uuid, cont_hdl_uuid);
+	if (rc != 0)
+		DL_ERROR(rc, DF_UUID ": ds_pool_iv_srv_hdl_update failed", DP_UUID(svc->ps_uuid));
+	else
+		D_DEBUG(DB_MD, DF_UUID ": pool reopened for new connections\n",
+			DP_UUID(svc->ps_uuid));
+
+out_svc:
+	ds_rsvc_set_hint(&svc->ps_rsvc, &out->proo_op.po_hint);
+	pool_svc_put_leader(svc);
+out:
+	out->proo_op.po_rc = rc;
+	D_DEBUG(DB_MD, DF_UUID ": replying rpc: %p " DF_RC "\n", DP_UUID(in->proi_op.pi_uuid), rpc,
+		DP_RC(rc));
+	crt_reply_send(rpc);
+}
+
 /*
  * Transfer list of pool ranks to "remote_bulk". If the remote bulk buffer
  * is too small, then return -DER_TRUNC. RPC response will contain the number
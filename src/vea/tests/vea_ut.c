@@ -785,6 +785,54 @@ ut_reserve_special(void **state)
 	ut_teardown(&args);
 }
 
+static void
+ut_extend(void **state)
+{
+	/* Use a temporary device instead of the main one the other tests use */
+	struct vea_ut_args args;
+	struct vea_unmap_context unmap_ctxt = { 0 };
+	struct vea_attr attr;
+	struct vea_stat stat;
+	uint32_t hdr_blks = 1;
+	uint32_t blk_sz = 0; /* use the default size */
+	uint64_t capacity = 1UL << 30; /* 1GB, 0.25M 4k blocks in total */
+	uint64_t tot_blks;
+	int rc;
+
+	ut_setup(&args);
+
+	rc = vea_format(&args.vua_umm, &args.vua_txd, args.vua_md, blk_sz,
+			hdr_blks, capacity, NULL, NULL, false, VEA_COMPAT_MASK);
+	assert_rc_equal(rc, 0);
+
+	rc = vea_load(&args.vua_umm, &args.vua_txd, args.vua_md, &unmap_ctxt,
+		      NULL, &args.vua_vsi);
+	assert_rc_equal(rc, 0);
+
+	print_message("extend to the same capacity\n");
+	rc = vea_extend(args.vua_vsi, capacity);
+	assert_rc_equal(rc, 0);
+
+	print_message("shrink the capacity\n");
+	rc = vea_extend(args.vua_vsi, capacity / 2);
+	assert_rc_equal(rc, -DER_NOTSUPPORTED);
+
+	print_message("extend the capacity\n");
+	rc = vea_extend(args.vua_vsi, capacity * 2);
+	assert_rc_equal(rc, 0);
+
+	rc = vea_query(args.vua_vsi, &attr, &stat);
+	assert_rc_equal(rc, 0);
+
+	/* The added blocks are merged with the initial free extent */
+	tot_blks = (capacity * 2) / (1 << 12) - hdr_blks;
+	assert_int_equal(attr.va_tot_blks, tot_blks);
+	assert_int_equal(stat.vs_free_persistent, tot_blks);
+
+	vea_unload(args.vua_vsi);
+	ut_teardown(&args);
+}
+
 static void
 ut_inval_params_format(void **state)
 {
@@ -1600,6 +1648,7 @@ static const struct CMUnitTest vea_uts[] = {
 	{ "vea_tx_publish_bitmap", ut_tx_publish, NULL, NULL},
 	{ "vea_free_bitmap", ut_free_bitmap, NULL, NULL},
 	{ "vea_reserve_special", ut_reserve_special, NULL, NULL},
+	{ "vea_extend", ut_extend, NULL, NULL},
 	{ "vea_inval_params_format", ut_inval_params_format, NULL, NULL},
 	{ "vea_inval_params_load", ut_inval_params_load, NULL, NULL},
 	{ "vea_inval_param_reserve", ut_inval_params_reserve, NULL, NULL},
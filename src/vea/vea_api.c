@@ -252,6 +252,66 @@ out:
 	return rc ? umem_tx_abort(umem, rc) : umem_tx_commit(umem);
 }
 
+/*
+ * Grow the space to the new capacity, the blocks added at the end of the
+ * space are freed and become available for allocation once aged.
+ */
+int
+vea_extend(struct vea_space_info *vsi, uint64_t capacity)
+{
+	struct vea_space_df	*md;
+	struct umem_instance	*umem;
+	uint64_t		 tot_blks;
+	uint64_t		 blk_off;
+	uint64_t		 blk_cnt;
+	int			 rc;
+
+	D_ASSERT(vsi != NULL);
+	md = vsi->vsi_md;
+	umem = vsi->vsi_umem;
+
+	tot_blks = capacity / md->vsd_blk_sz;
+	if (tot_blks <= md->vsd_hdr_blks)
+		return -DER_INVAL;
+	tot_blks -= md->vsd_hdr_blks;
+
+	if (tot_blks == md->vsd_tot_blks)
+		return 0;
+
+	if (tot_blks < md->vsd_tot_blks) {
+		D_ERROR("Shrinking space from "DF_U64" to "DF_U64" blocks isn't supported.\n",
+			md->vsd_tot_blks, tot_blks);
+		return -DER_NOTSUPPORTED;
+	}
+
+	/* Same limitation as vea_format(), see comments there */
+	if (tot_blks > UINT32_MAX) {
+		D_ERROR("Capacity "DF_U64" is too large.\n", capacity);
+		return -DER_INVAL;
+	}
+
+	blk_off = md->vsd_hdr_blks + md->vsd_tot_blks;
+	blk_cnt = tot_blks - md->vsd_tot_blks;
+
+	rc = umem_tx_begin(umem, vsi->vsi_txd);
+	if (rc != 0)
+		return rc;
+
+	rc = umem_tx_add_ptr(umem, &md->vsd_tot_blks, sizeof(md->vsd_tot_blks));
+	if (rc != 0)
+		goto out;
+
+	md->vsd_tot_blks = tot_blks;
+
+	rc = vea_free(vsi, blk_off, blk_cnt);
+	if (rc != 0)
+		D_ERROR("Failed to free extended extent ["DF_U64", "DF_U64"]. "DF_RC"\n",
+			blk_off, blk_cnt, DP_RC(rc));
+out:
+	/* Commit/Abort transaction on success/error */
+	return rc ? umem_tx_abort(umem, rc) : umem_tx_commit(umem);
+}
+
 static int
 destroy_free_bitmap_agg(daos_handle_t ih, d_iov_t *key, d_iov_t *val, void *arg)
 {
@@ -532,6 +532,63 @@ pool_interop_create_old(void **state)
 	assert_rc_equal(ret, 0);
 }
 
+static void
+pool_resize_test(void **state)
+{
+	struct vp_test_args	*arg = *state;
+	vos_pool_info_t		 pinfo;
+	uuid_t			 uuid;
+	daos_handle_t		 poh;
+	int			 ret;
+
+	uuid_generate(uuid);
+
+	print_message("resize shall fail on pool without NVMe space\n");
+	ret = vos_pool_create(arg->fname[0], uuid, VPOOL_256M, 0 /* data_sz */, 0 /* meta_sz */,
+			      0 /* flags */, 0 /* version */, &poh);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_resize(poh, 0);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_resize(poh, VPOOL_1G);
+	assert_rc_equal(ret, -DER_NOTSUPPORTED);
+	ret = vos_pool_close(poh);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_destroy(arg->fname[0], uuid);
+	assert_rc_equal(ret, 0);
+
+	if (!bio_nvme_configured(SMD_DEV_TYPE_DATA)) {
+		print_message("NVMe data device required for grow, skipping...\n");
+		return;
+	}
+
+	print_message("resize shall grow NVMe space only\n");
+	ret = vos_pool_create(arg->fname[0], uuid, VPOOL_256M, VPOOL_1G /* data_sz */,
+			      0 /* meta_sz */, 0 /* flags */, 0 /* version */, &poh);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_resize(poh, VPOOL_1G);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_resize(poh, VPOOL_256M);
+	assert_rc_equal(ret, -DER_NOTSUPPORTED);
+	ret = vos_pool_resize(poh, VPOOL_2G);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_query(poh, &pinfo);
+	assert_rc_equal(ret, 0);
+	assert_int_equal(NVME_TOTAL(&pinfo.pif_space), VPOOL_2G);
+	ret = vos_pool_close(poh);
+	assert_rc_equal(ret, 0);
+
+	print_message("grown NVMe space shall persist across reopen\n");
+	ret = vos_pool_open(arg->fname[0], uuid, 0, &poh);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_query(poh, &pinfo);
+	assert_rc_equal(ret, 0);
+	assert_int_equal(NVME_TOTAL(&pinfo.pif_space), VPOOL_2G);
+	ret = vos_pool_close(poh);
+	assert_rc_equal(ret, 0);
+	ret = vos_pool_destroy(arg->fname[0], uuid);
+	assert_rc_equal(ret, 0);
+}
+
 static const struct CMUnitTest pool_tests[] = {
 	{ "VOS1: Create Pool with existing files (File Count no:of cpus)",
 		pool_ops_run, pool_create_exists, pool_unit_teardown},
@@ -557,6 +614,8 @@ static const struct CMUnitTest pool_tests[] = {
 		pool_file_setup, pool_file_destroy},
 	{ "VOS12: Create pool with old DF version", pool_interop_create_old,
 		pool_file_setup, pool_file_destroy},
+	{ "VOS13: Pool resize", pool_resize_test,
+		pool_file_setup, pool_file_destroy},
 };
 
 int
@@ -871,6 +871,57 @@ wal_ut_holes(void **state)
 	ut_mc_fini(args);
 }
 
+static void
+ut_data_blob_resize(void **state)
+{
+	struct bio_ut_args	*args = *state;
+	struct bio_io_context	*ioc;
+	struct smd_pool_info	*pool_info;
+	uint64_t		 meta_sz = (128ULL << 20);	/* 128 MB */
+	int			 rc;
+
+	if (!bio_nvme_configured(SMD_DEV_TYPE_DATA)) {
+		print_message("NVMe data device required for test, skipping...\n");
+		skip();
+	}
+
+	rc = ut_mc_init(args, meta_sz, meta_sz, meta_sz);
+	assert_rc_equal(rc, 0);
+
+	ioc = bio_mc2ioc(args->bua_mc, SMD_DEV_TYPE_DATA);
+	assert_non_null(ioc);
+
+	/* Same size is a no-op */
+	rc = bio_blob_resize(ioc, meta_sz);
+	assert_rc_equal(rc, 0);
+
+	/* Shrinking isn't supported */
+	rc = bio_blob_resize(ioc, meta_sz / 2);
+	assert_rc_equal(rc, -DER_NOTSUPPORTED);
+
+	rc = bio_blob_resize(ioc, meta_sz * 2);
+	assert_rc_equal(rc, 0);
+
+	/* The new size is recorded in SMD */
+	rc = smd_pool_get_info(args->bua_pool_id, &pool_info);
+	assert_rc_equal(rc, 0);
+	assert_int_equal(pool_info->spi_blob_sz[SMD_DEV_TYPE_DATA], meta_sz * 2);
+	smd_pool_free_info(pool_info);
+
+	/* The grown blob survives a reopen */
+	rc = bio_mc_close(args->bua_mc);
+	assert_rc_equal(rc, 0);
+
+	rc = bio_mc_open(args->bua_xs_ctxt, args->bua_pool_id, 0, &args->bua_mc);
+	assert_rc_equal(rc, 0);
+
+	ioc = bio_mc2ioc(args->bua_mc, SMD_DEV_TYPE_DATA);
+	rc = bio_blob_resize(ioc, meta_sz * 2);
+	assert_rc_equal(rc, 0);
+
+	ut_mc_fini(args);
+}
+
 static const struct CMUnitTest wal_uts[] = {
 	{ "single tx commit/replay", wal_ut_single, NULL, NULL},
 	{ "single tx with many acts", wal_ut_many_acts, NULL, NULL},
@@ -880,6 +931,7 @@ static const struct CMUnitTest wal_uts[] = {
 	{ "wal log wraps once", wal_ut_wrap, NULL, NULL},
 	{ "wal log wraps many", wal_ut_wrap_many, NULL, NULL},
 	{ "holes on replay", wal_ut_holes, NULL, NULL},
+	{ "data blob resize", ut_data_blob_resize, NULL, NULL},
 };
 
 static int
@@ -1911,6 +1911,60 @@ end:
 	return 0;
 }
 
+int
+vos_pool_resize(daos_handle_t poh, daos_size_t nvme_sz)
+{
+	struct vos_pool    *pool;
+	struct vos_pool_df *pool_df;
+	int                 rc;
+
+	pool = vos_hdl2pool(poh);
+	D_ASSERT(pool != NULL);
+
+	pool_df = pool->vp_pool_df;
+
+	if (nvme_sz == pool_df->pd_nvme_sz)
+		return 0;
+
+	if (pool->vp_vea_info == NULL) {
+		D_ERROR(DF_UUID ": Pool without NVMe space can't be resized\n",
+			DP_UUID(pool->vp_id));
+		return -DER_NOTSUPPORTED;
+	}
+
+	if (nvme_sz < pool_df->pd_nvme_sz) {
+		D_ERROR(DF_UUID ": Shrinking NVMe space from " DF_U64 " to " DF_U64
+				" isn't supported\n",
+			DP_UUID(pool->vp_id), pool_df->pd_nvme_sz, nvme_sz);
+		return -DER_NOTSUPPORTED;
+	}
+
+	D_INFO(DF_UUID ": Growing NVMe space from " DF_U64 " to " DF_U64 "\n",
+	       DP_UUID(pool->vp_id), pool_df->pd_nvme_sz, nvme_sz);
+
+	/*
+	 * Grow the blob first, the space isn't visible to allocator until the
+	 * VEA is extended, and a larger blob is harmless if the pool isn't.
+	 */
+	rc = bio_blob_resize(vos_data_ioctxt(pool), nvme_sz);
+	if (rc != 0)
+		return rc;
+
+	rc = umem_tx_begin(&pool->vp_umm, NULL);
+	if (rc != 0)
+		return rc;
+
+	rc = umem_tx_add_ptr(&pool->vp_umm, &pool_df->pd_nvme_sz, sizeof(pool_df->pd_nvme_sz));
+	if (rc != 0)
+		goto end;
+
+	pool_df->pd_nvme_sz = nvme_sz;
+
+	rc = vea_extend(pool->vp_vea_info, nvme_sz);
+end:
+	return umem_tx_end(&pool->vp_umm, rc);
+}
+
 /**
  * Close a VOSP, all opened containers sharing this pool handle
  * will be revoked.